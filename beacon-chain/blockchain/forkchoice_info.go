@@ -0,0 +1,273 @@
+package blockchain
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/emicklei/dot"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// forkChoicePage draws the fork choice tree served by the JSON endpoint next to it, with slots from
+// left to right, and fetches it again after the start of every slot. It loads no external scripts.
+const forkChoicePage = `<html>
+<head>
+    <title>Fork choice</title>
+    <style>
+        body { font-family: monospace; font-size: 12px; }
+        rect { fill: white; stroke: black; }
+        rect.head { stroke: green; stroke-width: 3; }
+        rect.nonviable { stroke: grey; fill: #eee; }
+        rect.pruned, line.pruned { stroke-dasharray: 4; }
+        line { stroke: black; }
+        line.best { stroke: green; }
+    </style>
+</head>
+<body>
+    <div id="status"></div>
+    <div id="tree"></div>
+    <script type="application/javascript">
+        var boxWidth = 110, boxHeight = 60, slotWidth = 140, laneHeight = 80;
+
+        function short(root) { return root.substring(0, 14); }
+
+        function draw(tree) {
+            var nodes = tree.nodes, byRoot = {}, children = {}, minSlot = Infinity;
+            nodes.forEach(function(n) {
+                byRoot[n.root] = n;
+                minSlot = Math.min(minSlot, n.slot);
+            });
+            nodes.forEach(function(n) {
+                if (byRoot[n.parent_root]) {
+                    (children[n.parent_root] = children[n.parent_root] || []).push(n);
+                }
+            });
+            // Lay out every branch in its own lane, the best child staying in the lane of its parent.
+            var lanes = {}, nextLane = 0;
+            function place(n, lane) {
+                lanes[n.root] = lane;
+                var kids = (children[n.root] || []).sort(function(a, b) {
+                    return (b.root === n.best_child) - (a.root === n.best_child) || b.weight - a.weight;
+                });
+                kids.forEach(function(k, i) { place(k, i === 0 ? lane : nextLane++); });
+            }
+            nodes.forEach(function(n) {
+                if (!byRoot[n.parent_root]) { place(n, nextLane++); }
+            });
+
+            var svg = [];
+            nodes.forEach(function(n) {
+                var p = byRoot[n.parent_root];
+                if (!p) { return; }
+                var cls = (p.best_child === n.root ? 'best ' : '') + (n.pruned ? 'pruned' : '');
+                svg.push('<line class="' + cls + '" x1="' + x(p) + '" y1="' + (y(p) + boxHeight / 2) +
+                    '" x2="' + x(n) + '" y2="' + (y(n) + boxHeight / 2) + '"/>');
+            });
+            nodes.forEach(function(n) {
+                var cls = n.root === tree.head_root ? 'head' : (n.viable ? '' : 'nonviable');
+                if (n.pruned) { cls += ' pruned'; }
+                svg.push('<g><title>' + n.root + '\nparent: ' + n.parent_root + '\nbest child: ' + n.best_child +
+                    '\nbest descendant: ' + n.best_descendant + '\ngraffiti: ' + n.graffiti + '</title>' +
+                    '<rect class="' + cls + '" x="' + x(n) + '" y="' + y(n) + '" width="' + boxWidth + '" height="' + boxHeight + '"/>' +
+                    text(n, 0, 'slot ' + n.slot + ' ' + short(n.root)) +
+                    text(n, 1, 'weight ' + Math.floor(n.weight / 1e9)) +
+                    text(n, 2, 'j ' + n.justified_epoch + ' f ' + n.finalized_epoch) + '</g>');
+            });
+            var width = 0, height = 0;
+            nodes.forEach(function(n) {
+                width = Math.max(width, x(n) + boxWidth + 10);
+                height = Math.max(height, y(n) + boxHeight + 10);
+            });
+            document.getElementById('tree').innerHTML =
+                '<svg width="' + width + '" height="' + height + '">' + svg.join('') + '</svg>';
+            document.getElementById('status').textContent = 'head ' + short(tree.head_root) +
+                ' justified epoch ' + tree.justified_epoch + ' finalized epoch ' + tree.finalized_epoch +
+                ' nodes ' + nodes.length + ' updated ' + new Date().toLocaleTimeString();
+
+            function x(n) { return 10 + (n.slot - minSlot) * slotWidth; }
+            function y(n) { return 10 + lanes[n.root] * laneHeight; }
+            function text(n, line, s) {
+                return '<text x="' + (x(n) + 4) + '" y="' + (y(n) + 16 + line * 16) + '">' + s + '</text>';
+            }
+        }
+
+        function refresh() {
+            var delay = 12000;
+            fetch(window.location.pathname.replace(/\/?$/, '/json') + window.location.search)
+                .then(function(res) { return res.json(); })
+                .then(function(tree) {
+                    draw(tree);
+                    // Redraw shortly after the start of the next slot, once its block is likely in.
+                    var slotMs = tree.seconds_per_slot * 1000;
+                    if (tree.genesis_time > 0 && slotMs > 0) {
+                        delay = slotMs - (Date.now() - tree.genesis_time * 1000) % slotMs + 1000;
+                    }
+                })
+                .catch(function(err) {
+                    document.getElementById('status').textContent = 'Could not fetch fork choice: ' + err;
+                })
+                .then(function() { setTimeout(refresh, delay); });
+        }
+        refresh();
+    </script>
+</body>
+</html>`
+
+// forkChoiceTree is the fork choice store as served by the debug endpoints.
+type forkChoiceTree struct {
+	GenesisTime    uint64                `json:"genesis_time"`
+	SecondsPerSlot uint64                `json:"seconds_per_slot"`
+	HeadRoot       string                `json:"head_root"`
+	JustifiedEpoch types.Epoch           `json:"justified_epoch"`
+	FinalizedEpoch types.Epoch           `json:"finalized_epoch"`
+	FinalizedRoot  string                `json:"finalized_root"`
+	Nodes          []*forkChoiceTreeNode `json:"nodes"`
+}
+
+// forkChoiceTreeNode is a block node of the fork choice store. Roots which are not in the store are
+// left empty.
+type forkChoiceTreeNode struct {
+	Slot           types.Slot  `json:"slot"`
+	Root           string      `json:"root"`
+	ParentRoot     string      `json:"parent_root"`
+	JustifiedEpoch types.Epoch `json:"justified_epoch"`
+	FinalizedEpoch types.Epoch `json:"finalized_epoch"`
+	Weight         uint64      `json:"weight"`
+	BestChild      string      `json:"best_child"`
+	BestDescendant string      `json:"best_descendant"`
+	Graffiti       string      `json:"graffiti"`
+	Viable         bool        `json:"viable"`
+	Pruned         bool        `json:"pruned"`
+}
+
+// ForkChoiceHandler serves a page drawing the fork choice store, which redraws it every slot.
+// Recently pruned nodes are drawn with ?pruned=true.
+func (s *Service) ForkChoiceHandler(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/html")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write([]byte(forkChoicePage)); err != nil {
+		log.WithError(err).Error("Failed to render fork choice page")
+	}
+}
+
+// ForkChoiceJSONHandler serves the fork choice store as JSON. Recently pruned nodes are included
+// with ?pruned=true.
+func (s *Service) ForkChoiceJSONHandler(w http.ResponseWriter, r *http.Request) {
+	tree, err := s.forkChoiceTree(r)
+	if err != nil {
+		log.WithError(err).Error("Could not get fork choice tree")
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(tree); err != nil {
+		log.WithError(err).Error("Failed to render fork choice tree")
+	}
+}
+
+// ForkChoiceDotHandler serves the fork choice store as a graphviz DOT graph. Recently pruned nodes
+// are included with ?pruned=true.
+func (s *Service) ForkChoiceDotHandler(w http.ResponseWriter, r *http.Request) {
+	tree, err := s.forkChoiceTree(r)
+	if err != nil {
+		log.WithError(err).Error("Could not get fork choice tree")
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/vnd.graphviz")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write([]byte(forkChoiceDot(tree).String())); err != nil {
+		log.WithError(err).Error("Failed to render fork choice tree")
+	}
+}
+
+// forkChoiceTree copies the fork choice store for the debug endpoints.
+func (s *Service) forkChoiceTree(r *http.Request) (*forkChoiceTree, error) {
+	headRoot, err := s.HeadRoot(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	snapshot := s.cfg.ForkChoiceStore.Snapshot(r.URL.Query().Get("pruned") == "true")
+	tree := &forkChoiceTree{
+		SecondsPerSlot: params.BeaconConfig().SecondsPerSlot,
+		HeadRoot:       fmt.Sprintf("%#x", headRoot),
+		JustifiedEpoch: snapshot.JustifiedEpoch,
+		FinalizedEpoch: snapshot.FinalizedEpoch,
+		FinalizedRoot:  fmt.Sprintf("%#x", snapshot.FinalizedRoot),
+		Nodes:          make([]*forkChoiceTreeNode, len(snapshot.Nodes)),
+	}
+	if !s.genesisTime.IsZero() {
+		tree.GenesisTime = uint64(s.genesisTime.Unix())
+	}
+	for i, n := range snapshot.Nodes {
+		tree.Nodes[i] = &forkChoiceTreeNode{
+			Slot:           n.Slot,
+			Root:           fmt.Sprintf("%#x", n.Root),
+			ParentRoot:     rootString(n.Parent),
+			JustifiedEpoch: n.JustifiedEpoch,
+			FinalizedEpoch: n.FinalizedEpoch,
+			Weight:         n.Weight,
+			BestChild:      rootString(n.BestChild),
+			BestDescendant: rootString(n.BestDescendant),
+			Graffiti:       fmt.Sprintf("%#x", n.Graffiti),
+			Viable:         n.Viable,
+			Pruned:         n.Pruned,
+		}
+	}
+	return tree, nil
+}
+
+// forkChoiceDot draws the fork choice tree as a graph from the children to their parents. The head is
+// green, nodes which are not viable for head are grey and pruned nodes are dashed.
+func forkChoiceDot(tree *forkChoiceTree) *dot.Graph {
+	graph := dot.NewGraph(dot.Directed)
+	graph.Attr("rankdir", "RL")
+	graph.Attr("labeljust", "l")
+
+	dotNodes := make(map[string]dot.Node, len(tree.Nodes))
+	for _, n := range tree.Nodes {
+		label := fmt.Sprintf("slot: %d\nroot: %s\nweight: %d\njustified: %d\nfinalized: %d",
+			n.Slot, shortRoot(n.Root), n.Weight/params.BeaconConfig().GweiPerEth, n.JustifiedEpoch, n.FinalizedEpoch)
+		dotN := graph.Node(n.Root).Box().Attr("label", label)
+		switch {
+		case n.Root == tree.HeadRoot:
+			dotN = dotN.Attr("color", "green")
+		case !n.Viable:
+			dotN = dotN.Attr("color", "grey")
+		}
+		if n.Pruned {
+			dotN = dotN.Attr("style", "dashed")
+		}
+		dotNodes[n.Root] = dotN
+	}
+	for _, n := range tree.Nodes {
+		parent, ok := dotNodes[n.ParentRoot]
+		if !ok {
+			continue
+		}
+		edge := graph.Edge(dotNodes[n.Root], parent)
+		if n.Pruned {
+			edge.Attr("style", "dashed")
+		}
+	}
+	return graph
+}
+
+// rootString writes a root in hex, or an empty string for a zero root.
+func rootString(root [32]byte) string {
+	if root == params.BeaconConfig().ZeroHash {
+		return ""
+	}
+	return fmt.Sprintf("%#x", root)
+}
+
+// shortRoot truncates a root written in hex to its first 6 bytes, as bytesutil.Trunc does.
+func shortRoot(root string) string {
+	if len(root) > len("0x")+2*6 {
+		return root[:len("0x")+2*6]
+	}
+	return root
+}
@@ -0,0 +1,86 @@
+package blockchain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/proto/eth/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func setupForkChoiceInfoService(t *testing.T) *Service {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	headState, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	cfg := &Config{
+		BeaconDB: beaconDB,
+		ForkChoiceStore: protoarray.New(
+			0, // justifiedEpoch
+			0, // finalizedEpoch
+			[32]byte{'a'},
+		),
+		StateGen: stategen.New(beaconDB),
+	}
+	s, err := NewService(ctx, cfg)
+	require.NoError(t, err)
+	require.NoError(t, s.cfg.ForkChoiceStore.ProcessBlock(ctx, 0, [32]byte{'a'}, [32]byte{'g'}, [32]byte{'c'}, 0, 0))
+	require.NoError(t, s.cfg.ForkChoiceStore.ProcessBlock(ctx, 1, [32]byte{'b'}, [32]byte{'a'}, [32]byte{'c'}, 0, 0))
+	require.NoError(t, s.cfg.ForkChoiceStore.ProcessBlock(ctx, 1, [32]byte{'c'}, [32]byte{'a'}, [32]byte{'c'}, 0, 0))
+	s.setHead([32]byte{'b'}, wrapper.WrappedPhase0SignedBeaconBlock(testutil.NewBeaconBlock()), headState)
+	return s
+}
+
+func TestService_ForkChoiceJSONHandler(t *testing.T) {
+	s := setupForkChoiceInfoService(t)
+	req, err := http.NewRequest("GET", "/forkchoice/json", nil)
+	require.NoError(t, err)
+	rr := httptest.NewRecorder()
+	http.HandlerFunc(s.ForkChoiceJSONHandler).ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+
+	tree := &forkChoiceTree{}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), tree))
+	assert.Equal(t, fmt.Sprintf("%#x", [32]byte{'b'}), tree.HeadRoot)
+	require.Equal(t, 3, len(tree.Nodes))
+	assert.Equal(t, "", tree.Nodes[0].ParentRoot)
+	assert.Equal(t, fmt.Sprintf("%#x", [32]byte{'a'}), tree.Nodes[1].ParentRoot)
+	assert.Equal(t, true, tree.Nodes[1].Viable)
+	assert.Equal(t, false, tree.Nodes[1].Pruned)
+}
+
+func TestService_ForkChoiceDotHandler(t *testing.T) {
+	s := setupForkChoiceInfoService(t)
+	req, err := http.NewRequest("GET", "/forkchoice/dot?pruned=true", nil)
+	require.NoError(t, err)
+	rr := httptest.NewRecorder()
+	http.HandlerFunc(s.ForkChoiceDotHandler).ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	graph := rr.Body.String()
+	assert.Equal(t, true, strings.HasPrefix(graph, "digraph"), "Not a DOT graph: %s", graph)
+	assert.Equal(t, 2, strings.Count(graph, "->"), "Wrong number of edges")
+	assert.Equal(t, 1, strings.Count(graph, "green"), "Head is not highlighted")
+}
+
+func TestService_ForkChoiceHandler(t *testing.T) {
+	s := setupForkChoiceInfoService(t)
+	req, err := http.NewRequest("GET", "/forkchoice", nil)
+	require.NoError(t, err)
+	rr := httptest.NewRecorder()
+	http.HandlerFunc(s.ForkChoiceHandler).ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, true, strings.Contains(rr.Body.String(), "/json"))
+	assert.Equal(t, false, strings.Contains(rr.Body.String(), "<script src"), "Page loads external scripts")
+}
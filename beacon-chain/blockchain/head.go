@@ -129,12 +129,16 @@ func (s *Service) saveHead(ctx context.Context, headRoot [32]byte) error {
 			"newSlot": fmt.Sprintf("%d", newHeadSlot),
 			"oldSlot": fmt.Sprintf("%d", headSlot),
 		}).Debug("Chain reorg occurred")
-		absoluteSlotDifference := slotutil.AbsoluteValueSlotDifference(newHeadSlot, headSlot)
+		// The depth of the reorg is the number of slots of the old chain which are no longer canonical.
+		depth := slotutil.AbsoluteValueSlotDifference(newHeadSlot, headSlot)
+		if _, commonSlot, err := s.cfg.ForkChoiceStore.CommonAncestorRoot(ctx, oldHeadRoot, headRoot); err == nil && commonSlot <= headSlot {
+			depth = uint64(headSlot - commonSlot)
+		}
 		s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
 			Type: statefeed.Reorg,
 			Data: &ethpbv1.EventChainReorg{
 				Slot:         newHeadSlot,
-				Depth:        absoluteSlotDifference,
+				Depth:        depth,
 				OldHeadBlock: oldHeadRoot[:],
 				NewHeadBlock: headRoot[:],
 				OldHeadState: oldStateRoot,
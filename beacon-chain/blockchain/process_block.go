@@ -200,73 +200,117 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []interfaces.SignedBeac
 	ctx, span := trace.StartSpan(ctx, "blockChain.onBlockBatch")
 	defer span.End()
 
+	batch, err := s.executeBlockBatch(ctx, nil, blks, blockRoots)
+	if err != nil {
+		return nil, nil, err
+	}
+	verify, err := batch.SignatureSet.Verify()
+	if err != nil {
+		return nil, nil, err
+	}
+	if !verify {
+		return nil, nil, errors.New("batch block signature verification failed")
+	}
+	if err := s.saveBlockBatchStates(ctx, batch); err != nil {
+		return nil, nil, err
+	}
+	return batch.fCheckpoints, batch.jCheckpoints, nil
+}
+
+// executeBlockBatch transitions the state through a linear batch of blocks without verifying any
+// of their signatures, which are collected into the signature set of the returned batch instead.
+// The pre state is the post state of the parent batch if one is given, or else the state of the
+// first block's parent root.
+func (s *Service) executeBlockBatch(ctx context.Context, parent *BlockBatch, blks []interfaces.SignedBeaconBlock,
+	blockRoots [][32]byte) (*BlockBatch, error) {
+	ctx, span := trace.StartSpan(ctx, "blockChain.executeBlockBatch")
+	defer span.End()
+
 	if len(blks) == 0 || len(blockRoots) == 0 {
-		return nil, nil, errors.New("no blocks provided")
+		return nil, errors.New("no blocks provided")
+	}
+	if len(blks) != len(blockRoots) {
+		return nil, errors.New("mismatched number of blocks and block roots")
 	}
 	if blks[0] == nil || blks[0].IsNil() || blks[0].Block().IsNil() {
-		return nil, nil, errors.New("nil block")
+		return nil, errors.New("nil block")
 	}
 	b := blks[0].Block()
 
-	// Retrieve incoming block's pre state.
-	if err := s.verifyBlkPreState(ctx, b); err != nil {
-		return nil, nil, err
-	}
-	preState, err := s.cfg.StateGen.StateByRootInitialSync(ctx, bytesutil.ToBytes32(b.ParentRoot()))
-	if err != nil {
-		return nil, nil, err
+	var preState iface.BeaconState
+	if parent != nil {
+		if parent.postState == nil || len(parent.Roots) == 0 {
+			return nil, errors.New("parent batch has not been executed")
+		}
+		lastRoot := parent.Roots[len(parent.Roots)-1]
+		if bytesutil.ToBytes32(b.ParentRoot()) != lastRoot {
+			return nil, fmt.Errorf("expected batch with parent root of %#x but received %#x", lastRoot, b.ParentRoot())
+		}
+		preState = parent.postState.Copy()
+	} else {
+		// Retrieve incoming block's pre state.
+		if err := s.verifyBlkPreState(ctx, b); err != nil {
+			return nil, err
+		}
+		var err error
+		preState, err = s.cfg.StateGen.StateByRootInitialSync(ctx, bytesutil.ToBytes32(b.ParentRoot()))
+		if err != nil {
+			return nil, err
+		}
 	}
 	if preState == nil || preState.IsNil() {
-		return nil, nil, fmt.Errorf("nil pre state for slot %d", b.Slot())
+		return nil, fmt.Errorf("nil pre state for slot %d", b.Slot())
 	}
 
-	jCheckpoints := make([]*ethpb.Checkpoint, len(blks))
-	fCheckpoints := make([]*ethpb.Checkpoint, len(blks))
-	sigSet := &bls.SignatureSet{
-		Signatures: [][]byte{},
-		PublicKeys: []bls.PublicKey{},
-		Messages:   [][32]byte{},
+	batch := &BlockBatch{
+		Blocks: blks,
+		Roots:  blockRoots,
+		SignatureSet: &bls.SignatureSet{
+			Signatures: [][]byte{},
+			PublicKeys: []bls.PublicKey{},
+			Messages:   [][32]byte{},
+		},
+		boundaries:   make(map[[32]byte]iface.BeaconState),
+		jCheckpoints: make([]*ethpb.Checkpoint, len(blks)),
+		fCheckpoints: make([]*ethpb.Checkpoint, len(blks)),
 	}
 	var set *bls.SignatureSet
-	boundaries := make(map[[32]byte]iface.BeaconState)
+	var err error
 	for i, b := range blks {
 		set, preState, err = state.ExecuteStateTransitionNoVerifyAnySig(ctx, preState, b)
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
 		// Save potential boundary states.
 		if helpers.IsEpochStart(preState.Slot()) {
-			boundaries[blockRoots[i]] = preState.Copy()
+			batch.boundaries[blockRoots[i]] = preState.Copy()
 			if err := s.handleEpochBoundary(ctx, preState); err != nil {
-				return nil, nil, errors.Wrap(err, "could not handle epoch boundary state")
+				return nil, errors.Wrap(err, "could not handle epoch boundary state")
 			}
 		}
-		jCheckpoints[i] = preState.CurrentJustifiedCheckpoint()
-		fCheckpoints[i] = preState.FinalizedCheckpoint()
-		sigSet.Join(set)
+		batch.jCheckpoints[i] = preState.CurrentJustifiedCheckpoint()
+		batch.fCheckpoints[i] = preState.FinalizedCheckpoint()
+		batch.SignatureSet.Join(set)
 	}
-	verify, err := sigSet.Verify()
-	if err != nil {
-		return nil, nil, err
-	}
-	if !verify {
-		return nil, nil, errors.New("batch block signature verification failed")
-	}
-	for r, st := range boundaries {
+	batch.postState = preState
+	return batch, nil
+}
+
+// saveBlockBatchStates saves the boundary states and the post state of a verified batch, and
+// sets the last block of the batch as the head.
+func (s *Service) saveBlockBatchStates(ctx context.Context, batch *BlockBatch) error {
+	for r, st := range batch.boundaries {
 		if err := s.cfg.StateGen.SaveState(ctx, r, st); err != nil {
-			return nil, nil, err
+			return err
 		}
 	}
 	// Also saves the last post state which to be used as pre state for the next batch.
-	lastB := blks[len(blks)-1]
-	lastBR := blockRoots[len(blockRoots)-1]
-	if err := s.cfg.StateGen.SaveState(ctx, lastBR, preState); err != nil {
-		return nil, nil, err
-	}
-	if err := s.saveHeadNoDB(ctx, lastB, lastBR, preState); err != nil {
-		return nil, nil, err
+	lastB := batch.Blocks[len(batch.Blocks)-1]
+	lastBR := batch.Roots[len(batch.Roots)-1]
+	if err := s.cfg.StateGen.SaveState(ctx, lastBR, batch.postState); err != nil {
+		return err
 	}
-	return fCheckpoints, jCheckpoints, nil
+	return s.saveHeadNoDB(ctx, lastB, lastBR, batch.postState)
 }
 
 // handles a block after the block's batch has been verified, where we can save blocks
@@ -325,7 +369,11 @@ func (s *Service) handleEpochBoundary(ctx context.Context, postState iface.Beaco
 			return err
 		}
 	} else if postState.Slot() >= s.nextEpochBoundarySlot {
-		if err := reportEpochMetrics(ctx, postState, s.head.state); err != nil {
+		// Batches of blocks may be executed while the head is updated by initial sync.
+		s.headLock.RLock()
+		headState := s.head.state
+		s.headLock.RUnlock()
+		if err := reportEpochMetrics(ctx, postState, headState); err != nil {
 			return err
 		}
 		var err error
@@ -8,7 +8,10 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/interfaces"
+	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/timeutils"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
@@ -25,6 +28,28 @@ type BlockReceiver interface {
 	HasInitSyncBlock(root [32]byte) bool
 }
 
+// BlockBatchExecutor interface defines the methods of chain service to process a block batch in separate
+// steps, so that the state transition of a batch, the verification of its signatures and the saving of its
+// blocks may overlap with those of other batches.
+type BlockBatchExecutor interface {
+	ExecuteBlockBatch(ctx context.Context, parent *BlockBatch, blocks []interfaces.SignedBeaconBlock, blkRoots [][32]byte) (*BlockBatch, error)
+	CommitBlockBatch(ctx context.Context, batch *BlockBatch) error
+}
+
+// BlockBatch is a linear batch of blocks which has been transitioned through without verifying any
+// signature. The signatures of the batch are collected in its signature set, which must be verified
+// before the batch is committed.
+type BlockBatch struct {
+	Blocks       []interfaces.SignedBeaconBlock
+	Roots        [][32]byte
+	SignatureSet *bls.SignatureSet
+
+	postState    iface.BeaconState
+	boundaries   map[[32]byte]iface.BeaconState
+	fCheckpoints []*ethpb.Checkpoint
+	jCheckpoints []*ethpb.Checkpoint
+}
+
 // ReceiveBlock is a function that defines the the operations (minus pubsub)
 // that are performed on blocks that is received from regular sync service. The operations consists of:
 //   1. Validate block, apply state transition and update check points
@@ -98,10 +123,75 @@ func (s *Service) ReceiveBlockBatch(ctx context.Context, blocks []interfaces.Sig
 		return err
 	}
 
+	if err := s.handleBlockBatchAfterVerify(ctx, blocks, blkRoots, fCheckpoints, jCheckpoints); err != nil {
+		traceutil.AnnotateError(span, err)
+		return err
+	}
+
+	if err := s.VerifyWeakSubjectivityRoot(s.ctx); err != nil {
+		// log.Fatalf will prevent defer from being called
+		span.End()
+		// Exit run time if the node failed to verify weak subjectivity checkpoint.
+		log.Fatalf("Could not verify weak subjectivity checkpoint: %v", err)
+	}
+
+	return nil
+}
+
+// ExecuteBlockBatch transitions the state through a linear block batch without verifying any signature.
+// The batch is executed on top of the post state of the parent batch if one is given, which does not
+// need to be committed yet, or else on top of the state of its first block's parent.
+func (s *Service) ExecuteBlockBatch(ctx context.Context, parent *BlockBatch, blocks []interfaces.SignedBeaconBlock,
+	blkRoots [][32]byte) (*BlockBatch, error) {
+	ctx, span := trace.StartSpan(ctx, "blockChain.ExecuteBlockBatch")
+	defer span.End()
+
+	batch, err := s.executeBlockBatch(ctx, parent, blocks, blkRoots)
+	if err != nil {
+		err := errors.Wrap(err, "could not execute block batch")
+		traceutil.AnnotateError(span, err)
+		return nil, err
+	}
+	return batch, nil
+}
+
+// CommitBlockBatch saves an executed block batch and performs the appropriate actions for its blocks
+// post-transition. The caller is responsible for verifying the signature set of the batch beforehand,
+// and for committing batches in the order they were executed.
+func (s *Service) CommitBlockBatch(ctx context.Context, batch *BlockBatch) error {
+	ctx, span := trace.StartSpan(ctx, "blockChain.CommitBlockBatch")
+	defer span.End()
+
+	if batch == nil || batch.postState == nil {
+		return errors.New("block batch has not been executed")
+	}
+	if err := s.saveBlockBatchStates(ctx, batch); err != nil {
+		err := errors.Wrap(err, "could not save block batch states")
+		traceutil.AnnotateError(span, err)
+		return err
+	}
+	if err := s.handleBlockBatchAfterVerify(ctx, batch.Blocks, batch.Roots, batch.fCheckpoints, batch.jCheckpoints); err != nil {
+		traceutil.AnnotateError(span, err)
+		return err
+	}
+
+	if err := s.VerifyWeakSubjectivityRoot(s.ctx); err != nil {
+		// log.Fatalf will prevent defer from being called
+		span.End()
+		// Exit run time if the node failed to verify weak subjectivity checkpoint.
+		log.Fatalf("Could not verify weak subjectivity checkpoint: %v", err)
+	}
+
+	return nil
+}
+
+// handleBlockBatchAfterVerify saves the blocks of a verified batch, notifies the state feed of them and
+// reports their metrics.
+func (s *Service) handleBlockBatchAfterVerify(ctx context.Context, blocks []interfaces.SignedBeaconBlock,
+	blkRoots [][32]byte, fCheckpoints, jCheckpoints []*ethpb.Checkpoint) error {
 	for i, b := range blocks {
 		blockCopy := b.Copy()
-		if err = s.handleBlockAfterBatchVerify(ctx, blockCopy, blkRoots[i], fCheckpoints[i], jCheckpoints[i]); err != nil {
-			traceutil.AnnotateError(span, err)
+		if err := s.handleBlockAfterBatchVerify(ctx, blockCopy, blkRoots[i], fCheckpoints[i], jCheckpoints[i]); err != nil {
 			return err
 		}
 		// Send notification of the processed block to the state feed.
@@ -118,14 +208,6 @@ func (s *Service) ReceiveBlockBatch(ctx context.Context, blocks []interfaces.Sig
 		// Reports on blockCopy and fork choice metrics.
 		reportSlotMetrics(blockCopy.Block().Slot(), s.HeadSlot(), s.CurrentSlot(), s.finalizedCheckpt)
 	}
-
-	if err := s.VerifyWeakSubjectivityRoot(s.ctx); err != nil {
-		// log.Fatalf will prevent defer from being called
-		span.End()
-		// Exit run time if the node failed to verify weak subjectivity checkpoint.
-		log.Fatalf("Could not verify weak subjectivity checkpoint: %v", err)
-	}
-
 	return nil
 }
 
@@ -283,6 +283,64 @@ func TestService_ReceiveBlockBatch(t *testing.T) {
 	}
 }
 
+func TestService_ExecuteAndCommitBlockBatch(t *testing.T) {
+	ctx := context.Background()
+	genesis, keys := testutil.DeterministicGenesisState(t, 64)
+	beaconDB := testDB.SetupDB(t)
+	genesisBlockRoot, err := genesis.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	cfg := &Config{
+		BeaconDB: beaconDB,
+		ForkChoiceStore: protoarray.New(
+			0, // justifiedEpoch
+			0, // finalizedEpoch
+			genesisBlockRoot,
+		),
+		StateNotifier: &blockchainTesting.MockStateNotifier{RecordEvents: true},
+		StateGen:      stategen.New(beaconDB),
+	}
+	s, err := NewService(ctx, cfg)
+	require.NoError(t, err)
+	require.NoError(t, s.saveGenesisData(ctx, genesis))
+	gBlk, err := s.cfg.BeaconDB.GenesisBlock(ctx)
+	require.NoError(t, err)
+	gRoot, err := gBlk.Block().HashTreeRoot()
+	require.NoError(t, err)
+	s.finalizedCheckpt = &ethpb.Checkpoint{Root: gRoot[:]}
+
+	blk1, err := testutil.GenerateFullBlock(genesis, keys, testutil.DefaultBlockGenConfig(), 1)
+	require.NoError(t, err)
+	root1, err := blk1.Block.HashTreeRoot()
+	require.NoError(t, err)
+	batch1, err := s.ExecuteBlockBatch(ctx, nil, []interfaces.SignedBeaconBlock{wrapper.WrappedPhase0SignedBeaconBlock(blk1)}, [][32]byte{root1})
+	require.NoError(t, err)
+
+	// The second batch is executed on top of the first one before it is committed.
+	blk2, err := testutil.GenerateFullBlock(batch1.postState.Copy(), keys, testutil.DefaultBlockGenConfig(), 2)
+	require.NoError(t, err)
+	root2, err := blk2.Block.HashTreeRoot()
+	require.NoError(t, err)
+	blks2 := []interfaces.SignedBeaconBlock{wrapper.WrappedPhase0SignedBeaconBlock(blk2)}
+	_, err = s.ExecuteBlockBatch(ctx, nil, blks2, [][32]byte{root2})
+	assert.ErrorContains(t, "could not execute block batch", err)
+	_, err = s.ExecuteBlockBatch(ctx, &BlockBatch{}, blks2, [][32]byte{root2})
+	assert.ErrorContains(t, "parent batch has not been executed", err)
+	batch2, err := s.ExecuteBlockBatch(ctx, batch1, blks2, [][32]byte{root2})
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(1), batch1.postState.Slot(), "Parent post state was modified")
+
+	for _, batch := range []*BlockBatch{batch1, batch2} {
+		verified, err := batch.SignatureSet.Verify()
+		require.NoError(t, err)
+		require.Equal(t, true, verified)
+		require.NoError(t, s.CommitBlockBatch(ctx, batch))
+	}
+	assert.Equal(t, types.Slot(2), s.head.state.Slot(), "Incorrect head state slot")
+	assert.Equal(t, root2, s.head.root, "Incorrect head root")
+	assert.Equal(t, true, s.HasInitSyncBlock(root1))
+	assert.Equal(t, 2, len(s.cfg.StateNotifier.(*blockchainTesting.MockStateNotifier).ReceivedEvents()))
+}
+
 func TestService_HasInitSyncBlock(t *testing.T) {
 	s, err := NewService(context.Background(), &Config{StateNotifier: &blockchainTesting.MockStateNotifier{}})
 	require.NoError(t, err)
@@ -6,6 +6,7 @@ import (
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/statebudget"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
@@ -30,19 +31,28 @@ var (
 
 // CheckpointStateCache is a struct with 1 queue for looking up state by checkpoint.
 type CheckpointStateCache struct {
-	cache *lru.Cache
-	lock  sync.RWMutex
+	cache   *lru.Cache
+	lock    sync.RWMutex
+	tracker *statebudget.Tracker
 }
 
 // NewCheckpointStateCache creates a new checkpoint state cache for storing/accessing processed state.
-func NewCheckpointStateCache() *CheckpointStateCache {
-	cache, err := lru.New(maxCheckpointStateSize)
+// The memory of the cached states is tracked by the given state budget manager.
+func NewCheckpointStateCache(stateBudget *statebudget.Manager) *CheckpointStateCache {
+	c := &CheckpointStateCache{}
+	c.tracker = stateBudget.Register("checkpoint_state", func(key interface{}) {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		c.cache.Remove(key)
+	})
+	cache, err := lru.NewWithEvict(maxCheckpointStateSize, func(key interface{}, _ interface{}) {
+		c.tracker.Remove(key)
+	})
 	if err != nil {
 		panic(err)
 	}
-	return &CheckpointStateCache{
-		cache: cache,
-	}
+	c.cache = cache
+	return c
 }
 
 // StateByCheckpoint fetches state by checkpoint. Returns true with a
@@ -59,6 +69,7 @@ func (c *CheckpointStateCache) StateByCheckpoint(cp *ethpb.Checkpoint) (iface.Be
 
 	if exists && item != nil {
 		checkpointStateHit.Inc()
+		c.tracker.Touch(h)
 		// Copy here is unnecessary since the return will only be used to verify attestation signature.
 		return item.(iface.BeaconState), nil
 	}
@@ -70,12 +81,14 @@ func (c *CheckpointStateCache) StateByCheckpoint(cp *ethpb.Checkpoint) (iface.Be
 // AddCheckpointState adds CheckpointState object to the cache. This method also trims the least
 // recently added CheckpointState object if the cache size has ready the max cache size limit.
 func (c *CheckpointStateCache) AddCheckpointState(cp *ethpb.Checkpoint, s iface.ReadOnlyBeaconState) error {
-	c.lock.Lock()
-	defer c.lock.Unlock()
 	h, err := hashutil.HashProto(cp)
 	if err != nil {
 		return err
 	}
+	c.lock.Lock()
 	c.cache.Add(h, s)
+	evictions := c.tracker.Put(h, s)
+	c.lock.Unlock()
+	evictions.Evict()
 	return nil
 }
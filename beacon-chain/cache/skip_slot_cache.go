@@ -9,6 +9,7 @@ import (
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/statebudget"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	"go.opencensus.io/trace"
 )
@@ -31,18 +32,26 @@ type SkipSlotCache struct {
 	lock       sync.RWMutex
 	disabled   bool // Allow for programmatic toggling of the cache, useful during initial sync.
 	inProgress map[[32]byte]bool
+	tracker    *statebudget.Tracker
 }
 
-// NewSkipSlotCache initializes the map and underlying cache.
-func NewSkipSlotCache() *SkipSlotCache {
-	cache, err := lru.New(8)
+// NewSkipSlotCache initializes the map and underlying cache. The memory of the cached
+// states is tracked by the given state budget manager.
+func NewSkipSlotCache(stateBudget *statebudget.Manager) *SkipSlotCache {
+	c := &SkipSlotCache{
+		inProgress: make(map[[32]byte]bool),
+	}
+	c.tracker = stateBudget.Register("skip_slot", func(key interface{}) {
+		c.cache.Remove(key)
+	})
+	cache, err := lru.NewWithEvict(8, func(key interface{}, _ interface{}) {
+		c.tracker.Remove(key)
+	})
 	if err != nil {
 		panic(err)
 	}
-	return &SkipSlotCache{
-		cache:      cache,
-		inProgress: make(map[[32]byte]bool),
-	}
+	c.cache = cache
+	return c
 }
 
 // Enable the skip slot cache.
@@ -96,6 +105,7 @@ func (c *SkipSlotCache) Get(ctx context.Context, r [32]byte) (iface.BeaconState,
 
 	if exists && item != nil {
 		skipSlotCacheHit.Inc()
+		c.tracker.Touch(r)
 		span.AddAttributes(trace.BoolAttribute("hit", true))
 		return item.(iface.BeaconState).Copy(), nil
 	}
@@ -142,7 +152,9 @@ func (c *SkipSlotCache) Put(_ context.Context, r [32]byte, state iface.BeaconSta
 	}
 
 	// Copy state so cached value is not mutated.
-	c.cache.Add(r, state.Copy())
+	copied := state.Copy()
+	c.cache.Add(r, copied)
+	c.tracker.Put(r, copied).Evict()
 
 	return nil
 }
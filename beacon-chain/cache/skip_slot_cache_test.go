@@ -14,7 +14,7 @@ import (
 
 func TestSkipSlotCache_RoundTrip(t *testing.T) {
 	ctx := context.Background()
-	c := cache.NewSkipSlotCache()
+	c := cache.NewSkipSlotCache(nil)
 
 	r := [32]byte{'a'}
 	state, err := c.Get(ctx, r)
@@ -0,0 +1,269 @@
+// Package statebudget tracks the memory retained by beacon states held in the
+// beacon node's state caches and enforces a single byte budget across all of
+// them, evicting the most expensive and least recently used states first.
+package statebudget
+
+import (
+	"sync"
+
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+)
+
+// EvictFunc is called by the manager to remove an entry from the cache it was
+// registered for. It is never called while the manager's lock is held, nor from
+// within Put, so it is safe for it to acquire the cache's own lock.
+type EvictFunc func(key interface{})
+
+// Evictions are the states selected for eviction by Put. The cache which called
+// Put must call Evict once it released its own lock.
+type Evictions []victim
+
+// Manager keeps an estimate of the memory retained by every state tracked by its
+// registered caches. Fields shared by reference between tracked states are only
+// accounted for once. Whenever the total estimate exceeds the configured budget,
+// states are evicted from their caches until the budget is satisfied again.
+type Manager struct {
+	lock    sync.Mutex
+	budget  uint64
+	used    uint64
+	clock   uint64
+	entries map[entryKey]*entry
+	shared  map[uintptr]*sharedUsage
+	caches  map[string]*cacheUsage
+}
+
+// Tracker is the handle through which a single cache reports the states it
+// holds to the manager.
+type Tracker struct {
+	name    string
+	manager *Manager
+	evict   EvictFunc
+}
+
+type entryKey struct {
+	tracker *Tracker
+	key     interface{}
+}
+
+type entry struct {
+	size       stateSize
+	lastAccess uint64
+}
+
+type sharedUsage struct {
+	size uint64
+	refs uint64
+}
+
+type cacheUsage struct {
+	entries   uint64
+	evictions uint64
+}
+
+var defaultManager = NewManager(0)
+
+// Default returns the manager shared by all of the beacon node's state caches.
+func Default() *Manager {
+	return defaultManager
+}
+
+// NewManager creates a manager enforcing the given budget in bytes. A budget of
+// zero disables eviction while still keeping track of the memory in use.
+func NewManager(budget uint64) *Manager {
+	return &Manager{
+		budget:  budget,
+		entries: make(map[entryKey]*entry),
+		shared:  make(map[uintptr]*sharedUsage),
+		caches:  make(map[string]*cacheUsage),
+	}
+}
+
+// SetBudget updates the budget in bytes and evicts states as necessary to satisfy it.
+func (m *Manager) SetBudget(budget uint64) {
+	m.lock.Lock()
+	m.budget = budget
+	victims := m.selectVictims()
+	m.lock.Unlock()
+	evictAll(victims)
+}
+
+// Budget returns the configured budget in bytes.
+func (m *Manager) Budget() uint64 {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.budget
+}
+
+// Used returns the estimated number of bytes retained by all tracked states.
+func (m *Manager) Used() uint64 {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.used
+}
+
+// Register returns a tracker for a cache with the given name. The name is used to
+// label metrics, multiple caches may share the same name. The evict function is
+// called with the cache key of every state the manager decides to evict. A nil
+// manager returns a nil tracker, with which the cache holds its states untracked.
+func (m *Manager) Register(name string, evict EvictFunc) *Tracker {
+	if m == nil {
+		return nil
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.caches[name]; !ok {
+		m.caches[name] = &cacheUsage{}
+	}
+	return &Tracker{
+		name:    name,
+		manager: m,
+		evict:   evict,
+	}
+}
+
+// Put records the state held by the cache under the given key, replacing any state
+// previously recorded under it. If this causes the budget to be exceeded, the states
+// to evict from the registered caches are returned, possibly including the one just
+// added. Put may be called while holding the cache's own lock, which must be released
+// before evicting the returned states.
+func (t *Tracker) Put(key interface{}, st iface.ReadOnlyBeaconState) Evictions {
+	if t == nil {
+		return nil
+	}
+	size := estimateSize(st)
+	m := t.manager
+	m.lock.Lock()
+	k := entryKey{tracker: t, key: key}
+	m.remove(k)
+	m.clock++
+	m.entries[k] = &entry{size: size, lastAccess: m.clock}
+	m.used += size.fixed
+	for _, f := range size.shared {
+		if u, ok := m.shared[f.id]; ok {
+			u.refs++
+			continue
+		}
+		m.shared[f.id] = &sharedUsage{size: f.size, refs: 1}
+		m.used += f.size
+	}
+	m.caches[t.name].entries++
+	victims := m.selectVictims()
+	m.lock.Unlock()
+	return victims
+}
+
+// Evict removes the selected states from their caches.
+func (e Evictions) Evict() {
+	evictAll(e)
+}
+
+// Touch marks the state held under the given key as recently used.
+func (t *Tracker) Touch(key interface{}) {
+	if t == nil {
+		return
+	}
+	m := t.manager
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if e, ok := m.entries[entryKey{tracker: t, key: key}]; ok {
+		m.clock++
+		e.lastAccess = m.clock
+	}
+}
+
+// Remove stops tracking the state held under the given key. It should be called
+// whenever the cache drops a state by itself.
+func (t *Tracker) Remove(key interface{}) {
+	if t == nil {
+		return
+	}
+	m := t.manager
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.remove(entryKey{tracker: t, key: key})
+}
+
+// remove drops the entry from the accounting, if tracked. The caller must hold the lock.
+func (m *Manager) remove(k entryKey) {
+	e, ok := m.entries[k]
+	if !ok {
+		return
+	}
+	delete(m.entries, k)
+	m.used -= e.size.fixed
+	for _, f := range e.size.shared {
+		u, ok := m.shared[f.id]
+		if !ok {
+			continue
+		}
+		u.refs--
+		if u.refs == 0 {
+			delete(m.shared, f.id)
+			m.used -= u.size
+		}
+	}
+	m.caches[k.tracker.name].entries--
+}
+
+// reclaimable returns the number of bytes freed by evicting the entry, that is its
+// fixed size plus the shared fields no other tracked state references. The caller
+// must hold the lock.
+func (m *Manager) reclaimable(e *entry) uint64 {
+	r := e.size.fixed
+	for _, f := range e.size.shared {
+		if u, ok := m.shared[f.id]; ok && u.refs == 1 {
+			r += u.size
+		}
+	}
+	return r
+}
+
+type victim struct {
+	tracker *Tracker
+	key     interface{}
+}
+
+// selectVictims removes entries from the accounting until the budget is satisfied
+// and returns them so they can be evicted once the lock is released. Entries are
+// scored by the number of bytes their eviction reclaims multiplied by the time
+// since they were last used, and the highest scoring entry is evicted first. Ties
+// are broken in favor of evicting the least recently used entry. The caller must
+// hold the lock.
+func (m *Manager) selectVictims() []victim {
+	var victims []victim
+	for m.budget > 0 && m.used > m.budget && len(m.entries) > 0 {
+		var worst entryKey
+		var worstScore, worstAccess uint64
+		found := false
+		for k, e := range m.entries {
+			score := m.reclaimable(e) * (m.clock - e.lastAccess + 1)
+			if !found || score > worstScore || (score == worstScore && e.lastAccess < worstAccess) {
+				worst, worstScore, worstAccess, found = k, score, e.lastAccess, true
+			}
+		}
+		m.remove(worst)
+		m.caches[worst.tracker.name].evictions++
+		victims = append(victims, victim{tracker: worst.tracker, key: worst.key})
+	}
+	return victims
+}
+
+// evictAll removes the victims from their caches, except those which were put again
+// under the same key since they were selected, as the cache then holds a newer state.
+func evictAll(victims []victim) {
+	for _, v := range victims {
+		if v.tracker.evict == nil || v.tracker.tracked(v.key) {
+			continue
+		}
+		v.tracker.evict(v.key)
+	}
+}
+
+// tracked returns whether a state is recorded under the given key.
+func (t *Tracker) tracked(key interface{}) bool {
+	m := t.manager
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	_, ok := m.entries[entryKey{tracker: t, key: key}]
+	return ok
+}
@@ -0,0 +1,169 @@
+package statebudget
+
+import (
+	"testing"
+
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func testState(t *testing.T, numValidators int) iface.BeaconState {
+	vals := make([]*ethpb.Validator, numValidators)
+	balances := make([]uint64, numValidators)
+	for i := range vals {
+		vals[i] = &ethpb.Validator{
+			PublicKey:             make([]byte, 48),
+			WithdrawalCredentials: make([]byte, 32),
+		}
+	}
+	st, err := v1.InitializeFromProto(&pb.BeaconState{
+		Validators: vals,
+		Balances:   balances,
+	})
+	require.NoError(t, err)
+	return st
+}
+
+// evicted returns the keys evicted by a manager so far.
+func evicted(ch chan interface{}) []interface{} {
+	keys := make([]interface{}, 0)
+	for {
+		select {
+		case k := <-ch:
+			keys = append(keys, k)
+		default:
+			return keys
+		}
+	}
+}
+
+func TestManager_PutRemove(t *testing.T) {
+	m := NewManager(0)
+	tr := m.Register("test", nil)
+	st := testState(t, 64)
+
+	tr.Put("a", st)
+	assert.Equal(t, estimateSize(st).total(), m.Used())
+
+	// Replacing the state under the same key does not count it twice.
+	tr.Put("a", st)
+	assert.Equal(t, estimateSize(st).total(), m.Used())
+
+	tr.Remove("a")
+	assert.Equal(t, uint64(0), m.Used())
+	// Removing an unknown key is a no-op.
+	tr.Remove("b")
+	assert.Equal(t, uint64(0), m.Used())
+}
+
+func TestManager_SharedFieldsCountedOnce(t *testing.T) {
+	m := NewManager(0)
+	hot := m.Register("hot", nil)
+	boundary := m.Register("boundary", nil)
+	st := testState(t, 1024)
+	copied := st.Copy()
+	size := estimateSize(st)
+
+	hot.Put("a", st)
+	boundary.Put("a", copied)
+	assert.Equal(t, size.total()+estimateSize(copied).fixed, m.Used(), "Shared fields were not deduplicated")
+
+	// Modifying the copy detaches its balances from the original state.
+	require.NoError(t, copied.UpdateBalancesAtIndex(0, 1))
+	boundary.Put("a", copied)
+	assert.Equal(t, true, m.Used() > size.total()+estimateSize(copied).fixed)
+
+	hot.Remove("a")
+	assert.Equal(t, estimateSize(copied).total(), m.Used())
+	boundary.Remove("a")
+	assert.Equal(t, uint64(0), m.Used())
+}
+
+func TestManager_EvictsLeastRecentlyUsed(t *testing.T) {
+	st1, st2, st3 := testState(t, 128), testState(t, 128), testState(t, 128)
+	size := estimateSize(st1).total()
+	m := NewManager(2*size + size/2)
+	ch := make(chan interface{}, 3)
+	tr := m.Register("test", func(key interface{}) {
+		ch <- key
+	})
+
+	tr.Put(1, st1)
+	tr.Put(2, st2)
+	tr.Touch(1)
+	tr.Put(3, st3).Evict()
+
+	assert.DeepEqual(t, []interface{}{2}, evicted(ch))
+	assert.Equal(t, 2*size, m.Used())
+}
+
+func TestManager_EvictsMostExpensive(t *testing.T) {
+	small, large := testState(t, 16), testState(t, 4096)
+	m := NewManager(0)
+	ch := make(chan interface{}, 2)
+	tr := m.Register("test", func(key interface{}) {
+		ch <- key
+	})
+
+	tr.Put("large", large)
+	tr.Put("small", small)
+	tr.Touch("large")
+	tr.Touch("small")
+	m.SetBudget(estimateSize(large).total())
+
+	assert.DeepEqual(t, []interface{}{"large"}, evicted(ch))
+	assert.Equal(t, estimateSize(small).total(), m.Used())
+}
+
+func TestManager_EvictsAcrossCaches(t *testing.T) {
+	st := testState(t, 256)
+	size := estimateSize(st).total()
+	m := NewManager(size)
+	ch := make(chan interface{}, 2)
+	first := m.Register("first", func(key interface{}) {
+		ch <- "first"
+	})
+	second := m.Register("second", func(key interface{}) {
+		ch <- "second"
+	})
+
+	first.Put("a", st).Evict()
+	second.Put("a", testState(t, 256)).Evict()
+
+	assert.DeepEqual(t, []interface{}{"first"}, evicted(ch))
+	assert.Equal(t, size, m.Used())
+	assert.Equal(t, uint64(1), m.caches["first"].evictions)
+	assert.Equal(t, uint64(0), m.caches["first"].entries)
+	assert.Equal(t, uint64(1), m.caches["second"].entries)
+}
+
+func TestManager_KeepsStatePutAgain(t *testing.T) {
+	st1, st2 := testState(t, 128), testState(t, 128)
+	size := estimateSize(st1).total()
+	m := NewManager(size + size/2)
+	ch := make(chan interface{}, 2)
+	tr := m.Register("test", func(key interface{}) {
+		ch <- key
+	})
+
+	tr.Put(1, st1).Evict()
+	evictions := tr.Put(2, st2)
+	// The state selected for eviction is put again before the eviction happens.
+	tr.Put(1, st1).Evict()
+	evictions.Evict()
+
+	assert.DeepEqual(t, []interface{}{2}, evicted(ch))
+	assert.Equal(t, size, m.Used())
+}
+
+func TestManager_NilManager(t *testing.T) {
+	var m *Manager
+	tr := m.Register("test", nil)
+	tr.Put("a", testState(t, 16)).Evict()
+	tr.Touch("a")
+	tr.Remove("a")
+}
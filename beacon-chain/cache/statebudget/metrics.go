@@ -0,0 +1,90 @@
+package statebudget
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func init() {
+	prometheus.MustRegister(newCollector(defaultManager))
+}
+
+// collector exports the occupancy of the state caches tracked by a manager.
+type collector struct {
+	manager        *Manager
+	budgetBytes    *prometheus.Desc
+	usedBytes      *prometheus.Desc
+	cacheBytes     *prometheus.Desc
+	cacheEntries   *prometheus.Desc
+	cacheEvictions *prometheus.Desc
+}
+
+func newCollector(m *Manager) *collector {
+	return &collector{
+		manager: m,
+		budgetBytes: prometheus.NewDesc(
+			"state_cache_budget_bytes",
+			"The memory budget shared by all state caches, in bytes. Zero means unlimited.",
+			nil,
+			nil,
+		),
+		usedBytes: prometheus.NewDesc(
+			"state_cache_used_bytes",
+			"The estimated memory retained by all cached states, in bytes.",
+			nil,
+			nil,
+		),
+		cacheBytes: prometheus.NewDesc(
+			"state_cache_bytes",
+			"The estimated memory retained by the states of a cache, in bytes. Shared fields are split between the states referencing them.",
+			[]string{"cache"},
+			nil,
+		),
+		cacheEntries: prometheus.NewDesc(
+			"state_cache_entries",
+			"The number of states held by a cache.",
+			[]string{"cache"},
+			nil,
+		),
+		cacheEvictions: prometheus.NewDesc(
+			"state_cache_budget_evictions_total",
+			"The total number of states evicted from a cache to satisfy the memory budget.",
+			[]string{"cache"},
+			nil,
+		),
+	}
+}
+
+// Describe is invoked by prometheus collection loop.
+func (c *collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.budgetBytes
+	ch <- c.usedBytes
+	ch <- c.cacheBytes
+	ch <- c.cacheEntries
+	ch <- c.cacheEvictions
+}
+
+// Collect is invoked by prometheus collection loop.
+func (c *collector) Collect(ch chan<- prometheus.Metric) {
+	m := c.manager
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	ch <- prometheus.MustNewConstMetric(c.budgetBytes, prometheus.GaugeValue, float64(m.budget))
+	ch <- prometheus.MustNewConstMetric(c.usedBytes, prometheus.GaugeValue, float64(m.used))
+
+	cacheBytes := make(map[string]uint64, len(m.caches))
+	for k, e := range m.entries {
+		b := e.size.fixed
+		for _, f := range e.size.shared {
+			if u, ok := m.shared[f.id]; ok {
+				b += u.size / u.refs
+			}
+		}
+		cacheBytes[k.tracker.name] += b
+	}
+	for name, usage := range m.caches {
+		ch <- prometheus.MustNewConstMetric(c.cacheBytes, prometheus.GaugeValue, float64(cacheBytes[name]), name)
+		ch <- prometheus.MustNewConstMetric(c.cacheEntries, prometheus.GaugeValue, float64(usage.entries), name)
+		ch <- prometheus.MustNewConstMetric(c.cacheEvictions, prometheus.CounterValue, float64(usage.evictions), name)
+	}
+}
@@ -0,0 +1,115 @@
+package statebudget
+
+import (
+	"reflect"
+	"strings"
+
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// The sizes below are rough estimates of the heap memory retained by the
+// protobuf objects held in a beacon state, including the protobuf message
+// header (state, size cache and unknown fields) and slice headers.
+const (
+	sliceHeaderSize        = 24
+	pointerSize            = 8
+	protoHeaderSize        = 48
+	rootSize               = sliceHeaderSize + 32
+	validatorSize          = pointerSize + protoHeaderSize + 2*sliceHeaderSize + 48 + 32 + 6*8
+	eth1DataSize           = pointerSize + protoHeaderSize + 2*sliceHeaderSize + 32 + 32 + 8
+	pendingAttestationSize = pointerSize + 4*protoHeaderSize + 4*sliceHeaderSize + 3*32 + 5*8 + 256
+	syncCommitteeSize      = pointerSize + protoHeaderSize + 2*sliceHeaderSize + 512*(sliceHeaderSize+48) + 48
+	// baseStateSize covers the beacon state wrapper, its bookkeeping maps and the
+	// small constant sized fields such as checkpoints, fork and block header.
+	baseStateSize = 4096
+	// trieNodeSize is the estimated size of a single node held in a field trie layer.
+	trieNodeSize = sliceHeaderSize + 32
+	// trieSuffix is appended to a field name by FieldReferencesCount for field tries.
+	trieSuffix = "_trie"
+)
+
+// sharedField describes a large field of a beacon state that may be shared
+// between multiple copies of the same state. The field is identified by the
+// address of its backing array, so that copies referencing the same memory
+// are only ever accounted for once.
+type sharedField struct {
+	id   uintptr
+	size uint64
+}
+
+// stateSize is the estimated memory footprint of a beacon state, split into the
+// memory exclusively held by the state and the memory of its shareable fields.
+type stateSize struct {
+	fixed  uint64
+	shared []sharedField
+}
+
+// total returns the estimated size of the state when none of its fields are shared.
+func (s stateSize) total() uint64 {
+	t := s.fixed
+	for _, f := range s.shared {
+		t += f.size
+	}
+	return t
+}
+
+// estimateSize estimates the memory retained by the given beacon state. Fields which
+// are shared by reference between state copies are reported separately, keyed by
+// the address of their backing array. Field tries cannot be identified in the same
+// manner, so their estimated size is divided between the states referencing them.
+func estimateSize(st iface.ReadOnlyBeaconState) stateSize {
+	if st == nil || st.IsNil() {
+		return stateSize{}
+	}
+	s := stateSize{fixed: baseStateSize}
+	leaves := make(map[string]int)
+	addField := func(name string, field interface{}, n int, itemSize uint64) {
+		leaves[name] = n
+		if n == 0 {
+			return
+		}
+		s.shared = append(s.shared, sharedField{
+			id:   reflect.ValueOf(field).Pointer(),
+			size: sliceHeaderSize + uint64(n)*itemSize,
+		})
+	}
+	switch inner := st.InnerStateUnsafe().(type) {
+	case *pbp2p.BeaconState:
+		addField("blockRoots", inner.BlockRoots, len(inner.BlockRoots), rootSize)
+		addField("stateRoots", inner.StateRoots, len(inner.StateRoots), rootSize)
+		addField("historicalRoots", inner.HistoricalRoots, len(inner.HistoricalRoots), rootSize)
+		addField("eth1DataVotes", inner.Eth1DataVotes, len(inner.Eth1DataVotes), eth1DataSize)
+		addField("validators", inner.Validators, len(inner.Validators), validatorSize)
+		addField("balances", inner.Balances, len(inner.Balances), 8)
+		addField("randaoMixes", inner.RandaoMixes, len(inner.RandaoMixes), rootSize)
+		addField("slashings", inner.Slashings, len(inner.Slashings), 8)
+		addField("previousEpochAttestations", inner.PreviousEpochAttestations, len(inner.PreviousEpochAttestations), pendingAttestationSize)
+		addField("currentEpochAttestations", inner.CurrentEpochAttestations, len(inner.CurrentEpochAttestations), pendingAttestationSize)
+	case *pbp2p.BeaconStateAltair:
+		addField("blockRoots", inner.BlockRoots, len(inner.BlockRoots), rootSize)
+		addField("stateRoots", inner.StateRoots, len(inner.StateRoots), rootSize)
+		addField("historicalRoots", inner.HistoricalRoots, len(inner.HistoricalRoots), rootSize)
+		addField("eth1DataVotes", inner.Eth1DataVotes, len(inner.Eth1DataVotes), eth1DataSize)
+		addField("validators", inner.Validators, len(inner.Validators), validatorSize)
+		addField("balances", inner.Balances, len(inner.Balances), 8)
+		addField("randaoMixes", inner.RandaoMixes, len(inner.RandaoMixes), rootSize)
+		addField("slashings", inner.Slashings, len(inner.Slashings), 8)
+		addField("previousEpochParticipationBits", inner.PreviousEpochParticipation, len(inner.PreviousEpochParticipation), 1)
+		addField("currentEpochParticipationBits", inner.CurrentEpochParticipation, len(inner.CurrentEpochParticipation), 1)
+		addField("inactivityScores", inner.InactivityScores, len(inner.InactivityScores), 8)
+		s.fixed += 2 * syncCommitteeSize
+	default:
+		return s
+	}
+
+	// A field trie holds roughly two nodes per leaf across all of its layers.
+	for field, refs := range st.FieldReferencesCount() {
+		if refs == 0 || !strings.HasSuffix(field, trieSuffix) {
+			continue
+		}
+		n := leaves[strings.TrimSuffix(field, trieSuffix)]
+		s.fixed += uint64(n) * 2 * trieNodeSize / refs
+	}
+	return s
+}
@@ -0,0 +1,42 @@
+package statebudget
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestEstimateSize_GrowsWithValidators(t *testing.T) {
+	small := estimateSize(testState(t, 16))
+	large := estimateSize(testState(t, 1024))
+	assert.Equal(t, true, large.total() > small.total())
+	assert.Equal(t, small.fixed, large.fixed)
+}
+
+func TestEstimateSize_CopySharesFields(t *testing.T) {
+	st := testState(t, 64)
+	copied := st.Copy()
+
+	original := estimateSize(st)
+	copiedSize := estimateSize(copied)
+	require.Equal(t, len(original.shared), len(copiedSize.shared))
+	for i := range original.shared {
+		assert.Equal(t, original.shared[i], copiedSize.shared[i])
+	}
+
+	// Writing to a shared field copies it, the copy is no longer shared.
+	require.NoError(t, copied.UpdateBalancesAtIndex(0, 1))
+	copiedSize = estimateSize(copied)
+	shared := 0
+	for i := range original.shared {
+		if original.shared[i].id == copiedSize.shared[i].id {
+			shared++
+		}
+	}
+	assert.Equal(t, len(original.shared)-1, shared)
+}
+
+func TestEstimateSize_Nil(t *testing.T) {
+	assert.Equal(t, uint64(0), estimateSize(nil).total())
+}
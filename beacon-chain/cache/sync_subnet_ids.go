@@ -0,0 +1,92 @@
+package cache
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+)
+
+type syncSubnetIDs struct {
+	subscriptions *cache.Cache
+	lock          sync.RWMutex
+}
+
+// syncSubnetSubscription is a set of sync committee subnets a validator participates in
+// from the join epoch onwards.
+type syncSubnetSubscription struct {
+	joinEpoch types.Epoch
+	subnets   []uint64
+}
+
+// SyncSubnetIDs for sync committee members.
+var SyncSubnetIDs = newSyncSubnetIDs()
+
+func newSyncSubnetIDs() *syncSubnetIDs {
+	epochDuration := time.Duration(params.BeaconConfig().SlotsPerEpoch.Mul(params.BeaconConfig().SecondsPerSlot))
+	periodDuration := epochDuration * time.Duration(params.BeaconConfig().EpochsPerSyncCommitteePeriod)
+	return &syncSubnetIDs{subscriptions: cache.New(periodDuration*time.Second, epochDuration*time.Second)}
+}
+
+// AddSyncCommitteeSubnets adds the sync committee subnets a validator has to participate in from the
+// join epoch onwards. The subscription is dropped once the duration elapses.
+func (s *syncSubnetIDs) AddSyncCommitteeSubnets(pubkey []byte, joinEpoch types.Epoch, subnets []uint64, duration time.Duration) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.subscriptions.Set(syncSubnetKey(pubkey, joinEpoch), &syncSubnetSubscription{
+		joinEpoch: joinEpoch,
+		subnets:   sliceutil.SetUint64(subnets),
+	}, duration)
+}
+
+// GetSyncCommitteeSubnets retrieves the sync committee subnets a validator participates in at the given epoch.
+func (s *syncSubnetIDs) GetSyncCommitteeSubnets(pubkey []byte, epoch types.Epoch) []uint64 {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	prefix := fmt.Sprintf("%x-", pubkey)
+	var subnets []uint64
+	for k, v := range s.subscriptions.Items() {
+		sub, ok := v.Object.(*syncSubnetSubscription)
+		if !ok || v.Expired() || sub.joinEpoch > epoch || !strings.HasPrefix(k, prefix) {
+			continue
+		}
+		subnets = append(subnets, sub.subnets...)
+	}
+	return sliceutil.SetUint64(subnets)
+}
+
+// GetAllSubnets retrieves the non-expired sync committee subnets of all the validators in the
+// cache which participate in them at the given epoch.
+func (s *syncSubnetIDs) GetAllSubnets(epoch types.Epoch) []uint64 {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	var subnets []uint64
+	for _, v := range s.subscriptions.Items() {
+		sub, ok := v.Object.(*syncSubnetSubscription)
+		if !ok || v.Expired() || sub.joinEpoch > epoch {
+			continue
+		}
+		subnets = append(subnets, sub.subnets...)
+	}
+	return sliceutil.SetUint64(subnets)
+}
+
+// EmptyAllCaches empties out all the sync committee subscriptions. This should only ever be used
+// for testing.
+func (s *syncSubnetIDs) EmptyAllCaches() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.subscriptions.Flush()
+}
+
+func syncSubnetKey(pubkey []byte, joinEpoch types.Epoch) string {
+	return fmt.Sprintf("%x-%d", pubkey, joinEpoch)
+}
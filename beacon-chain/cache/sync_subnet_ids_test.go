@@ -0,0 +1,40 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+)
+
+func TestSyncSubnetIDsCache_RoundTrip(t *testing.T) {
+	c := newSyncSubnetIDs()
+	pubkey := [48]byte{'A'}
+	assert.Equal(t, 0, len(c.GetSyncCommitteeSubnets(pubkey[:], 10)), "Empty cache returned an object")
+	assert.Equal(t, 0, len(c.GetAllSubnets(10)), "Empty cache returned an object")
+
+	c.AddSyncCommitteeSubnets(pubkey[:], 5, []uint64{1, 2, 1}, time.Minute)
+	c.AddSyncCommitteeSubnets(pubkey[:], 20, []uint64{3}, time.Minute)
+	other := [48]byte{'B'}
+	c.AddSyncCommitteeSubnets(other[:], 0, []uint64{0}, time.Minute)
+
+	assert.Equal(t, 0, len(c.GetSyncCommitteeSubnets(pubkey[:], 4)), "Subscription returned before its join epoch")
+	assert.DeepEqual(t, []uint64{1, 2}, c.GetSyncCommitteeSubnets(pubkey[:], 10))
+	assert.Equal(t, 3, len(c.GetSyncCommitteeSubnets(pubkey[:], 20)))
+	assert.DeepEqual(t, []uint64{0}, c.GetSyncCommitteeSubnets(other[:], 20))
+	assert.Equal(t, 3, len(c.GetAllSubnets(10)))
+	assert.Equal(t, 4, len(c.GetAllSubnets(20)))
+
+	c.EmptyAllCaches()
+	assert.Equal(t, 0, len(c.GetAllSubnets(20)))
+}
+
+func TestSyncSubnetIDsCache_Expiration(t *testing.T) {
+	c := newSyncSubnetIDs()
+	pubkey := [48]byte{'A'}
+	c.AddSyncCommitteeSubnets(pubkey[:], 0, []uint64{1}, time.Millisecond)
+	c.AddSyncCommitteeSubnets(pubkey[:], 1, []uint64{2}, time.Minute)
+	time.Sleep(10 * time.Millisecond)
+	assert.DeepEqual(t, []uint64{2}, c.GetAllSubnets(1))
+	assert.DeepEqual(t, []uint64{2}, c.GetSyncCommitteeSubnets(pubkey[:], 1))
+}
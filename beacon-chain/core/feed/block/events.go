@@ -9,6 +9,8 @@ import (
 const (
 	// ReceivedBlock is sent after a block has been received by the beacon node via p2p or RPC.
 	ReceivedBlock = iota + 1
+	// ReceivedGossipBlock is sent after a block has been received via p2p gossip, before it is validated and processed.
+	ReceivedGossipBlock
 )
 
 // ReceivedBlockData is the data sent with ReceivedBlock events.
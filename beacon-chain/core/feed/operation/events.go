@@ -5,6 +5,7 @@ package operation
 
 import (
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
 )
 
 const (
@@ -18,6 +19,22 @@ const (
 
 	// ExitReceived is sent after an voluntary exit object has been received from the outside world (eg in RPC or sync)
 	ExitReceived
+
+	// AttesterSlashingReceived is sent after an attester slashing object has been received from the outside world
+	// and added to the slashing pool. (eg. in RPC or sync)
+	AttesterSlashingReceived
+
+	// ProposerSlashingReceived is sent after a proposer slashing object has been received from the outside world
+	// and added to the slashing pool. (eg. in RPC or sync)
+	ProposerSlashingReceived
+
+	// SyncCommitteeContributionReceived is sent after a sync committee contribution object has been received
+	// from the outside world. (eg. in RPC)
+	SyncCommitteeContributionReceived
+
+	// SyncCommitteeMessageReceived is sent after a sync committee message object has been received
+	// from the outside world. (eg. in RPC or sync)
+	SyncCommitteeMessageReceived
 )
 
 // UnAggregatedAttReceivedData is the data sent with UnaggregatedAttReceived events.
@@ -37,3 +54,27 @@ type ExitReceivedData struct {
 	// Exit is the voluntary exit object.
 	Exit *ethpb.SignedVoluntaryExit
 }
+
+// AttesterSlashingReceivedData is the data sent with AttesterSlashingReceived events.
+type AttesterSlashingReceivedData struct {
+	// AttesterSlashing is the attester slashing object.
+	AttesterSlashing *ethpb.AttesterSlashing
+}
+
+// ProposerSlashingReceivedData is the data sent with ProposerSlashingReceived events.
+type ProposerSlashingReceivedData struct {
+	// ProposerSlashing is the proposer slashing object.
+	ProposerSlashing *ethpb.ProposerSlashing
+}
+
+// SyncCommitteeContributionReceivedData is the data sent with SyncCommitteeContributionReceived events.
+type SyncCommitteeContributionReceivedData struct {
+	// Contribution is the signed contribution and proof object.
+	Contribution *prysmv2.SignedContributionAndProof
+}
+
+// SyncCommitteeMessageReceivedData is the data sent with SyncCommitteeMessageReceived events.
+type SyncCommitteeMessageReceivedData struct {
+	// Message is the sync committee message object.
+	Message *prysmv2.SyncCommitteeMessage
+}
@@ -0,0 +1,89 @@
+package helpers
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+)
+
+// SyncCommitteePeriod returns the sync committee period of the input epoch.
+//
+// Spec pseudocode definition:
+//   def compute_sync_committee_period(epoch: Epoch) -> uint64:
+//    return epoch // EPOCHS_PER_SYNC_COMMITTEE_PERIOD
+func SyncCommitteePeriod(epoch types.Epoch) uint64 {
+	return uint64(epoch / params.BeaconConfig().EpochsPerSyncCommitteePeriod)
+}
+
+// SyncCommitteePeriodStartEpoch returns the first epoch of the sync committee period the input epoch belongs to.
+func SyncCommitteePeriodStartEpoch(epoch types.Epoch) types.Epoch {
+	return types.Epoch(SyncCommitteePeriod(epoch)).Mul(uint64(params.BeaconConfig().EpochsPerSyncCommitteePeriod))
+}
+
+// SyncCommitteeForEpoch returns the sync committee which is in charge during the input epoch.
+// A state only knows the sync committees of its current and its next sync committee period,
+// other epochs result in an error.
+func SyncCommitteeForEpoch(st iface.BeaconState, epoch types.Epoch) (*pbp2p.SyncCommittee, error) {
+	if epoch < params.BeaconConfig().AltairForkEpoch {
+		return nil, errors.Errorf("sync committees are not available before the Altair fork epoch %d", params.BeaconConfig().AltairForkEpoch)
+	}
+	statePeriod := SyncCommitteePeriod(SlotToEpoch(st.Slot()))
+	switch SyncCommitteePeriod(epoch) {
+	case statePeriod:
+		return st.CurrentSyncCommittee()
+	case statePeriod + 1:
+		return st.NextSyncCommittee()
+	default:
+		return nil, errors.Errorf("epoch %d is not within the current or the next sync committee period of state at slot %d", epoch, st.Slot())
+	}
+}
+
+// SyncCommitteeIndices returns the positions of the public key in the sync committee. A validator
+// holds several positions when the committee is larger than the active validator set.
+func SyncCommitteeIndices(committee *pbp2p.SyncCommittee, pubkey []byte) []uint64 {
+	var indices []uint64
+	for i, pk := range committee.Pubkeys {
+		if bytes.Equal(pk, pubkey) {
+			indices = append(indices, uint64(i))
+		}
+	}
+	return indices
+}
+
+// SyncSubcommitteeSize returns the number of sync committee members assigned to a single sync committee subnet.
+func SyncSubcommitteeSize() uint64 {
+	return params.BeaconConfig().SyncCommitteeSize / params.BeaconConfig().SyncCommitteeSubnetCount
+}
+
+// SyncSubnetsFromCommitteeIndices returns the set of sync committee subnets for the given
+// positions in the sync committee.
+func SyncSubnetsFromCommitteeIndices(indices []uint64) []uint64 {
+	subnets := make([]uint64, 0, len(indices))
+	for _, idx := range indices {
+		subnets = append(subnets, idx/SyncSubcommitteeSize())
+	}
+	return sliceutil.SetUint64(subnets)
+}
+
+// IsSyncCommitteeAggregator returns true if the selection proof selects its signer as an aggregator
+// of its sync subcommittee.
+//
+// Spec pseudocode definition:
+//   def is_sync_committee_aggregator(signature: BLSSignature) -> bool:
+//    modulo = max(1, SYNC_COMMITTEE_SIZE // SYNC_COMMITTEE_SUBNET_COUNT // TARGET_AGGREGATORS_PER_SYNC_SUBCOMMITTEE)
+//    return bytes_to_uint64(hash(signature)[0:8]) % modulo == 0
+func IsSyncCommitteeAggregator(sig []byte) bool {
+	modulo := uint64(1)
+	if SyncSubcommitteeSize()/params.BeaconConfig().TargetAggregatorsPerSyncSubcommittee > 1 {
+		modulo = SyncSubcommitteeSize() / params.BeaconConfig().TargetAggregatorsPerSyncSubcommittee
+	}
+	b := hashutil.Hash(sig)
+	return binary.LittleEndian.Uint64(b[:8])%modulo == 0
+}
@@ -0,0 +1,87 @@
+package helpers_test
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestSyncCommitteePeriod(t *testing.T) {
+	period := params.BeaconConfig().EpochsPerSyncCommitteePeriod
+	assert.Equal(t, uint64(0), helpers.SyncCommitteePeriod(0))
+	assert.Equal(t, uint64(0), helpers.SyncCommitteePeriod(period-1))
+	assert.Equal(t, uint64(1), helpers.SyncCommitteePeriod(period))
+	assert.Equal(t, period, helpers.SyncCommitteePeriodStartEpoch(period+3))
+}
+
+func TestSyncCommitteeForEpoch(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig()
+	cfg.AltairForkEpoch = 0
+	params.OverrideBeaconConfig(cfg)
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	st, err := testutil.DeterministicSyncCommitteeState(beaconState)
+	require.NoError(t, err)
+	period := params.BeaconConfig().EpochsPerSyncCommitteePeriod
+
+	committee, err := helpers.SyncCommitteeForEpoch(st, period-1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, st.Current, committee)
+	committee, err = helpers.SyncCommitteeForEpoch(st, period)
+	require.NoError(t, err)
+	assert.DeepEqual(t, st.Next, committee)
+	_, err = helpers.SyncCommitteeForEpoch(st, period*2)
+	assert.ErrorContains(t, "is not within the current or the next sync committee period", err)
+
+	cfg.AltairForkEpoch = 10
+	params.OverrideBeaconConfig(cfg)
+	_, err = helpers.SyncCommitteeForEpoch(st, 9)
+	assert.ErrorContains(t, "sync committees are not available before the Altair fork epoch", err)
+}
+
+func TestSyncCommitteeIndices(t *testing.T) {
+	beaconState, _ := testutil.DeterministicGenesisState(t, 200)
+	st, err := testutil.DeterministicSyncCommitteeState(beaconState)
+	require.NoError(t, err)
+
+	pk := st.PubkeyAtIndex(3)
+	want := []uint64{3}
+	for i := uint64(203); i < params.BeaconConfig().SyncCommitteeSize; i += 200 {
+		want = append(want, i)
+	}
+	assert.DeepEqual(t, want, helpers.SyncCommitteeIndices(st.Current, pk[:]))
+	assert.Equal(t, 0, len(helpers.SyncCommitteeIndices(st.Current, []byte{'A'})))
+}
+
+func TestSyncSubnetsFromCommitteeIndices(t *testing.T) {
+	size := helpers.SyncSubcommitteeSize()
+	assert.Equal(t, params.BeaconConfig().SyncCommitteeSize/params.BeaconConfig().SyncCommitteeSubnetCount, size)
+	subnets := helpers.SyncSubnetsFromCommitteeIndices([]uint64{0, 1, size, 3*size + 2, size + 5})
+	assert.DeepEqual(t, []uint64{0, 1, 3}, subnets)
+	assert.DeepEqual(t, []uint64{}, helpers.SyncSubnetsFromCommitteeIndices(nil))
+}
+
+func TestIsSyncCommitteeAggregator(t *testing.T) {
+	_, privKeys := testutil.DeterministicGenesisState(t, 64)
+	var aggregators int
+	for _, k := range privKeys {
+		if helpers.IsSyncCommitteeAggregator(k.Sign([]byte{'A'}).Marshal()) {
+			aggregators++
+		}
+	}
+	// With a modulo of 8 roughly an eighth of the validators are selected.
+	assert.Equal(t, true, aggregators > 0 && aggregators < len(privKeys), "Unexpected aggregator count %d", aggregators)
+
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig()
+	cfg.TargetAggregatorsPerSyncSubcommittee = cfg.SyncCommitteeSize
+	params.OverrideBeaconConfig(cfg)
+	for _, k := range privKeys {
+		assert.Equal(t, true, helpers.IsSyncCommitteeAggregator(k.Sign([]byte{'A'}).Marshal()))
+	}
+}
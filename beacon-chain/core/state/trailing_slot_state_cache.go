@@ -7,6 +7,7 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/statebudget"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 )
 
@@ -16,8 +17,18 @@ type nextSlotCache struct {
 	state iface.BeaconState
 }
 
+// nextSlotCacheKey is the key the next slot cache tracks its single state with.
+const nextSlotCacheKey = "next_slot"
+
 var (
 	nsc nextSlotCache
+	// nscTracker reports the state held by the next slot cache to the state cache budget.
+	nscTracker = statebudget.Default().Register("next_slot", func(_ interface{}) {
+		nsc.Lock()
+		defer nsc.Unlock()
+		nsc.root = nil
+		nsc.state = nil
+	})
 	// Metrics for the validator cache.
 	nextSlotCacheHit = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "next_slot_cache_hit",
@@ -35,11 +46,12 @@ var (
 func NextSlotState(ctx context.Context, root []byte) (iface.BeaconState, error) {
 	nsc.RLock()
 	defer nsc.RUnlock()
-	if !bytes.Equal(root, nsc.root) {
+	if nsc.state == nil || !bytes.Equal(root, nsc.root) {
 		nextSlotCacheMiss.Inc()
 		return nil, nil
 	}
 	nextSlotCacheHit.Inc()
+	nscTracker.Touch(nextSlotCacheKey)
 	// Returning copied state.
 	return nsc.state.Copy(), nil
 }
@@ -56,9 +68,10 @@ func UpdateNextSlotCache(ctx context.Context, root []byte, state iface.BeaconSta
 	}
 
 	nsc.Lock()
-	defer nsc.Unlock()
-
 	nsc.root = root
 	nsc.state = copied
+	evictions := nscTracker.Put(nextSlotCacheKey, copied)
+	nsc.Unlock()
+	evictions.Evict()
 	return nil
 }
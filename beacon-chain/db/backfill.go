@@ -0,0 +1,45 @@
+package db
+
+import (
+	"context"
+	"path"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// BackfillInclusionIndices indexes the attestations of the blocks already saved in a beacon chain
+// database, so that they can be looked up by the inclusion explorer API.
+func BackfillInclusionIndices(cliCtx *cli.Context) error {
+	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
+	startSlot := types.Slot(cliCtx.Uint64(flags.InclusionBackfillStartSlot.Name))
+	endSlot := types.Slot(cliCtx.Uint64(flags.InclusionBackfillEndSlot.Name))
+
+	ctx := cliCtx.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	d, err := kv.NewKVStore(ctx, path.Join(dataDir, kv.BeaconNodeDbDirName), &kv.Config{})
+	if err != nil {
+		return errors.Wrap(err, "could not open database")
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Could not close database")
+		}
+	}()
+	count, err := d.BackfillInclusionIndices(ctx, startSlot, endSlot)
+	if err != nil {
+		return errors.Wrap(err, "could not backfill inclusion indices")
+	}
+	log.WithFields(logrus.Fields{
+		"startSlot": startSlot,
+		"blocks":    count,
+	}).Info("Backfilled inclusion indices")
+	return nil
+}
@@ -0,0 +1,57 @@
+package db
+
+import (
+	"context"
+	"flag"
+	"path"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/eth/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/urfave/cli/v2"
+)
+
+func TestBackfillInclusionIndices(t *testing.T) {
+	logHook := logTest.NewGlobal()
+	ctx := context.Background()
+	dataDir := t.TempDir()
+
+	d, err := kv.NewKVStore(ctx, path.Join(dataDir, kv.BeaconNodeDbDirName), &kv.Config{})
+	require.NoError(t, err)
+	att := testutil.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.NewBitlist(8)})
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = 10
+	blk.Block.Body.Attestations = []*ethpb.Attestation{att}
+	require.NoError(t, d.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(blk)))
+	require.NoError(t, d.Close())
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(cmd.DataDirFlag.Name, dataDir, "")
+	set.Uint64(flags.InclusionBackfillStartSlot.Name, 0, "")
+	set.Uint64(flags.InclusionBackfillEndSlot.Name, flags.InclusionBackfillEndSlot.Value, "")
+	cliCtx := cli.NewContext(&app, set, nil)
+	require.NoError(t, BackfillInclusionIndices(cliCtx))
+	assert.LogsContain(t, logHook, "Backfilled inclusion indices")
+
+	d, err = kv.NewKVStore(ctx, path.Join(dataDir, kv.BeaconNodeDbDirName), &kv.Config{})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, d.Close())
+	}()
+	dataRoot, err := att.Data.HashTreeRoot()
+	require.NoError(t, err)
+	roots, err := d.AttestationInclusionBlockRoots(ctx, dataRoot)
+	require.NoError(t, err)
+	blockRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][32]byte{blockRoot}, roots)
+}
@@ -32,6 +32,9 @@ type ReadOnlyDatabase interface {
 	IsFinalizedBlock(ctx context.Context, blockRoot [32]byte) bool
 	FinalizedChildBlock(ctx context.Context, blockRoot [32]byte) (interfaces.SignedBeaconBlock, error)
 	HighestSlotBlocksBelow(ctx context.Context, slot types.Slot) ([]interfaces.SignedBeaconBlock, error)
+	// Attestation inclusion related methods.
+	AttestationInclusionBlockRoots(ctx context.Context, dataRoot [32]byte) ([][32]byte, error)
+	CommitteeInclusionBlockRoots(ctx context.Context, slot types.Slot, committeeIndex types.CommitteeIndex) ([][32]byte, error)
 	// State related methods.
 	State(ctx context.Context, blockRoot [32]byte) (iface.BeaconState, error)
 	GenesisState(ctx context.Context) (iface.BeaconState, error)
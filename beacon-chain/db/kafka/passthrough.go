@@ -249,6 +249,18 @@ func (e Exporter) HighestSlotBlocksBelow(ctx context.Context, slot types.Slot) (
 	return e.db.HighestSlotBlocksBelow(ctx, slot)
 }
 
+// AttestationInclusionBlockRoots -- passthrough
+func (e Exporter) AttestationInclusionBlockRoots(ctx context.Context, dataRoot [32]byte) ([][32]byte, error) {
+	return e.db.AttestationInclusionBlockRoots(ctx, dataRoot)
+}
+
+// CommitteeInclusionBlockRoots -- passthrough
+func (e Exporter) CommitteeInclusionBlockRoots(
+	ctx context.Context, slot types.Slot, committeeIndex types.CommitteeIndex,
+) ([][32]byte, error) {
+	return e.db.CommitteeInclusionBlockRoots(ctx, slot, committeeIndex)
+}
+
 // HighestSlotStatesBelow -- passthrough
 func (e Exporter) HighestSlotStatesBelow(ctx context.Context, slot types.Slot) ([]iface.ReadOnlyBeaconState, error) {
 	return e.db.HighestSlotStatesBelow(ctx, slot)
@@ -204,6 +204,9 @@ func (s *Store) deleteBlock(ctx context.Context, blockRoot [32]byte) error {
 		if err := deleteValueForIndices(ctx, indicesByBucket, blockRoot[:], tx); err != nil {
 			return errors.Wrap(err, "could not delete root for DB indices")
 		}
+		if err := deleteInclusionIndices(tx, wrapper.WrappedPhase0BeaconBlock(block.Block), blockRoot); err != nil {
+			return errors.Wrap(err, "could not delete root for inclusion indices")
+		}
 		s.blockCache.Del(string(blockRoot[:]))
 		return bkt.Delete(blockRoot[:])
 	})
@@ -229,6 +232,9 @@ func (s *Store) deleteBlocks(ctx context.Context, blockRoots [][32]byte) error {
 			if err := deleteValueForIndices(ctx, indicesByBucket, blockRoot[:], tx); err != nil {
 				return errors.Wrap(err, "could not delete root for DB indices")
 			}
+			if err := deleteInclusionIndices(tx, wrapper.WrappedPhase0BeaconBlock(block.Block), blockRoot); err != nil {
+				return errors.Wrap(err, "could not delete root for inclusion indices")
+			}
 			s.blockCache.Del(string(blockRoot[:]))
 			if err := bkt.Delete(blockRoot[:]); err != nil {
 				return err
@@ -277,6 +283,11 @@ func (s *Store) SaveBlocks(ctx context.Context, blocks []interfaces.SignedBeacon
 			if err := updateValueForIndices(ctx, indicesByBucket, blockRoot[:], tx); err != nil {
 				return errors.Wrap(err, "could not update DB indices")
 			}
+			if s.inclusionIndices {
+				if err := updateInclusionIndices(tx, block.Block(), blockRoot); err != nil {
+					return errors.Wrap(err, "could not update inclusion indices")
+				}
+			}
 			s.blockCache.Set(string(blockRoot[:]), block, int64(len(enc)))
 
 			if err := bkt.Put(blockRoot[:], enc); err != nil {
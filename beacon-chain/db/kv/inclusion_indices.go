@@ -0,0 +1,230 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/eth/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/proto/interfaces"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// inclusionBackfillBatchSize is the number of slots indexed in a single transaction when
+// backfilling the inclusion indices.
+const inclusionBackfillBatchSize = 1024
+
+// AttestationInclusionBlockRoots returns the roots of the blocks which included an attestation
+// with the given attestation data root.
+func (s *Store) AttestationInclusionBlockRoots(ctx context.Context, dataRoot [32]byte) ([][32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.AttestationInclusionBlockRoots")
+	defer span.End()
+	var roots [][32]byte
+	err := s.db.View(func(tx *bolt.Tx) error {
+		roots = splitRoots(tx.Bucket(attestationDataRootInclusionIndicesBucket).Get(dataRoot[:]))
+		return nil
+	})
+	return roots, err
+}
+
+// CommitteeInclusionBlockRoots returns the roots of the blocks which included an attestation
+// of the given beacon committee.
+func (s *Store) CommitteeInclusionBlockRoots(
+	ctx context.Context,
+	slot types.Slot,
+	committeeIndex types.CommitteeIndex,
+) ([][32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.CommitteeInclusionBlockRoots")
+	defer span.End()
+	var roots [][32]byte
+	err := s.db.View(func(tx *bolt.Tx) error {
+		roots = splitRoots(tx.Bucket(attestationCommitteeInclusionIndicesBucket).Get(committeeInclusionKey(slot, committeeIndex)))
+		return nil
+	})
+	return roots, err
+}
+
+// BackfillInclusionIndices indexes the attestations of every block saved in the given slot range,
+// inclusive, and returns the number of indexed blocks. Blocks which are already indexed are
+// not indexed twice. The end of the range is capped to the highest slot of a saved block.
+func (s *Store) BackfillInclusionIndices(ctx context.Context, startSlot, endSlot types.Slot) (int, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.BackfillInclusionIndices")
+	defer span.End()
+	if endSlot < startSlot {
+		return 0, errInvalidSlotRange
+	}
+	var highestSlot types.Slot
+	hasBlocks := false
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		k, _ := tx.Bucket(blockSlotIndicesBucket).Cursor().Last()
+		if k != nil {
+			hasBlocks = true
+			highestSlot = bytesutil.BytesToSlotBigEndian(k)
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	if !hasBlocks || startSlot > highestSlot {
+		return 0, nil
+	}
+	if endSlot > highestSlot {
+		endSlot = highestSlot
+	}
+	count := 0
+	for batchStart := startSlot; batchStart <= endSlot; batchStart += inclusionBackfillBatchSize {
+		if ctx.Err() != nil {
+			return count, ctx.Err()
+		}
+		batchEnd := batchStart + inclusionBackfillBatchSize - 1
+		if batchEnd > endSlot || batchEnd < batchStart {
+			batchEnd = endSlot
+		}
+		if err := s.db.Update(func(tx *bolt.Tx) error {
+			max := bytesutil.SlotToBytesBigEndian(batchEnd)
+			blocks := tx.Bucket(blocksBucket)
+			c := tx.Bucket(blockSlotIndicesBucket).Cursor()
+			for k, v := c.Seek(bytesutil.SlotToBytesBigEndian(batchStart)); k != nil && bytes.Compare(k, max) <= 0; k, v = c.Next() {
+				for _, root := range splitRoots(v) {
+					enc := blocks.Get(root[:])
+					if enc == nil {
+						continue
+					}
+					blk := &ethpb.SignedBeaconBlock{}
+					if err := decode(ctx, enc, blk); err != nil {
+						return err
+					}
+					if err := updateInclusionIndices(tx, wrapper.WrappedPhase0BeaconBlock(blk.Block), root); err != nil {
+						return err
+					}
+					count++
+				}
+			}
+			return nil
+		}); err != nil {
+			return count, err
+		}
+		if batchEnd == endSlot {
+			break
+		}
+	}
+	return count, nil
+}
+
+// updateInclusionIndices adds the block root to the inclusion indices of each attestation in the block.
+func updateInclusionIndices(tx *bolt.Tx, block interfaces.BeaconBlock, blockRoot [32]byte) error {
+	dataRootKeys, committeeKeys, err := inclusionKeys(block)
+	if err != nil {
+		return err
+	}
+	if err := addRootForKeys(tx.Bucket(attestationDataRootInclusionIndicesBucket), dataRootKeys, blockRoot); err != nil {
+		return err
+	}
+	return addRootForKeys(tx.Bucket(attestationCommitteeInclusionIndicesBucket), committeeKeys, blockRoot)
+}
+
+// deleteInclusionIndices removes the block root from the inclusion indices of each attestation in the block.
+func deleteInclusionIndices(tx *bolt.Tx, block interfaces.BeaconBlock, blockRoot [32]byte) error {
+	dataRootKeys, committeeKeys, err := inclusionKeys(block)
+	if err != nil {
+		return err
+	}
+	if err := deleteRootForKeys(tx.Bucket(attestationDataRootInclusionIndicesBucket), dataRootKeys, blockRoot); err != nil {
+		return err
+	}
+	return deleteRootForKeys(tx.Bucket(attestationCommitteeInclusionIndicesBucket), committeeKeys, blockRoot)
+}
+
+// inclusionKeys returns the distinct attestation data roots and beacon committees of the attestations in a block.
+func inclusionKeys(block interfaces.BeaconBlock) ([][]byte, [][]byte, error) {
+	if block == nil || block.IsNil() || block.Body().IsNil() {
+		return nil, nil, nil
+	}
+	seen := make(map[string]bool)
+	var dataRootKeys, committeeKeys [][]byte
+	for _, att := range block.Body().Attestations() {
+		if att == nil || att.Data == nil {
+			continue
+		}
+		dataRoot, err := att.Data.HashTreeRoot()
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "could not hash attestation data")
+		}
+		if !seen[string(dataRoot[:])] {
+			seen[string(dataRoot[:])] = true
+			dataRootKeys = append(dataRootKeys, dataRoot[:])
+		}
+		committeeKey := committeeInclusionKey(att.Data.Slot, att.Data.CommitteeIndex)
+		if !seen[string(committeeKey)] {
+			seen[string(committeeKey)] = true
+			committeeKeys = append(committeeKeys, committeeKey)
+		}
+	}
+	return dataRootKeys, committeeKeys, nil
+}
+
+// committeeInclusionKey is the slot followed by the committee index, so that keys are sorted by slot.
+func committeeInclusionKey(slot types.Slot, committeeIndex types.CommitteeIndex) []byte {
+	return append(bytesutil.SlotToBytesBigEndian(slot), bytesutil.Uint64ToBytesBigEndian(uint64(committeeIndex))...)
+}
+
+func addRootForKeys(bkt *bolt.Bucket, keys [][]byte, root [32]byte) error {
+	for _, k := range keys {
+		values := bkt.Get(k)
+		if containsRoot(values, root) {
+			continue
+		}
+		updated := make([]byte, len(values), len(values)+len(root))
+		copy(updated, values)
+		if err := bkt.Put(k, append(updated, root[:]...)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func deleteRootForKeys(bkt *bolt.Bucket, keys [][]byte, root [32]byte) error {
+	for _, k := range keys {
+		values := bkt.Get(k)
+		if !containsRoot(values, root) {
+			continue
+		}
+		updated := make([]byte, 0, len(values)-len(root))
+		for i := 0; i < len(values); i += 32 {
+			if !bytes.Equal(values[i:i+32], root[:]) {
+				updated = append(updated, values[i:i+32]...)
+			}
+		}
+		if len(updated) == 0 {
+			if err := bkt.Delete(k); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := bkt.Put(k, updated); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func containsRoot(values []byte, root [32]byte) bool {
+	for i := 0; i+32 <= len(values); i += 32 {
+		if bytes.Equal(values[i:i+32], root[:]) {
+			return true
+		}
+	}
+	return false
+}
+
+func splitRoots(values []byte) [][32]byte {
+	roots := make([][32]byte, 0, len(values)/32)
+	for i := 0; i+32 <= len(values); i += 32 {
+		roots = append(roots, bytesutil.ToBytes32(values[i:i+32]))
+	}
+	return roots
+}
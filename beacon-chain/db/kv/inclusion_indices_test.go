@@ -0,0 +1,142 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/eth/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func setupInclusionIndicesDB(t testing.TB) *Store {
+	db, err := NewKVStore(context.Background(), t.TempDir(), &Config{EnableInclusionIndices: true})
+	require.NoError(t, err, "Failed to instantiate DB")
+	t.Cleanup(func() {
+		require.NoError(t, db.Close(), "Failed to close database")
+	})
+	return db
+}
+
+func inclusionTestBlock(slot types.Slot, atts ...*ethpb.Attestation) *ethpb.SignedBeaconBlock {
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = slot
+	b.Block.Body.Attestations = atts
+	return b
+}
+
+func inclusionTestAttestation(slot types.Slot, committeeIndex types.CommitteeIndex, beaconBlockRoot byte) *ethpb.Attestation {
+	att := testutil.HydrateAttestation(&ethpb.Attestation{
+		AggregationBits: bitfield.NewBitlist(8),
+		Data: &ethpb.AttestationData{
+			Slot:            slot,
+			CommitteeIndex:  committeeIndex,
+			BeaconBlockRoot: bytesutil.PadTo([]byte{beaconBlockRoot}, 32),
+		},
+	})
+	return att
+}
+
+func TestStore_InclusionIndices(t *testing.T) {
+	db := setupInclusionIndicesDB(t)
+	ctx := context.Background()
+
+	att1 := inclusionTestAttestation(5, 1, 'a')
+	att2 := inclusionTestAttestation(5, 1, 'b')
+	att3 := inclusionTestAttestation(6, 0, 'a')
+	blk1 := inclusionTestBlock(6, att1, att2)
+	blk2 := inclusionTestBlock(7, att1, att3)
+	root1, err := blk1.Block.HashTreeRoot()
+	require.NoError(t, err)
+	root2, err := blk2.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(blk1)))
+	require.NoError(t, db.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(blk2)))
+
+	dataRoot1, err := att1.Data.HashTreeRoot()
+	require.NoError(t, err)
+	roots, err := db.AttestationInclusionBlockRoots(ctx, dataRoot1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][32]byte{root1, root2}, roots)
+	dataRoot2, err := att2.Data.HashTreeRoot()
+	require.NoError(t, err)
+	roots, err = db.AttestationInclusionBlockRoots(ctx, dataRoot2)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][32]byte{root1}, roots)
+
+	// Both attestations of the committee in the first block are indexed once.
+	roots, err = db.CommitteeInclusionBlockRoots(ctx, 5, 1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][32]byte{root1, root2}, roots)
+	roots, err = db.CommitteeInclusionBlockRoots(ctx, 6, 0)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][32]byte{root2}, roots)
+	roots, err = db.CommitteeInclusionBlockRoots(ctx, 6, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(roots))
+
+	require.NoError(t, db.deleteBlock(ctx, root1))
+	roots, err = db.AttestationInclusionBlockRoots(ctx, dataRoot1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][32]byte{root2}, roots)
+	roots, err = db.AttestationInclusionBlockRoots(ctx, dataRoot2)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(roots))
+}
+
+func TestStore_InclusionIndices_Disabled(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	att := inclusionTestAttestation(5, 1, 'a')
+	require.NoError(t, db.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(inclusionTestBlock(6, att))))
+	dataRoot, err := att.Data.HashTreeRoot()
+	require.NoError(t, err)
+	roots, err := db.AttestationInclusionBlockRoots(ctx, dataRoot)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(roots))
+}
+
+func TestStore_BackfillInclusionIndices(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	att := inclusionTestAttestation(5, 1, 'a')
+	var roots [][32]byte
+	for _, slot := range []types.Slot{6, 7, 2000} {
+		blk := inclusionTestBlock(slot, att)
+		root, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		roots = append(roots, root)
+		require.NoError(t, db.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(blk)))
+	}
+	dataRoot, err := att.Data.HashTreeRoot()
+	require.NoError(t, err)
+
+	count, err := db.BackfillInclusionIndices(ctx, 7, 1<<63)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	indexed, err := db.AttestationInclusionBlockRoots(ctx, dataRoot)
+	require.NoError(t, err)
+	assert.DeepEqual(t, roots[1:], indexed)
+
+	// Backfilling an indexed range does not duplicate entries.
+	count, err = db.BackfillInclusionIndices(ctx, 0, 3000)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	indexed, err = db.CommitteeInclusionBlockRoots(ctx, 5, 1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][32]byte{roots[1], roots[2], roots[0]}, indexed)
+
+	count, err = db.BackfillInclusionIndices(ctx, 2001, 3000)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	_, err = db.BackfillInclusionIndices(ctx, 10, 9)
+	assert.ErrorContains(t, errInvalidSlotRange.Error(), err)
+}
@@ -46,11 +46,16 @@ var blockedBuckets = [][]byte{
 	blockParentRootIndicesBucket,
 	blockSlotIndicesBucket,
 	finalizedBlockRootsIndexBucket,
+	attestationDataRootInclusionIndicesBucket,
+	attestationCommitteeInclusionIndicesBucket,
 }
 
 // Config for the bolt db kv store.
 type Config struct {
 	InitialMMapSize int
+	// EnableInclusionIndices indexes the attestations of blocks as they are saved, so that the blocks
+	// which included an attestation can be looked up.
+	EnableInclusionIndices bool
 }
 
 // Store defines an implementation of the Prysm Database interface
@@ -61,6 +66,7 @@ type Store struct {
 	blockCache          *ristretto.Cache
 	validatorIndexCache *ristretto.Cache
 	stateSummaryCache   *stateSummaryCache
+	inclusionIndices    bool
 	ctx                 context.Context
 }
 
@@ -124,6 +130,7 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 		blockCache:          blockCache,
 		validatorIndexCache: validatorCache,
 		stateSummaryCache:   newStateSummaryCache(),
+		inclusionIndices:    config.EnableInclusionIndices,
 		ctx:                 ctx,
 	}
 
@@ -150,6 +157,8 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 			stateSlotIndicesBucket,
 			blockParentRootIndicesBucket,
 			finalizedBlockRootsIndexBucket,
+			attestationDataRootInclusionIndicesBucket,
+			attestationCommitteeInclusionIndicesBucket,
 			// State management service bucket.
 			newStateServiceCompatibleBucket,
 			// Migrations
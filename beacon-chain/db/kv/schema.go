@@ -34,6 +34,10 @@ var (
 	attestationTargetEpochIndicesBucket = []byte("attestation-target-epoch-indices")
 	finalizedBlockRootsIndexBucket      = []byte("finalized-block-roots-index")
 
+	// Optional attestation inclusion indices buckets.
+	attestationDataRootInclusionIndicesBucket  = []byte("attestation-data-root-inclusion-indices")
+	attestationCommitteeInclusionIndicesBucket = []byte("attestation-committee-inclusion-indices")
+
 	// Specific item keys.
 	headBlockRootKey          = []byte("head-root")
 	genesisBlockRootKey       = []byte("genesis-root")
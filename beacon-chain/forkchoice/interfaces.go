@@ -44,5 +44,7 @@ type Getter interface {
 	Store() *protoarray.Store
 	HasParent(root [32]byte) bool
 	AncestorRoot(ctx context.Context, root [32]byte, slot types.Slot) ([]byte, error)
+	CommonAncestorRoot(ctx context.Context, r1, r2 [32]byte) ([32]byte, types.Slot, error)
 	IsCanonical(root [32]byte) bool
+	Snapshot(includePruned bool) *protoarray.Snapshot
 }
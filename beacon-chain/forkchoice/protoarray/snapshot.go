@@ -0,0 +1,122 @@
+package protoarray
+
+import (
+	types "github.com/prysmaticlabs/eth2-types"
+)
+
+// This defines the maximum number of pruned block nodes kept in the store
+// for debugging, the oldest are dropped first.
+const prunedNodesLimit = 256
+
+// NodeSnapshot is a copy of a block node in the fork choice store. Unlike the node itself, it refers
+// to its parent, best child and best descendant by root, so it stays valid once the store is pruned.
+type NodeSnapshot struct {
+	Slot           types.Slot
+	Root           [32]byte
+	Parent         [32]byte // zero if the parent is not in the store.
+	JustifiedEpoch types.Epoch
+	FinalizedEpoch types.Epoch
+	Weight         uint64
+	BestChild      [32]byte // zero if the node has no best child.
+	BestDescendant [32]byte // zero if the node has no best descendant.
+	Graffiti       [32]byte
+	Viable         bool // whether the node is viable for head.
+	Pruned         bool // whether the node was pruned from the store.
+}
+
+// Snapshot is a copy of the fork choice store at a point in time.
+type Snapshot struct {
+	JustifiedEpoch types.Epoch
+	FinalizedEpoch types.Epoch
+	FinalizedRoot  [32]byte
+	Nodes          []*NodeSnapshot
+}
+
+// Snapshot returns a copy of the block nodes and checkpoints in the fork choice store. The most
+// recently pruned nodes are included, before the others, when includePruned is set.
+func (f *ForkChoice) Snapshot(includePruned bool) *Snapshot {
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+
+	s := f.store
+	snapshot := &Snapshot{
+		JustifiedEpoch: s.justifiedEpoch,
+		FinalizedEpoch: s.finalizedEpoch,
+		FinalizedRoot:  s.finalizedRoot,
+	}
+	if includePruned {
+		snapshot.Nodes = make([]*NodeSnapshot, 0, len(s.prunedNodes)+len(s.nodes))
+		for _, n := range s.prunedNodes {
+			cpy := *n
+			snapshot.Nodes = append(snapshot.Nodes, &cpy)
+		}
+	}
+	for _, n := range s.nodes {
+		node := s.snapshotNode(n)
+		if parent, ok := s.prunedParents[n.root]; ok && includePruned {
+			node.Parent = parent
+		}
+		snapshot.Nodes = append(snapshot.Nodes, node)
+	}
+	return snapshot
+}
+
+// snapshotNode copies a node of the store, resolving the indices of its parent, best child and best
+// descendant to roots. The caller must hold the nodes lock.
+func (s *Store) snapshotNode(n *Node) *NodeSnapshot {
+	return &NodeSnapshot{
+		Slot:           n.slot,
+		Root:           n.root,
+		Parent:         s.nodeRoot(n.parent),
+		JustifiedEpoch: n.justifiedEpoch,
+		FinalizedEpoch: n.finalizedEpoch,
+		Weight:         n.weight,
+		BestChild:      s.nodeRoot(n.bestChild),
+		BestDescendant: s.nodeRoot(n.bestDescendant),
+		Graffiti:       n.graffiti,
+		Viable:         s.viableForHead(n),
+	}
+}
+
+// nodeRoot returns the root of the node at an index of the store, or a zero root if there is none.
+func (s *Store) nodeRoot(index uint64) [32]byte {
+	if index == NonExistentNode || index >= uint64(len(s.nodes)) {
+		return [32]byte{}
+	}
+	return s.nodes[index].root
+}
+
+// keepPrunedNodes records the nodes before an index of the store, which are about to be pruned,
+// keeping at most prunedNodesLimit of them, and the parents of the remaining nodes which are pruned.
+// The caller must hold the nodes lock.
+func (s *Store) keepPrunedNodes(finalizedIndex uint64) {
+	if s.prunedParents == nil {
+		s.prunedParents = make(map[[32]byte][32]byte)
+	}
+	for _, n := range s.nodes[:finalizedIndex] {
+		delete(s.prunedParents, n.root)
+	}
+	for _, n := range s.nodes[finalizedIndex:] {
+		if n.parent < finalizedIndex {
+			s.prunedParents[n.root] = s.nodes[n.parent].root
+		}
+	}
+
+	start := uint64(0)
+	if finalizedIndex > prunedNodesLimit {
+		start = finalizedIndex - prunedNodesLimit
+	}
+	pruned := make([]*NodeSnapshot, 0, prunedNodesLimit)
+	if kept := int(prunedNodesLimit - (finalizedIndex - start)); kept < len(s.prunedNodes) {
+		pruned = append(pruned, s.prunedNodes[len(s.prunedNodes)-kept:]...)
+	} else {
+		pruned = append(pruned, s.prunedNodes...)
+	}
+	for _, n := range s.nodes[start:finalizedIndex] {
+		snapshot := s.snapshotNode(n)
+		snapshot.Viable = false
+		snapshot.Pruned = true
+		pruned = append(pruned, snapshot)
+	}
+	s.prunedNodes = pruned
+}
@@ -0,0 +1,82 @@
+package protoarray
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestForkChoice_Snapshot(t *testing.T) {
+	ctx := context.Background()
+	f := New(1, 1, [32]byte{'a'})
+	require.NoError(t, f.ProcessBlock(ctx, 32, [32]byte{'a'}, [32]byte{'g'}, [32]byte{}, 1, 1))
+	require.NoError(t, f.ProcessBlock(ctx, 33, [32]byte{'b'}, [32]byte{'a'}, [32]byte{}, 1, 1))
+	require.NoError(t, f.ProcessBlock(ctx, 34, [32]byte{'c'}, [32]byte{'a'}, [32]byte{}, 0, 0))
+
+	snapshot := f.Snapshot(false)
+	assert.Equal(t, types.Epoch(1), snapshot.JustifiedEpoch)
+	assert.Equal(t, types.Epoch(1), snapshot.FinalizedEpoch)
+	assert.Equal(t, [32]byte{'a'}, snapshot.FinalizedRoot)
+	require.Equal(t, 3, len(snapshot.Nodes))
+
+	a, b, c := snapshot.Nodes[0], snapshot.Nodes[1], snapshot.Nodes[2]
+	assert.Equal(t, [32]byte{}, a.Parent, "Parent of the first node is not in the store")
+	assert.Equal(t, [32]byte{'b'}, a.BestChild)
+	assert.Equal(t, [32]byte{'b'}, a.BestDescendant)
+	assert.Equal(t, [32]byte{'a'}, b.Parent)
+	assert.Equal(t, [32]byte{}, b.BestChild)
+	assert.Equal(t, true, a.Viable)
+	assert.Equal(t, true, b.Viable)
+	assert.Equal(t, false, c.Viable, "Node with older checkpoints is viable for head")
+	assert.Equal(t, false, c.Pruned)
+}
+
+func TestForkChoice_Snapshot_IncludesPrunedNodes(t *testing.T) {
+	numOfNodes := 100
+	indices := make(map[[32]byte]uint64)
+	nodes := make([]*Node, 0)
+	for i := 0; i < numOfNodes; i++ {
+		indices[indexToHash(uint64(i))] = uint64(i)
+		nodes = append(nodes, &Node{slot: types.Slot(i), root: indexToHash(uint64(i)), parent: uint64(i) - 1,
+			bestDescendant: NonExistentNode, bestChild: NonExistentNode})
+	}
+	f := &ForkChoice{store: &Store{nodes: nodes, nodesIndices: indices}}
+
+	require.NoError(t, f.Prune(context.Background(), indexToHash(10)))
+	require.Equal(t, 90, len(f.Snapshot(false).Nodes))
+	assert.Equal(t, [32]byte{}, f.Snapshot(false).Nodes[0].Parent, "Pruned parent without pruned nodes")
+
+	snapshot := f.Snapshot(true)
+	require.Equal(t, 100, len(snapshot.Nodes))
+	for i, n := range snapshot.Nodes {
+		assert.Equal(t, indexToHash(uint64(i)), n.Root)
+		assert.Equal(t, i < 10, n.Pruned, "Wrong pruned status of node %d", i)
+	}
+	assert.Equal(t, indexToHash(8), snapshot.Nodes[9].Parent, "Pruned node lost its parent")
+	assert.Equal(t, indexToHash(9), snapshot.Nodes[10].Parent, "Finalized node lost its pruned parent")
+	assert.Equal(t, false, snapshot.Nodes[9].Viable, "Pruned node is viable for head")
+}
+
+func TestStore_KeepPrunedNodes_Limit(t *testing.T) {
+	numOfNodes := prunedNodesLimit + 100
+	indices := make(map[[32]byte]uint64)
+	nodes := make([]*Node, 0)
+	for i := 0; i < numOfNodes; i++ {
+		indices[indexToHash(uint64(i))] = uint64(i)
+		nodes = append(nodes, &Node{slot: types.Slot(i), root: indexToHash(uint64(i)),
+			bestDescendant: NonExistentNode, bestChild: NonExistentNode})
+	}
+	s := &Store{nodes: nodes, nodesIndices: indices}
+
+	require.NoError(t, s.prune(context.Background(), indexToHash(50)))
+	require.Equal(t, 50, len(s.prunedNodes))
+
+	// Only the most recently pruned nodes are kept.
+	require.NoError(t, s.prune(context.Background(), indexToHash(uint64(numOfNodes-1))))
+	require.Equal(t, prunedNodesLimit, len(s.prunedNodes))
+	assert.Equal(t, indexToHash(uint64(numOfNodes-1-prunedNodesLimit)), s.prunedNodes[0].Root)
+	assert.Equal(t, indexToHash(uint64(numOfNodes-2)), s.prunedNodes[prunedNodesLimit-1].Root)
+}
@@ -212,6 +212,43 @@ func (f *ForkChoice) AncestorRoot(ctx context.Context, root [32]byte, slot types
 	return f.store.nodes[i].root[:], nil
 }
 
+// CommonAncestorRoot returns the root and the slot of the latest block which is an ancestor of both input
+// block roots. A block is considered to be its own ancestor.
+func (f *ForkChoice) CommonAncestorRoot(ctx context.Context, r1, r2 [32]byte) ([32]byte, types.Slot, error) {
+	ctx, span := trace.StartSpan(ctx, "protoArray.CommonAncestorRoot")
+	defer span.End()
+
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+
+	i, ok := f.store.nodesIndices[r1]
+	if !ok {
+		return [32]byte{}, 0, errors.New("node does not exist")
+	}
+	j, ok := f.store.nodesIndices[r2]
+	if !ok {
+		return [32]byte{}, 0, errors.New("node does not exist")
+	}
+
+	// Parents are always inserted before their children, so the node with the higher index can't be
+	// an ancestor of the other one.
+	for i != j {
+		if ctx.Err() != nil {
+			return [32]byte{}, 0, ctx.Err()
+		}
+		if i > j {
+			i = f.store.nodes[i].parent
+		} else {
+			j = f.store.nodes[j].parent
+		}
+		if i >= uint64(len(f.store.nodes)) || j >= uint64(len(f.store.nodes)) {
+			return [32]byte{}, 0, errors.New("no common ancestor found")
+		}
+	}
+
+	return f.store.nodes[i].root, f.store.nodes[i].slot, nil
+}
+
 // PruneThreshold of fork choice store.
 func (s *Store) PruneThreshold() uint64 {
 	return s.pruneThreshold
@@ -585,6 +622,7 @@ func (s *Store) prune(ctx context.Context, finalizedRoot [32]byte) error {
 	if int(finalizedIndex) >= len(s.nodes) {
 		return errors.New("invalid finalized index")
 	}
+	s.keepPrunedNodes(finalizedIndex)
 	s.nodes = s.nodes[finalizedIndex:]
 
 	// Adjust indices to node mapping.
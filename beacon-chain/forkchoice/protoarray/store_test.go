@@ -536,6 +536,42 @@ func TestStore_AncestorRootOutOfBound(t *testing.T) {
 	require.ErrorContains(t, "node index out of range", err)
 }
 
+func TestStore_CommonAncestorRoot(t *testing.T) {
+	ctx := context.Background()
+	f := &ForkChoice{store: &Store{}}
+	f.store.nodesIndices = map[[32]byte]uint64{}
+	// a <- b <- c
+	//   \
+	//    <- d <- e
+	f.store.nodes = []*Node{
+		{slot: 1, root: [32]byte{'a'}, parent: NonExistentNode},
+		{slot: 2, root: [32]byte{'b'}, parent: 0},
+		{slot: 3, root: [32]byte{'c'}, parent: 1},
+		{slot: 4, root: [32]byte{'d'}, parent: 0},
+		{slot: 5, root: [32]byte{'e'}, parent: 3},
+		{slot: 5, root: [32]byte{'f'}, parent: NonExistentNode},
+	}
+	for i, n := range f.store.nodes {
+		f.store.nodesIndices[n.root] = uint64(i)
+	}
+
+	_, _, err := f.CommonAncestorRoot(ctx, [32]byte{'a'}, [32]byte{'z'})
+	assert.ErrorContains(t, "node does not exist", err)
+
+	r, slot, err := f.CommonAncestorRoot(ctx, [32]byte{'c'}, [32]byte{'e'})
+	require.NoError(t, err)
+	assert.Equal(t, [32]byte{'a'}, r)
+	assert.Equal(t, types.Slot(1), slot)
+
+	r, slot, err = f.CommonAncestorRoot(ctx, [32]byte{'c'}, [32]byte{'b'})
+	require.NoError(t, err)
+	assert.Equal(t, [32]byte{'b'}, r)
+	assert.Equal(t, types.Slot(2), slot)
+
+	_, _, err = f.CommonAncestorRoot(ctx, [32]byte{'e'}, [32]byte{'f'})
+	assert.ErrorContains(t, "no common ancestor found", err)
+}
+
 func TestStore_UpdateCanonicalNodes_WholeList(t *testing.T) {
 	ctx := context.Background()
 	f := &ForkChoice{store: &Store{}}
@@ -16,13 +16,15 @@ type ForkChoice struct {
 
 // Store defines the fork choice store which includes block nodes and the last view of checkpoint information.
 type Store struct {
-	pruneThreshold uint64              // do not prune tree unless threshold is reached.
-	justifiedEpoch types.Epoch         // latest justified epoch in store.
-	finalizedEpoch types.Epoch         // latest finalized epoch in store.
-	finalizedRoot  [32]byte            // latest finalized root in store.
-	nodes          []*Node             // list of block nodes, each node is a representation of one block.
-	nodesIndices   map[[32]byte]uint64 // the root of block node and the nodes index in the list.
-	canonicalNodes map[[32]byte]bool   // the canonical block nodes.
+	pruneThreshold uint64                // do not prune tree unless threshold is reached.
+	justifiedEpoch types.Epoch           // latest justified epoch in store.
+	finalizedEpoch types.Epoch           // latest finalized epoch in store.
+	finalizedRoot  [32]byte              // latest finalized root in store.
+	nodes          []*Node               // list of block nodes, each node is a representation of one block.
+	nodesIndices   map[[32]byte]uint64   // the root of block node and the nodes index in the list.
+	canonicalNodes map[[32]byte]bool     // the canonical block nodes.
+	prunedNodes    []*NodeSnapshot       // the most recently pruned block nodes, for debugging.
+	prunedParents  map[[32]byte][32]byte // the pruned parents of the block nodes, for debugging.
 	nodesLock      sync.RWMutex
 }
 
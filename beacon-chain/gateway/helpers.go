@@ -7,6 +7,7 @@ import (
 	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
 	"github.com/prysmaticlabs/prysm/shared/gateway"
 	"google.golang.org/protobuf/encoding/protojson"
 )
@@ -31,10 +32,12 @@ func DefaultConfig(enableDebugRPCEndpoints bool) MuxConfig {
 		ethpbv1.RegisterBeaconChainHandler,
 		ethpbv1.RegisterBeaconValidatorHandler,
 		ethpbv1.RegisterEventsHandler,
+		ethpbv2.RegisterBeaconChainHandler,
+		ethpbv2.RegisterBeaconValidatorHandler,
 	}
 	if enableDebugRPCEndpoints {
 		v1Alpha1Registrations = append(v1Alpha1Registrations, pbrpc.RegisterDebugHandler)
-		v1Registrations = append(v1Registrations, ethpbv1.RegisterBeaconDebugHandler)
+		v1Registrations = append(v1Registrations, ethpbv1.RegisterBeaconDebugHandler, ethpbv2.RegisterBeaconDebugHandler)
 
 	}
 	v1Alpha1Mux := gwruntime.NewServeMux(
@@ -52,18 +55,21 @@ func DefaultConfig(enableDebugRPCEndpoints bool) MuxConfig {
 			"text/event-stream", &gwruntime.EventSourceJSONPb{},
 		),
 	)
-	v1Mux := gwruntime.NewServeMux(
-		gwruntime.WithMarshalerOption(gwruntime.MIMEWildcard, &gwruntime.HTTPBodyMarshaler{
-			Marshaler: &gwruntime.JSONPb{
-				MarshalOptions: protojson.MarshalOptions{
-					UseProtoNames:   true,
-					EmitUnpopulated: true,
-				},
-				UnmarshalOptions: protojson.UnmarshalOptions{
-					DiscardUnknown: true,
-				},
+	v1JSONMarshaler := &gwruntime.HTTPBodyMarshaler{
+		Marshaler: &gwruntime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				UseProtoNames:   true,
+				EmitUnpopulated: true,
 			},
-		}),
+			UnmarshalOptions: protojson.UnmarshalOptions{
+				DiscardUnknown: true,
+			},
+		},
+	}
+	v1Mux := gwruntime.NewServeMux(
+		gwruntime.WithMarshalerOption(gwruntime.MIMEWildcard, v1JSONMarshaler),
+		gwruntime.WithMarshalerOption(gateway.JSONContentType, v1JSONMarshaler),
+		gwruntime.WithMarshalerOption(gateway.SSZContentType, &gateway.SSZMarshaler{Fallback: v1JSONMarshaler}),
 	)
 	muxHandler := func(h http.Handler, w http.ResponseWriter, req *http.Request) {
 		h.ServeHTTP(w, req)
@@ -75,7 +81,7 @@ func DefaultConfig(enableDebugRPCEndpoints bool) MuxConfig {
 	}
 	v1PbHandler := gateway.PbMux{
 		Registrations: v1Registrations,
-		Patterns:      []string{"/eth/v1/"},
+		Patterns:      []string{"/eth/v1/", "/eth/v2/"},
 		Mux:           v1Mux,
 	}
 
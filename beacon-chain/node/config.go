@@ -2,6 +2,7 @@ package node
 
 import (
 	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/statebudget"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -52,6 +53,14 @@ func configureSlotsPerArchivedPoint(cliCtx *cli.Context) {
 	}
 }
 
+func configureStateCacheMemoryBudget(cliCtx *cli.Context) {
+	if cliCtx.IsSet(flags.StateCacheMemoryBudget.Name) {
+		budget := cliCtx.Uint64(flags.StateCacheMemoryBudget.Name)
+		statebudget.Default().SetBudget(budget * 1024 * 1024)
+		log.Infof("Limiting memory retained by cached states to %d MB", budget)
+	}
+}
+
 func configureEth1Config(cliCtx *cli.Context) {
 	if cliCtx.IsSet(flags.ChainID.Name) {
 		c := params.BeaconConfig()
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/statebudget"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -50,6 +51,20 @@ func TestConfigureSlotsPerArchivedPoint(t *testing.T) {
 	assert.Equal(t, types.Slot(100), params.BeaconConfig().SlotsPerArchivedPoint)
 }
 
+func TestConfigureStateCacheMemoryBudget(t *testing.T) {
+	defer statebudget.Default().SetBudget(0)
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.Uint64(flags.StateCacheMemoryBudget.Name, 0, "")
+	require.NoError(t, set.Set(flags.StateCacheMemoryBudget.Name, strconv.Itoa(512)))
+	cliCtx := cli.NewContext(&app, set, nil)
+
+	configureStateCacheMemoryBudget(cliCtx)
+
+	assert.Equal(t, uint64(512*1024*1024), statebudget.Default().Budget())
+}
+
 func TestConfigureProofOfWork(t *testing.T) {
 	params.SetupTestConfigCleanup(t)
 
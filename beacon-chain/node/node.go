@@ -16,8 +16,10 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/statebudget"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
@@ -28,22 +30,26 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/node/registration"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/apimiddleware"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/statefetcher"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	regularsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	initialsync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/apiauth"
 	"github.com/prysmaticlabs/prysm/shared/backuputil"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/debug"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/gateway"
+	"github.com/prysmaticlabs/prysm/shared/networkdir"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/prereq"
 	"github.com/prysmaticlabs/prysm/shared/prometheus"
@@ -59,23 +65,27 @@ const testSkipPowFlag = "test-skip-pow"
 // full PoS node. It handles the lifecycle of the entire system and registers
 // services to a service registry.
 type BeaconNode struct {
-	cliCtx          *cli.Context
-	ctx             context.Context
-	cancel          context.CancelFunc
-	services        *shared.ServiceRegistry
-	lock            sync.RWMutex
-	stop            chan struct{} // Channel to wait for termination notifications.
-	db              db.Database
-	attestationPool attestations.Pool
-	exitPool        voluntaryexits.PoolManager
-	slashingsPool   slashings.PoolManager
-	depositCache    *depositcache.DepositCache
-	stateFeed       *event.Feed
-	blockFeed       *event.Feed
-	opFeed          *event.Feed
-	forkChoiceStore forkchoice.ForkChoicer
-	stateGen        *stategen.State
-	collector       *bcnodeCollector
+	cliCtx            *cli.Context
+	ctx               context.Context
+	cancel            context.CancelFunc
+	services          *shared.ServiceRegistry
+	lock              sync.RWMutex
+	stop              chan struct{} // Channel to wait for termination notifications.
+	db                db.Database
+	attestationPool   attestations.Pool
+	exitPool          voluntaryexits.PoolManager
+	slashingsPool     slashings.PoolManager
+	syncCommitteePool synccommittee.Pool
+	depositCache      *depositcache.DepositCache
+	stateFeed         *event.Feed
+	blockFeed         *event.Feed
+	opFeed            *event.Feed
+	forkChoiceStore   forkchoice.ForkChoicer
+	stateGen          *stategen.State
+	stateBudget       *statebudget.Manager // Default manager, shared with the package level state caches.
+	collector         *bcnodeCollector
+	apiAuthorizer     *apiauth.Authorizer
+	network           *networkdir.Network
 }
 
 // New creates a new node instance, sets up configuration options, and registers
@@ -89,8 +99,13 @@ func New(cliCtx *cli.Context) (*BeaconNode, error) {
 	cmd.ConfigureBeaconChain(cliCtx)
 	flags.ConfigureGlobalFlags(cliCtx)
 	configureChainConfig(cliCtx)
+	network, err := networkdir.ConfigureFromCLI(cliCtx)
+	if err != nil {
+		return nil, err
+	}
 	configureHistoricalSlasher(cliCtx)
 	configureSlotsPerArchivedPoint(cliCtx)
+	configureStateCacheMemoryBudget(cliCtx)
 	configureEth1Config(cliCtx)
 	configureNetwork(cliCtx)
 	configureInteropConfig(cliCtx)
@@ -99,17 +114,20 @@ func New(cliCtx *cli.Context) (*BeaconNode, error) {
 
 	ctx, cancel := context.WithCancel(cliCtx.Context)
 	beacon := &BeaconNode{
-		cliCtx:          cliCtx,
-		ctx:             ctx,
-		cancel:          cancel,
-		services:        registry,
-		stop:            make(chan struct{}),
-		stateFeed:       new(event.Feed),
-		blockFeed:       new(event.Feed),
-		opFeed:          new(event.Feed),
-		attestationPool: attestations.NewPool(),
-		exitPool:        voluntaryexits.NewPool(),
-		slashingsPool:   slashings.NewPool(),
+		cliCtx:            cliCtx,
+		ctx:               ctx,
+		cancel:            cancel,
+		services:          registry,
+		stop:              make(chan struct{}),
+		stateFeed:         new(event.Feed),
+		blockFeed:         new(event.Feed),
+		opFeed:            new(event.Feed),
+		attestationPool:   attestations.NewPool(),
+		exitPool:          voluntaryexits.NewPool(),
+		slashingsPool:     slashings.NewPool(),
+		syncCommitteePool: synccommittee.NewPool(),
+		network:           network,
+		stateBudget:       statebudget.Default(),
 	}
 
 	depositAddress, err := registration.DepositContractAddress()
@@ -152,6 +170,10 @@ func New(cliCtx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if err := beacon.loadAPIAuthorizer(); err != nil {
+		return nil, err
+	}
+
 	if err := beacon.registerRPCService(); err != nil {
 		return nil, err
 	}
@@ -256,7 +278,8 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
 	log.WithField("database-path", dbPath).Info("Checking DB")
 
 	d, err := db.NewDB(b.ctx, dbPath, &kv.Config{
-		InitialMMapSize: cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
+		InitialMMapSize:        cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
+		EnableInclusionIndices: cliCtx.Bool(flags.EnableInclusionIndices.Name),
 	})
 	if err != nil {
 		return err
@@ -280,7 +303,8 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
 			return errors.Wrap(err, "could not clear database")
 		}
 		d, err = db.NewDB(b.ctx, dbPath, &kv.Config{
-			InitialMMapSize: cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
+			InitialMMapSize:        cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
+			EnableInclusionIndices: cliCtx.Bool(flags.EnableInclusionIndices.Name),
 		})
 		if err != nil {
 			return errors.Wrap(err, "could not create new database")
@@ -300,8 +324,12 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
 
 	b.depositCache = depositCache
 
-	if cliCtx.IsSet(flags.GenesisStatePath.Name) {
-		r, err := os.Open(cliCtx.String(flags.GenesisStatePath.Name))
+	genesisStatePath := cliCtx.String(flags.GenesisStatePath.Name)
+	if genesisStatePath == "" && b.network != nil {
+		genesisStatePath = b.network.GenesisStatePath
+	}
+	if genesisStatePath != "" {
+		r, err := os.Open(genesisStatePath)
 		if err != nil {
 			return err
 		}
@@ -346,7 +374,7 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
 }
 
 func (b *BeaconNode) startStateGen() {
-	b.stateGen = stategen.New(b.db)
+	b.stateGen = stategen.New(b.db, stategen.WithStateBudget(b.stateBudget))
 }
 
 func (b *BeaconNode) registerP2P(cliCtx *cli.Context) error {
@@ -431,6 +459,7 @@ func (b *BeaconNode) registerBlockchainService() error {
 		ForkChoiceStore:         b.forkChoiceStore,
 		AttService:              attService,
 		StateGen:                b.stateGen,
+		StateBudget:             b.stateBudget,
 		WeakSubjectivityCheckpt: wsCheckpt,
 	})
 	if err != nil {
@@ -500,6 +529,7 @@ func (b *BeaconNode) registerSyncService() error {
 		AttPool:           b.attestationPool,
 		ExitPool:          b.exitPool,
 		SlashingPool:      b.slashingsPool,
+		SyncCommsPool:     b.syncCommitteePool,
 		StateGen:          b.stateGen,
 	})
 
@@ -563,6 +593,13 @@ func (b *BeaconNode) registerRPCService() error {
 	mockEth1DataVotes := b.cliCtx.Bool(flags.InteropMockEth1DataVotesFlag.Name)
 	enableDebugRPCEndpoints := b.cliCtx.Bool(flags.EnableDebugRPCEndpoints.Name)
 	maxMsgSize := b.cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name)
+	stateReplayLimits := statefetcher.ReplayLimits{
+		Workers:   b.cliCtx.Int(flags.HistoricalStateReplayWorkers.Name),
+		QueueSize: b.cliCtx.Int(flags.HistoricalStateReplayQueueSize.Name),
+		MaxSlots:  types.Slot(b.cliCtx.Uint64(flags.HistoricalStateReplayMaxSlots.Name)),
+		Timeout:   b.cliCtx.Duration(flags.HistoricalStateReplayTimeout.Name),
+		CacheSize: b.cliCtx.Int(flags.HistoricalStateCacheSize.Name),
+	}
 	p2pService := b.fetchP2P()
 	rpcService := rpc.NewService(b.ctx, &rpc.Config{
 		Host:                    host,
@@ -588,6 +625,7 @@ func (b *BeaconNode) registerRPCService() error {
 		AttestationsPool:        b.attestationPool,
 		ExitPool:                b.exitPool,
 		SlashingsPool:           b.slashingsPool,
+		SyncCommitteePool:       b.syncCommitteePool,
 		POWChainService:         web3Service,
 		ChainStartFetcher:       chainStartFetcher,
 		MockEth1Votes:           mockEth1DataVotes,
@@ -600,6 +638,11 @@ func (b *BeaconNode) registerRPCService() error {
 		StateGen:                b.stateGen,
 		EnableDebugRPCEndpoints: enableDebugRPCEndpoints,
 		MaxMsgSize:              maxMsgSize,
+		StateReplayLimits:       stateReplayLimits,
+		StateBudget:             b.stateBudget,
+		EnableInclusionIndices:  b.cliCtx.Bool(flags.EnableInclusionIndices.Name),
+		EventReplayBufferSize:   b.cliCtx.Int(flags.EventStreamReplayBufferSize.Name),
+		Authorizer:              b.apiAuthorizer,
 	})
 
 	return b.services.RegisterService(rpcService)
@@ -629,6 +672,11 @@ func (b *BeaconNode) registerPrometheusService(cliCtx *cli.Context) error {
 	}
 
 	additionalHandlers = append(additionalHandlers, prometheus.Handler{Path: "/tree", Handler: c.TreeHandler})
+	additionalHandlers = append(additionalHandlers,
+		prometheus.Handler{Path: "/forkchoice", Handler: c.ForkChoiceHandler},
+		prometheus.Handler{Path: "/forkchoice/json", Handler: c.ForkChoiceJSONHandler},
+		prometheus.Handler{Path: "/forkchoice/dot", Handler: c.ForkChoiceDotHandler},
+	)
 
 	service := prometheus.NewService(
 		fmt.Sprintf("%s:%d", b.cliCtx.String(cmd.MonitoringHostFlag.Name), b.cliCtx.Int(flags.MonitoringPortFlag.Name)),
@@ -640,6 +688,28 @@ func (b *BeaconNode) registerPrometheusService(cliCtx *cli.Context) error {
 	return b.services.RegisterService(service)
 }
 
+// loadAPIAuthorizer reads the credentials accepted by the gRPC and REST APIs, if configured.
+func (b *BeaconNode) loadAPIAuthorizer() error {
+	path := b.cliCtx.String(flags.APIAuthConfigFile.Name)
+	if path == "" {
+		return nil
+	}
+	cfg, err := apiauth.LoadConfigFile(path)
+	if err != nil {
+		return err
+	}
+	a, err := apiauth.NewAuthorizer(cfg)
+	if err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"path":   path,
+		"tokens": len(cfg.Tokens),
+	}).Info("Restricting API access to configured tokens")
+	b.apiAuthorizer = a
+	return nil
+}
+
 func (b *BeaconNode) registerGRPCGateway() error {
 	if b.cliCtx.Bool(flags.DisableGRPCGateway.Name) {
 		return nil
@@ -667,7 +737,8 @@ func (b *BeaconNode) registerGRPCGateway() error {
 	).WithAllowedOrigins(allowedOrigins).
 		WithRemoteCert(selfCert).
 		WithMaxCallRecvMsgSize(maxCallSize).
-		WithApiMiddleware(apiMiddlewareAddress, &apimiddleware.BeaconEndpointFactory{})
+		WithApiMiddleware(apiMiddlewareAddress, &apimiddleware.BeaconEndpointFactory{}).
+		WithAuthorizer(b.apiAuthorizer)
 
 	return b.services.RegisterService(g)
 }
@@ -84,8 +84,8 @@ func TestService_CanSubscribe(t *testing.T) {
 	for topic := range GossipTopicMappings {
 		formatting := []interface{}{currentFork}
 
-		// Special case for attestation subnets which have a second formatting placeholder.
-		if topic == AttestationSubnetTopicFormat {
+		// Special case for attestation and sync committee subnets which have a second formatting placeholder.
+		if topic == AttestationSubnetTopicFormat || topic == SyncCommitteeSubnetTopicFormat {
 			formatting = append(formatting, 0 /* some subnet ID */)
 		}
 
@@ -2,45 +2,46 @@ package apimiddleware
 
 import (
 	"bytes"
-	"encoding/base64"
 	"encoding/json"
 	"fmt"
-	"io"
-	"io/ioutil"
 	"net/http"
-	"strconv"
 	"strings"
 
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/eth/v1/events"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/shared/apiauth"
 	"github.com/prysmaticlabs/prysm/shared/gateway"
-	"github.com/prysmaticlabs/prysm/shared/grpcutils"
 	"github.com/r3labs/sse"
 )
 
+// eventWithIdTypeName is the type URL suffix of event data sent along with its ID.
+var eventWithIdTypeName = "/" + string((&ethpbv1.EventWithId{}).ProtoReflect().Descriptor().FullName())
+
 type sszConfig struct {
-	sszPath      string
-	fileName     string
-	responseJson sszResponseJson
+	sszPath  string
+	fileName string
 }
 
 func handleGetBeaconStateSSZ(m *gateway.ApiProxyMiddleware, endpoint gateway.Endpoint, w http.ResponseWriter, req *http.Request) (handled bool) {
 	config := sszConfig{
-		sszPath:      "/eth/v1/debug/beacon/states/{state_id}/ssz",
-		fileName:     "beacon_state.ssz",
-		responseJson: &beaconStateSSZResponseJson{},
+		sszPath:  "/eth/v1/debug/beacon/states/{state_id}/ssz",
+		fileName: "beacon_state.ssz",
 	}
 	return handleGetSSZ(m, endpoint, w, req, config)
 }
 
 func handleGetBeaconBlockSSZ(m *gateway.ApiProxyMiddleware, endpoint gateway.Endpoint, w http.ResponseWriter, req *http.Request) (handled bool) {
 	config := sszConfig{
-		sszPath:      "/eth/v1/beacon/blocks/{block_id}/ssz",
-		fileName:     "beacon_block.ssz",
-		responseJson: &blockSSZResponseJson{},
+		sszPath:  "/eth/v1/beacon/blocks/{block_id}/ssz",
+		fileName: "beacon_block.ssz",
 	}
 	return handleGetSSZ(m, endpoint, w, req, config)
 }
 
+// handleGetSSZ proxies the request to the route which serializes the requested object using its own SSZ code,
+// and returns the resulting SSZ as a downloadable file.
 func handleGetSSZ(
 	m *gateway.ApiProxyMiddleware,
 	endpoint gateway.Endpoint,
@@ -48,7 +49,7 @@ func handleGetSSZ(
 	req *http.Request,
 	config sszConfig,
 ) (handled bool) {
-	if !sszRequested(req) {
+	if !gateway.SSZRequested(req) {
 		return false
 	}
 
@@ -66,24 +67,16 @@ func handleGetSSZ(
 		gateway.WriteError(w, errJson, nil)
 		return true
 	}
-	if errJson := gateway.DeserializeGrpcResponseBodyIntoErrorJson(endpoint.Err, grpcResponseBody); errJson != nil {
-		gateway.WriteError(w, errJson, nil)
-		return true
-	}
-	if endpoint.Err.Msg() != "" {
+	if grpcResponse.StatusCode >= http.StatusBadRequest {
+		if errJson := gateway.DeserializeGrpcResponseBodyIntoErrorJson(endpoint.Err, grpcResponseBody); errJson != nil {
+			gateway.WriteError(w, errJson, nil)
+			return true
+		}
 		gateway.HandleGrpcResponseError(endpoint.Err, grpcResponse, w)
 		return true
 	}
-	if errJson := gateway.DeserializeGrpcResponseBodyIntoContainer(grpcResponseBody, config.responseJson); errJson != nil {
-		gateway.WriteError(w, errJson, nil)
-		return true
-	}
-	responseSsz, errJson := serializeMiddlewareResponseIntoSSZ(config.responseJson.SSZData())
-	if errJson != nil {
-		gateway.WriteError(w, errJson, nil)
-		return true
-	}
-	if errJson := writeSSZResponseHeaderAndBody(grpcResponse, w, responseSsz, config.fileName); errJson != nil {
+	w.Header().Set("Content-Disposition", "attachment; filename="+config.fileName)
+	if errJson := gateway.WriteMiddlewareResponseHeadersAndBody(req, grpcResponse, grpcResponseBody, w); errJson != nil {
 		gateway.WriteError(w, errJson, nil)
 		return true
 	}
@@ -95,71 +88,25 @@ func handleGetSSZ(
 	return true
 }
 
-func sszRequested(req *http.Request) bool {
-	accept, ok := req.Header["Accept"]
-	if !ok {
-		return false
-	}
-	for _, v := range accept {
-		if v == "application/octet-stream" {
-			return true
-		}
-	}
-	return false
-}
-
 func prepareSSZRequestForProxying(m *gateway.ApiProxyMiddleware, endpoint gateway.Endpoint, req *http.Request, sszPath string) gateway.ErrorJson {
-	req.URL.Scheme = "http"
-	req.URL.Host = m.GatewayAddress
-	req.RequestURI = ""
 	req.URL.Path = sszPath
-	return gateway.HandleURLParameters(endpoint.Path, req, []string{})
+	return m.PrepareRequestForProxying(endpoint, req)
 }
 
-func serializeMiddlewareResponseIntoSSZ(data string) (sszResponse []byte, errJson gateway.ErrorJson) {
-	// Serialize the SSZ part of the deserialized value.
-	b, err := base64.StdEncoding.DecodeString(data)
-	if err != nil {
-		return nil, gateway.InternalServerErrorWithMessage(err, "could not decode response body into base64")
+func handleEvents(m *gateway.ApiProxyMiddleware, _ gateway.Endpoint, w http.ResponseWriter, req *http.Request) (handled bool) {
+	// Event IDs are requested from the gateway so that clients can resume the stream with a Last-Event-ID header.
+	query := req.URL.Query()
+	query.Set("with_event_ids", "true")
+	if lastEventID := req.Header.Get("Last-Event-ID"); lastEventID != "" {
+		query.Set("last_event_id", lastEventID)
 	}
-	return b, nil
-}
-
-func writeSSZResponseHeaderAndBody(grpcResp *http.Response, w http.ResponseWriter, responseSsz []byte, fileName string) gateway.ErrorJson {
-	var statusCodeHeader string
-	for h, vs := range grpcResp.Header {
-		// We don't want to expose any gRPC metadata in the HTTP response, so we skip forwarding metadata headers.
-		if strings.HasPrefix(h, "Grpc-Metadata") {
-			if h == "Grpc-Metadata-"+grpcutils.HttpCodeMetadataKey {
-				statusCodeHeader = vs[0]
-			}
-		} else {
-			for _, v := range vs {
-				w.Header().Set(h, v)
-			}
+	sseClient := sse.NewClient("http://" + m.GatewayAddress + req.URL.Path + "?" + query.Encode())
+	// Credentials are passed on so that the gateway can authorize the subscription.
+	for _, h := range []string{apiauth.AuthorizationHeader, apiauth.APIKeyHeader} {
+		if v := req.Header.Get(h); v != "" {
+			sseClient.Headers[h] = v
 		}
 	}
-	if statusCodeHeader != "" {
-		code, err := strconv.Atoi(statusCodeHeader)
-		if err != nil {
-			return gateway.InternalServerErrorWithMessage(err, "could not parse status code")
-		}
-		w.WriteHeader(code)
-	} else {
-		w.WriteHeader(grpcResp.StatusCode)
-	}
-	w.Header().Set("Content-Length", strconv.Itoa(len(responseSsz)))
-	w.Header().Set("Content-Type", "application/octet-stream")
-	w.Header().Set("Content-Disposition", "attachment; filename="+fileName)
-	w.WriteHeader(grpcResp.StatusCode)
-	if _, err := io.Copy(w, ioutil.NopCloser(bytes.NewReader(responseSsz))); err != nil {
-		return gateway.InternalServerErrorWithMessage(err, "could not write response message")
-	}
-	return nil
-}
-
-func handleEvents(m *gateway.ApiProxyMiddleware, _ gateway.Endpoint, w http.ResponseWriter, req *http.Request) (handled bool) {
-	sseClient := sse.NewClient("http://" + m.GatewayAddress + req.URL.RequestURI())
 	eventChan := make(chan *sse.Event)
 
 	// We use grpc-gateway as the server side of events, not the sse library.
@@ -184,6 +131,9 @@ func receiveEvents(eventChan <-chan *sse.Event, w http.ResponseWriter, req *http
 	for {
 		select {
 		case msg := <-eventChan:
+			if errJson := unwrapEventID(msg); errJson != nil {
+				return errJson
+			}
 			var data interface{}
 
 			switch strings.TrimSpace(string(msg.Event)) {
@@ -212,6 +162,18 @@ func receiveEvents(eventChan <-chan *sse.Event, w http.ResponseWriter, req *http
 				data = &eventFinalizedCheckpointJson{}
 			case events.ChainReorgTopic:
 				data = &eventChainReorgJson{}
+			case events.ContributionAndProofTopic:
+				data = &signedContributionAndProofJson{}
+			case events.SyncCommitteeTopic:
+				data = &syncCommitteeMessageJson{}
+			case events.AttesterSlashingTopic:
+				data = &attesterSlashingJson{}
+			case events.ProposerSlashingTopic:
+				data = &proposerSlashingJson{}
+			case events.BlockGossipTopic:
+				data = &receivedBlockDataJson{}
+			case events.BlockFullTopic:
+				data = &eventBlockFullJson{}
 			case "error":
 				data = &eventErrorJson{}
 			default:
@@ -233,6 +195,25 @@ func receiveEvents(eventChan <-chan *sse.Event, w http.ResponseWriter, req *http
 	}
 }
 
+// unwrapEventID replaces the data of an event sent along with its ID by the data of the event itself,
+// keeping the ID so that it is written as the event stream "id" field.
+func unwrapEventID(msg *sse.Event) gateway.ErrorJson {
+	envelope := &eventWithIdJson{}
+	if err := json.Unmarshal(msg.Data, envelope); err != nil {
+		// Data which is not a JSON object can't be an envelope, it is handled as the event data.
+		return nil
+	}
+	if !strings.HasSuffix(envelope.Type, eventWithIdTypeName) {
+		return nil
+	}
+	if len(envelope.Data) == 0 {
+		return gateway.InternalServerError(errors.New("event sent with an ID has no data"))
+	}
+	msg.ID = []byte(envelope.Id)
+	msg.Data = envelope.Data
+	return nil
+}
+
 func writeEvent(msg *sse.Event, w http.ResponseWriter, data interface{}) gateway.ErrorJson {
 	if err := json.Unmarshal(msg.Data, data); err != nil {
 		return gateway.InternalServerError(err)
@@ -240,6 +221,9 @@ func writeEvent(msg *sse.Event, w http.ResponseWriter, data interface{}) gateway
 	if errJson := gateway.ProcessMiddlewareResponseFields(data); errJson != nil {
 		return errJson
 	}
+	if blockFull, ok := data.(*eventBlockFullJson); ok {
+		data = blockFull.output()
+	}
 	dataJson, errJson := gateway.SerializeMiddlewareResponseIntoJson(data)
 	if errJson != nil {
 		return errJson
@@ -253,6 +237,14 @@ func writeEvent(msg *sse.Event, w http.ResponseWriter, data interface{}) gateway
 	if _, err := w.Write(msg.Event); err != nil {
 		return gateway.InternalServerError(err)
 	}
+	if id := bytes.TrimSpace(msg.ID); len(id) > 0 {
+		if _, err := w.Write([]byte("\nid: ")); err != nil {
+			return gateway.InternalServerError(err)
+		}
+		if _, err := w.Write(id); err != nil {
+			return gateway.InternalServerError(err)
+		}
+	}
 	if _, err := w.Write([]byte("\ndata: ")); err != nil {
 		return gateway.InternalServerError(err)
 	}
@@ -266,6 +258,36 @@ func writeEvent(msg *sse.Event, w http.ResponseWriter, data interface{}) gateway
 	return nil
 }
 
+// eventBlockFullOutputJson is the event stream representation of an imported block along with its contents.
+type eventBlockFullOutputJson struct {
+	Slot    string                   `json:"slot"`
+	Block   string                   `json:"block"`
+	Version string                   `json:"version"`
+	Data    *signedBlockV2OutputJson `json:"data,omitempty"`
+	Ssz     string                   `json:"ssz,omitempty"`
+}
+
+// output moves the block of the event's fork up into 'data', the same way as the v2 block endpoint does.
+// Blocks requested as SSZ are sent in 'ssz' instead.
+func (e *eventBlockFullJson) output() *eventBlockFullOutputJson {
+	out := &eventBlockFullOutputJson{
+		Slot:    e.Slot,
+		Block:   e.Block,
+		Version: e.Version,
+	}
+	if e.Data == nil {
+		out.Ssz = e.Ssz
+		return out
+	}
+	out.Data = &signedBlockV2OutputJson{Signature: e.Data.Signature}
+	if isVersion(e.Version, ethpbv2.Version_ALTAIR) {
+		out.Data.Message = e.Data.AltairBlock
+	} else {
+		out.Data.Message = e.Data.Phase0Block
+	}
+	return out
+}
+
 func flushEvent(w http.ResponseWriter) gateway.ErrorJson {
 	flusher, ok := w.(http.Flusher)
 	if !ok {
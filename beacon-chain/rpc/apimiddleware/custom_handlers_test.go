@@ -10,46 +10,17 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gorilla/mux"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/eth/v1/events"
 	"github.com/prysmaticlabs/prysm/shared/gateway"
-	"github.com/prysmaticlabs/prysm/shared/grpcutils"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	"github.com/r3labs/sse"
 )
 
-func TestSSZRequested(t *testing.T) {
-	t.Run("ssz_requested", func(t *testing.T) {
-		request := httptest.NewRequest("GET", "http://foo.example", nil)
-		request.Header["Accept"] = []string{"application/octet-stream"}
-		result := sszRequested(request)
-		assert.Equal(t, true, result)
-	})
-
-	t.Run("multiple_content_types", func(t *testing.T) {
-		request := httptest.NewRequest("GET", "http://foo.example", nil)
-		request.Header["Accept"] = []string{"application/json", "application/octet-stream"}
-		result := sszRequested(request)
-		assert.Equal(t, true, result)
-	})
-
-	t.Run("no_header", func(t *testing.T) {
-		request := httptest.NewRequest("GET", "http://foo.example", nil)
-		result := sszRequested(request)
-		assert.Equal(t, false, result)
-	})
-
-	t.Run("other_content_type", func(t *testing.T) {
-		request := httptest.NewRequest("GET", "http://foo.example", nil)
-		request.Header["Accept"] = []string{"application/json"}
-		result := sszRequested(request)
-		assert.Equal(t, false, result)
-	})
-}
-
 func TestPrepareSSZRequestForProxying(t *testing.T) {
 	middleware := &gateway.ApiProxyMiddleware{
-		GatewayAddress: "http://gateway.example",
+		GatewayAddress: "gateway.example",
 	}
 	endpoint := gateway.Endpoint{
 		Path: "http://foo.example",
@@ -60,85 +31,67 @@ func TestPrepareSSZRequestForProxying(t *testing.T) {
 	errJson := prepareSSZRequestForProxying(middleware, endpoint, request, "/ssz")
 	require.Equal(t, true, errJson == nil)
 	assert.Equal(t, "/ssz", request.URL.Path)
+	assert.Equal(t, "gateway.example", request.URL.Host)
 }
 
-func TestSerializeMiddlewareResponseIntoSSZ(t *testing.T) {
+func TestHandleGetSSZ(t *testing.T) {
 	t.Run("ok", func(t *testing.T) {
-		ssz, errJson := serializeMiddlewareResponseIntoSSZ("Zm9v")
-		require.Equal(t, true, errJson == nil)
-		assert.DeepEqual(t, []byte("foo"), ssz)
-	})
-
-	t.Run("invalid_data", func(t *testing.T) {
-		_, errJson := serializeMiddlewareResponseIntoSSZ("invalid")
-		require.Equal(t, false, errJson == nil)
-		assert.Equal(t, true, strings.Contains(errJson.Msg(), "could not decode response body into base64"))
-		assert.Equal(t, http.StatusInternalServerError, errJson.StatusCode())
-	})
-}
-
-func TestWriteSSZResponseHeaderAndBody(t *testing.T) {
-	t.Run("ok", func(t *testing.T) {
-		response := &http.Response{
-			Header: http.Header{
-				"Foo": []string{"foo"},
-				"Grpc-Metadata-" + grpcutils.HttpCodeMetadataKey: []string{"204"},
-			},
-		}
-		responseSsz := []byte("ssz")
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/ssz/Zm9v", r.URL.Path)
+			assert.Equal(t, gateway.SSZContentType, r.Header.Get("Accept"))
+			w.Header().Set("Content-Type", gateway.SSZContentType)
+			_, err := w.Write([]byte("ssz"))
+			require.NoError(t, err)
+		}))
+		defer srv.Close()
+		middleware := &gateway.ApiProxyMiddleware{GatewayAddress: strings.TrimPrefix(srv.URL, "http://")}
+		endpoint := gateway.Endpoint{Path: "/foo/{id}", Err: &gateway.DefaultErrorJson{}}
+		request := httptest.NewRequest("GET", "http://foo.example/foo/foo", nil)
+		request = mux.SetURLVars(request, map[string]string{"id": "foo"})
+		request.Header.Set("Accept", gateway.SSZContentType)
 		writer := httptest.NewRecorder()
 		writer.Body = &bytes.Buffer{}
 
-		errJson := writeSSZResponseHeaderAndBody(response, writer, responseSsz, "test.ssz")
-		require.Equal(t, true, errJson == nil)
-		v, ok := writer.Header()["Foo"]
-		require.Equal(t, true, ok, "header not found")
-		require.Equal(t, 1, len(v), "wrong number of header values")
-		assert.Equal(t, "foo", v[0])
-		v, ok = writer.Header()["Content-Length"]
-		require.Equal(t, true, ok, "header not found")
-		require.Equal(t, 1, len(v), "wrong number of header values")
-		assert.Equal(t, "3", v[0])
-		v, ok = writer.Header()["Content-Type"]
-		require.Equal(t, true, ok, "header not found")
-		require.Equal(t, 1, len(v), "wrong number of header values")
-		assert.Equal(t, "application/octet-stream", v[0])
-		v, ok = writer.Header()["Content-Disposition"]
-		require.Equal(t, true, ok, "header not found")
-		require.Equal(t, 1, len(v), "wrong number of header values")
-		assert.Equal(t, "attachment; filename=test.ssz", v[0])
-		assert.Equal(t, 204, writer.Code)
+		handled := handleGetSSZ(middleware, endpoint, writer, request, sszConfig{sszPath: "/ssz/{id}", fileName: "test.ssz"})
+		require.Equal(t, true, handled)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, "ssz", writer.Body.String())
+		assert.Equal(t, "3", writer.Header().Get("Content-Length"))
+		assert.Equal(t, gateway.SSZContentType, writer.Header().Get("Content-Type"))
+		assert.Equal(t, "attachment; filename=test.ssz", writer.Header().Get("Content-Disposition"))
 	})
 
-	t.Run("no_grpc_status_code_header", func(t *testing.T) {
-		response := &http.Response{
-			Header:     http.Header{},
-			StatusCode: 204,
-		}
-		responseSsz := []byte("ssz")
+	t.Run("error", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", gateway.JSONContentType)
+			w.WriteHeader(http.StatusNotFound)
+			_, err := w.Write([]byte(`{"message":"not found","code":5}`))
+			require.NoError(t, err)
+		}))
+		defer srv.Close()
+		middleware := &gateway.ApiProxyMiddleware{GatewayAddress: strings.TrimPrefix(srv.URL, "http://")}
+		endpoint := gateway.Endpoint{Path: "/foo/{id}", Err: &gateway.DefaultErrorJson{}}
+		request := httptest.NewRequest("GET", "http://foo.example/foo/foo", nil)
+		request = mux.SetURLVars(request, map[string]string{"id": "foo"})
+		request.Header.Set("Accept", gateway.SSZContentType)
 		writer := httptest.NewRecorder()
 		writer.Body = &bytes.Buffer{}
 
-		errJson := writeSSZResponseHeaderAndBody(response, writer, responseSsz, "test.ssz")
-		require.Equal(t, true, errJson == nil)
-		assert.Equal(t, 204, writer.Code)
+		handled := handleGetSSZ(middleware, endpoint, writer, request, sszConfig{sszPath: "/ssz/{id}", fileName: "test.ssz"})
+		require.Equal(t, true, handled)
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+		assert.Equal(t, "", writer.Header().Get("Content-Disposition"))
+		e := &gateway.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.Equal(t, "not found", e.Message)
+		assert.Equal(t, http.StatusNotFound, e.Code)
 	})
 
-	t.Run("invalid_status_code", func(t *testing.T) {
-		response := &http.Response{
-			Header: http.Header{
-				"Foo": []string{"foo"},
-				"Grpc-Metadata-" + grpcutils.HttpCodeMetadataKey: []string{"invalid"},
-			},
-		}
-		responseSsz := []byte("ssz")
-		writer := httptest.NewRecorder()
-		writer.Body = &bytes.Buffer{}
-
-		errJson := writeSSZResponseHeaderAndBody(response, writer, responseSsz, "test.ssz")
-		require.Equal(t, false, errJson == nil)
-		assert.Equal(t, true, strings.Contains(errJson.Msg(), "could not parse status code"))
-		assert.Equal(t, http.StatusInternalServerError, errJson.StatusCode())
+	t.Run("ssz_not_requested", func(t *testing.T) {
+		request := httptest.NewRequest("GET", "http://foo.example/foo/foo", nil)
+		request.Header.Set("Accept", gateway.JSONContentType)
+		handled := handleGetSSZ(&gateway.ApiProxyMiddleware{}, gateway.Endpoint{}, httptest.NewRecorder(), request, sszConfig{})
+		assert.Equal(t, false, handled)
 	})
 }
 
@@ -172,6 +125,31 @@ func TestReceiveEvents(t *testing.T) {
 	assert.Equal(t, true, errJson == nil)
 }
 
+func TestReceiveEvents_WithID(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan *sse.Event)
+	w := httptest.NewRecorder()
+	w.Body = &bytes.Buffer{}
+	req := httptest.NewRequest("GET", "http://foo.example", &bytes.Buffer{})
+	req = req.WithContext(ctx)
+
+	go func() {
+		msg := &sse.Event{
+			Data: []byte(`{"@type":"type.googleapis.com/ethereum.eth.v1.EventWithId","id":"5",` +
+				`"data":{"@type":"type.googleapis.com/ethereum.eth.v1.EventFinalizedCheckpoint","block":"Zm9v","state":"Zm9v","epoch":"1"}}`),
+			Event: []byte(events.FinalizedCheckpointTopic),
+		}
+		ch <- msg
+		time.Sleep(time.Second)
+		cancel()
+	}()
+
+	errJson := receiveEvents(ch, w, req)
+	require.Equal(t, true, errJson == nil)
+	written := w.Body.String()
+	assert.Equal(t, "event: finalized_checkpoint\nid: 5\ndata: {\"block\":\"0x666f6f\",\"state\":\"0x666f6f\",\"epoch\":\"1\"}\n\n", written)
+}
+
 func TestReceiveEvents_EventNotSupported(t *testing.T) {
 	ch := make(chan *sse.Event)
 	w := httptest.NewRecorder()
@@ -212,3 +190,73 @@ func TestWriteEvent(t *testing.T) {
 	written := w.Body.String()
 	assert.Equal(t, "event: test_event\ndata: {\"block\":\"0x666f6f\",\"state\":\"0x666f6f\",\"epoch\":\"1\"}\n\n", written)
 }
+
+func TestWriteEvent_WithID(t *testing.T) {
+	data := &receivedBlockDataJson{
+		Slot:  "1",
+		Block: "Zm9v",
+	}
+	bData, err := json.Marshal(data)
+	require.NoError(t, err)
+	msg := &sse.Event{
+		ID:    []byte("7 "),
+		Data:  bData,
+		Event: []byte(events.BlockGossipTopic),
+	}
+	w := httptest.NewRecorder()
+	w.Body = &bytes.Buffer{}
+
+	errJson := writeEvent(msg, w, &receivedBlockDataJson{})
+	require.Equal(t, true, errJson == nil)
+	written := w.Body.String()
+	assert.Equal(t, "event: block_gossip\nid: 7\ndata: {\"slot\":\"1\",\"block\":\"0x666f6f\"}\n\n", written)
+}
+
+func TestWriteEvent_BlockFull(t *testing.T) {
+	t.Run("ssz", func(t *testing.T) {
+		data := &eventBlockFullJson{
+			Slot:    "1",
+			Block:   "Zm9v",
+			Version: "PHASE0",
+			Ssz:     "Zm9v",
+		}
+		bData, err := json.Marshal(data)
+		require.NoError(t, err)
+		msg := &sse.Event{
+			Data:  bData,
+			Event: []byte(events.BlockFullTopic),
+		}
+		w := httptest.NewRecorder()
+		w.Body = &bytes.Buffer{}
+
+		errJson := writeEvent(msg, w, &eventBlockFullJson{})
+		require.Equal(t, true, errJson == nil)
+		written := w.Body.String()
+		assert.Equal(t, "event: block_full\ndata: {\"slot\":\"1\",\"block\":\"0x666f6f\",\"version\":\"phase0\",\"ssz\":\"0x666f6f\"}\n\n", written)
+	})
+	t.Run("json", func(t *testing.T) {
+		data := &eventBlockFullJson{
+			Slot:    "1",
+			Block:   "Zm9v",
+			Version: "ALTAIR",
+			Data: &signedBeaconBlockContainerV2Json{
+				AltairBlock: &beaconBlockAltairJson{Slot: "1"},
+				Signature:   "Zm9v",
+			},
+		}
+		bData, err := json.Marshal(data)
+		require.NoError(t, err)
+		msg := &sse.Event{
+			Data:  bData,
+			Event: []byte(events.BlockFullTopic),
+		}
+		w := httptest.NewRecorder()
+		w.Body = &bytes.Buffer{}
+
+		errJson := writeEvent(msg, w, &eventBlockFullJson{})
+		require.Equal(t, true, errJson == nil)
+		written := w.Body.String()
+		assert.Equal(t, true, strings.HasPrefix(written, "event: block_full\ndata: {\"slot\":\"1\",\"block\":\"0x666f6f\",\"version\":\"altair\",\"data\":{\"message\":{\"slot\":\"1\","), written)
+		assert.Equal(t, true, strings.HasSuffix(written, "\"signature\":\"0x666f6f\"}}\n\n"), written)
+	})
+}
@@ -5,8 +5,11 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/gateway"
 )
@@ -29,18 +32,115 @@ func wrapAttestationsArray(endpoint gateway.Endpoint, _ http.ResponseWriter, req
 	return nil
 }
 
-// https://ethereum.github.io/eth2.0-APIs/#/Validator/getAttesterDuties expects posting a top-level array.
+// https://ethereum.github.io/eth2.0-APIs/#/Validator/getAttesterDuties and
+// https://ethereum.github.io/eth2.0-APIs/#/Validator/getSyncCommitteeDuties expect posting a top-level array.
 // We make it more proto-friendly by wrapping it in a struct with an 'index' field.
 func wrapValidatorIndicesArray(endpoint gateway.Endpoint, _ http.ResponseWriter, req *http.Request) gateway.ErrorJson {
-	if _, ok := endpoint.PostRequest.(*attesterDutiesRequestJson); ok {
-		indices := make([]string, 0)
-		if err := json.NewDecoder(req.Body).Decode(&indices); err != nil {
-			return gateway.InternalServerErrorWithMessage(err, "could not decode attestations array")
+	var wrap func(indices []string) interface{}
+	switch endpoint.PostRequest.(type) {
+	case *attesterDutiesRequestJson:
+		wrap = func(indices []string) interface{} { return &attesterDutiesRequestJson{Index: indices} }
+	case *syncCommitteeDutiesRequestJson:
+		wrap = func(indices []string) interface{} { return &syncCommitteeDutiesRequestJson{Index: indices} }
+	default:
+		return nil
+	}
+	indices := make([]string, 0)
+	if err := json.NewDecoder(req.Body).Decode(&indices); err != nil {
+		return gateway.InternalServerErrorWithMessage(err, "could not decode validator indices array")
+	}
+	b, err := json.Marshal(wrap(indices))
+	if err != nil {
+		return gateway.InternalServerErrorWithMessage(err, "could not marshal wrapped validator indices array")
+	}
+	req.Body = ioutil.NopCloser(bytes.NewReader(b))
+	return nil
+}
+
+// https://ethereum.github.io/eth2.0-APIs/#/Beacon/submitPoolSyncCommitteeSignatures expects posting a top-level array.
+// We make it more proto-friendly by wrapping it in a struct with a 'data' field.
+func wrapSyncCommitteeSignaturesArray(endpoint gateway.Endpoint, _ http.ResponseWriter, req *http.Request) gateway.ErrorJson {
+	if _, ok := endpoint.PostRequest.(*submitSyncCommitteeSignaturesRequestJson); ok {
+		data := make([]*syncCommitteeMessageJson, 0)
+		if err := json.NewDecoder(req.Body).Decode(&data); err != nil {
+			return gateway.InternalServerErrorWithMessage(err, "could not decode sync committee signatures array")
+		}
+		j := &submitSyncCommitteeSignaturesRequestJson{Data: data}
+		b, err := json.Marshal(j)
+		if err != nil {
+			return gateway.InternalServerErrorWithMessage(err, "could not marshal wrapped sync committee signatures array")
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(b))
+	}
+	return nil
+}
+
+// https://ethereum.github.io/eth2.0-APIs/#/Validator/publishAggregateAndProofs expects posting a top-level array.
+// We make it more proto-friendly by wrapping it in a struct with a 'data' field.
+func wrapSignedAggregateAndProofArray(endpoint gateway.Endpoint, _ http.ResponseWriter, req *http.Request) gateway.ErrorJson {
+	if _, ok := endpoint.PostRequest.(*submitAggregateAndProofsRequestJson); ok {
+		data := make([]*signedAggregateAttestationAndProofJson, 0)
+		if err := json.NewDecoder(req.Body).Decode(&data); err != nil {
+			return gateway.InternalServerErrorWithMessage(err, "could not decode aggregate and proofs array")
+		}
+		j := &submitAggregateAndProofsRequestJson{Data: data}
+		b, err := json.Marshal(j)
+		if err != nil {
+			return gateway.InternalServerErrorWithMessage(err, "could not marshal wrapped aggregate and proofs array")
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(b))
+	}
+	return nil
+}
+
+// https://ethereum.github.io/eth2.0-APIs/#/Validator/prepareBeaconCommitteeSubnet expects posting a top-level array.
+// We make it more proto-friendly by wrapping it in a struct with a 'data' field.
+func wrapBeaconCommitteeSubscriptionsArray(endpoint gateway.Endpoint, _ http.ResponseWriter, req *http.Request) gateway.ErrorJson {
+	if _, ok := endpoint.PostRequest.(*submitBeaconCommitteeSubscriptionsRequestJson); ok {
+		data := make([]*beaconCommitteeSubscribeJson, 0)
+		if err := json.NewDecoder(req.Body).Decode(&data); err != nil {
+			return gateway.InternalServerErrorWithMessage(err, "could not decode subscriptions array")
+		}
+		j := &submitBeaconCommitteeSubscriptionsRequestJson{Data: data}
+		b, err := json.Marshal(j)
+		if err != nil {
+			return gateway.InternalServerErrorWithMessage(err, "could not marshal wrapped subscriptions array")
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(b))
+	}
+	return nil
+}
+
+// https://ethereum.github.io/eth2.0-APIs/#/Validator/publishContributionAndProofs expects posting a top-level array.
+// We make it more proto-friendly by wrapping it in a struct with a 'data' field.
+func wrapSignedContributionAndProofsArray(endpoint gateway.Endpoint, _ http.ResponseWriter, req *http.Request) gateway.ErrorJson {
+	if _, ok := endpoint.PostRequest.(*submitContributionAndProofsRequestJson); ok {
+		data := make([]*signedContributionAndProofJson, 0)
+		if err := json.NewDecoder(req.Body).Decode(&data); err != nil {
+			return gateway.InternalServerErrorWithMessage(err, "could not decode contribution and proofs array")
 		}
-		j := &attesterDutiesRequestJson{Index: indices}
+		j := &submitContributionAndProofsRequestJson{Data: data}
 		b, err := json.Marshal(j)
 		if err != nil {
-			return gateway.InternalServerErrorWithMessage(err, "could not marshal wrapped validator indices array")
+			return gateway.InternalServerErrorWithMessage(err, "could not marshal wrapped contribution and proofs array")
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(b))
+	}
+	return nil
+}
+
+// https://ethereum.github.io/eth2.0-APIs/#/Validator/prepareSyncCommitteeSubnets expects posting a top-level array.
+// We make it more proto-friendly by wrapping it in a struct with a 'data' field.
+func wrapSyncCommitteeSubscriptionsArray(endpoint gateway.Endpoint, _ http.ResponseWriter, req *http.Request) gateway.ErrorJson {
+	if _, ok := endpoint.PostRequest.(*submitSyncCommitteeSubscriptionsRequestJson); ok {
+		data := make([]*syncCommitteeSubscriptionJson, 0)
+		if err := json.NewDecoder(req.Body).Decode(&data); err != nil {
+			return gateway.InternalServerErrorWithMessage(err, "could not decode subscriptions array")
+		}
+		j := &submitSyncCommitteeSubscriptionsRequestJson{Data: data}
+		b, err := json.Marshal(j)
+		if err != nil {
+			return gateway.InternalServerErrorWithMessage(err, "could not marshal wrapped subscriptions array")
 		}
 		req.Body = ioutil.NopCloser(bytes.NewReader(b))
 	}
@@ -55,3 +155,102 @@ func prepareGraffiti(endpoint gateway.Endpoint, _ http.ResponseWriter, _ *http.R
 	}
 	return nil
 }
+
+type syncCommitteesOutputJson struct {
+	Data *syncCommitteeValidatorsOutputJson `json:"data"`
+}
+
+type syncCommitteeValidatorsOutputJson struct {
+	Validators          []string   `json:"validators"`
+	ValidatorAggregates [][]string `json:"validator_aggregates"`
+}
+
+// https://ethereum.github.io/eth2.0-APIs/#/Beacon/getEpochSyncCommittees returns the validators of each
+// sync subcommittee as a nested array. Protobuf has no nested repeated fields, so the subcommittees
+// are wrapped in messages which this hook unwraps.
+func serializeSyncCommittees(response interface{}) (gateway.RunDefault, []byte, gateway.ErrorJson) {
+	respContainer, ok := response.(*stateSyncCommitteesResponseJson)
+	if !ok || respContainer.Data == nil {
+		return false, nil, gateway.InternalServerError(errors.New("container is not of the correct type"))
+	}
+	aggregates := make([][]string, len(respContainer.Data.ValidatorAggregates))
+	for i, a := range respContainer.Data.ValidatorAggregates {
+		aggregates[i] = a.Validators
+	}
+	j, err := json.Marshal(&syncCommitteesOutputJson{
+		Data: &syncCommitteeValidatorsOutputJson{
+			Validators:          respContainer.Data.Validators,
+			ValidatorAggregates: aggregates,
+		},
+	})
+	if err != nil {
+		return false, nil, gateway.InternalServerErrorWithMessage(err, "could not marshal response")
+	}
+	return false, j, nil
+}
+
+// The fork-versioned responses of https://ethereum.github.io/eth2.0-APIs/ hold the object of the fork
+// given by the version field directly under 'data'. Protobuf needs a oneof field to hold objects
+// of different types, so these hooks move the populated member of the oneof up into 'data'.
+
+type signedBlockV2OutputJson struct {
+	Message   interface{} `json:"message"`
+	Signature string      `json:"signature"`
+}
+
+type versionedOutputJson struct {
+	Version string      `json:"version"`
+	Data    interface{} `json:"data"`
+}
+
+func serializeV2Block(response interface{}) (gateway.RunDefault, []byte, gateway.ErrorJson) {
+	respContainer, ok := response.(*blockV2ResponseJson)
+	if !ok || respContainer.Data == nil {
+		return false, nil, gateway.InternalServerError(errors.New("container is not of the correct type"))
+	}
+	var message interface{}
+	if isVersion(respContainer.Version, ethpbv2.Version_ALTAIR) {
+		message = respContainer.Data.AltairBlock
+	} else {
+		message = respContainer.Data.Phase0Block
+	}
+	return serializeVersioned(respContainer.Version, &signedBlockV2OutputJson{
+		Message:   message,
+		Signature: respContainer.Data.Signature,
+	})
+}
+
+func serializeV2State(response interface{}) (gateway.RunDefault, []byte, gateway.ErrorJson) {
+	respContainer, ok := response.(*beaconStateV2ResponseJson)
+	if !ok || respContainer.Data == nil {
+		return false, nil, gateway.InternalServerError(errors.New("container is not of the correct type"))
+	}
+	if isVersion(respContainer.Version, ethpbv2.Version_ALTAIR) {
+		return serializeVersioned(respContainer.Version, respContainer.Data.AltairState)
+	}
+	return serializeVersioned(respContainer.Version, respContainer.Data.Phase0State)
+}
+
+func serializeProducedV2Block(response interface{}) (gateway.RunDefault, []byte, gateway.ErrorJson) {
+	respContainer, ok := response.(*produceBlockResponseV2Json)
+	if !ok || respContainer.Data == nil {
+		return false, nil, gateway.InternalServerError(errors.New("container is not of the correct type"))
+	}
+	if isVersion(respContainer.Version, ethpbv2.Version_ALTAIR) {
+		return serializeVersioned(respContainer.Version, respContainer.Data.AltairBlock)
+	}
+	return serializeVersioned(respContainer.Version, respContainer.Data.Phase0Block)
+}
+
+// isVersion reports whether a processed (lowercase) version field holds the given version.
+func isVersion(v string, version ethpbv2.Version) bool {
+	return v == strings.ToLower(version.String())
+}
+
+func serializeVersioned(version string, data interface{}) (gateway.RunDefault, []byte, gateway.ErrorJson) {
+	j, err := json.Marshal(&versionedOutputJson{Version: version, Data: data})
+	if err != nil {
+		return false, nil, gateway.InternalServerErrorWithMessage(err, "could not marshal response")
+	}
+	return false, j, nil
+}
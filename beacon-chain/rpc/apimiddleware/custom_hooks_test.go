@@ -74,6 +74,176 @@ func TestWrapValidatorIndicesArray(t *testing.T) {
 		assert.Equal(t, "1", wrappedIndices.Index[0])
 		assert.Equal(t, "2", wrappedIndices.Index[1])
 	})
+
+	t.Run("sync_committee_duties", func(t *testing.T) {
+		endpoint := gateway.Endpoint{
+			PostRequest: &syncCommitteeDutiesRequestJson{},
+		}
+		var body bytes.Buffer
+		_, err := body.Write([]byte(`["3"]`))
+		require.NoError(t, err)
+		request := httptest.NewRequest("POST", "http://foo.example", &body)
+
+		errJson := wrapValidatorIndicesArray(endpoint, nil, request)
+		require.Equal(t, true, errJson == nil)
+		wrappedIndices := &syncCommitteeDutiesRequestJson{}
+		require.NoError(t, json.NewDecoder(request.Body).Decode(wrappedIndices))
+		assert.DeepEqual(t, []string{"3"}, wrappedIndices.Index)
+	})
+}
+
+func TestWrapSyncCommitteeSignaturesArray(t *testing.T) {
+	endpoint := gateway.Endpoint{
+		PostRequest: &submitSyncCommitteeSignaturesRequestJson{},
+	}
+	unwrappedMsgsJson, err := json.Marshal([]*syncCommitteeMessageJson{{Slot: "1"}, {Slot: "2"}})
+	require.NoError(t, err)
+
+	var body bytes.Buffer
+	_, err = body.Write(unwrappedMsgsJson)
+	require.NoError(t, err)
+	request := httptest.NewRequest("POST", "http://foo.example", &body)
+
+	errJson := wrapSyncCommitteeSignaturesArray(endpoint, nil, request)
+	require.Equal(t, true, errJson == nil)
+	wrappedMsgs := &submitSyncCommitteeSignaturesRequestJson{}
+	require.NoError(t, json.NewDecoder(request.Body).Decode(wrappedMsgs))
+	require.Equal(t, 2, len(wrappedMsgs.Data), "wrong number of wrapped items")
+	assert.Equal(t, "2", wrappedMsgs.Data[1].Slot)
+}
+
+func TestWrapSignedAggregateAndProofArray(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		endpoint := gateway.Endpoint{
+			PostRequest: &submitAggregateAndProofsRequestJson{},
+		}
+		unwrappedAggs := []*signedAggregateAttestationAndProofJson{{Signature: "sig"}}
+		unwrappedAggsJson, err := json.Marshal(unwrappedAggs)
+		require.NoError(t, err)
+
+		var body bytes.Buffer
+		_, err = body.Write(unwrappedAggsJson)
+		require.NoError(t, err)
+		request := httptest.NewRequest("POST", "http://foo.example", &body)
+
+		errJson := wrapSignedAggregateAndProofArray(endpoint, nil, request)
+		require.Equal(t, true, errJson == nil)
+		wrappedAggs := &submitAggregateAndProofsRequestJson{}
+		require.NoError(t, json.NewDecoder(request.Body).Decode(wrappedAggs))
+		require.Equal(t, 1, len(wrappedAggs.Data), "wrong number of wrapped items")
+		assert.Equal(t, "sig", wrappedAggs.Data[0].Signature)
+	})
+
+	t.Run("invalid_body", func(t *testing.T) {
+		endpoint := gateway.Endpoint{
+			PostRequest: &submitAggregateAndProofsRequestJson{},
+		}
+		var body bytes.Buffer
+		_, err := body.Write([]byte("invalid"))
+		require.NoError(t, err)
+		request := httptest.NewRequest("POST", "http://foo.example", &body)
+
+		errJson := wrapSignedAggregateAndProofArray(endpoint, nil, request)
+		require.Equal(t, false, errJson == nil)
+		assert.Equal(t, true, strings.Contains(errJson.Msg(), "could not decode aggregate and proofs array"))
+		assert.Equal(t, http.StatusInternalServerError, errJson.StatusCode())
+	})
+}
+
+func TestWrapBeaconCommitteeSubscriptionsArray(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		endpoint := gateway.Endpoint{
+			PostRequest: &submitBeaconCommitteeSubscriptionsRequestJson{},
+		}
+		unwrappedSubs := []*beaconCommitteeSubscribeJson{
+			{
+				ValidatorIndex:   "1",
+				CommitteeIndex:   "1",
+				CommitteesAtSlot: "1",
+				Slot:             "1",
+				IsAggregator:     false,
+			},
+			{
+				ValidatorIndex:   "1",
+				CommitteeIndex:   "1",
+				CommitteesAtSlot: "1",
+				Slot:             "1",
+				IsAggregator:     true,
+			},
+		}
+		unwrappedSubsJson, err := json.Marshal(unwrappedSubs)
+		require.NoError(t, err)
+
+		var body bytes.Buffer
+		_, err = body.Write(unwrappedSubsJson)
+		require.NoError(t, err)
+		request := httptest.NewRequest("POST", "http://foo.example", &body)
+
+		errJson := wrapBeaconCommitteeSubscriptionsArray(endpoint, nil, request)
+		require.Equal(t, true, errJson == nil)
+		wrappedSubs := &submitBeaconCommitteeSubscriptionsRequestJson{}
+		require.NoError(t, json.NewDecoder(request.Body).Decode(wrappedSubs))
+		require.Equal(t, 2, len(wrappedSubs.Data), "wrong number of wrapped items")
+		assert.Equal(t, false, wrappedSubs.Data[0].IsAggregator)
+		assert.Equal(t, true, wrappedSubs.Data[1].IsAggregator)
+	})
+
+	t.Run("invalid_body", func(t *testing.T) {
+		endpoint := gateway.Endpoint{
+			PostRequest: &submitBeaconCommitteeSubscriptionsRequestJson{},
+		}
+		var body bytes.Buffer
+		_, err := body.Write([]byte("invalid"))
+		require.NoError(t, err)
+		request := httptest.NewRequest("POST", "http://foo.example", &body)
+
+		errJson := wrapBeaconCommitteeSubscriptionsArray(endpoint, nil, request)
+		require.Equal(t, false, errJson == nil)
+		assert.Equal(t, true, strings.Contains(errJson.Msg(), "could not decode subscriptions array"))
+		assert.Equal(t, http.StatusInternalServerError, errJson.StatusCode())
+	})
+}
+
+func TestWrapSignedContributionAndProofsArray(t *testing.T) {
+	endpoint := gateway.Endpoint{
+		PostRequest: &submitContributionAndProofsRequestJson{},
+	}
+	unwrappedJson, err := json.Marshal([]*signedContributionAndProofJson{{Signature: "sig"}})
+	require.NoError(t, err)
+
+	var body bytes.Buffer
+	_, err = body.Write(unwrappedJson)
+	require.NoError(t, err)
+	request := httptest.NewRequest("POST", "http://foo.example", &body)
+
+	errJson := wrapSignedContributionAndProofsArray(endpoint, nil, request)
+	require.Equal(t, true, errJson == nil)
+	wrapped := &submitContributionAndProofsRequestJson{}
+	require.NoError(t, json.NewDecoder(request.Body).Decode(wrapped))
+	require.Equal(t, 1, len(wrapped.Data), "wrong number of wrapped items")
+	assert.Equal(t, "sig", wrapped.Data[0].Signature)
+}
+
+func TestWrapSyncCommitteeSubscriptionsArray(t *testing.T) {
+	endpoint := gateway.Endpoint{
+		PostRequest: &submitSyncCommitteeSubscriptionsRequestJson{},
+	}
+	unwrappedJson, err := json.Marshal([]*syncCommitteeSubscriptionJson{
+		{ValidatorIndex: "1", SyncCommitteeIndices: []string{"1", "130"}, UntilEpoch: "256"},
+	})
+	require.NoError(t, err)
+
+	var body bytes.Buffer
+	_, err = body.Write(unwrappedJson)
+	require.NoError(t, err)
+	request := httptest.NewRequest("POST", "http://foo.example", &body)
+
+	errJson := wrapSyncCommitteeSubscriptionsArray(endpoint, nil, request)
+	require.Equal(t, true, errJson == nil)
+	wrapped := &submitSyncCommitteeSubscriptionsRequestJson{}
+	require.NoError(t, json.NewDecoder(request.Body).Decode(wrapped))
+	require.Equal(t, 1, len(wrapped.Data), "wrong number of wrapped items")
+	assert.DeepEqual(t, []string{"1", "130"}, wrapped.Data[0].SyncCommitteeIndices)
 }
 
 func TestPrepareGraffiti(t *testing.T) {
@@ -118,3 +288,100 @@ func TestPrepareGraffiti(t *testing.T) {
 		)
 	})
 }
+
+func TestSerializeV2Block(t *testing.T) {
+	t.Run("phase0", func(t *testing.T) {
+		response := &blockV2ResponseJson{
+			Version: "phase0",
+			Data: &signedBeaconBlockContainerV2Json{
+				Phase0Block: &beaconBlockJson{Slot: "1"},
+				Signature:   "sig",
+			},
+		}
+		runDefault, j, errJson := serializeV2Block(response)
+		require.Equal(t, nil, errJson)
+		assert.Equal(t, gateway.RunDefault(false), runDefault)
+		assert.Equal(t, `{"version":"phase0","data":{"message":{"slot":"1","proposer_index":"","parent_root":"","state_root":"","body":null},"signature":"sig"}}`, string(j))
+	})
+
+	t.Run("altair", func(t *testing.T) {
+		response := &blockV2ResponseJson{
+			Version: "altair",
+			Data: &signedBeaconBlockContainerV2Json{
+				AltairBlock: &beaconBlockAltairJson{Slot: "1"},
+				Signature:   "sig",
+			},
+		}
+		runDefault, j, errJson := serializeV2Block(response)
+		require.Equal(t, nil, errJson)
+		assert.Equal(t, gateway.RunDefault(false), runDefault)
+		assert.Equal(t, `{"version":"altair","data":{"message":{"slot":"1","proposer_index":"","parent_root":"","state_root":"","body":null},"signature":"sig"}}`, string(j))
+	})
+
+	t.Run("incorrect_response_type", func(t *testing.T) {
+		_, _, errJson := serializeV2Block(&beaconStateV2ResponseJson{})
+		require.NotNil(t, errJson)
+		assert.Equal(t, true, strings.Contains(errJson.Msg(), "container is not of the correct type"))
+	})
+}
+
+func TestSerializeV2State(t *testing.T) {
+	response := &beaconStateV2ResponseJson{
+		Version: "altair",
+		Data: &beaconStateContainerV2Json{
+			AltairState: &beaconStateV2Json{Slot: "1", InactivityScores: []string{"2"}},
+		},
+	}
+	runDefault, j, errJson := serializeV2State(response)
+	require.Equal(t, nil, errJson)
+	assert.Equal(t, gateway.RunDefault(false), runDefault)
+	resp := &struct {
+		Version string             `json:"version"`
+		Data    *beaconStateV2Json `json:"data"`
+	}{}
+	require.NoError(t, json.Unmarshal(j, resp))
+	assert.Equal(t, "altair", resp.Version)
+	require.NotNil(t, resp.Data)
+	assert.Equal(t, "1", resp.Data.Slot)
+	assert.DeepEqual(t, []string{"2"}, resp.Data.InactivityScores)
+}
+
+func TestSerializeProducedV2Block(t *testing.T) {
+	response := &produceBlockResponseV2Json{
+		Version: "phase0",
+		Data: &beaconBlockContainerV2Json{
+			Phase0Block: &beaconBlockJson{Slot: "1"},
+		},
+	}
+	runDefault, j, errJson := serializeProducedV2Block(response)
+	require.Equal(t, nil, errJson)
+	assert.Equal(t, gateway.RunDefault(false), runDefault)
+	resp := &struct {
+		Version string           `json:"version"`
+		Data    *beaconBlockJson `json:"data"`
+	}{}
+	require.NoError(t, json.Unmarshal(j, resp))
+	assert.Equal(t, "phase0", resp.Version)
+	require.NotNil(t, resp.Data)
+	assert.Equal(t, "1", resp.Data.Slot)
+}
+
+func TestSerializeSyncCommittees(t *testing.T) {
+	response := &stateSyncCommitteesResponseJson{
+		Data: &syncCommitteeValidatorsJson{
+			Validators: []string{"1", "2", "3", "4"},
+			ValidatorAggregates: []*syncSubcommitteeValidatorsJson{
+				{Validators: []string{"1", "2"}},
+				{Validators: []string{"3", "4"}},
+			},
+		},
+	}
+	runDefault, j, errJson := serializeSyncCommittees(response)
+	require.Equal(t, nil, errJson)
+	assert.Equal(t, gateway.RunDefault(false), runDefault)
+	assert.Equal(t, `{"data":{"validators":["1","2","3","4"],"validator_aggregates":[["1","2"],["3","4"]]}}`, string(j))
+
+	_, _, errJson = serializeSyncCommittees(&blockV2ResponseJson{})
+	require.NotNil(t, errJson)
+	assert.Equal(t, http.StatusInternalServerError, errJson.StatusCode())
+}
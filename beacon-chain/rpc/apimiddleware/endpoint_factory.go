@@ -24,6 +24,7 @@ func (f *BeaconEndpointFactory) Paths() []string {
 		"/eth/v1/beacon/states/{state_id}/validators/{validator_id}",
 		"/eth/v1/beacon/states/{state_id}/validator_balances",
 		"/eth/v1/beacon/states/{state_id}/committees",
+		"/eth/v1/beacon/states/{state_id}/sync_committees",
 		"/eth/v1/beacon/headers",
 		"/eth/v1/beacon/headers/{block_id}",
 		"/eth/v1/beacon/blocks",
@@ -34,6 +35,7 @@ func (f *BeaconEndpointFactory) Paths() []string {
 		"/eth/v1/beacon/pool/attester_slashings",
 		"/eth/v1/beacon/pool/proposer_slashings",
 		"/eth/v1/beacon/pool/voluntary_exits",
+		"/eth/v1/beacon/pool/sync_committees",
 		"/eth/v1/node/identity",
 		"/eth/v1/node/peers",
 		"/eth/v1/node/peers/{peer_id}",
@@ -49,6 +51,18 @@ func (f *BeaconEndpointFactory) Paths() []string {
 		"/eth/v1/events",
 		"/eth/v1/validator/duties/attester/{epoch}",
 		"/eth/v1/validator/duties/proposer/{epoch}",
+		"/eth/v1/validator/duties/sync/{epoch}",
+		"/eth/v1/validator/blocks/{slot}",
+		"/eth/v1/validator/attestation_data",
+		"/eth/v1/validator/aggregate_attestation",
+		"/eth/v1/validator/aggregate_and_proofs",
+		"/eth/v1/validator/beacon_committee_subscriptions",
+		"/eth/v1/validator/sync_committee_contribution",
+		"/eth/v1/validator/contribution_and_proofs",
+		"/eth/v1/validator/sync_committee_subscriptions",
+		"/eth/v2/beacon/blocks/{block_id}",
+		"/eth/v2/debug/beacon/states/{state_id}",
+		"/eth/v2/validator/blocks/{slot}",
 	}
 }
 
@@ -99,6 +113,15 @@ func (f *BeaconEndpointFactory) Create(path string) (*gateway.Endpoint, error) {
 			GetResponse:        &stateCommitteesResponseJson{},
 			Err:                &gateway.DefaultErrorJson{},
 		}
+	case "/eth/v1/beacon/states/{state_id}/sync_committees":
+		endpoint = gateway.Endpoint{
+			RequestQueryParams: []gateway.QueryParam{{Name: "epoch"}},
+			GetResponse:        &stateSyncCommitteesResponseJson{},
+			Err:                &gateway.DefaultErrorJson{},
+			Hooks: gateway.HookCollection{
+				OnPreSerializeMiddlewareResponseIntoJson: serializeSyncCommittees,
+			},
+		}
 	case "/eth/v1/beacon/headers":
 		endpoint = gateway.Endpoint{
 			RequestQueryParams: []gateway.QueryParam{{Name: "slot"}, {Name: "parent_root", Hex: true}},
@@ -164,6 +187,14 @@ func (f *BeaconEndpointFactory) Create(path string) (*gateway.Endpoint, error) {
 			GetResponse: &voluntaryExitsPoolResponseJson{},
 			Err:         &gateway.DefaultErrorJson{},
 		}
+	case "/eth/v1/beacon/pool/sync_committees":
+		endpoint = gateway.Endpoint{
+			PostRequest: &submitSyncCommitteeSignaturesRequestJson{},
+			Err:         &submitSyncCommitteeSignaturesErrorJson{},
+			Hooks: gateway.HookCollection{
+				OnPostStart: []gateway.Hook{wrapSyncCommitteeSignaturesArray},
+			},
+		}
 	case "/eth/v1/node/identity":
 		endpoint = gateway.Endpoint{
 			GetResponse: &identityResponseJson{},
@@ -251,6 +282,99 @@ func (f *BeaconEndpointFactory) Create(path string) (*gateway.Endpoint, error) {
 			RequestURLLiterals: []string{"epoch"},
 			Err:                &gateway.DefaultErrorJson{},
 		}
+	case "/eth/v1/validator/duties/sync/{epoch}":
+		endpoint = gateway.Endpoint{
+			PostRequest:        &syncCommitteeDutiesRequestJson{},
+			PostResponse:       &syncCommitteeDutiesResponseJson{},
+			RequestURLLiterals: []string{"epoch"},
+			Err:                &gateway.DefaultErrorJson{},
+			Hooks: gateway.HookCollection{
+				OnPostStart: []gateway.Hook{wrapValidatorIndicesArray},
+			},
+		}
+	case "/eth/v1/validator/blocks/{slot}":
+		endpoint = gateway.Endpoint{
+			GetResponse:        &produceBlockResponseJson{},
+			RequestURLLiterals: []string{"slot"},
+			RequestQueryParams: []gateway.QueryParam{{Name: "randao_reveal", Hex: true}, {Name: "graffiti", Hex: true}},
+			Err:                &gateway.DefaultErrorJson{},
+		}
+	case "/eth/v1/validator/attestation_data":
+		endpoint = gateway.Endpoint{
+			GetResponse:        &produceAttestationDataResponseJson{},
+			RequestQueryParams: []gateway.QueryParam{{Name: "slot"}, {Name: "committee_index"}},
+			Err:                &gateway.DefaultErrorJson{},
+		}
+	case "/eth/v1/validator/aggregate_attestation":
+		endpoint = gateway.Endpoint{
+			GetResponse:        &aggregateAttestationResponseJson{},
+			RequestQueryParams: []gateway.QueryParam{{Name: "attestation_data_root", Hex: true}, {Name: "slot"}},
+			Err:                &gateway.DefaultErrorJson{},
+		}
+	case "/eth/v1/validator/aggregate_and_proofs":
+		endpoint = gateway.Endpoint{
+			PostRequest: &submitAggregateAndProofsRequestJson{},
+			Err:         &gateway.DefaultErrorJson{},
+			Hooks: gateway.HookCollection{
+				OnPostStart: []gateway.Hook{wrapSignedAggregateAndProofArray},
+			},
+		}
+	case "/eth/v1/validator/beacon_committee_subscriptions":
+		endpoint = gateway.Endpoint{
+			PostRequest: &submitBeaconCommitteeSubscriptionsRequestJson{},
+			Err:         &gateway.DefaultErrorJson{},
+			Hooks: gateway.HookCollection{
+				OnPostStart: []gateway.Hook{wrapBeaconCommitteeSubscriptionsArray},
+			},
+		}
+	case "/eth/v1/validator/sync_committee_contribution":
+		endpoint = gateway.Endpoint{
+			GetResponse:        &produceSyncCommitteeContributionResponseJson{},
+			RequestQueryParams: []gateway.QueryParam{{Name: "slot"}, {Name: "subcommittee_index"}, {Name: "beacon_block_root", Hex: true}},
+			Err:                &gateway.DefaultErrorJson{},
+		}
+	case "/eth/v1/validator/contribution_and_proofs":
+		endpoint = gateway.Endpoint{
+			PostRequest: &submitContributionAndProofsRequestJson{},
+			Err:         &gateway.DefaultErrorJson{},
+			Hooks: gateway.HookCollection{
+				OnPostStart: []gateway.Hook{wrapSignedContributionAndProofsArray},
+			},
+		}
+	case "/eth/v1/validator/sync_committee_subscriptions":
+		endpoint = gateway.Endpoint{
+			PostRequest: &submitSyncCommitteeSubscriptionsRequestJson{},
+			Err:         &gateway.DefaultErrorJson{},
+			Hooks: gateway.HookCollection{
+				OnPostStart: []gateway.Hook{wrapSyncCommitteeSubscriptionsArray},
+			},
+		}
+	case "/eth/v2/beacon/blocks/{block_id}":
+		endpoint = gateway.Endpoint{
+			GetResponse: &blockV2ResponseJson{},
+			Err:         &gateway.DefaultErrorJson{},
+			Hooks: gateway.HookCollection{
+				OnPreSerializeMiddlewareResponseIntoJson: serializeV2Block,
+			},
+		}
+	case "/eth/v2/debug/beacon/states/{state_id}":
+		endpoint = gateway.Endpoint{
+			GetResponse: &beaconStateV2ResponseJson{},
+			Err:         &gateway.DefaultErrorJson{},
+			Hooks: gateway.HookCollection{
+				OnPreSerializeMiddlewareResponseIntoJson: serializeV2State,
+			},
+		}
+	case "/eth/v2/validator/blocks/{slot}":
+		endpoint = gateway.Endpoint{
+			GetResponse:        &produceBlockResponseV2Json{},
+			RequestURLLiterals: []string{"slot"},
+			RequestQueryParams: []gateway.QueryParam{{Name: "randao_reveal", Hex: true}, {Name: "graffiti", Hex: true}},
+			Err:                &gateway.DefaultErrorJson{},
+			Hooks: gateway.HookCollection{
+				OnPreSerializeMiddlewareResponseIntoJson: serializeProducedV2Block,
+			},
+		}
 	default:
 		return nil, errors.New("invalid path")
 	}
@@ -0,0 +1,608 @@
+package apimiddleware
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	types "github.com/prysmaticlabs/eth2-types"
+	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	beacongateway "github.com/prysmaticlabs/prysm/beacon-chain/gateway"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
+	mockp2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	mockPOW "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/eth/v1/validator"
+	v1alpha1validator "github.com/prysmaticlabs/prysm/beacon-chain/rpc/prysm/v1alpha1/validator"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/eth/v1alpha1/wrapper"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/gateway"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+)
+
+func TestBeaconEndpointFactory_CreatesAllPaths(t *testing.T) {
+	f := &BeaconEndpointFactory{}
+	for _, path := range f.Paths() {
+		endpoint, err := f.Create(path)
+		require.NoError(t, err, path)
+		assert.Equal(t, path, endpoint.Path)
+	}
+	_, err := f.Create("/eth/v1/foo")
+	assert.ErrorContains(t, "invalid path", err)
+}
+
+func TestValidatorAPI_ProduceBlock(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	ctx := context.Background()
+
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	stateRoot, err := beaconState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	genesis := blocks.NewGenesisBlock(stateRoot[:])
+	require.NoError(t, db.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(genesis)))
+	parentRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, beaconState, parentRoot))
+	require.NoError(t, db.SaveHeadBlockRoot(ctx, parentRoot))
+
+	addr := runValidatorAPI(t, &validator.Server{
+		V1Alpha1Server: &v1alpha1validator.Server{
+			HeadFetcher:       &mockChain.ChainService{State: beaconState, Root: parentRoot[:]},
+			SyncChecker:       &mockSync.Sync{IsSyncing: false},
+			BlockReceiver:     &mockChain.ChainService{},
+			ChainStartFetcher: &mockPOW.POWChain{},
+			Eth1InfoFetcher:   &mockPOW.POWChain{},
+			Eth1BlockFetcher:  &mockPOW.POWChain{},
+			MockEth1Votes:     true,
+			AttPool:           attestations.NewPool(),
+			SlashingsPool:     slashings.NewPool(),
+			ExitPool:          voluntaryexits.NewPool(),
+			StateGen:          stategen.New(db),
+		},
+	})
+
+	randaoReveal, err := testutil.RandaoReveal(beaconState, 0, privKeys)
+	require.NoError(t, err)
+	graffiti := bytesutil.ToBytes32([]byte("eth2"))
+
+	t.Run("ok", func(t *testing.T) {
+		url := fmt.Sprintf("%s/eth/v1/validator/blocks/1?randao_reveal=%s&graffiti=%s",
+			addr, hexutil.Encode(randaoReveal), hexutil.Encode(graffiti[:]))
+		code, body := doRequest(t, "GET", url, nil)
+		require.Equal(t, http.StatusOK, code, string(body))
+		resp := &produceBlockResponseJson{}
+		require.NoError(t, json.Unmarshal(body, resp))
+		require.NotNil(t, resp.Data)
+		assert.Equal(t, "1", resp.Data.Slot)
+		assert.Equal(t, hexutil.Encode(parentRoot[:]), resp.Data.ParentRoot)
+		require.NotNil(t, resp.Data.Body)
+		assert.Equal(t, hexutil.Encode(randaoReveal), resp.Data.Body.RandaoReveal)
+		assert.Equal(t, hexutil.Encode(graffiti[:]), resp.Data.Body.Graffiti)
+	})
+
+	t.Run("invalid_randao_reveal", func(t *testing.T) {
+		url := fmt.Sprintf("%s/eth/v1/validator/blocks/1?randao_reveal=%s", addr, hexutil.Encode(make([]byte, 96)))
+		code, body := doRequest(t, "GET", url, nil)
+		assert.NotEqual(t, http.StatusOK, code)
+		errJson := &gateway.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(body, errJson))
+		assert.Equal(t, code, errJson.Code)
+		assert.NotEqual(t, "", errJson.Message)
+	})
+}
+
+func TestValidatorAPI_ProduceBlockV2(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	ctx := context.Background()
+
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	stateRoot, err := beaconState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	genesis := blocks.NewGenesisBlock(stateRoot[:])
+	require.NoError(t, db.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(genesis)))
+	parentRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, beaconState, parentRoot))
+	require.NoError(t, db.SaveHeadBlockRoot(ctx, parentRoot))
+
+	v1Alpha1Server := &v1alpha1validator.Server{
+		SyncChecker:       &mockSync.Sync{IsSyncing: false},
+		BlockReceiver:     &mockChain.ChainService{},
+		ChainStartFetcher: &mockPOW.POWChain{},
+		Eth1InfoFetcher:   &mockPOW.POWChain{},
+		Eth1BlockFetcher:  &mockPOW.POWChain{},
+		MockEth1Votes:     true,
+		AttPool:           attestations.NewPool(),
+		SlashingsPool:     slashings.NewPool(),
+		ExitPool:          voluntaryexits.NewPool(),
+		SyncCommitteePool: synccommittee.NewPool(),
+		StateGen:          stategen.New(db),
+	}
+	addr := runValidatorAPI(t, &validator.Server{V1Alpha1Server: v1Alpha1Server})
+
+	randaoReveal, err := testutil.RandaoReveal(beaconState, 0, privKeys)
+	require.NoError(t, err)
+	graffiti := bytesutil.ToBytes32([]byte("eth2"))
+	url := fmt.Sprintf("%s/eth/v2/validator/blocks/1?randao_reveal=%s&graffiti=%s",
+		addr, hexutil.Encode(randaoReveal), hexutil.Encode(graffiti[:]))
+
+	t.Run("phase0", func(t *testing.T) {
+		v1Alpha1Server.HeadFetcher = &mockChain.ChainService{State: beaconState.Copy(), Root: parentRoot[:]}
+		code, body := doRequest(t, "GET", url, nil)
+		require.Equal(t, http.StatusOK, code, string(body))
+		resp := &struct {
+			Version string           `json:"version"`
+			Data    *beaconBlockJson `json:"data"`
+		}{}
+		require.NoError(t, json.Unmarshal(body, resp))
+		assert.Equal(t, "phase0", resp.Version)
+		require.NotNil(t, resp.Data)
+		assert.Equal(t, "1", resp.Data.Slot)
+		assert.Equal(t, hexutil.Encode(parentRoot[:]), resp.Data.ParentRoot)
+		require.NotNil(t, resp.Data.Body)
+		assert.Equal(t, hexutil.Encode(randaoReveal), resp.Data.Body.RandaoReveal)
+	})
+
+	t.Run("phase0_ssz", func(t *testing.T) {
+		v1Alpha1Server.HeadFetcher = &mockChain.ChainService{State: beaconState.Copy(), Root: parentRoot[:]}
+		code, contentType, body := doSSZRequest(t, "GET", url, nil)
+		require.Equal(t, http.StatusOK, code, string(body))
+		assert.Equal(t, gateway.SSZContentType, contentType)
+		block := &ethpbv1.BeaconBlock{}
+		require.NoError(t, block.UnmarshalSSZ(body))
+		assert.Equal(t, types.Slot(1), block.Slot)
+		assert.DeepEqual(t, randaoReveal, block.Body.RandaoReveal)
+	})
+
+	t.Run("altair", func(t *testing.T) {
+		cfg := params.BeaconConfig().Copy()
+		cfg.AltairForkEpoch = 0
+		params.OverrideBeaconConfig(cfg)
+
+		v1Alpha1Server.HeadFetcher = &mockChain.ChainService{State: beaconState.Copy(), Root: parentRoot[:]}
+		code, body := doRequest(t, "GET", url, nil)
+		require.Equal(t, http.StatusOK, code, string(body))
+		resp := &struct {
+			Version string                 `json:"version"`
+			Data    *beaconBlockAltairJson `json:"data"`
+		}{}
+		require.NoError(t, json.Unmarshal(body, resp))
+		assert.Equal(t, "altair", resp.Version)
+		require.NotNil(t, resp.Data)
+		assert.Equal(t, "1", resp.Data.Slot)
+		require.NotNil(t, resp.Data.Body)
+		require.NotNil(t, resp.Data.Body.SyncAggregate)
+		assert.Equal(t, hexutil.Encode(make([]byte, params.BeaconConfig().SyncCommitteeSize/8)), resp.Data.Body.SyncAggregate.SyncCommitteeBits)
+	})
+}
+
+func TestValidatorAPI_ProduceAttestationData(t *testing.T) {
+	block := testutil.NewBeaconBlock()
+	block.Block.Slot = 3*params.BeaconConfig().SlotsPerEpoch + 1
+	justifiedBlock := testutil.NewBeaconBlock()
+	justifiedBlock.Block.Slot = 2 * params.BeaconConfig().SlotsPerEpoch
+	blockRoot, err := block.Block.HashTreeRoot()
+	require.NoError(t, err)
+	justifiedRoot, err := justifiedBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+	slot := 3*params.BeaconConfig().SlotsPerEpoch + 1
+	beaconState, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, beaconState.SetSlot(slot))
+	require.NoError(t, beaconState.SetCurrentJustifiedCheckpoint(&ethpb.Checkpoint{
+		Epoch: 2,
+		Root:  justifiedRoot[:],
+	}))
+	blockRoots := beaconState.BlockRoots()
+	blockRoots[1] = blockRoot[:]
+	blockRoots[2*params.BeaconConfig().SlotsPerEpoch] = justifiedRoot[:]
+	require.NoError(t, beaconState.SetBlockRoots(blockRoots))
+
+	chainService := &mockChain.ChainService{}
+	offset := int64(slot.Mul(params.BeaconConfig().SecondsPerSlot))
+	addr := runValidatorAPI(t, &validator.Server{
+		V1Alpha1Server: &v1alpha1validator.Server{
+			SyncChecker:      &mockSync.Sync{IsSyncing: false},
+			AttestationCache: cache.NewAttestationCache(),
+			HeadFetcher: &mockChain.ChainService{
+				State: beaconState, Root: blockRoot[:],
+			},
+			FinalizationFetcher: &mockChain.ChainService{
+				CurrentJustifiedCheckPoint: beaconState.CurrentJustifiedCheckpoint(),
+			},
+			TimeFetcher: &mockChain.ChainService{
+				Genesis: time.Now().Add(time.Duration(-1*offset) * time.Second),
+			},
+			StateNotifier: chainService.StateNotifier(),
+		},
+	})
+
+	url := fmt.Sprintf("%s/eth/v1/validator/attestation_data?slot=%d&committee_index=3", addr, slot)
+	code, body := doRequest(t, "GET", url, nil)
+	require.Equal(t, http.StatusOK, code, string(body))
+	resp := &produceAttestationDataResponseJson{}
+	require.NoError(t, json.Unmarshal(body, resp))
+	require.NotNil(t, resp.Data)
+	assert.Equal(t, fmt.Sprintf("%d", slot), resp.Data.Slot)
+	assert.Equal(t, "3", resp.Data.CommitteeIndex)
+	assert.Equal(t, hexutil.Encode(blockRoot[:]), resp.Data.BeaconBlockRoot)
+	require.NotNil(t, resp.Data.Source)
+	assert.Equal(t, "2", resp.Data.Source.Epoch)
+	assert.Equal(t, hexutil.Encode(justifiedRoot[:]), resp.Data.Source.Root)
+	require.NotNil(t, resp.Data.Target)
+	assert.Equal(t, "3", resp.Data.Target.Epoch)
+	assert.Equal(t, hexutil.Encode(blockRoot[:]), resp.Data.Target.Root)
+}
+
+func TestValidatorAPI_GetAggregateAttestation(t *testing.T) {
+	root := bytesutil.PadTo([]byte("root"), 32)
+	sig := bytesutil.PadTo([]byte("sig"), 96)
+	att := &ethpb.Attestation{
+		AggregationBits: []byte{0b1111},
+		Data: &ethpb.AttestationData{
+			Slot:            2,
+			CommitteeIndex:  1,
+			BeaconBlockRoot: root,
+			Source:          &ethpb.Checkpoint{Epoch: 1, Root: root},
+			Target:          &ethpb.Checkpoint{Epoch: 1, Root: root},
+		},
+		Signature: sig,
+	}
+	pool := attestations.NewPool()
+	require.NoError(t, pool.SaveAggregatedAttestation(att))
+	addr := runValidatorAPI(t, &validator.Server{AttestationsPool: pool})
+	dataRoot, err := att.Data.HashTreeRoot()
+	require.NoError(t, err)
+
+	t.Run("ok", func(t *testing.T) {
+		url := fmt.Sprintf("%s/eth/v1/validator/aggregate_attestation?attestation_data_root=%s&slot=2", addr, hexutil.Encode(dataRoot[:]))
+		code, body := doRequest(t, "GET", url, nil)
+		require.Equal(t, http.StatusOK, code, string(body))
+		resp := &aggregateAttestationResponseJson{}
+		require.NoError(t, json.Unmarshal(body, resp))
+		require.NotNil(t, resp.Data)
+		assert.Equal(t, "0x0f", resp.Data.AggregationBits)
+		assert.Equal(t, hexutil.Encode(sig), resp.Data.Signature)
+		require.NotNil(t, resp.Data.Data)
+		assert.Equal(t, "2", resp.Data.Data.Slot)
+		assert.Equal(t, "1", resp.Data.Data.CommitteeIndex)
+		assert.Equal(t, hexutil.Encode(root), resp.Data.Data.BeaconBlockRoot)
+	})
+
+	t.Run("ssz", func(t *testing.T) {
+		url := fmt.Sprintf("%s/eth/v1/validator/aggregate_attestation?attestation_data_root=%s&slot=2", addr, hexutil.Encode(dataRoot[:]))
+		code, contentType, body := doSSZRequest(t, "GET", url, nil)
+		require.Equal(t, http.StatusOK, code, string(body))
+		assert.Equal(t, gateway.SSZContentType, contentType)
+		expected, err := att.MarshalSSZ()
+		require.NoError(t, err)
+		assert.DeepEqual(t, expected, body)
+	})
+
+	t.Run("ssz_not_found", func(t *testing.T) {
+		url := fmt.Sprintf("%s/eth/v1/validator/aggregate_attestation?attestation_data_root=%s&slot=3", addr, hexutil.Encode(dataRoot[:]))
+		code, contentType, body := doSSZRequest(t, "GET", url, nil)
+		require.Equal(t, http.StatusNotFound, code, string(body))
+		assert.Equal(t, gateway.JSONContentType, contentType)
+		errJson := &gateway.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(body, errJson))
+		assert.Equal(t, true, strings.Contains(errJson.Message, "No matching attestation found"))
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		url := fmt.Sprintf("%s/eth/v1/validator/aggregate_attestation?attestation_data_root=%s&slot=3", addr, hexutil.Encode(dataRoot[:]))
+		code, body := doRequest(t, "GET", url, nil)
+		require.Equal(t, http.StatusNotFound, code, string(body))
+		errJson := &gateway.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(body, errJson))
+		assert.Equal(t, http.StatusNotFound, errJson.Code)
+		assert.Equal(t, true, strings.Contains(errJson.Message, "No matching attestation found"))
+	})
+}
+
+func TestValidatorAPI_SubmitAggregateAndProofs(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	c := params.BeaconNetworkConfig()
+	c.MaximumGossipClockDisparity = time.Hour
+	params.OverrideBeaconNetworkConfig(c)
+	root := hexutil.Encode(bytesutil.PadTo([]byte("root"), 32))
+	sig := hexutil.Encode(bytesutil.PadTo([]byte("sig"), 96))
+	proof := hexutil.Encode(bytesutil.PadTo([]byte("proof"), 96))
+	broadcaster := &mockp2p.MockBroadcaster{}
+	addr := runValidatorAPI(t, &validator.Server{
+		TimeFetcher: &mockChain.ChainService{Genesis: time.Now()},
+		Broadcaster: broadcaster,
+	})
+	newRequest := func(signature string) []*signedAggregateAttestationAndProofJson {
+		return []*signedAggregateAttestationAndProofJson{
+			{
+				Message: &aggregateAttestationAndProofJson{
+					AggregatorIndex: "1",
+					Aggregate: &attestationJson{
+						AggregationBits: "0x03",
+						Data: &attestationDataJson{
+							Slot:            "1",
+							CommitteeIndex:  "1",
+							BeaconBlockRoot: root,
+							Source:          &checkpointJson{Epoch: "1", Root: root},
+							Target:          &checkpointJson{Epoch: "1", Root: root},
+						},
+						Signature: sig,
+					},
+					SelectionProof: proof,
+				},
+				Signature: signature,
+			},
+		}
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		reqJson, err := json.Marshal(newRequest(sig))
+		require.NoError(t, err)
+		code, body := doRequest(t, "POST", addr+"/eth/v1/validator/aggregate_and_proofs", reqJson)
+		require.Equal(t, http.StatusOK, code, string(body))
+		assert.Equal(t, 0, len(body))
+		require.Equal(t, 1, len(broadcaster.BroadcastMessages))
+		msg, ok := broadcaster.BroadcastMessages[0].(*ethpb.SignedAggregateAttestationAndProof)
+		require.Equal(t, true, ok, "Broadcast message has wrong type")
+		assert.Equal(t, types.ValidatorIndex(1), msg.Message.AggregatorIndex)
+		assert.Equal(t, proof, hexutil.Encode(msg.Message.SelectionProof))
+		assert.Equal(t, root, hexutil.Encode(msg.Message.Aggregate.Data.BeaconBlockRoot))
+	})
+
+	t.Run("ssz", func(t *testing.T) {
+		rootBytes := bytesutil.PadTo([]byte("root"), 32)
+		aggregate := &ethpb.SignedAggregateAttestationAndProof{
+			Message: &ethpb.AggregateAttestationAndProof{
+				AggregatorIndex: 2,
+				Aggregate: &ethpb.Attestation{
+					AggregationBits: []byte{0b11},
+					Data: &ethpb.AttestationData{
+						Slot:            1,
+						CommitteeIndex:  1,
+						BeaconBlockRoot: rootBytes,
+						Source:          &ethpb.Checkpoint{Epoch: 1, Root: rootBytes},
+						Target:          &ethpb.Checkpoint{Epoch: 1, Root: rootBytes},
+					},
+					Signature: bytesutil.PadTo([]byte("sig"), 96),
+				},
+				SelectionProof: bytesutil.PadTo([]byte("proof"), 96),
+			},
+			Signature: bytesutil.PadTo([]byte("sig"), 96),
+		}
+		enc, err := aggregate.MarshalSSZ()
+		require.NoError(t, err)
+		// A list with a single variable-size element is prefixed with the offset of that element.
+		reqSsz := append([]byte{4, 0, 0, 0}, enc...)
+		broadcaster.BroadcastMessages = nil
+
+		code, _, body := doSSZRequest(t, "POST", addr+"/eth/v1/validator/aggregate_and_proofs", reqSsz)
+		require.Equal(t, http.StatusOK, code, string(body))
+		require.Equal(t, 1, len(broadcaster.BroadcastMessages))
+		msg, ok := broadcaster.BroadcastMessages[0].(*ethpb.SignedAggregateAttestationAndProof)
+		require.Equal(t, true, ok, "Broadcast message has wrong type")
+		assert.Equal(t, types.ValidatorIndex(2), msg.Message.AggregatorIndex)
+	})
+
+	t.Run("zero_signature", func(t *testing.T) {
+		reqJson, err := json.Marshal(newRequest(hexutil.Encode(make([]byte, 96))))
+		require.NoError(t, err)
+		code, body := doRequest(t, "POST", addr+"/eth/v1/validator/aggregate_and_proofs", reqJson)
+		require.Equal(t, http.StatusBadRequest, code, string(body))
+		errJson := &gateway.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(body, errJson))
+		assert.Equal(t, http.StatusBadRequest, errJson.Code)
+		assert.Equal(t, true, strings.Contains(errJson.Message, "Signed signatures can't be zero hashes"))
+	})
+}
+
+func TestValidatorAPI_SubmitBeaconCommitteeSubscription(t *testing.T) {
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	chainSlot := types.Slot(0)
+	chain := &mockChain.ChainService{State: beaconState, Slot: &chainSlot}
+	syncChecker := &mockSync.Sync{IsSyncing: false}
+	addr := runValidatorAPI(t, &validator.Server{
+		HeadFetcher: chain,
+		TimeFetcher: chain,
+		SyncChecker: syncChecker,
+	})
+	reqJson, err := json.Marshal([]*beaconCommitteeSubscribeJson{
+		{
+			ValidatorIndex:   "1",
+			CommitteeIndex:   "1",
+			CommitteesAtSlot: "1",
+			Slot:             "1",
+			IsAggregator:     true,
+		},
+	})
+	require.NoError(t, err)
+
+	t.Run("ok", func(t *testing.T) {
+		cache.SubnetIDs.EmptyAllCaches()
+		code, body := doRequest(t, "POST", addr+"/eth/v1/validator/beacon_committee_subscriptions", reqJson)
+		require.Equal(t, http.StatusOK, code, string(body))
+		assert.Equal(t, 0, len(body))
+		assert.DeepEqual(t, []uint64{2}, cache.SubnetIDs.GetAttesterSubnetIDs(1))
+		assert.DeepEqual(t, []uint64{2}, cache.SubnetIDs.GetAggregatorSubnetIDs(1))
+	})
+
+	t.Run("syncing", func(t *testing.T) {
+		syncChecker.IsSyncing = true
+		defer func() { syncChecker.IsSyncing = false }()
+		code, body := doRequest(t, "POST", addr+"/eth/v1/validator/beacon_committee_subscriptions", reqJson)
+		require.Equal(t, http.StatusServiceUnavailable, code, string(body))
+		errJson := &gateway.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(body, errJson))
+		assert.Equal(t, http.StatusServiceUnavailable, errJson.Code)
+	})
+}
+
+func TestValidatorAPI_GetSyncCommitteeDuties(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig()
+	cfg.AltairForkEpoch = 0
+	params.OverrideBeaconConfig(cfg)
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 200)
+	st, err := testutil.DeterministicSyncCommitteeState(beaconState)
+	require.NoError(t, err)
+	chain := &mockChain.ChainService{State: st, Genesis: time.Now()}
+	addr := runValidatorAPI(t, &validator.Server{
+		HeadFetcher: chain,
+		TimeFetcher: chain,
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+	})
+
+	code, body := doRequest(t, "POST", addr+"/eth/v1/validator/duties/sync/0", []byte(`["3"]`))
+	require.Equal(t, http.StatusOK, code, string(body))
+	resp := &syncCommitteeDutiesResponseJson{}
+	require.NoError(t, json.Unmarshal(body, resp))
+	require.Equal(t, 1, len(resp.Data))
+	pubkey := st.PubkeyAtIndex(3)
+	assert.Equal(t, hexutil.Encode(pubkey[:]), resp.Data[0].Pubkey)
+	assert.Equal(t, "3", resp.Data[0].ValidatorIndex)
+	assert.DeepEqual(t, []string{"3", "203", "403"}, resp.Data[0].ValidatorSyncCommitteeIndices)
+}
+
+func TestValidatorAPI_SubmitSyncCommitteeSubscription(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig()
+	cfg.AltairForkEpoch = 0
+	params.OverrideBeaconConfig(cfg)
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 200)
+	chainSlot := types.Slot(0)
+	chain := &mockChain.ChainService{State: beaconState, Genesis: time.Now(), Slot: &chainSlot}
+	addr := runValidatorAPI(t, &validator.Server{
+		HeadFetcher: chain,
+		TimeFetcher: chain,
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+	})
+	reqJson, err := json.Marshal([]*syncCommitteeSubscriptionJson{
+		{ValidatorIndex: "3", SyncCommitteeIndices: []string{"3", "203"}, UntilEpoch: "1"},
+	})
+	require.NoError(t, err)
+
+	cache.SyncSubnetIDs.EmptyAllCaches()
+	code, body := doRequest(t, "POST", addr+"/eth/v1/validator/sync_committee_subscriptions", reqJson)
+	require.Equal(t, http.StatusOK, code, string(body))
+	assert.DeepEqual(t, []uint64{0, 1}, cache.SyncSubnetIDs.GetAllSubnets(0))
+}
+
+// runValidatorAPI serves the validator server through grpc-gateway and the API middleware,
+// returning the base URL of the middleware.
+func runValidatorAPI(t *testing.T, vs *validator.Server) string {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	grpcServer := grpc.NewServer()
+	ethpbv1.RegisterBeaconValidatorServer(grpcServer, vs)
+	ethpbv2.RegisterBeaconValidatorServer(grpcServer, vs)
+	go func() {
+		if err := grpcServer.Serve(lis); err != nil {
+			t.Log(err)
+		}
+	}()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.DialContext(ctx, lis.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := conn.Close(); err != nil {
+			t.Log(err)
+		}
+	})
+	mux := beacongateway.DefaultConfig(false).V1PbMux.Mux
+	require.NoError(t, ethpbv1.RegisterBeaconValidatorHandler(ctx, mux, conn))
+	require.NoError(t, ethpbv2.RegisterBeaconValidatorHandler(ctx, mux, conn))
+	gatewayServer := httptest.NewServer(mux)
+	t.Cleanup(gatewayServer.Close)
+
+	proxyLis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	proxyAddr := proxyLis.Addr().String()
+	require.NoError(t, proxyLis.Close())
+	m := &gateway.ApiProxyMiddleware{
+		GatewayAddress:  strings.TrimPrefix(gatewayServer.URL, "http://"),
+		ProxyAddress:    proxyAddr,
+		EndpointCreator: &BeaconEndpointFactory{},
+	}
+	go func() {
+		if err := m.Run(); err != nil {
+			t.Log(err)
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		if c, err := net.Dial("tcp", proxyAddr); err == nil {
+			require.NoError(t, c.Close())
+			return "http://" + proxyAddr
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("API middleware did not start")
+	return ""
+}
+
+func doRequest(t *testing.T, method, url string, body []byte) (int, []byte) {
+	req, err := http.NewRequest(method, url, bytes.NewReader(body))
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			t.Log(err)
+		}
+	}()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp.StatusCode, respBody
+}
+
+// doSSZRequest sends an SSZ-encoded request, accepting an SSZ-encoded response.
+func doSSZRequest(t *testing.T, method, url string, body []byte) (int, string, []byte) {
+	req, err := http.NewRequest(method, url, bytes.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Accept", gateway.SSZContentType)
+	if body != nil {
+		req.Header.Set("Content-Type", gateway.SSZContentType)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			t.Log(err)
+		}
+	}()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp.StatusCode, resp.Header.Get("Content-Type"), respBody
+}
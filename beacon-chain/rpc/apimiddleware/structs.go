@@ -1,6 +1,10 @@
 package apimiddleware
 
-import "github.com/prysmaticlabs/prysm/shared/gateway"
+import (
+	"encoding/json"
+
+	"github.com/prysmaticlabs/prysm/shared/gateway"
+)
 
 // genesisResponseJson is used in /beacon/genesis API endpoint.
 type genesisResponseJson struct {
@@ -61,6 +65,11 @@ type stateCommitteesResponseJson struct {
 	Data []*committeeJson `json:"data"`
 }
 
+// stateSyncCommitteesResponseJson is used in /beacon/states/{state_id}/sync_committees API endpoint.
+type stateSyncCommitteesResponseJson struct {
+	Data *syncCommitteeValidatorsJson `json:"data"`
+}
+
 // blockHeadersResponseJson is used in /beacon/headers API endpoint.
 type blockHeadersResponseJson struct {
 	Data []*blockHeaderContainerJson `json:"data"`
@@ -76,6 +85,12 @@ type blockResponseJson struct {
 	Data *beaconBlockContainerJson `json:"data"`
 }
 
+// blockV2ResponseJson is used in /v2/beacon/blocks/{block_id} API endpoint.
+type blockV2ResponseJson struct {
+	Version string                            `json:"version" enum:"true"`
+	Data    *signedBeaconBlockContainerV2Json `json:"data"`
+}
+
 // blockRootResponseJson is used in /beacon/blocks/{block_id}/root API endpoint.
 type blockRootResponseJson struct {
 	Data *blockRootContainerJson `json:"data"`
@@ -154,6 +169,12 @@ type beaconStateResponseJson struct {
 	Data *beaconStateJson `json:"data"`
 }
 
+// beaconStateV2ResponseJson is used in /v2/debug/beacon/states/{state_id} API endpoint.
+type beaconStateV2ResponseJson struct {
+	Version string                      `json:"version" enum:"true"`
+	Data    *beaconStateContainerV2Json `json:"data"`
+}
+
 // forkChoiceHeadsResponseJson is used in /debug/beacon/heads API endpoint.
 type forkChoiceHeadsResponseJson struct {
 	Data []*forkChoiceHeadJson `json:"data"`
@@ -165,6 +186,11 @@ type forkScheduleResponseJson struct {
 }
 
 // depositContractResponseJson is used in /config/deposit_contract API endpoint.
+// submitSyncCommitteeSignaturesRequestJson is used in /beacon/pool/sync_committees API endpoint.
+type submitSyncCommitteeSignaturesRequestJson struct {
+	Data []*syncCommitteeMessageJson `json:"data"`
+}
+
 type depositContractResponseJson struct {
 	Data *depositContractJson `json:"data"`
 }
@@ -185,12 +211,68 @@ type attesterDutiesResponseJson struct {
 	Data          []*attesterDutyJson `json:"data"`
 }
 
+// syncCommitteeDutiesRequestJson is used in /validator/duties/sync/{epoch} API endpoint.
+type syncCommitteeDutiesRequestJson struct {
+	Index []string `json:"index"`
+}
+
+// syncCommitteeDutiesResponseJson is used in /validator/duties/sync/{epoch} API endpoint.
+type syncCommitteeDutiesResponseJson struct {
+	Data []*syncCommitteeDutyJson `json:"data"`
+}
+
 // proposerDutiesResponseJson is used in /validator/duties/proposer/{epoch} API endpoint.
 type proposerDutiesResponseJson struct {
 	DependentRoot string              `json:"dependent_root" hex:"true"`
 	Data          []*proposerDutyJson `json:"data"`
 }
 
+// produceBlockResponseJson is used in /validator/blocks/{slot} API endpoint.
+type produceBlockResponseJson struct {
+	Data *beaconBlockJson `json:"data"`
+}
+
+// produceBlockResponseV2Json is used in /v2/validator/blocks/{slot} API endpoint.
+type produceBlockResponseV2Json struct {
+	Version string                      `json:"version" enum:"true"`
+	Data    *beaconBlockContainerV2Json `json:"data"`
+}
+
+// produceAttestationDataResponseJson is used in /validator/attestation_data API endpoint.
+type produceAttestationDataResponseJson struct {
+	Data *attestationDataJson `json:"data"`
+}
+
+// aggregateAttestationResponseJson is used in /validator/aggregate_attestation API endpoint.
+type aggregateAttestationResponseJson struct {
+	Data *attestationJson `json:"data"`
+}
+
+// submitAggregateAndProofsRequestJson is used in /validator/aggregate_and_proofs API endpoint.
+type submitAggregateAndProofsRequestJson struct {
+	Data []*signedAggregateAttestationAndProofJson `json:"data"`
+}
+
+// submitBeaconCommitteeSubscriptionsRequestJson is used in /validator/beacon_committee_subscriptions API endpoint.
+type submitBeaconCommitteeSubscriptionsRequestJson struct {
+	Data []*beaconCommitteeSubscribeJson `json:"data"`
+}
+
+// produceSyncCommitteeContributionResponseJson is used in /validator/sync_committee_contribution API endpoint.
+type produceSyncCommitteeContributionResponseJson struct {
+	Data *syncCommitteeContributionJson `json:"data"`
+}
+
+// submitContributionAndProofsRequestJson is used in /validator/contribution_and_proofs API endpoint.
+type submitContributionAndProofsRequestJson struct {
+	Data []*signedContributionAndProofJson `json:"data"`
+}
+
+// submitSyncCommitteeSubscriptionsRequestJson is used in /validator/sync_committee_subscriptions API endpoint.
+type submitSyncCommitteeSubscriptionsRequestJson struct {
+	Data []*syncCommitteeSubscriptionJson `json:"data"`
+}
+
 //----------------
 // Reusable types.
 //----------------
@@ -233,6 +315,49 @@ type beaconBlockBodyJson struct {
 	VoluntaryExits    []*signedVoluntaryExitJson `json:"voluntary_exits"`
 }
 
+// signedBeaconBlockContainerV2Json is a JSON representation of a signed beacon block of any fork.
+// Only the block of the fork given by the response's version is set.
+type signedBeaconBlockContainerV2Json struct {
+	Phase0Block *beaconBlockJson       `json:"phase0_block"`
+	AltairBlock *beaconBlockAltairJson `json:"altair_block"`
+	Signature   string                 `json:"signature" hex:"true"`
+}
+
+// beaconBlockContainerV2Json is a JSON representation of a beacon block of any fork.
+// Only the block of the fork given by the response's version is set.
+type beaconBlockContainerV2Json struct {
+	Phase0Block *beaconBlockJson       `json:"phase0_block"`
+	AltairBlock *beaconBlockAltairJson `json:"altair_block"`
+}
+
+// beaconBlockAltairJson is a JSON representation of an Altair beacon block.
+type beaconBlockAltairJson struct {
+	Slot          string                     `json:"slot"`
+	ProposerIndex string                     `json:"proposer_index"`
+	ParentRoot    string                     `json:"parent_root" hex:"true"`
+	StateRoot     string                     `json:"state_root" hex:"true"`
+	Body          *beaconBlockBodyAltairJson `json:"body"`
+}
+
+// beaconBlockBodyAltairJson is a JSON representation of an Altair beacon block body.
+type beaconBlockBodyAltairJson struct {
+	RandaoReveal      string                     `json:"randao_reveal" hex:"true"`
+	Eth1Data          *eth1DataJson              `json:"eth1_data"`
+	Graffiti          string                     `json:"graffiti" hex:"true"`
+	ProposerSlashings []*proposerSlashingJson    `json:"proposer_slashings"`
+	AttesterSlashings []*attesterSlashingJson    `json:"attester_slashings"`
+	Attestations      []*attestationJson         `json:"attestations"`
+	Deposits          []*depositJson             `json:"deposits"`
+	VoluntaryExits    []*signedVoluntaryExitJson `json:"voluntary_exits"`
+	SyncAggregate     *syncAggregateJson         `json:"sync_aggregate"`
+}
+
+// syncAggregateJson is a JSON representation of a sync aggregate.
+type syncAggregateJson struct {
+	SyncCommitteeBits      string `json:"sync_committee_bits" hex:"true"`
+	SyncCommitteeSignature string `json:"sync_committee_signature" hex:"true"`
+}
+
 // blockHeaderContainerJson is a JSON representation of a block header container.
 type blockHeaderContainerJson struct {
 	Root      string                          `json:"root" hex:"true"`
@@ -383,6 +508,47 @@ type beaconStateJson struct {
 	FinalizedCheckpoint         *checkpointJson           `json:"finalized_checkpoint"`
 }
 
+// beaconStateContainerV2Json is a JSON representation of a beacon state of any fork.
+// Only the state of the fork given by the response's version is set.
+type beaconStateContainerV2Json struct {
+	Phase0State *beaconStateJson   `json:"phase0_state"`
+	AltairState *beaconStateV2Json `json:"altair_state"`
+}
+
+// beaconStateV2Json is a JSON representation of an Altair beacon state.
+type beaconStateV2Json struct {
+	GenesisTime                 string                 `json:"genesis_time"`
+	GenesisValidatorsRoot       string                 `json:"genesis_validators_root" hex:"true"`
+	Slot                        string                 `json:"slot"`
+	Fork                        *forkJson              `json:"fork"`
+	LatestBlockHeader           *beaconBlockHeaderJson `json:"latest_block_header"`
+	BlockRoots                  []string               `json:"block_roots" hex:"true"`
+	StateRoots                  []string               `json:"state_roots" hex:"true"`
+	HistoricalRoots             []string               `json:"historical_roots" hex:"true"`
+	Eth1Data                    *eth1DataJson          `json:"eth1_data"`
+	Eth1DataVotes               []*eth1DataJson        `json:"eth1_data_votes"`
+	Eth1DepositIndex            string                 `json:"eth1_deposit_index"`
+	Validators                  []*validatorJson       `json:"validators"`
+	Balances                    []string               `json:"balances"`
+	RandaoMixes                 []string               `json:"randao_mixes" hex:"true"`
+	Slashings                   []string               `json:"slashings"`
+	PreviousEpochParticipation  string                 `json:"previous_epoch_participation" hex:"true"`
+	CurrentEpochParticipation   string                 `json:"current_epoch_participation" hex:"true"`
+	JustificationBits           string                 `json:"justification_bits" hex:"true"`
+	PreviousJustifiedCheckpoint *checkpointJson        `json:"previous_justified_checkpoint"`
+	CurrentJustifiedCheckpoint  *checkpointJson        `json:"current_justified_checkpoint"`
+	FinalizedCheckpoint         *checkpointJson        `json:"finalized_checkpoint"`
+	InactivityScores            []string               `json:"inactivity_scores"`
+	CurrentSyncCommittee        *syncCommitteeJson     `json:"current_sync_committee"`
+	NextSyncCommittee           *syncCommitteeJson     `json:"next_sync_committee"`
+}
+
+// syncCommitteeJson is a JSON representation of a sync committee.
+type syncCommitteeJson struct {
+	Pubkeys         []string `json:"pubkeys" hex:"true"`
+	AggregatePubkey string   `json:"aggregate_pubkey" hex:"true"`
+}
+
 // forkJson is a JSON representation of a fork.
 type forkJson struct {
 	PreviousVersion string `json:"previous_version" hex:"true"`
@@ -423,6 +589,47 @@ type committeeJson struct {
 	Validators []string `json:"validators"`
 }
 
+// syncCommitteeValidatorsJson is a JSON representation of the validators of a sync committee.
+type syncCommitteeValidatorsJson struct {
+	Validators          []string                          `json:"validators"`
+	ValidatorAggregates []*syncSubcommitteeValidatorsJson `json:"validator_aggregates"`
+}
+
+// syncSubcommitteeValidatorsJson is a JSON representation of the validators of a sync subcommittee.
+type syncSubcommitteeValidatorsJson struct {
+	Validators []string `json:"validators"`
+}
+
+// syncCommitteeMessageJson is a JSON representation of a sync committee signature.
+type syncCommitteeMessageJson struct {
+	Slot            string `json:"slot"`
+	BeaconBlockRoot string `json:"beacon_block_root" hex:"true"`
+	ValidatorIndex  string `json:"validator_index"`
+	Signature       string `json:"signature" hex:"true"`
+}
+
+// syncCommitteeContributionJson is a JSON representation of a sync committee contribution.
+type syncCommitteeContributionJson struct {
+	Slot              string `json:"slot"`
+	BeaconBlockRoot   string `json:"beacon_block_root" hex:"true"`
+	SubcommitteeIndex string `json:"subcommittee_index"`
+	AggregationBits   string `json:"aggregation_bits" hex:"true"`
+	Signature         string `json:"signature" hex:"true"`
+}
+
+// contributionAndProofJson is a JSON representation of a sync committee contribution and proof.
+type contributionAndProofJson struct {
+	AggregatorIndex string                         `json:"aggregator_index"`
+	Contribution    *syncCommitteeContributionJson `json:"contribution"`
+	SelectionProof  string                         `json:"selection_proof" hex:"true"`
+}
+
+// signedContributionAndProofJson is a JSON representation of a signed sync committee contribution and proof.
+type signedContributionAndProofJson struct {
+	Message   *contributionAndProofJson `json:"message"`
+	Signature string                    `json:"signature" hex:"true"`
+}
+
 // pendingAttestationJson is a JSON representation of a pending attestation.
 type pendingAttestationJson struct {
 	AggregationBits string               `json:"aggregation_bits" hex:"true"`
@@ -468,31 +675,40 @@ type proposerDutyJson struct {
 	Slot           string `json:"slot"`
 }
 
-//----------------
-// SSZ
-// ---------------
-
-// sszResponseJson is a common abstraction over all SSZ responses.
-type sszResponseJson interface {
-	SSZData() string
+// signedAggregateAttestationAndProofJson is a JSON representation of a signed aggregate attestation and proof.
+type signedAggregateAttestationAndProofJson struct {
+	Message   *aggregateAttestationAndProofJson `json:"message"`
+	Signature string                            `json:"signature" hex:"true"`
 }
 
-// blockSSZResponseJson is used in /beacon/blocks/{block_id} API endpoint.
-type blockSSZResponseJson struct {
-	Data string `json:"data"`
+// aggregateAttestationAndProofJson is a JSON representation of an aggregate attestation and proof.
+type aggregateAttestationAndProofJson struct {
+	AggregatorIndex string           `json:"aggregator_index"`
+	Aggregate       *attestationJson `json:"aggregate"`
+	SelectionProof  string           `json:"selection_proof" hex:"true"`
 }
 
-func (ssz *blockSSZResponseJson) SSZData() string {
-	return ssz.Data
+// beaconCommitteeSubscribeJson is a JSON representation of a beacon committee subscription.
+type beaconCommitteeSubscribeJson struct {
+	ValidatorIndex   string `json:"validator_index"`
+	CommitteeIndex   string `json:"committee_index"`
+	CommitteesAtSlot string `json:"committees_at_slot"`
+	Slot             string `json:"slot"`
+	IsAggregator     bool   `json:"is_aggregator"`
 }
 
-// beaconStateSSZResponseJson is used in /debug/beacon/states/{state_id} API endpoint.
-type beaconStateSSZResponseJson struct {
-	Data string `json:"data"`
+// syncCommitteeDutyJson is a JSON representation of a sync committee duty.
+type syncCommitteeDutyJson struct {
+	Pubkey                        string   `json:"pubkey" hex:"true"`
+	ValidatorIndex                string   `json:"validator_index"`
+	ValidatorSyncCommitteeIndices []string `json:"validator_sync_committee_indices"`
 }
 
-func (ssz *beaconStateSSZResponseJson) SSZData() string {
-	return ssz.Data
+// syncCommitteeSubscriptionJson is a JSON representation of a sync committee subscription.
+type syncCommitteeSubscriptionJson struct {
+	ValidatorIndex       string   `json:"validator_index"`
+	SyncCommitteeIndices []string `json:"sync_committee_indices"`
+	UntilEpoch           string   `json:"until_epoch"`
 }
 
 // TODO: Documentation
@@ -534,6 +750,14 @@ type eventChainReorgJson struct {
 	Epoch        string `json:"epoch"`
 }
 
+type eventBlockFullJson struct {
+	Slot    string                            `json:"slot"`
+	Block   string                            `json:"block" hex:"true"`
+	Version string                            `json:"version" enum:"true"`
+	Data    *signedBeaconBlockContainerV2Json `json:"data"`
+	Ssz     string                            `json:"ssz" hex:"true"`
+}
+
 // ---------------
 // Error handling.
 // ---------------
@@ -550,6 +774,25 @@ type singleAttestationVerificationFailureJson struct {
 	Message string `json:"message"`
 }
 
+// submitSyncCommitteeSignaturesErrorJson is a JSON representation of the error returned when submitting sync committee signatures.
+type submitSyncCommitteeSignaturesErrorJson struct {
+	gateway.DefaultErrorJson
+	Failures []*singleSyncCommitteeSignatureVerificationFailureJson `json:"failures"`
+}
+
+// singleSyncCommitteeSignatureVerificationFailureJson is a JSON representation of a failure when verifying a single submitted sync committee signature.
+type singleSyncCommitteeSignatureVerificationFailureJson struct {
+	Index   int    `json:"index"`
+	Message string `json:"message"`
+}
+
+// eventWithIdJson is the envelope in which the gateway sends the data of an event along with its ID.
+type eventWithIdJson struct {
+	Type string          `json:"@type"`
+	Id   string          `json:"id"`
+	Data json.RawMessage `json:"data"`
+}
+
 type eventErrorJson struct {
 	StatusCode int    `json:"status_code"`
 	Message    string `json:"message"`
@@ -12,9 +12,11 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	"github.com/prysmaticlabs/prysm/proto/eth/v1alpha1/wrapper"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
 	"github.com/prysmaticlabs/prysm/proto/interfaces"
 	"github.com/prysmaticlabs/prysm/proto/migration"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/version"
 	"go.opencensus.io/trace"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -218,6 +220,52 @@ func (bs *Server) GetBlock(ctx context.Context, req *ethpb.BlockRequest) (*ethpb
 	}, nil
 }
 
+// GetBlockV2 retrieves block details for given block ID, along with the fork version of the block.
+func (bs *Server) GetBlockV2(ctx context.Context, req *ethpb.BlockRequest) (*ethpbv2.BlockResponseV2, error) {
+	ctx, span := trace.StartSpan(ctx, "beaconv2.GetBlockV2")
+	defer span.End()
+
+	block, err := bs.blockFromBlockID(ctx, req.BlockId)
+	if invalidBlockIdErr, ok := err.(*blockIdParseError); ok {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid block ID: %v", invalidBlockIdErr)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get block from block ID: %v", err)
+	}
+	if block == nil || block.IsNil() {
+		return nil, status.Errorf(codes.NotFound, "Could not find requested block")
+	}
+
+	switch block.Version() {
+	case version.Phase0:
+		signedBeaconBlock, err := migration.SignedBeaconBlock(block)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get signed beacon block: %v", err)
+		}
+		return &ethpbv2.BlockResponseV2{
+			Version: ethpbv2.Version_PHASE0,
+			Data: &ethpbv2.SignedBeaconBlockContainerV2{
+				Message:   &ethpbv2.SignedBeaconBlockContainerV2_Phase0Block{Phase0Block: signedBeaconBlock.Block},
+				Signature: signedBeaconBlock.Signature,
+			},
+		}, nil
+	case version.Altair:
+		signedBeaconBlock, err := migration.AltairSignedBeaconBlock(block)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get signed beacon block: %v", err)
+		}
+		return &ethpbv2.BlockResponseV2{
+			Version: ethpbv2.Version_ALTAIR,
+			Data: &ethpbv2.SignedBeaconBlockContainerV2{
+				Message:   &ethpbv2.SignedBeaconBlockContainerV2_AltairBlock{AltairBlock: signedBeaconBlock.Block},
+				Signature: signedBeaconBlock.Signature,
+			},
+		}, nil
+	default:
+		return nil, status.Errorf(codes.Internal, "Unsupported block version %d", block.Version())
+	}
+}
+
 // GetBlockSSZ returns the SSZ-serialized version of the becaon block for given block ID.
 func (bs *Server) GetBlockSSZ(ctx context.Context, req *ethpb.BlockRequest) (*ethpb.BlockSSZResponse, error) {
 	ctx, span := trace.StartSpan(ctx, "beaconv1.GetBlockSSZ")
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
@@ -14,8 +15,11 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	ethpb_alpha "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/eth/v1alpha1/wrapper"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
 	"github.com/prysmaticlabs/prysm/proto/interfaces"
 	"github.com/prysmaticlabs/prysm/proto/migration"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	wrapperv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2/wrapper"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
@@ -392,6 +396,55 @@ func TestServer_GetBlock(t *testing.T) {
 	}
 }
 
+func TestServer_GetBlockV2(t *testing.T) {
+	beaconDB := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	_, blkContainers := fillDBTestBlocks(ctx, t, beaconDB)
+	headBlock := testutil.HydrateSignedBeaconBlockAltair(&prysmv2.SignedBeaconBlockAltair{})
+	headBlock.Block.Slot = 100
+	headBlock.Block.Body.SyncAggregate.SyncCommitteeBits = bitfield.NewBitvector512()
+	headBlock.Block.Body.SyncAggregate.SyncCommitteeBits.SetBitAt(1, true)
+	wrappedHead, err := wrapperv2.WrappedAltairSignedBeaconBlock(headBlock)
+	require.NoError(t, err)
+
+	bs := &Server{
+		BeaconDB: beaconDB,
+		ChainInfoFetcher: &mock.ChainService{
+			DB:    beaconDB,
+			Block: wrappedHead,
+		},
+	}
+
+	t.Run("phase0", func(t *testing.T) {
+		resp, err := bs.GetBlockV2(ctx, &ethpb.BlockRequest{BlockId: []byte("30")})
+		require.NoError(t, err)
+		assert.Equal(t, ethpbv2.Version_PHASE0, resp.Version)
+		v1Block, err := migration.V1Alpha1ToV1Block(blkContainers[30].Block)
+		require.NoError(t, err)
+		assert.DeepEqual(t, v1Block.Block, resp.Data.GetPhase0Block())
+		assert.DeepEqual(t, v1Block.Signature, resp.Data.Signature)
+	})
+	t.Run("altair", func(t *testing.T) {
+		resp, err := bs.GetBlockV2(ctx, &ethpb.BlockRequest{BlockId: []byte("head")})
+		require.NoError(t, err)
+		assert.Equal(t, ethpbv2.Version_ALTAIR, resp.Version)
+		altairBlock := resp.Data.GetAltairBlock()
+		require.NotNil(t, altairBlock)
+		assert.Equal(t, headBlock.Block.Slot, altairBlock.Slot)
+		assert.DeepEqual(t, headBlock.Block.Body.SyncAggregate.SyncCommitteeBits, altairBlock.Body.SyncAggregate.SyncCommitteeBits)
+		assert.DeepEqual(t, headBlock.Signature, resp.Data.Signature)
+	})
+	t.Run("bad formatting", func(t *testing.T) {
+		_, err := bs.GetBlockV2(ctx, &ethpb.BlockRequest{BlockId: []byte("3bad0")})
+		assert.ErrorContains(t, "Invalid block ID", err)
+	})
+	t.Run("no block", func(t *testing.T) {
+		_, err := bs.GetBlockV2(ctx, &ethpb.BlockRequest{BlockId: []byte("105")})
+		assert.ErrorContains(t, "Could not find requested block", err)
+	})
+}
+
 func TestServer_GetBlockSSZ(t *testing.T) {
 	beaconDB := dbTest.SetupDB(t)
 	ctx := context.Background()
@@ -10,6 +10,8 @@ import (
 	eth2types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/go-bitfield"
 	chainMock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
@@ -430,14 +432,21 @@ func TestSubmitAttesterSlashing_Ok(t *testing.T) {
 	}
 
 	broadcaster := &p2pMock.MockBroadcaster{}
+	opNotifier := &chainMock.MockOperationNotifier{}
+	opChannel := make(chan *feed.Event, 1)
+	opSub := opNotifier.OperationFeed().Subscribe(opChannel)
+	defer opSub.Unsubscribe()
 	s := &Server{
-		ChainInfoFetcher: &chainMock.ChainService{State: state},
-		SlashingsPool:    &slashings.PoolMock{},
-		Broadcaster:      broadcaster,
+		ChainInfoFetcher:  &chainMock.ChainService{State: state},
+		SlashingsPool:     &slashings.PoolMock{},
+		Broadcaster:       broadcaster,
+		OperationNotifier: opNotifier,
 	}
 
 	_, err = s.SubmitAttesterSlashing(ctx, slashing)
 	require.NoError(t, err)
+	event := <-opChannel
+	assert.Equal(t, feed.EventType(operation.AttesterSlashingReceived), event.Type)
 	pendingSlashings := s.SlashingsPool.PendingAttesterSlashings(ctx, state, true)
 	require.Equal(t, 1, len(pendingSlashings))
 	assert.DeepEqual(t, migration.V1AttSlashingToV1Alpha1(slashing), pendingSlashings[0])
@@ -537,14 +546,21 @@ func TestSubmitProposerSlashing_Ok(t *testing.T) {
 	}
 
 	broadcaster := &p2pMock.MockBroadcaster{}
+	opNotifier := &chainMock.MockOperationNotifier{}
+	opChannel := make(chan *feed.Event, 1)
+	opSub := opNotifier.OperationFeed().Subscribe(opChannel)
+	defer opSub.Unsubscribe()
 	s := &Server{
-		ChainInfoFetcher: &chainMock.ChainService{State: state},
-		SlashingsPool:    &slashings.PoolMock{},
-		Broadcaster:      broadcaster,
+		ChainInfoFetcher:  &chainMock.ChainService{State: state},
+		SlashingsPool:     &slashings.PoolMock{},
+		Broadcaster:       broadcaster,
+		OperationNotifier: opNotifier,
 	}
 
 	_, err = s.SubmitProposerSlashing(ctx, slashing)
 	require.NoError(t, err)
+	event := <-opChannel
+	assert.Equal(t, feed.EventType(operation.ProposerSlashingReceived), event.Type)
 	pendingSlashings := s.SlashingsPool.PendingProposerSlashings(ctx, state, true)
 	require.Equal(t, 1, len(pendingSlashings))
 	assert.DeepEqual(t, migration.V1ProposerSlashingToV1Alpha1(slashing), pendingSlashings[0])
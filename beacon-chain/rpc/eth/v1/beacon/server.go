@@ -6,9 +6,11 @@ package beacon
 import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/statefetcher"
@@ -23,10 +25,12 @@ type Server struct {
 	GenesisTimeFetcher blockchain.TimeFetcher
 	BlockReceiver      blockchain.BlockReceiver
 	BlockNotifier      blockfeed.Notifier
+	OperationNotifier  opfeed.Notifier
 	Broadcaster        p2p.Broadcaster
 	AttestationsPool   attestations.Pool
 	SlashingsPool      slashings.PoolManager
 	VoluntaryExitsPool voluntaryexits.PoolManager
+	SyncCommitteePool  synccommittee.Pool
 	StateGenService    stategen.StateManager
 	StateFetcher       statefetcher.Fetcher
 }
@@ -86,6 +86,8 @@ func (bs *Server) GetStateFork(ctx context.Context, req *ethpb.StateRequest) (*e
 			return nil, status.Errorf(codes.NotFound, "State not found: %v", stateNotFoundErr)
 		} else if parseErr, ok := err.(*statefetcher.StateIdParseError); ok {
 			return nil, status.Errorf(codes.InvalidArgument, "Invalid state ID: %v", parseErr)
+		} else if replayErr := statefetcher.ReplayErrorToStatus(err); replayErr != nil {
+			return nil, replayErr
 		}
 		return nil, status.Errorf(codes.Internal, "Could not get state: %v", err)
 	}
@@ -117,6 +119,8 @@ func (bs *Server) GetFinalityCheckpoints(ctx context.Context, req *ethpb.StateRe
 			return nil, status.Errorf(codes.NotFound, "State not found: %v", stateNotFoundErr)
 		} else if parseErr, ok := err.(*statefetcher.StateIdParseError); ok {
 			return nil, status.Errorf(codes.InvalidArgument, "Invalid state ID: %v", parseErr)
+		} else if replayErr := statefetcher.ReplayErrorToStatus(err); replayErr != nil {
+			return nil, replayErr
 		}
 		return nil, status.Errorf(codes.Internal, "Could not get state: %v", err)
 	}
@@ -0,0 +1,180 @@
+package beacon
+
+import (
+	"context"
+	"fmt"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/statefetcher"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// syncCommitteeSignaturesVerificationFailure represents failures when verifying submitted sync committee signatures.
+type syncCommitteeSignaturesVerificationFailure struct {
+	Failures []*singleSyncCommitteeSignatureVerificationFailure `json:"failures"`
+}
+
+// singleSyncCommitteeSignatureVerificationFailure represents an issue when verifying a single submitted sync committee signature.
+type singleSyncCommitteeSignatureVerificationFailure struct {
+	Index   int    `json:"index"`
+	Message string `json:"message"`
+}
+
+// ListSyncCommittees retrieves the sync committee for the given state at the given epoch.
+// The epoch defaults to the epoch of the state and has to be within the current or the next
+// sync committee period of the state.
+func (bs *Server) ListSyncCommittees(ctx context.Context, req *ethpbv2.StateSyncCommitteesRequest) (*ethpbv2.StateSyncCommitteesResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "beaconv1.ListSyncCommittees")
+	defer span.End()
+
+	st, err := bs.StateFetcher.State(ctx, req.StateId)
+	if err != nil {
+		if stateNotFoundErr, ok := err.(*statefetcher.StateNotFoundError); ok {
+			return nil, status.Errorf(codes.NotFound, "State not found: %v", stateNotFoundErr)
+		} else if parseErr, ok := err.(*statefetcher.StateIdParseError); ok {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid state ID: %v", parseErr)
+		} else if replayErr := statefetcher.ReplayErrorToStatus(err); replayErr != nil {
+			return nil, replayErr
+		}
+		return nil, status.Errorf(codes.Internal, "Could not get state: %v", err)
+	}
+
+	epoch := helpers.SlotToEpoch(st.Slot())
+	if req.Epoch != nil {
+		epoch = *req.Epoch
+	}
+	committee, err := helpers.SyncCommitteeForEpoch(st, epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not get sync committee: %v", err)
+	}
+
+	validators := make([]types.ValidatorIndex, len(committee.Pubkeys))
+	for i, pubkey := range committee.Pubkeys {
+		index, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubkey))
+		if !ok {
+			return nil, status.Errorf(codes.Internal, "Could not find validator index of sync committee member %#x", pubkey)
+		}
+		validators[i] = index
+	}
+	subcommitteeSize := helpers.SyncSubcommitteeSize()
+	aggregates := make([]*ethpbv2.SyncSubcommitteeValidators, 0, params.BeaconConfig().SyncCommitteeSubnetCount)
+	for i := uint64(0); i < uint64(len(validators)); i += subcommitteeSize {
+		end := i + subcommitteeSize
+		if end > uint64(len(validators)) {
+			end = uint64(len(validators))
+		}
+		aggregates = append(aggregates, &ethpbv2.SyncSubcommitteeValidators{Validators: validators[i:end]})
+	}
+
+	return &ethpbv2.StateSyncCommitteesResponse{
+		Data: &ethpbv2.SyncCommitteeValidators{
+			Validators:          validators,
+			ValidatorAggregates: aggregates,
+		},
+	}, nil
+}
+
+// SubmitPoolSyncCommitteeSignatures submits sync committee signature objects to the node. Signatures
+// which pass validation are saved to the pool and published on the sync committee subnets of their
+// validators, failures of the other signatures are reported in the error details.
+func (bs *Server) SubmitPoolSyncCommitteeSignatures(ctx context.Context, req *ethpbv2.SubmitPoolSyncCommitteeSignatures) (*emptypb.Empty, error) {
+	ctx, span := trace.StartSpan(ctx, "beaconv1.SubmitPoolSyncCommitteeSignatures")
+	defer span.End()
+
+	headState, err := bs.ChainInfoFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+
+	var validMessages []*prysmv2.SyncCommitteeMessage
+	var msgSubnets [][]uint64
+	var msgFailures []*singleSyncCommitteeSignatureVerificationFailure
+	for i, sourceMsg := range req.Data {
+		msg := migration.V2SyncCommitteeMessageToV1Alpha1(sourceMsg)
+		subnets, err := verifySyncCommitteeMessage(headState, msg)
+		if err != nil {
+			msgFailures = append(msgFailures, &singleSyncCommitteeSignatureVerificationFailure{
+				Index:   i,
+				Message: err.Error(),
+			})
+			continue
+		}
+		validMessages = append(validMessages, msg)
+		msgSubnets = append(msgSubnets, subnets)
+	}
+
+	broadcastFailed := false
+	for i, msg := range validMessages {
+		if err := bs.SyncCommitteePool.SaveSyncCommitteeMessage(msg); err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not save sync committee signature: %v", err)
+		}
+		bs.OperationNotifier.OperationFeed().Send(&feed.Event{
+			Type: operation.SyncCommitteeMessageReceived,
+			Data: &operation.SyncCommitteeMessageReceivedData{
+				Message: msg,
+			},
+		})
+		for _, subnet := range msgSubnets[i] {
+			if err := bs.Broadcaster.BroadcastSyncCommitteeMessage(ctx, subnet, msg); err != nil {
+				broadcastFailed = true
+			}
+		}
+	}
+	if broadcastFailed {
+		return nil, status.Errorf(
+			codes.Internal,
+			"Could not publish one or more sync committee signatures. Some signatures could be published successfully.")
+	}
+
+	if len(msgFailures) > 0 {
+		failuresContainer := &syncCommitteeSignaturesVerificationFailure{Failures: msgFailures}
+		err = grpcutils.AppendCustomErrorHeader(ctx, failuresContainer)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not prepare sync committee signature failure information: %v", err)
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "One or more sync committee signatures failed validation")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// verifySyncCommitteeMessage checks that the message comes from a member of the sync committee of
+// its slot and that it is correctly signed. It returns the sync committee subnets of the member.
+func verifySyncCommitteeMessage(st iface.BeaconState, msg *prysmv2.SyncCommitteeMessage) ([]uint64, error) {
+	if len(msg.BlockRoot) != 32 {
+		return nil, fmt.Errorf("invalid block root length %d", len(msg.BlockRoot))
+	}
+	epoch := helpers.SlotToEpoch(msg.Slot)
+	committee, err := helpers.SyncCommitteeForEpoch(st, epoch)
+	if err != nil {
+		return nil, err
+	}
+	val, err := st.ValidatorAtIndexReadOnly(msg.ValidatorIndex)
+	if err != nil {
+		return nil, err
+	}
+	pubkey := val.PublicKey()
+	indices := helpers.SyncCommitteeIndices(committee, pubkey[:])
+	if len(indices) == 0 {
+		return nil, fmt.Errorf("validator %d is not a member of the sync committee at slot %d", msg.ValidatorIndex, msg.Slot)
+	}
+	signedRoot := p2ptypes.SSZBytes(msg.BlockRoot)
+	if err := helpers.ComputeDomainVerifySigningRoot(st, msg.ValidatorIndex, epoch, &signedRoot, params.BeaconConfig().DomainSyncCommittee, msg.Signature); err != nil {
+		return nil, err
+	}
+	return helpers.SyncSubnetsFromCommitteeIndices(indices), nil
+}
@@ -0,0 +1,120 @@
+package beacon
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	types "github.com/prysmaticlabs/eth2-types"
+	chainMock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
+	p2pMock "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/testutil"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	sharedtestutil "github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+)
+
+func TestListSyncCommittees(t *testing.T) {
+	ctx := context.Background()
+	params.SetupTestConfigCleanup(t)
+	c := params.BeaconConfig()
+	c.AltairForkEpoch = 0
+	params.OverrideBeaconConfig(c)
+
+	beaconState, _ := sharedtestutil.DeterministicGenesisState(t, 200)
+	st, err := sharedtestutil.DeterministicSyncCommitteeState(beaconState)
+	require.NoError(t, err)
+	s := &Server{
+		StateFetcher: &testutil.MockFetcher{
+			BeaconState: st,
+		},
+	}
+
+	t.Run("Current sync committee", func(t *testing.T) {
+		resp, err := s.ListSyncCommittees(ctx, &ethpbv2.StateSyncCommitteesRequest{StateId: []byte("head")})
+		require.NoError(t, err)
+		require.Equal(t, int(params.BeaconConfig().SyncCommitteeSize), len(resp.Data.Validators))
+		for i, index := range resp.Data.Validators {
+			assert.Equal(t, types.ValidatorIndex(i%200), index)
+		}
+		require.Equal(t, int(params.BeaconConfig().SyncCommitteeSubnetCount), len(resp.Data.ValidatorAggregates))
+		size := helpers.SyncSubcommitteeSize()
+		for i, aggregate := range resp.Data.ValidatorAggregates {
+			assert.DeepEqual(t, resp.Data.Validators[uint64(i)*size:uint64(i+1)*size], aggregate.Validators)
+		}
+	})
+	t.Run("Next sync committee", func(t *testing.T) {
+		epoch := params.BeaconConfig().EpochsPerSyncCommitteePeriod
+		resp, err := s.ListSyncCommittees(ctx, &ethpbv2.StateSyncCommitteesRequest{StateId: []byte("head"), Epoch: &epoch})
+		require.NoError(t, err)
+		for i, index := range resp.Data.Validators {
+			assert.Equal(t, types.ValidatorIndex((i+1)%200), index)
+		}
+	})
+	t.Run("Epoch too far in the future", func(t *testing.T) {
+		epoch := params.BeaconConfig().EpochsPerSyncCommitteePeriod * 2
+		_, err := s.ListSyncCommittees(ctx, &ethpbv2.StateSyncCommitteesRequest{StateId: []byte("head"), Epoch: &epoch})
+		assert.ErrorContains(t, "Could not get sync committee", err)
+	})
+}
+
+func TestSubmitPoolSyncCommitteeSignatures(t *testing.T) {
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), &runtime.ServerTransportStream{})
+	params.SetupTestConfigCleanup(t)
+	c := params.BeaconConfig()
+	c.AltairForkEpoch = 0
+	params.OverrideBeaconConfig(c)
+
+	beaconState, keys := sharedtestutil.DeterministicGenesisState(t, 200)
+	st, err := sharedtestutil.DeterministicSyncCommitteeState(beaconState)
+	require.NoError(t, err)
+	root := bytesutil.PadTo([]byte("root"), 32)
+	sign := func(key bls.SecretKey) []byte {
+		signedRoot := p2ptypes.SSZBytes(root)
+		domain, err := helpers.Domain(st.Fork(), 0, params.BeaconConfig().DomainSyncCommittee, st.GenesisValidatorRoot())
+		require.NoError(t, err)
+		signingRoot, err := helpers.ComputeSigningRoot(&signedRoot, domain)
+		require.NoError(t, err)
+		return key.Sign(signingRoot[:]).Marshal()
+	}
+
+	broadcaster := &p2pMock.MockBroadcaster{}
+	pool := synccommittee.NewPool()
+	s := &Server{
+		ChainInfoFetcher:  &chainMock.ChainService{State: st},
+		SyncCommitteePool: pool,
+		Broadcaster:       broadcaster,
+		OperationNotifier: &chainMock.MockOperationNotifier{},
+	}
+	_, err = s.SubmitPoolSyncCommitteeSignatures(ctx, &ethpbv2.SubmitPoolSyncCommitteeSignatures{
+		Data: []*ethpbv2.SyncCommitteeMessage{
+			{Slot: 0, BeaconBlockRoot: root, ValidatorIndex: 3, Signature: sign(keys[3])},
+			{Slot: 0, BeaconBlockRoot: root, ValidatorIndex: 3, Signature: sign(keys[4])},
+		},
+	})
+	require.ErrorContains(t, "One or more sync committee signatures failed validation", err)
+
+	sts, ok := grpc.ServerTransportStreamFromContext(ctx).(*runtime.ServerTransportStream)
+	require.Equal(t, true, ok, "type assertion failed")
+	v, ok := sts.Header()[strings.ToLower(grpcutils.CustomErrorMetadataKey)]
+	require.Equal(t, true, ok, "could not retrieve custom error metadata value")
+	require.Equal(t, 1, len(v))
+	assert.Equal(t, true, strings.HasPrefix(v[0], "{\"failures\":[{\"index\":1,"), v[0])
+
+	msgs, err := pool.SyncCommitteeMessages(0)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(msgs))
+	assert.Equal(t, types.ValidatorIndex(3), msgs[0].ValidatorIndex)
+	// Validator 3 holds positions 3, 203 and 403 of the sync committee, which are in subnets 0, 1 and 3.
+	assert.Equal(t, 3, len(broadcaster.BroadcastMessages))
+}
@@ -43,6 +43,8 @@ func (bs *Server) GetValidator(ctx context.Context, req *ethpb.StateValidatorReq
 			return nil, status.Errorf(codes.NotFound, "could not get state: %v", stateNotFoundErr)
 		} else if parseErr, ok := err.(*statefetcher.StateIdParseError); ok {
 			return nil, status.Errorf(codes.InvalidArgument, "Invalid state ID: %v", parseErr)
+		} else if replayErr := statefetcher.ReplayErrorToStatus(err); replayErr != nil {
+			return nil, replayErr
 		}
 		return nil, status.Errorf(codes.Internal, "State not found: %v", err)
 	}
@@ -67,6 +69,8 @@ func (bs *Server) ListValidators(ctx context.Context, req *ethpb.StateValidators
 			return nil, status.Errorf(codes.NotFound, "State not found: %v", stateNotFoundErr)
 		} else if parseErr, ok := err.(*statefetcher.StateIdParseError); ok {
 			return nil, status.Errorf(codes.InvalidArgument, "Invalid state ID: %v", parseErr)
+		} else if replayErr := statefetcher.ReplayErrorToStatus(err); replayErr != nil {
+			return nil, replayErr
 		}
 		return nil, status.Errorf(codes.Internal, "Could not get state: %v", err)
 	}
@@ -115,6 +119,8 @@ func (bs *Server) ListValidatorBalances(ctx context.Context, req *ethpb.Validato
 			return nil, status.Errorf(codes.NotFound, "State not found: %v", stateNotFoundErr)
 		} else if parseErr, ok := err.(*statefetcher.StateIdParseError); ok {
 			return nil, status.Errorf(codes.InvalidArgument, "Invalid state ID: %v", parseErr)
+		} else if replayErr := statefetcher.ReplayErrorToStatus(err); replayErr != nil {
+			return nil, replayErr
 		}
 		return nil, status.Errorf(codes.Internal, "Could not get state: %v", err)
 	}
@@ -142,6 +148,8 @@ func (bs *Server) ListCommittees(ctx context.Context, req *ethpb.StateCommittees
 			return nil, status.Errorf(codes.NotFound, "State not found: %v", stateNotFoundErr)
 		} else if parseErr, ok := err.(*statefetcher.StateIdParseError); ok {
 			return nil, status.Errorf(codes.InvalidArgument, "Invalid state ID: %v", parseErr)
+		} else if replayErr := statefetcher.ReplayErrorToStatus(err); replayErr != nil {
+			return nil, replayErr
 		}
 		return nil, status.Errorf(codes.Internal, "Could not get state: %v", err)
 	}
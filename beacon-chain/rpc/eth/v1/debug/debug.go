@@ -4,7 +4,11 @@ import (
 	"context"
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/statefetcher"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	"github.com/prysmaticlabs/prysm/shared/version"
 	"go.opencensus.io/trace"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -22,6 +26,8 @@ func (ds *Server) GetBeaconState(ctx context.Context, req *ethpb.StateRequest) (
 			return nil, status.Errorf(codes.NotFound, "State not found: %v", stateNotFoundErr)
 		} else if parseErr, ok := err.(*statefetcher.StateIdParseError); ok {
 			return nil, status.Errorf(codes.InvalidArgument, "Invalid state ID: %v", parseErr)
+		} else if replayErr := statefetcher.ReplayErrorToStatus(err); replayErr != nil {
+			return nil, replayErr
 		}
 		return nil, status.Errorf(codes.Internal, "Invalid state ID: %v", err)
 	}
@@ -36,6 +42,55 @@ func (ds *Server) GetBeaconState(ctx context.Context, req *ethpb.StateRequest) (
 	}, nil
 }
 
+// GetBeaconStateV2 returns the full beacon state for a given state id, along with the fork version of the state.
+func (ds *Server) GetBeaconStateV2(ctx context.Context, req *ethpb.StateRequest) (*ethpbv2.BeaconStateResponseV2, error) {
+	ctx, span := trace.StartSpan(ctx, "beaconv2.GetBeaconStateV2")
+	defer span.End()
+
+	state, err := ds.StateFetcher.State(ctx, req.StateId)
+	if err != nil {
+		if stateNotFoundErr, ok := err.(*statefetcher.StateNotFoundError); ok {
+			return nil, status.Errorf(codes.NotFound, "State not found: %v", stateNotFoundErr)
+		} else if parseErr, ok := err.(*statefetcher.StateIdParseError); ok {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid state ID: %v", parseErr)
+		} else if replayErr := statefetcher.ReplayErrorToStatus(err); replayErr != nil {
+			return nil, replayErr
+		}
+		return nil, status.Errorf(codes.Internal, "Invalid state ID: %v", err)
+	}
+
+	switch state.Version() {
+	case version.Phase0:
+		protoState, err := state.ToProto()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not convert state to proto: %v", err)
+		}
+		return &ethpbv2.BeaconStateResponseV2{
+			Version: ethpbv2.Version_PHASE0,
+			Data: &ethpbv2.BeaconStateContainer{
+				State: &ethpbv2.BeaconStateContainer_Phase0State{Phase0State: protoState},
+			},
+		}, nil
+	case version.Altair:
+		altairState, ok := state.InnerStateUnsafe().(*pbp2p.BeaconStateAltair)
+		if !ok {
+			return nil, status.Error(codes.Internal, "Altair state has unexpected type")
+		}
+		protoState, err := migration.BeaconStateAltairToV2(altairState)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not convert state to proto: %v", err)
+		}
+		return &ethpbv2.BeaconStateResponseV2{
+			Version: ethpbv2.Version_ALTAIR,
+			Data: &ethpbv2.BeaconStateContainer{
+				State: &ethpbv2.BeaconStateContainer_AltairState{AltairState: protoState},
+			},
+		}, nil
+	default:
+		return nil, status.Errorf(codes.Internal, "Unsupported state version %d", state.Version())
+	}
+}
+
 // GetBeaconStateSSZ returns the SSZ-serialized version of the full beacon state object for given stateId.
 func (ds *Server) GetBeaconStateSSZ(ctx context.Context, req *ethpb.StateRequest) (*ethpb.BeaconStateSSZResponse, error) {
 	ctx, span := trace.StartSpan(ctx, "beaconv1.GetBeaconStateSSZ")
@@ -47,6 +102,8 @@ func (ds *Server) GetBeaconStateSSZ(ctx context.Context, req *ethpb.StateRequest
 			return nil, status.Errorf(codes.NotFound, "State not found: %v", stateNotFoundErr)
 		} else if parseErr, ok := err.(*statefetcher.StateIdParseError); ok {
 			return nil, status.Errorf(codes.InvalidArgument, "Invalid state ID: %v", parseErr)
+		} else if replayErr := statefetcher.ReplayErrorToStatus(err); replayErr != nil {
+			return nil, replayErr
 		}
 		return nil, status.Errorf(codes.Internal, "Invalid state ID: %v", err)
 	}
@@ -8,6 +8,7 @@ import (
 	blockchainmock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/testutil"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	sharedtestutil "github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
@@ -30,6 +31,24 @@ func TestGetBeaconState(t *testing.T) {
 	assert.NotNil(t, resp)
 }
 
+func TestGetBeaconStateV2(t *testing.T) {
+	fakeState, err := sharedtestutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, fakeState.SetSlot(5))
+	server := &Server{
+		StateFetcher: &testutil.MockFetcher{
+			BeaconState: fakeState,
+		},
+	}
+	resp, err := server.GetBeaconStateV2(context.Background(), &ethpb.StateRequest{
+		StateId: make([]byte, 0),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, ethpbv2.Version_PHASE0, resp.Version)
+	require.NotNil(t, resp.Data.GetPhase0State())
+	assert.Equal(t, types.Slot(5), resp.Data.GetPhase0State().Slot)
+}
+
 func TestGetBeaconStateSSZ(t *testing.T) {
 	fakeState, err := sharedtestutil.NewBeaconState()
 	require.NoError(t, err)
@@ -0,0 +1,70 @@
+package events
+
+import (
+	"sync"
+
+	"github.com/prysmaticlabs/prysm/proto/interfaces"
+	"google.golang.org/protobuf/proto"
+)
+
+// streamEvent is an event of a single topic, as sent to subscribers.
+type streamEvent struct {
+	id    uint64
+	topic string
+	data  proto.Message
+	// block is set for full block events, which are encoded on a per subscriber basis.
+	block interfaces.SignedBeaconBlock
+}
+
+// eventBuffer assigns increasing IDs to events and keeps the most recent ones, so that
+// subscribers can resume a stream after reconnecting.
+type eventBuffer struct {
+	lock   sync.RWMutex
+	events []*streamEvent
+	start  int
+	count  int
+	lastID uint64
+}
+
+func newEventBuffer(size int) *eventBuffer {
+	if size < 0 {
+		size = 0
+	}
+	return &eventBuffer{events: make([]*streamEvent, size)}
+}
+
+// add assigns the next ID to the event and keeps it in the buffer, evicting the oldest event
+// once the buffer is full.
+func (b *eventBuffer) add(ev *streamEvent) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.lastID++
+	ev.id = b.lastID
+	if len(b.events) == 0 {
+		return
+	}
+	if b.count < len(b.events) {
+		b.events[(b.start+b.count)%len(b.events)] = ev
+		b.count++
+		return
+	}
+	b.events[b.start] = ev
+	b.start = (b.start + 1) % len(b.events)
+}
+
+// since returns the buffered events which followed the event with the given ID, oldest first,
+// along with the ID of the latest event.
+func (b *eventBuffer) since(id uint64) ([]*streamEvent, uint64) {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	var events []*streamEvent
+	for i := 0; i < b.count; i++ {
+		ev := b.events[(b.start+i)%len(b.events)]
+		if ev.id > id {
+			events = append(events, ev)
+		}
+	}
+	return events, b.lastID
+}
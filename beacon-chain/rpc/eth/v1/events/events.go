@@ -1,6 +1,8 @@
 package events
 
 import (
+	"strconv"
+
 	gwpb "github.com/grpc-ecosystem/grpc-gateway/v2/proto/gateway"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
@@ -8,10 +10,13 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/proto/interfaces"
 	"github.com/prysmaticlabs/prysm/proto/migration"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/version"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 )
 
@@ -28,20 +33,49 @@ const (
 	FinalizedCheckpointTopic = "finalized_checkpoint"
 	// ChainReorgTopic represents a chain reorganization event topic.
 	ChainReorgTopic = "chain_reorg"
+	// ContributionAndProofTopic represents a new sync committee contribution event topic.
+	ContributionAndProofTopic = "contribution_and_proof"
+	// SyncCommitteeTopic represents a new sync committee message event topic.
+	SyncCommitteeTopic = "sync_committee"
+	// AttesterSlashingTopic represents a new attester slashing event topic.
+	AttesterSlashingTopic = "attester_slashing"
+	// ProposerSlashingTopic represents a new proposer slashing event topic.
+	ProposerSlashingTopic = "proposer_slashing"
+	// BlockGossipTopic represents a block seen on gossip, before it is processed, event topic.
+	BlockGossipTopic = "block_gossip"
+	// BlockFullTopic represents an imported block event topic, carrying the whole block.
+	BlockFullTopic = "block_full"
+)
+
+const (
+	// JSONBlockEncoding sends the blocks of full block events as JSON objects.
+	JSONBlockEncoding = "json"
+	// SSZBlockEncoding sends the blocks of full block events as SSZ bytes.
+	SSZBlockEncoding = "ssz"
 )
 
+// Size of the channel of each subscriber, absorbing short bursts of events.
+const subscriberChannelSize = 16
+
 var casesHandled = map[string]bool{
-	HeadTopic:                true,
-	BlockTopic:               true,
-	AttestationTopic:         true,
-	VoluntaryExitTopic:       true,
-	FinalizedCheckpointTopic: true,
-	ChainReorgTopic:          true,
+	HeadTopic:                 true,
+	BlockTopic:                true,
+	AttestationTopic:          true,
+	VoluntaryExitTopic:        true,
+	FinalizedCheckpointTopic:  true,
+	ChainReorgTopic:           true,
+	ContributionAndProofTopic: true,
+	SyncCommitteeTopic:        true,
+	AttesterSlashingTopic:     true,
+	ProposerSlashingTopic:     true,
+	BlockGossipTopic:          true,
+	BlockFullTopic:            true,
 }
 
 // StreamEvents allows requesting all events from a set of topics defined in the Ethereum consensus API standard.
 // The topics supported include block events, attestations, chain reorgs, voluntary exits,
-// chain finality, and more.
+// chain finality, and more. A subscriber which reconnects can resume the stream after the last
+// event it received, as long as the events which followed it are still buffered.
 func (s *Server) StreamEvents(
 	req *ethpb.StreamEventsRequest, stream ethpb.Events_StreamEventsServer,
 ) error {
@@ -56,7 +90,82 @@ func (s *Server) StreamEvents(
 		}
 		requestedTopics[topic] = true
 	}
+	encoding := req.BlockEncoding
+	if encoding == "" {
+		encoding = JSONBlockEncoding
+	}
+	if encoding != JSONBlockEncoding && encoding != SSZBlockEncoding {
+		return status.Errorf(codes.InvalidArgument, "Block encoding %s not supported", encoding)
+	}
+	var lastEventID uint64
+	if req.LastEventId != "" {
+		var err error
+		lastEventID, err = strconv.ParseUint(req.LastEventId, 10, 64)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "Invalid last event ID %s", req.LastEventId)
+		}
+	}
+	sendEvent := func(ev *streamEvent) error {
+		if _, ok := requestedTopics[ev.topic]; !ok {
+			return nil
+		}
+		return s.streamData(stream, ev, req.WithEventIds, encoding)
+	}
+
+	eventsChan := make(chan *streamEvent, subscriberChannelSize)
+	eventsSub := s.subscribe(eventsChan)
+	defer eventsSub.Unsubscribe()
+
+	// Events up to the latest buffered one are either replayed or not wanted, later events are
+	// received through the subscription.
+	var replayed uint64
+	if req.LastEventId != "" {
+		var missed []*streamEvent
+		missed, replayed = s.buffer.since(lastEventID)
+		for _, ev := range missed {
+			if err := sendEvent(ev); err != nil {
+				return status.Errorf(codes.Internal, "Could not replay event: %v", err)
+			}
+		}
+	}
 
+	// Handle each event received and context cancelation.
+	for {
+		select {
+		case ev := <-eventsChan:
+			if ev.id <= replayed {
+				continue
+			}
+			if err := sendEvent(ev); err != nil {
+				return status.Errorf(codes.Internal, "Could not handle %s event: %v", ev.topic, err)
+			}
+		case <-s.Ctx.Done():
+			return status.Errorf(codes.Canceled, "Context canceled")
+		case <-stream.Context().Done():
+			return status.Errorf(codes.Canceled, "Context canceled")
+		}
+	}
+}
+
+// subscribe registers the channel for all the events of the node. The first subscription starts
+// collecting events from the node's feeds.
+func (s *Server) subscribe(ch chan<- *streamEvent) event.Subscription {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.eventFeed != nil {
+		return s.eventFeed.Subscribe(ch)
+	}
+	s.eventFeed = new(event.Feed)
+	s.buffer = newEventBuffer(s.ReplayBufferSize)
+	sub := s.eventFeed.Subscribe(ch)
+	go s.collectEvents()
+	return sub
+}
+
+// collectEvents converts the events of the node's feeds to stream events, buffering them and
+// sending them to all subscribers.
+func (s *Server) collectEvents() {
 	// Subscribe to event feeds from information received in the beacon node runtime.
 	blockChan := make(chan *feed.Event, 1)
 	blockSub := s.BlockNotifier.BlockFeed().Subscribe(blockChan)
@@ -71,141 +180,239 @@ func (s *Server) StreamEvents(
 	defer opsSub.Unsubscribe()
 	defer stateSub.Unsubscribe()
 
-	// Handle each event received and context cancelation.
 	for {
+		var ev *streamEvent
+		var err error
 		select {
-		case event := <-blockChan:
-			if err := s.handleBlockEvents(stream, requestedTopics, event); err != nil {
-				return status.Errorf(codes.Internal, "Could not handle block event: %v", err)
+		case e := <-blockChan:
+			ev, err = blockEvent(e)
+			if err != nil {
+				log.WithError(err).Error("Could not handle block event")
 			}
-		case event := <-opsChan:
-			if err := s.handleBlockOperationEvents(stream, requestedTopics, event); err != nil {
-				return status.Errorf(codes.Internal, "Could not handle block operations event: %v", err)
-			}
-		case event := <-stateChan:
-			if err := s.handleStateEvents(stream, requestedTopics, event); err != nil {
-				return status.Errorf(codes.Internal, "Could not handle state event: %v", err)
+		case e := <-opsChan:
+			ev = blockOperationEvent(e)
+		case e := <-stateChan:
+			ev, err = stateEvent(e)
+			if err != nil {
+				log.WithError(err).Error("Could not handle state event")
 			}
 		case <-s.Ctx.Done():
-			return status.Errorf(codes.Canceled, "Context canceled")
-		case <-stream.Context().Done():
-			return status.Errorf(codes.Canceled, "Context canceled")
+			return
 		}
+		if ev == nil {
+			continue
+		}
+		s.buffer.add(ev)
+		s.eventFeed.Send(ev)
 	}
 }
 
-func (s *Server) handleBlockEvents(
-	stream ethpb.Events_StreamEventsServer, requestedTopics map[string]bool, event *feed.Event,
-) error {
+func blockEvent(event *feed.Event) (*streamEvent, error) {
+	var topic string
 	switch event.Type {
 	case blockfeed.ReceivedBlock:
-		if _, ok := requestedTopics[BlockTopic]; !ok {
-			return nil
-		}
-		blkData, ok := event.Data.(*blockfeed.ReceivedBlockData)
-		if !ok {
-			return nil
-		}
-		v1Data, err := migration.BlockIfaceToV1BlockHeader(blkData.SignedBlock)
-		if err != nil {
-			return err
-		}
-		item, err := v1Data.HashTreeRoot()
-		if err != nil {
-			return errors.Wrap(err, "could not hash tree root block")
-		}
-		eventBlock := &ethpb.EventBlock{
-			Slot:  v1Data.Message.Slot,
-			Block: item[:],
-		}
-		return s.streamData(stream, BlockTopic, eventBlock)
+		topic = BlockTopic
+	case blockfeed.ReceivedGossipBlock:
+		topic = BlockGossipTopic
 	default:
-		return nil
+		return nil, nil
+	}
+	blkData, ok := event.Data.(*blockfeed.ReceivedBlockData)
+	if !ok {
+		return nil, nil
+	}
+	v1Data, err := migration.BlockIfaceToV1BlockHeader(blkData.SignedBlock)
+	if err != nil {
+		return nil, err
+	}
+	item, err := v1Data.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not hash tree root block")
 	}
+	eventBlock := &ethpb.EventBlock{
+		Slot:  v1Data.Message.Slot,
+		Block: item[:],
+	}
+	return &streamEvent{topic: topic, data: eventBlock}, nil
 }
 
-func (s *Server) handleBlockOperationEvents(
-	stream ethpb.Events_StreamEventsServer, requestedTopics map[string]bool, event *feed.Event,
-) error {
+func blockOperationEvent(event *feed.Event) *streamEvent {
 	switch event.Type {
 	case operation.AggregatedAttReceived:
-		if _, ok := requestedTopics[AttestationTopic]; !ok {
-			return nil
-		}
 		attData, ok := event.Data.(*operation.AggregatedAttReceivedData)
 		if !ok {
 			return nil
 		}
 		v1Data := migration.V1Alpha1AggregateAttAndProofToV1(attData.Attestation)
-		return s.streamData(stream, AttestationTopic, v1Data)
+		return &streamEvent{topic: AttestationTopic, data: v1Data}
 	case operation.UnaggregatedAttReceived:
-		if _, ok := requestedTopics[AttestationTopic]; !ok {
-			return nil
-		}
 		attData, ok := event.Data.(*operation.UnAggregatedAttReceivedData)
 		if !ok {
 			return nil
 		}
 		v1Data := migration.V1Alpha1AttestationToV1(attData.Attestation)
-		return s.streamData(stream, AttestationTopic, v1Data)
+		return &streamEvent{topic: AttestationTopic, data: v1Data}
 	case operation.ExitReceived:
-		if _, ok := requestedTopics[VoluntaryExitTopic]; !ok {
-			return nil
-		}
 		exitData, ok := event.Data.(*operation.ExitReceivedData)
 		if !ok {
 			return nil
 		}
 		v1Data := migration.V1Alpha1ExitToV1(exitData.Exit)
-		return s.streamData(stream, VoluntaryExitTopic, v1Data)
+		return &streamEvent{topic: VoluntaryExitTopic, data: v1Data}
+	case operation.AttesterSlashingReceived:
+		slashingData, ok := event.Data.(*operation.AttesterSlashingReceivedData)
+		if !ok {
+			return nil
+		}
+		v1Data := migration.V1Alpha1AttSlashingToV1(slashingData.AttesterSlashing)
+		return &streamEvent{topic: AttesterSlashingTopic, data: v1Data}
+	case operation.ProposerSlashingReceived:
+		slashingData, ok := event.Data.(*operation.ProposerSlashingReceivedData)
+		if !ok {
+			return nil
+		}
+		v1Data := migration.V1Alpha1ProposerSlashingToV1(slashingData.ProposerSlashing)
+		return &streamEvent{topic: ProposerSlashingTopic, data: v1Data}
+	case operation.SyncCommitteeContributionReceived:
+		contributionData, ok := event.Data.(*operation.SyncCommitteeContributionReceivedData)
+		if !ok {
+			return nil
+		}
+		v2Data := migration.V1Alpha1SignedContributionAndProofToV2(contributionData.Contribution)
+		return &streamEvent{topic: ContributionAndProofTopic, data: v2Data}
+	case operation.SyncCommitteeMessageReceived:
+		msgData, ok := event.Data.(*operation.SyncCommitteeMessageReceivedData)
+		if !ok {
+			return nil
+		}
+		v2Data := migration.V1Alpha1SyncCommitteeMessageToV2(msgData.Message)
+		return &streamEvent{topic: SyncCommitteeTopic, data: v2Data}
 	default:
 		return nil
 	}
 }
 
-func (s *Server) handleStateEvents(
-	stream ethpb.Events_StreamEventsServer, requestedTopics map[string]bool, event *feed.Event,
-) error {
+func stateEvent(event *feed.Event) (*streamEvent, error) {
 	switch event.Type {
 	case statefeed.NewHead:
-		if _, ok := requestedTopics[HeadTopic]; !ok {
-			return nil
-		}
 		head, ok := event.Data.(*ethpb.EventHead)
 		if !ok {
-			return nil
+			return nil, nil
 		}
-		return s.streamData(stream, HeadTopic, head)
+		return &streamEvent{topic: HeadTopic, data: head}, nil
 	case statefeed.FinalizedCheckpoint:
-		if _, ok := requestedTopics[FinalizedCheckpointTopic]; !ok {
-			return nil
-		}
 		finalizedCheckpoint, ok := event.Data.(*ethpb.EventFinalizedCheckpoint)
 		if !ok {
-			return nil
+			return nil, nil
 		}
-		return s.streamData(stream, FinalizedCheckpointTopic, finalizedCheckpoint)
+		return &streamEvent{topic: FinalizedCheckpointTopic, data: finalizedCheckpoint}, nil
 	case statefeed.Reorg:
-		if _, ok := requestedTopics[ChainReorgTopic]; !ok {
-			return nil
-		}
 		reorg, ok := event.Data.(*ethpb.EventChainReorg)
 		if !ok {
-			return nil
+			return nil, nil
 		}
-		return s.streamData(stream, ChainReorgTopic, reorg)
+		return &streamEvent{topic: ChainReorgTopic, data: reorg}, nil
+	case statefeed.BlockProcessed:
+		blkData, ok := event.Data.(*statefeed.BlockProcessedData)
+		if !ok || blkData.SignedBlock == nil || blkData.SignedBlock.IsNil() {
+			return nil, nil
+		}
+		var v ethpbv2.Version
+		switch blkData.SignedBlock.Version() {
+		case version.Phase0:
+			v = ethpbv2.Version_PHASE0
+		case version.Altair:
+			v = ethpbv2.Version_ALTAIR
+		default:
+			return nil, errors.Errorf("unsupported block version %d", blkData.SignedBlock.Version())
+		}
+		eventBlock := &ethpbv2.EventBlockFull{
+			Slot:    blkData.Slot,
+			Block:   blkData.BlockRoot[:],
+			Version: v,
+		}
+		return &streamEvent{topic: BlockFullTopic, data: eventBlock, block: blkData.SignedBlock}, nil
 	default:
-		return nil
+		return nil, nil
 	}
 }
 
-func (s *Server) streamData(stream ethpb.Events_StreamEventsServer, name string, data proto.Message) error {
+// fullBlockData returns the full block event carrying the block in the requested encoding.
+func fullBlockData(ev *streamEvent, encoding string) (*ethpbv2.EventBlockFull, error) {
+	base, ok := ev.data.(*ethpbv2.EventBlockFull)
+	if !ok {
+		return nil, errors.New("full block event has no block data")
+	}
+	eventBlock := &ethpbv2.EventBlockFull{
+		Slot:    base.Slot,
+		Block:   base.Block,
+		Version: base.Version,
+	}
+	if encoding == SSZBlockEncoding {
+		sszBlock, err := ev.block.MarshalSSZ()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not marshal block into SSZ")
+		}
+		eventBlock.Ssz = sszBlock
+		return eventBlock, nil
+	}
+	container, err := signedBlockContainer(ev.block)
+	if err != nil {
+		return nil, err
+	}
+	eventBlock.Data = container
+	return eventBlock, nil
+}
+
+func signedBlockContainer(block interfaces.SignedBeaconBlock) (*ethpbv2.SignedBeaconBlockContainerV2, error) {
+	switch block.Version() {
+	case version.Phase0:
+		signedBeaconBlock, err := migration.SignedBeaconBlock(block)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get signed beacon block")
+		}
+		return &ethpbv2.SignedBeaconBlockContainerV2{
+			Message:   &ethpbv2.SignedBeaconBlockContainerV2_Phase0Block{Phase0Block: signedBeaconBlock.Block},
+			Signature: signedBeaconBlock.Signature,
+		}, nil
+	case version.Altair:
+		signedBeaconBlock, err := migration.AltairSignedBeaconBlock(block)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get signed beacon block")
+		}
+		return &ethpbv2.SignedBeaconBlockContainerV2{
+			Message:   &ethpbv2.SignedBeaconBlockContainerV2_AltairBlock{AltairBlock: signedBeaconBlock.Block},
+			Signature: signedBeaconBlock.Signature,
+		}, nil
+	default:
+		return nil, errors.Errorf("unsupported block version %d", block.Version())
+	}
+}
+
+func (s *Server) streamData(stream ethpb.Events_StreamEventsServer, ev *streamEvent, withID bool, encoding string) error {
+	data := ev.data
+	if ev.topic == BlockFullTopic {
+		var err error
+		data, err = fullBlockData(ev, encoding)
+		if err != nil {
+			return err
+		}
+	}
 	returnData, err := anypb.New(data)
 	if err != nil {
 		return err
 	}
+	if withID {
+		returnData, err = anypb.New(&ethpb.EventWithId{
+			Id:   strconv.FormatUint(ev.id, 10),
+			Data: returnData,
+		})
+		if err != nil {
+			return err
+		}
+	}
 	return stream.Send(&gwpb.EventSource{
-		Event: name,
+		Event: ev.topic,
 		Data:  returnData,
 	})
 }
@@ -2,10 +2,12 @@ package events
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/proto/gateway"
+	types "github.com/prysmaticlabs/eth2-types"
 	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
@@ -14,7 +16,9 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	ethpb_v1alpha1 "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/eth/v1alpha1/wrapper"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
 	"github.com/prysmaticlabs/prysm/proto/migration"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/mock"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
@@ -80,6 +84,102 @@ func TestStreamEvents_BlockEvents(t *testing.T) {
 			feed: srv.BlockNotifier.BlockFeed(),
 		})
 	})
+	t.Run(BlockGossipTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedBlock := testutil.HydrateSignedBeaconBlock(&ethpb_v1alpha1.SignedBeaconBlock{
+			Block: &ethpb_v1alpha1.BeaconBlock{
+				Slot: 8,
+			},
+		})
+		wantedBlockRoot, err := wantedBlock.HashTreeRoot()
+		require.NoError(t, err)
+		genericResponse, err := anypb.New(&ethpb.EventBlock{
+			Slot:  8,
+			Block: wantedBlockRoot[:],
+		})
+		require.NoError(t, err)
+		wantedMessage := &gateway.EventSource{
+			Event: BlockGossipTopic,
+			Data:  genericResponse,
+		}
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{BlockGossipTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: blockfeed.ReceivedGossipBlock,
+				Data: &blockfeed.ReceivedBlockData{
+					SignedBlock: wrapper.WrappedPhase0SignedBeaconBlock(wantedBlock),
+				},
+			},
+			feed: srv.BlockNotifier.BlockFeed(),
+		})
+	})
+	t.Run(BlockFullTopic, func(t *testing.T) {
+		wantedBlock := testutil.HydrateSignedBeaconBlock(&ethpb_v1alpha1.SignedBeaconBlock{
+			Block: &ethpb_v1alpha1.BeaconBlock{
+				Slot: 8,
+			},
+		})
+		wantedBlockRoot, err := wantedBlock.Block.HashTreeRoot()
+		require.NoError(t, err)
+		v1Block, err := migration.V1Alpha1ToV1Block(wantedBlock)
+		require.NoError(t, err)
+		sszBlock, err := wantedBlock.MarshalSSZ()
+		require.NoError(t, err)
+
+		for _, encoding := range []string{JSONBlockEncoding, SSZBlockEncoding} {
+			t.Run(encoding, func(t *testing.T) {
+				ctx := context.Background()
+				srv, ctrl, mockStream := setupServer(ctx, t)
+				defer ctrl.Finish()
+
+				eventBlock := &ethpbv2.EventBlockFull{
+					Slot:    8,
+					Block:   wantedBlockRoot[:],
+					Version: ethpbv2.Version_PHASE0,
+				}
+				if encoding == SSZBlockEncoding {
+					eventBlock.Ssz = sszBlock
+				} else {
+					eventBlock.Data = &ethpbv2.SignedBeaconBlockContainerV2{
+						Message:   &ethpbv2.SignedBeaconBlockContainerV2_Phase0Block{Phase0Block: v1Block.Block},
+						Signature: v1Block.Signature,
+					}
+				}
+				genericResponse, err := anypb.New(eventBlock)
+				require.NoError(t, err)
+				wantedMessage := &gateway.EventSource{
+					Event: BlockFullTopic,
+					Data:  genericResponse,
+				}
+
+				assertFeedSendAndReceive(ctx, &assertFeedArgs{
+					t:             t,
+					srv:           srv,
+					topics:        []string{BlockFullTopic},
+					encoding:      encoding,
+					stream:        mockStream,
+					shouldReceive: wantedMessage,
+					itemToSend: &feed.Event{
+						Type: statefeed.BlockProcessed,
+						Data: &statefeed.BlockProcessedData{
+							Slot:        8,
+							BlockRoot:   wantedBlockRoot,
+							SignedBlock: wrapper.WrappedPhase0SignedBeaconBlock(wantedBlock),
+						},
+					},
+					feed: srv.StateNotifier.StateFeed(),
+				})
+			})
+		}
+	})
 }
 
 func TestStreamEvents_OperationsEvents(t *testing.T) {
@@ -185,6 +285,201 @@ func TestStreamEvents_OperationsEvents(t *testing.T) {
 			feed: srv.OperationNotifier.OperationFeed(),
 		})
 	})
+	t.Run(AttesterSlashingTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedSlashingV1alpha1 := &ethpb_v1alpha1.AttesterSlashing{
+			Attestation_1: testutil.HydrateIndexedAttestation(&ethpb_v1alpha1.IndexedAttestation{AttestingIndices: []uint64{1}}),
+			Attestation_2: testutil.HydrateIndexedAttestation(&ethpb_v1alpha1.IndexedAttestation{AttestingIndices: []uint64{1}}),
+		}
+		genericResponse, err := anypb.New(migration.V1Alpha1AttSlashingToV1(wantedSlashingV1alpha1))
+		require.NoError(t, err)
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{AttesterSlashingTopic},
+			stream:        mockStream,
+			shouldReceive: &gateway.EventSource{Event: AttesterSlashingTopic, Data: genericResponse},
+			itemToSend: &feed.Event{
+				Type: operation.AttesterSlashingReceived,
+				Data: &operation.AttesterSlashingReceivedData{
+					AttesterSlashing: wantedSlashingV1alpha1,
+				},
+			},
+			feed: srv.OperationNotifier.OperationFeed(),
+		})
+	})
+	t.Run(ProposerSlashingTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedSlashingV1alpha1 := &ethpb_v1alpha1.ProposerSlashing{
+			Header_1: testutil.HydrateSignedBeaconHeader(&ethpb_v1alpha1.SignedBeaconBlockHeader{}),
+			Header_2: testutil.HydrateSignedBeaconHeader(&ethpb_v1alpha1.SignedBeaconBlockHeader{}),
+		}
+		genericResponse, err := anypb.New(migration.V1Alpha1ProposerSlashingToV1(wantedSlashingV1alpha1))
+		require.NoError(t, err)
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{ProposerSlashingTopic},
+			stream:        mockStream,
+			shouldReceive: &gateway.EventSource{Event: ProposerSlashingTopic, Data: genericResponse},
+			itemToSend: &feed.Event{
+				Type: operation.ProposerSlashingReceived,
+				Data: &operation.ProposerSlashingReceivedData{
+					ProposerSlashing: wantedSlashingV1alpha1,
+				},
+			},
+			feed: srv.OperationNotifier.OperationFeed(),
+		})
+	})
+	t.Run(ContributionAndProofTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedContributionV1alpha1 := &prysmv2.SignedContributionAndProof{
+			Message: &prysmv2.ContributionAndProof{
+				AggregatorIndex: 1,
+				Contribution: &prysmv2.SyncCommitteeContribution{
+					Slot:              1,
+					BlockRoot:         make([]byte, 32),
+					SubcommitteeIndex: 1,
+					AggregationBits:   prysmv2.NewSyncCommitteeAggregationBits(),
+					Signature:         make([]byte, 96),
+				},
+				SelectionProof: make([]byte, 96),
+			},
+			Signature: make([]byte, 96),
+		}
+		genericResponse, err := anypb.New(migration.V1Alpha1SignedContributionAndProofToV2(wantedContributionV1alpha1))
+		require.NoError(t, err)
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{ContributionAndProofTopic},
+			stream:        mockStream,
+			shouldReceive: &gateway.EventSource{Event: ContributionAndProofTopic, Data: genericResponse},
+			itemToSend: &feed.Event{
+				Type: operation.SyncCommitteeContributionReceived,
+				Data: &operation.SyncCommitteeContributionReceivedData{
+					Contribution: wantedContributionV1alpha1,
+				},
+			},
+			feed: srv.OperationNotifier.OperationFeed(),
+		})
+	})
+	t.Run(SyncCommitteeTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedMessageV1alpha1 := &prysmv2.SyncCommitteeMessage{
+			Slot:           1,
+			BlockRoot:      make([]byte, 32),
+			ValidatorIndex: 1,
+			Signature:      make([]byte, 96),
+		}
+		genericResponse, err := anypb.New(migration.V1Alpha1SyncCommitteeMessageToV2(wantedMessageV1alpha1))
+		require.NoError(t, err)
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{SyncCommitteeTopic},
+			stream:        mockStream,
+			shouldReceive: &gateway.EventSource{Event: SyncCommitteeTopic, Data: genericResponse},
+			itemToSend: &feed.Event{
+				Type: operation.SyncCommitteeMessageReceived,
+				Data: &operation.SyncCommitteeMessageReceivedData{
+					Message: wantedMessageV1alpha1,
+				},
+			},
+			feed: srv.OperationNotifier.OperationFeed(),
+		})
+	})
+}
+
+func TestStreamEvents_ResumeFromLastEventID(t *testing.T) {
+	ctx := context.Background()
+	srv, ctrl, mockStream := setupServer(ctx, t)
+	defer ctrl.Finish()
+	srv.ReplayBufferSize = 2
+
+	// Start collecting events, then publish three events of which the subscriber received the first one.
+	sub := srv.subscribe(make(chan *streamEvent, 3))
+	defer sub.Unsubscribe()
+	heads := make([]*ethpb.EventHead, 3)
+	for i := range heads {
+		heads[i] = &ethpb.EventHead{Slot: types.Slot(i), Block: make([]byte, 32), State: make([]byte, 32)}
+		ev := &streamEvent{topic: HeadTopic, data: heads[i]}
+		srv.buffer.add(ev)
+		srv.eventFeed.Send(ev)
+	}
+
+	exitRoutine := make(chan bool)
+	defer close(exitRoutine)
+	var sent []*gateway.EventSource
+	mockStream.EXPECT().Send(gomock.Any()).Do(func(arg0 interface{}) {
+		sent = append(sent, arg0.(*gateway.EventSource))
+		exitRoutine <- true
+	}).Times(2)
+	mockStream.EXPECT().Context().Return(ctx).AnyTimes()
+
+	req := &ethpb.StreamEventsRequest{Topics: []string{HeadTopic}, LastEventId: "1", WithEventIds: true}
+	go func() {
+		assert.NoError(t, srv.StreamEvents(req, mockStream), "Could not call RPC method")
+	}()
+	<-exitRoutine
+	<-exitRoutine
+	require.Equal(t, 2, len(sent))
+	for i, msg := range sent {
+		assert.Equal(t, HeadTopic, msg.Event)
+		withID := &ethpb.EventWithId{}
+		require.NoError(t, msg.Data.UnmarshalTo(withID))
+		assert.Equal(t, fmt.Sprintf("%d", i+2), withID.Id)
+		head := &ethpb.EventHead{}
+		require.NoError(t, withID.Data.UnmarshalTo(head))
+		assert.Equal(t, types.Slot(i+1), head.Slot)
+	}
+}
+
+func TestStreamEvents_InvalidLastEventID(t *testing.T) {
+	srv := &Server{}
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStream := mock.NewMockEvents_StreamEventsServer(ctrl)
+	err := srv.StreamEvents(&ethpb.StreamEventsRequest{Topics: []string{HeadTopic}, LastEventId: "foo"}, mockStream)
+	require.ErrorContains(t, "Invalid last event ID", err)
+	err = srv.StreamEvents(&ethpb.StreamEventsRequest{Topics: []string{BlockFullTopic}, BlockEncoding: "xml"}, mockStream)
+	require.ErrorContains(t, "Block encoding xml not supported", err)
+}
+
+func TestEventBuffer(t *testing.T) {
+	b := newEventBuffer(2)
+	for i := 0; i < 3; i++ {
+		b.add(&streamEvent{topic: HeadTopic})
+	}
+	events, latest := b.since(0)
+	assert.Equal(t, uint64(3), latest)
+	require.Equal(t, 2, len(events))
+	assert.Equal(t, uint64(2), events[0].id)
+	assert.Equal(t, uint64(3), events[1].id)
+	events, _ = b.since(3)
+	assert.Equal(t, 0, len(events))
+
+	b = newEventBuffer(0)
+	b.add(&streamEvent{topic: HeadTopic})
+	events, latest = b.since(0)
+	assert.Equal(t, uint64(1), latest)
+	assert.Equal(t, 0, len(events))
 }
 
 func TestStreamEvents_StateEvents(t *testing.T) {
@@ -302,6 +597,7 @@ func setupServer(ctx context.Context, t testing.TB) (*Server, *gomock.Controller
 type assertFeedArgs struct {
 	t             *testing.T
 	topics        []string
+	encoding      string
 	srv           *Server
 	stream        *mock.MockEvents_StreamEventsServer
 	shouldReceive interface{}
@@ -317,7 +613,7 @@ func assertFeedSendAndReceive(ctx context.Context, args *assertFeedArgs) {
 	})
 	args.stream.EXPECT().Context().Return(ctx).AnyTimes()
 
-	req := &ethpb.StreamEventsRequest{Topics: args.topics}
+	req := &ethpb.StreamEventsRequest{Topics: args.topics, BlockEncoding: args.encoding}
 	go func(tt *testing.T) {
 		assert.NoError(tt, args.srv.StreamEvents(req, args.stream), "Could not call RPC method")
 	}(args.t)
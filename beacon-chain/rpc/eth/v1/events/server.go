@@ -5,10 +5,12 @@ package events
 
 import (
 	"context"
+	"sync"
 
 	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
 	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/shared/event"
 )
 
 // Server defines a server implementation of the gRPC events service,
@@ -18,4 +20,10 @@ type Server struct {
 	StateNotifier     statefeed.Notifier
 	BlockNotifier     blockfeed.Notifier
 	OperationNotifier opfeed.Notifier
+	// ReplayBufferSize is the number of recent events kept for subscribers resuming a stream.
+	ReplayBufferSize int
+
+	lock      sync.Mutex
+	eventFeed *event.Feed
+	buffer    *eventBuffer
 }
@@ -0,0 +1,314 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	emptypb "github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	statev1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	v2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetSyncCommitteeDuties requests the beacon node to provide a set of sync committee duties
+// for a particular epoch. Only validators which are members of the sync committee in charge
+// during the epoch are given a duty.
+func (vs *Server) GetSyncCommitteeDuties(ctx context.Context, req *v2.SyncCommitteeDutiesRequest) (*v2.SyncCommitteeDutiesResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "validatorv1.GetSyncCommitteeDuties")
+	defer span.End()
+
+	if vs.SyncChecker.Syncing() {
+		return nil, status.Error(codes.Unavailable, "Syncing to latest head, not ready to respond")
+	}
+
+	currentEpoch := helpers.SlotToEpoch(vs.TimeFetcher.CurrentSlot())
+	if helpers.SyncCommitteePeriod(req.Epoch) > helpers.SyncCommitteePeriod(currentEpoch)+1 {
+		return nil, status.Errorf(codes.InvalidArgument, "Request epoch %d can not be later than the next sync committee period", req.Epoch)
+	}
+
+	s, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	committee, err := helpers.SyncCommitteeForEpoch(s, req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not get sync committee: %v", err)
+	}
+
+	duties := make([]*v2.SyncCommitteeDuty, 0)
+	for _, index := range req.Index {
+		val, err := s.ValidatorAtIndexReadOnly(index)
+		if _, ok := err.(*statev1.ValidatorIndexOutOfRangeError); ok {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid index: %v", err)
+		} else if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get validator: %v", err)
+		}
+		pubkey := val.PublicKey()
+		committeeIndices := helpers.SyncCommitteeIndices(committee, pubkey[:])
+		if len(committeeIndices) == 0 {
+			continue
+		}
+		duties = append(duties, &v2.SyncCommitteeDuty{
+			Pubkey:                        pubkey[:],
+			ValidatorIndex:                index,
+			ValidatorSyncCommitteeIndices: committeeIndices,
+		})
+	}
+
+	return &v2.SyncCommitteeDutiesResponse{Data: duties}, nil
+}
+
+// ProduceSyncCommitteeContribution requests that the beacon node produces a sync committee contribution
+// by aggregating the sync committee signatures of the subcommittee's members for the requested block root.
+func (vs *Server) ProduceSyncCommitteeContribution(
+	ctx context.Context,
+	req *v2.ProduceSyncCommitteeContributionRequest,
+) (*v2.ProduceSyncCommitteeContributionResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "validatorv1.ProduceSyncCommitteeContribution")
+	defer span.End()
+
+	if req.SubcommitteeIndex >= params.BeaconConfig().SyncCommitteeSubnetCount {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid subcommittee index %d", req.SubcommitteeIndex)
+	}
+	if len(req.BeaconBlockRoot) != 32 {
+		return nil, status.Error(codes.InvalidArgument, "Invalid block root length")
+	}
+
+	s, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	committee, err := helpers.SyncCommitteeForEpoch(s, helpers.SlotToEpoch(req.Slot))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not get sync committee: %v", err)
+	}
+	msgs, err := vs.SyncCommitteePool.SyncCommitteeMessages(req.Slot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get sync committee signatures: %v", err)
+	}
+
+	subcommitteeSize := helpers.SyncSubcommitteeSize()
+	start := req.SubcommitteeIndex * subcommitteeSize
+	bits := prysmv2.NewSyncCommitteeAggregationBits()
+	sigs := make([]bls.Signature, 0)
+	seen := make(map[types.ValidatorIndex]bool)
+	for _, msg := range msgs {
+		if seen[msg.ValidatorIndex] || !bytes.Equal(msg.BlockRoot, req.BeaconBlockRoot) {
+			continue
+		}
+		val, err := s.ValidatorAtIndexReadOnly(msg.ValidatorIndex)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get validator: %v", err)
+		}
+		pubkey := val.PublicKey()
+		var inSubcommittee bool
+		for _, idx := range helpers.SyncCommitteeIndices(committee, pubkey[:]) {
+			if idx >= start && idx < start+subcommitteeSize {
+				bits.SetBitAt(idx-start, true)
+				inSubcommittee = true
+			}
+		}
+		if !inSubcommittee {
+			continue
+		}
+		sig, err := bls.SignatureFromBytes(msg.Signature)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not decode sync committee signature: %v", err)
+		}
+		sigs = append(sigs, sig)
+		seen[msg.ValidatorIndex] = true
+	}
+	if len(sigs) == 0 {
+		return nil, status.Errorf(codes.NotFound, "No sync committee signatures found for slot %d, subcommittee %d and block root %#x", req.Slot, req.SubcommitteeIndex, req.BeaconBlockRoot)
+	}
+
+	contribution := &prysmv2.SyncCommitteeContribution{
+		Slot:              req.Slot,
+		BlockRoot:         req.BeaconBlockRoot,
+		SubcommitteeIndex: req.SubcommitteeIndex,
+		AggregationBits:   bits,
+		Signature:         bls.AggregateSignatures(sigs).Marshal(),
+	}
+	return &v2.ProduceSyncCommitteeContributionResponse{
+		Data: migration.V1Alpha1SyncCommitteeContributionToV2(contribution),
+	}, nil
+}
+
+// SubmitContributionAndProofs verifies given sync committee contribution and proofs, saves the
+// contributions to the pool and publishes them on the contribution and proof gossipsub topic.
+func (vs *Server) SubmitContributionAndProofs(ctx context.Context, req *v2.SubmitContributionAndProofsRequest) (*emptypb.Empty, error) {
+	ctx, span := trace.StartSpan(ctx, "validatorv1.SubmitContributionAndProofs")
+	defer span.End()
+
+	if len(req.Data) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "No contribution and proofs provided")
+	}
+
+	s, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	for _, c := range req.Data {
+		if c == nil || c.Message == nil || c.Message.Contribution == nil {
+			return nil, status.Error(codes.InvalidArgument, "Signed contribution and proof can't be nil")
+		}
+		if err := verifyContributionAndProof(s, c); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid contribution and proof: %v", err)
+		}
+	}
+
+	broadcastFailed := false
+	for _, c := range req.Data {
+		v1alpha1Contribution := migration.V2SignedContributionAndProofToV1Alpha1(c)
+		if err := vs.SyncCommitteePool.SaveSyncCommitteeContribution(v1alpha1Contribution.Message.Contribution); err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not save sync committee contribution: %v", err)
+		}
+		vs.OperationNotifier.OperationFeed().Send(&feed.Event{
+			Type: operation.SyncCommitteeContributionReceived,
+			Data: &operation.SyncCommitteeContributionReceivedData{
+				Contribution: v1alpha1Contribution,
+			},
+		})
+		if err := vs.Broadcaster.Broadcast(ctx, v1alpha1Contribution); err != nil {
+			broadcastFailed = true
+		}
+	}
+	if broadcastFailed {
+		return nil, status.Error(
+			codes.Internal,
+			"Could not broadcast one or more signed contribution and proofs")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// SubmitSyncCommitteeSubscription subscribes the beacon node to the sync committee subnets of the given
+// validators. The node joins the subnets one epoch before the sync committee period of the validators
+// starts and leaves them at the requested epoch.
+func (vs *Server) SubmitSyncCommitteeSubscription(ctx context.Context, req *v2.SubmitSyncCommitteeSubscriptionsRequest) (*emptypb.Empty, error) {
+	ctx, span := trace.StartSpan(ctx, "validatorv1.SubmitSyncCommitteeSubscription")
+	defer span.End()
+
+	if vs.SyncChecker.Syncing() {
+		return nil, status.Error(codes.Unavailable, "Syncing to latest head, not ready to respond")
+	}
+
+	if len(req.Data) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "No subscriptions provided")
+	}
+
+	s, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	currentEpoch := helpers.SlotToEpoch(vs.TimeFetcher.CurrentSlot())
+	currentPeriod := helpers.SyncCommitteePeriod(currentEpoch)
+
+	// Verify subscriptions at the beginning to return early if request is invalid.
+	pubkeys := make([][48]byte, len(req.Data))
+	for i, sub := range req.Data {
+		if sub == nil {
+			return nil, status.Error(codes.InvalidArgument, "Subscription can't be nil")
+		}
+		val, err := s.ValidatorAtIndexReadOnly(sub.ValidatorIndex)
+		if _, ok := err.(*statev1.ValidatorIndexOutOfRangeError); ok {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid validator index: %v", err)
+		} else if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get validator: %v", err)
+		}
+		pubkeys[i] = val.PublicKey()
+		if sub.UntilEpoch <= currentEpoch {
+			return nil, status.Errorf(codes.InvalidArgument, "Epoch %d for validator %d is not in the future", sub.UntilEpoch, sub.ValidatorIndex)
+		}
+		if helpers.SyncCommitteePeriod(sub.UntilEpoch-1) > currentPeriod+1 {
+			return nil, status.Errorf(codes.InvalidArgument, "Epoch %d for validator %d is later than the next sync committee period", sub.UntilEpoch, sub.ValidatorIndex)
+		}
+		for _, idx := range sub.SyncCommitteeIndices {
+			if idx >= params.BeaconConfig().SyncCommitteeSize {
+				return nil, status.Errorf(codes.InvalidArgument, "Invalid sync committee index %d for validator %d", idx, sub.ValidatorIndex)
+			}
+		}
+	}
+
+	for i, sub := range req.Data {
+		joinEpoch := currentEpoch
+		if helpers.SyncCommitteePeriod(sub.UntilEpoch-1) > currentPeriod {
+			// Join the subnets of the next sync committee one epoch before its period starts.
+			nextPeriodStart := helpers.SyncCommitteePeriodStartEpoch(sub.UntilEpoch - 1)
+			if nextPeriodStart-1 > joinEpoch {
+				joinEpoch = nextPeriodStart - 1
+			}
+		}
+		untilSlot, err := helpers.StartSlot(sub.UntilEpoch)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid epoch: %v", err)
+		}
+		untilTime := vs.TimeFetcher.GenesisTime().Add(time.Duration(uint64(untilSlot)*params.BeaconConfig().SecondsPerSlot) * time.Second)
+		subnets := helpers.SyncSubnetsFromCommitteeIndices(sub.SyncCommitteeIndices)
+		cache.SyncSubnetIDs.AddSyncCommitteeSubnets(pubkeys[i][:], joinEpoch, subnets, untilTime.Sub(timeutils.Now()))
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// verifyContributionAndProof checks that the aggregator is a member of the contribution's subcommittee
+// which is selected to aggregate, and that both the selection proof and the contribution and proof
+// are correctly signed by the aggregator.
+func verifyContributionAndProof(s iface.BeaconState, c *v2.SignedContributionAndProof) error {
+	contribution := c.Message.Contribution
+	if contribution.SubcommitteeIndex >= params.BeaconConfig().SyncCommitteeSubnetCount {
+		return errors.Errorf("invalid subcommittee index %d", contribution.SubcommitteeIndex)
+	}
+	epoch := helpers.SlotToEpoch(contribution.Slot)
+	committee, err := helpers.SyncCommitteeForEpoch(s, epoch)
+	if err != nil {
+		return err
+	}
+	val, err := s.ValidatorAtIndexReadOnly(c.Message.AggregatorIndex)
+	if err != nil {
+		return err
+	}
+	pubkey := val.PublicKey()
+	var inSubcommittee bool
+	for _, subnet := range helpers.SyncSubnetsFromCommitteeIndices(helpers.SyncCommitteeIndices(committee, pubkey[:])) {
+		if subnet == contribution.SubcommitteeIndex {
+			inSubcommittee = true
+			break
+		}
+	}
+	if !inSubcommittee {
+		return errors.Errorf("aggregator %d is not a member of subcommittee %d", c.Message.AggregatorIndex, contribution.SubcommitteeIndex)
+	}
+	if !helpers.IsSyncCommitteeAggregator(c.Message.SelectionProof) {
+		return errors.Errorf("validator %d is not selected to aggregate", c.Message.AggregatorIndex)
+	}
+	selectionData := &pbp2p.SyncAggregatorSelectionData{
+		Slot:              contribution.Slot,
+		SubcommitteeIndex: contribution.SubcommitteeIndex,
+	}
+	if err := helpers.ComputeDomainVerifySigningRoot(s, c.Message.AggregatorIndex, epoch, selectionData,
+		params.BeaconConfig().DomainSyncCommitteeSelectionProof, c.Message.SelectionProof); err != nil {
+		return errors.Wrap(err, "invalid selection proof")
+	}
+	if err := helpers.ComputeDomainVerifySigningRoot(s, c.Message.AggregatorIndex, epoch, c.Message,
+		params.BeaconConfig().DomainContributionAndProof, c.Signature); err != nil {
+		return errors.Wrap(err, "invalid contribution and proof signature")
+	}
+	return nil
+}
@@ -0,0 +1,269 @@
+package validator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	fssz "github.com/ferranbt/fastssz"
+	types "github.com/prysmaticlabs/eth2-types"
+	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
+	mockp2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	v2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func setupSyncCommitteeState(t *testing.T) (*testutil.SyncCommitteeState, []bls.SecretKey) {
+	params.SetupTestConfigCleanup(t)
+	c := params.BeaconConfig()
+	c.AltairForkEpoch = 0
+	params.OverrideBeaconConfig(c)
+
+	beaconState, keys := testutil.DeterministicGenesisState(t, 200)
+	st, err := testutil.DeterministicSyncCommitteeState(beaconState)
+	require.NoError(t, err)
+	return st, keys
+}
+
+func TestGetSyncCommitteeDuties(t *testing.T) {
+	ctx := context.Background()
+	st, _ := setupSyncCommitteeState(t)
+	chain := &mockChain.ChainService{State: st, Genesis: time.Now()}
+	vs := &Server{
+		HeadFetcher: chain,
+		TimeFetcher: chain,
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+	}
+	period := params.BeaconConfig().EpochsPerSyncCommitteePeriod
+
+	t.Run("Current period", func(t *testing.T) {
+		resp, err := vs.GetSyncCommitteeDuties(ctx, &v2.SyncCommitteeDutiesRequest{Epoch: 0, Index: []types.ValidatorIndex{3, 1}})
+		require.NoError(t, err)
+		require.Equal(t, 2, len(resp.Data))
+		pubkey := st.PubkeyAtIndex(3)
+		assert.DeepEqual(t, pubkey[:], resp.Data[0].Pubkey)
+		assert.Equal(t, types.ValidatorIndex(3), resp.Data[0].ValidatorIndex)
+		assert.DeepEqual(t, []uint64{3, 203, 403}, resp.Data[0].ValidatorSyncCommitteeIndices)
+		assert.Equal(t, types.ValidatorIndex(1), resp.Data[1].ValidatorIndex)
+		assert.DeepEqual(t, []uint64{1, 201, 401}, resp.Data[1].ValidatorSyncCommitteeIndices)
+	})
+	t.Run("Next period", func(t *testing.T) {
+		resp, err := vs.GetSyncCommitteeDuties(ctx, &v2.SyncCommitteeDutiesRequest{Epoch: period, Index: []types.ValidatorIndex{3}})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(resp.Data))
+		assert.DeepEqual(t, []uint64{2, 202, 402}, resp.Data[0].ValidatorSyncCommitteeIndices)
+	})
+	t.Run("Epoch too far in the future", func(t *testing.T) {
+		_, err := vs.GetSyncCommitteeDuties(ctx, &v2.SyncCommitteeDutiesRequest{Epoch: period * 2, Index: []types.ValidatorIndex{3}})
+		assert.ErrorContains(t, "can not be later than the next sync committee period", err)
+	})
+	t.Run("Invalid index", func(t *testing.T) {
+		_, err := vs.GetSyncCommitteeDuties(ctx, &v2.SyncCommitteeDutiesRequest{Epoch: 0, Index: []types.ValidatorIndex{200}})
+		assert.ErrorContains(t, "Invalid index", err)
+	})
+	t.Run("Syncing", func(t *testing.T) {
+		vs := &Server{SyncChecker: &mockSync.Sync{IsSyncing: true}}
+		_, err := vs.GetSyncCommitteeDuties(ctx, &v2.SyncCommitteeDutiesRequest{})
+		assert.ErrorContains(t, "Syncing to latest head, not ready to respond", err)
+	})
+}
+
+func TestProduceSyncCommitteeContribution(t *testing.T) {
+	ctx := context.Background()
+	st, keys := setupSyncCommitteeState(t)
+	root := bytesutil.PadTo([]byte("root"), 32)
+	otherRoot := bytesutil.PadTo([]byte("other"), 32)
+	pool := synccommittee.NewPool()
+	for _, idx := range []types.ValidatorIndex{3, 5} {
+		require.NoError(t, pool.SaveSyncCommitteeMessage(&prysmv2.SyncCommitteeMessage{
+			Slot: 1, BlockRoot: root, ValidatorIndex: idx, Signature: keys[idx].Sign(root).Marshal(),
+		}))
+	}
+	require.NoError(t, pool.SaveSyncCommitteeMessage(&prysmv2.SyncCommitteeMessage{
+		Slot: 1, BlockRoot: otherRoot, ValidatorIndex: 7, Signature: keys[7].Sign(otherRoot).Marshal(),
+	}))
+	vs := &Server{
+		HeadFetcher:       &mockChain.ChainService{State: st},
+		SyncCommitteePool: pool,
+	}
+	aggregate := bls.AggregateSignatures([]bls.Signature{keys[3].Sign(root), keys[5].Sign(root)}).Marshal()
+
+	t.Run("First subcommittee", func(t *testing.T) {
+		resp, err := vs.ProduceSyncCommitteeContribution(ctx, &v2.ProduceSyncCommitteeContributionRequest{
+			Slot: 1, SubcommitteeIndex: 0, BeaconBlockRoot: root,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, types.Slot(1), resp.Data.Slot)
+		assert.DeepEqual(t, root, resp.Data.BeaconBlockRoot)
+		assert.DeepEqual(t, []int{3, 5}, resp.Data.AggregationBits.BitIndices())
+		assert.DeepEqual(t, aggregate, resp.Data.Signature)
+	})
+	t.Run("Second subcommittee", func(t *testing.T) {
+		resp, err := vs.ProduceSyncCommitteeContribution(ctx, &v2.ProduceSyncCommitteeContributionRequest{
+			Slot: 1, SubcommitteeIndex: 1, BeaconBlockRoot: root,
+		})
+		require.NoError(t, err)
+		// Positions 203 and 205 of the sync committee.
+		assert.DeepEqual(t, []int{75, 77}, resp.Data.AggregationBits.BitIndices())
+		assert.DeepEqual(t, aggregate, resp.Data.Signature)
+	})
+	t.Run("No signatures", func(t *testing.T) {
+		_, err := vs.ProduceSyncCommitteeContribution(ctx, &v2.ProduceSyncCommitteeContributionRequest{
+			Slot: 2, SubcommitteeIndex: 0, BeaconBlockRoot: root,
+		})
+		assert.ErrorContains(t, "No sync committee signatures found", err)
+	})
+	t.Run("Invalid subcommittee", func(t *testing.T) {
+		_, err := vs.ProduceSyncCommitteeContribution(ctx, &v2.ProduceSyncCommitteeContributionRequest{
+			Slot: 1, SubcommitteeIndex: params.BeaconConfig().SyncCommitteeSubnetCount, BeaconBlockRoot: root,
+		})
+		assert.ErrorContains(t, "Invalid subcommittee index", err)
+	})
+}
+
+func TestSubmitContributionAndProofs(t *testing.T) {
+	ctx := context.Background()
+	st, keys := setupSyncCommitteeState(t)
+	signingRoot := func(obj fssz.HashRoot, domainType [4]byte) []byte {
+		domain, err := helpers.Domain(st.Fork(), 0, domainType, st.GenesisValidatorRoot())
+		require.NoError(t, err)
+		r, err := helpers.ComputeSigningRoot(obj, domain)
+		require.NoError(t, err)
+		return r[:]
+	}
+
+	// Find a member of the first subcommittee which is selected to aggregate.
+	selectionData := &pbp2p.SyncAggregatorSelectionData{Slot: 1, SubcommitteeIndex: 0}
+	var aggregator types.ValidatorIndex
+	var proof []byte
+	for i := types.ValidatorIndex(0); i < types.ValidatorIndex(helpers.SyncSubcommitteeSize()); i++ {
+		sig := keys[i].Sign(signingRoot(selectionData, params.BeaconConfig().DomainSyncCommitteeSelectionProof)).Marshal()
+		if helpers.IsSyncCommitteeAggregator(sig) {
+			aggregator, proof = i, sig
+			break
+		}
+	}
+	require.NotNil(t, proof, "No aggregator found")
+
+	root := bytesutil.PadTo([]byte("root"), 32)
+	msg := &v2.ContributionAndProof{
+		AggregatorIndex: aggregator,
+		Contribution: &v2.SyncCommitteeContribution{
+			Slot:              1,
+			BeaconBlockRoot:   root,
+			SubcommitteeIndex: 0,
+			AggregationBits:   prysmv2.NewSyncCommitteeAggregationBits(),
+			Signature:         keys[aggregator].Sign(root).Marshal(),
+		},
+		SelectionProof: proof,
+	}
+	signed := &v2.SignedContributionAndProof{
+		Message:   msg,
+		Signature: keys[aggregator].Sign(signingRoot(msg, params.BeaconConfig().DomainContributionAndProof)).Marshal(),
+	}
+
+	t.Run("Valid contribution and proof", func(t *testing.T) {
+		broadcaster := &mockp2p.MockBroadcaster{}
+		pool := synccommittee.NewPool()
+		vs := &Server{
+			HeadFetcher:       &mockChain.ChainService{State: st},
+			SyncCommitteePool: pool,
+			Broadcaster:       broadcaster,
+			OperationNotifier: &mockChain.MockOperationNotifier{},
+		}
+		_, err := vs.SubmitContributionAndProofs(ctx, &v2.SubmitContributionAndProofsRequest{
+			Data: []*v2.SignedContributionAndProof{signed},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, true, broadcaster.BroadcastCalled)
+		contributions, err := pool.SyncCommitteeContributions(1)
+		require.NoError(t, err)
+		assert.Equal(t, 1, len(contributions))
+	})
+	t.Run("Invalid signature", func(t *testing.T) {
+		broadcaster := &mockp2p.MockBroadcaster{}
+		vs := &Server{
+			HeadFetcher:       &mockChain.ChainService{State: st},
+			SyncCommitteePool: synccommittee.NewPool(),
+			Broadcaster:       broadcaster,
+		}
+		invalid := &v2.SignedContributionAndProof{Message: msg, Signature: proof}
+		_, err := vs.SubmitContributionAndProofs(ctx, &v2.SubmitContributionAndProofsRequest{
+			Data: []*v2.SignedContributionAndProof{invalid},
+		})
+		assert.ErrorContains(t, "invalid contribution and proof signature", err)
+		assert.Equal(t, false, broadcaster.BroadcastCalled)
+	})
+	t.Run("Empty request", func(t *testing.T) {
+		vs := &Server{}
+		_, err := vs.SubmitContributionAndProofs(ctx, &v2.SubmitContributionAndProofsRequest{})
+		assert.ErrorContains(t, "No contribution and proofs provided", err)
+	})
+}
+
+func TestSubmitSyncCommitteeSubscription(t *testing.T) {
+	ctx := context.Background()
+	st, _ := setupSyncCommitteeState(t)
+	chainSlot := types.Slot(0)
+	chain := &mockChain.ChainService{State: st, Genesis: time.Now(), Slot: &chainSlot}
+	vs := &Server{
+		HeadFetcher: chain,
+		TimeFetcher: chain,
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+	}
+	period := params.BeaconConfig().EpochsPerSyncCommitteePeriod
+
+	t.Run("Current period", func(t *testing.T) {
+		cache.SyncSubnetIDs.EmptyAllCaches()
+		_, err := vs.SubmitSyncCommitteeSubscription(ctx, &v2.SubmitSyncCommitteeSubscriptionsRequest{
+			Data: []*v2.SyncCommitteeSubscription{{ValidatorIndex: 3, SyncCommitteeIndices: []uint64{3, 203}, UntilEpoch: period}},
+		})
+		require.NoError(t, err)
+		pubkey := st.PubkeyAtIndex(3)
+		assert.DeepEqual(t, []uint64{0, 1}, cache.SyncSubnetIDs.GetSyncCommitteeSubnets(pubkey[:], 0))
+	})
+	t.Run("Next period", func(t *testing.T) {
+		cache.SyncSubnetIDs.EmptyAllCaches()
+		_, err := vs.SubmitSyncCommitteeSubscription(ctx, &v2.SubmitSyncCommitteeSubscriptionsRequest{
+			Data: []*v2.SyncCommitteeSubscription{{ValidatorIndex: 4, SyncCommitteeIndices: []uint64{130}, UntilEpoch: period * 2}},
+		})
+		require.NoError(t, err)
+		pubkey := st.PubkeyAtIndex(4)
+		assert.Equal(t, 0, len(cache.SyncSubnetIDs.GetSyncCommitteeSubnets(pubkey[:], period-2)))
+		assert.DeepEqual(t, []uint64{1}, cache.SyncSubnetIDs.GetSyncCommitteeSubnets(pubkey[:], period-1))
+	})
+	t.Run("Epoch not in the future", func(t *testing.T) {
+		_, err := vs.SubmitSyncCommitteeSubscription(ctx, &v2.SubmitSyncCommitteeSubscriptionsRequest{
+			Data: []*v2.SyncCommitteeSubscription{{ValidatorIndex: 3, UntilEpoch: 0}},
+		})
+		assert.ErrorContains(t, "is not in the future", err)
+	})
+	t.Run("Epoch too far in the future", func(t *testing.T) {
+		_, err := vs.SubmitSyncCommitteeSubscription(ctx, &v2.SubmitSyncCommitteeSubscriptionsRequest{
+			Data: []*v2.SyncCommitteeSubscription{{ValidatorIndex: 3, UntilEpoch: period*2 + 1}},
+		})
+		assert.ErrorContains(t, "is later than the next sync committee period", err)
+	})
+	t.Run("Invalid sync committee index", func(t *testing.T) {
+		_, err := vs.SubmitSyncCommitteeSubscription(ctx, &v2.SubmitSyncCommitteeSubscriptionsRequest{
+			Data: []*v2.SyncCommitteeSubscription{{ValidatorIndex: 3, SyncCommitteeIndices: []uint64{params.BeaconConfig().SyncCommitteeSize}, UntilEpoch: 1}},
+		})
+		assert.ErrorContains(t, "Invalid sync committee index", err)
+	})
+	t.Run("Syncing", func(t *testing.T) {
+		vs := &Server{SyncChecker: &mockSync.Sync{IsSyncing: true}}
+		_, err := vs.SubmitSyncCommitteeSubscription(ctx, &v2.SubmitSyncCommitteeSubscriptionsRequest{})
+		assert.ErrorContains(t, "Syncing to latest head, not ready to respond", err)
+	})
+}
@@ -1,17 +1,23 @@
 package validator
 
 import (
+	"bytes"
 	"context"
 	"sort"
 
 	emptypb "github.com/golang/protobuf/ptypes/empty"
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	statev1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
 	v1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	v1alpha1 "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	v2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"go.opencensus.io/trace"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -157,29 +163,238 @@ func (vs *Server) GetProposerDuties(ctx context.Context, req *v1.ProposerDutiesR
 
 // ProduceBlock requests the beacon node to produce a valid unsigned beacon block, which can then be signed by a proposer and submitted.
 func (vs *Server) ProduceBlock(ctx context.Context, req *v1.ProduceBlockRequest) (*v1.ProduceBlockResponse, error) {
-	return nil, errors.New("Unimplemented")
+	ctx, span := trace.StartSpan(ctx, "validatorv1.ProduceBlock")
+	defer span.End()
+
+	v1alpha1req := &v1alpha1.BlockRequest{
+		Slot:         req.Slot,
+		RandaoReveal: req.RandaoReveal,
+		Graffiti:     req.Graffiti,
+	}
+	v1alpha1resp, err := vs.V1Alpha1Server.GetBlock(ctx, v1alpha1req)
+	if err != nil {
+		// We simply return err because it's already of a gRPC error type.
+		return nil, err
+	}
+	block, err := migration.V1Alpha1ToV1Block(&v1alpha1.SignedBeaconBlock{Block: v1alpha1resp})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not prepare beacon block: %v", err)
+	}
+	return &v1.ProduceBlockResponse{Data: block.Block}, nil
+}
+
+// ProduceBlockV2 requests the beacon node to produce a valid unsigned beacon block of the fork
+// active at the requested slot, which can then be signed by a proposer and submitted.
+func (vs *Server) ProduceBlockV2(ctx context.Context, req *v1.ProduceBlockRequest) (*v2.ProduceBlockResponseV2, error) {
+	ctx, span := trace.StartSpan(ctx, "validatorv2.ProduceBlockV2")
+	defer span.End()
+
+	v1alpha1req := &v1alpha1.BlockRequest{
+		Slot:         req.Slot,
+		RandaoReveal: req.RandaoReveal,
+		Graffiti:     req.Graffiti,
+	}
+
+	if helpers.SlotToEpoch(req.Slot) < params.BeaconConfig().AltairForkEpoch {
+		v1alpha1resp, err := vs.V1Alpha1Server.GetBlock(ctx, v1alpha1req)
+		if err != nil {
+			// We simply return err because it's already of a gRPC error type.
+			return nil, err
+		}
+		block, err := migration.V1Alpha1ToV1Block(&v1alpha1.SignedBeaconBlock{Block: v1alpha1resp})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not prepare beacon block: %v", err)
+		}
+		return &v2.ProduceBlockResponseV2{
+			Version: v2.Version_PHASE0,
+			Data: &v2.BeaconBlockContainerV2{
+				Block: &v2.BeaconBlockContainerV2_Phase0Block{Phase0Block: block.Block},
+			},
+		}, nil
+	}
+
+	v1alpha1resp, err := vs.V1Alpha1Server.GetBlockAltair(ctx, v1alpha1req)
+	if err != nil {
+		// We simply return err because it's already of a gRPC error type.
+		return nil, err
+	}
+	block, err := migration.V1Alpha1BeaconBlockAltairToV2(v1alpha1resp)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not prepare beacon block: %v", err)
+	}
+	return &v2.ProduceBlockResponseV2{
+		Version: v2.Version_ALTAIR,
+		Data: &v2.BeaconBlockContainerV2{
+			Block: &v2.BeaconBlockContainerV2_AltairBlock{AltairBlock: block},
+		},
+	}, nil
 }
 
 // ProduceAttestationData requests that the beacon node produces attestation data for
 // the requested committee index and slot based on the nodes current head.
 func (vs *Server) ProduceAttestationData(ctx context.Context, req *v1.ProduceAttestationDataRequest) (*v1.ProduceAttestationDataResponse, error) {
-	return nil, errors.New("Unimplemented")
+	ctx, span := trace.StartSpan(ctx, "validatorv1.ProduceAttestationData")
+	defer span.End()
+
+	v1alpha1req := &v1alpha1.AttestationDataRequest{
+		Slot:           req.Slot,
+		CommitteeIndex: req.CommitteeIndex,
+	}
+	v1alpha1resp, err := vs.V1Alpha1Server.GetAttestationData(ctx, v1alpha1req)
+	if err != nil {
+		// We simply return err because it's already of a gRPC error type.
+		return nil, err
+	}
+	return &v1.ProduceAttestationDataResponse{Data: migration.V1Alpha1AttDataToV1(v1alpha1resp)}, nil
 }
 
 // GetAggregateAttestation aggregates all attestations matching the given attestation data root and slot, returning the aggregated result.
 func (vs *Server) GetAggregateAttestation(ctx context.Context, req *v1.AggregateAttestationRequest) (*v1.AggregateAttestationResponse, error) {
-	return nil, errors.New("Unimplemented")
+	ctx, span := trace.StartSpan(ctx, "validatorv1.GetAggregateAttestation")
+	defer span.End()
+
+	if err := vs.AttestationsPool.AggregateUnaggregatedAttestations(ctx); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not aggregate unaggregated attestations: %v", err)
+	}
+
+	var bestMatchingAtt *v1alpha1.Attestation
+	for _, att := range vs.AttestationsPool.AggregatedAttestations() {
+		if att.Data == nil || att.Data.Slot != req.Slot {
+			continue
+		}
+		root, err := att.Data.HashTreeRoot()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get attestation data root: %v", err)
+		}
+		if !bytes.Equal(root[:], req.AttestationDataRoot) {
+			continue
+		}
+		if bestMatchingAtt == nil || att.AggregationBits.Count() > bestMatchingAtt.AggregationBits.Count() {
+			bestMatchingAtt = att
+		}
+	}
+	if bestMatchingAtt == nil {
+		return nil, status.Error(codes.NotFound, "No matching attestation found")
+	}
+	return &v1.AggregateAttestationResponse{Data: migration.V1Alpha1AttestationToV1(bestMatchingAtt)}, nil
 }
 
 // SubmitAggregateAndProofs verifies given aggregate and proofs and publishes them on appropriate gossipsub topic.
 func (vs *Server) SubmitAggregateAndProofs(ctx context.Context, req *v1.SubmitAggregateAndProofsRequest) (*emptypb.Empty, error) {
-	return nil, errors.New("Unimplemented")
+	ctx, span := trace.StartSpan(ctx, "validatorv1.SubmitAggregateAndProofs")
+	defer span.End()
+
+	if len(req.Data) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "No aggregate and proofs provided")
+	}
+
+	emptySig := make([]byte, params.BeaconConfig().BLSSignatureLength)
+	for _, agg := range req.Data {
+		if agg == nil || agg.Message == nil || agg.Message.Aggregate == nil || agg.Message.Aggregate.Data == nil {
+			return nil, status.Error(codes.InvalidArgument, "Signed aggregate request can't be nil")
+		}
+		if len(agg.Signature) != len(emptySig) || len(agg.Message.SelectionProof) != len(emptySig) {
+			return nil, status.Error(codes.InvalidArgument, "Incorrect signature length")
+		}
+		if bytes.Equal(agg.Signature, emptySig) || bytes.Equal(agg.Message.SelectionProof, emptySig) {
+			return nil, status.Error(codes.InvalidArgument, "Signed signatures can't be zero hashes")
+		}
+
+		// As a preventive measure, a beacon node shouldn't broadcast an attestation whose slot is out of range.
+		if err := helpers.ValidateAttestationTime(agg.Message.Aggregate.Data.Slot,
+			vs.TimeFetcher.GenesisTime(), params.BeaconNetworkConfig().MaximumGossipClockDisparity); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "Attestation slot is no longer valid from current time")
+		}
+	}
+
+	broadcastFailed := false
+	for _, agg := range req.Data {
+		v1alpha1Agg := &v1alpha1.SignedAggregateAttestationAndProof{
+			Message: &v1alpha1.AggregateAttestationAndProof{
+				AggregatorIndex: agg.Message.AggregatorIndex,
+				Aggregate:       migration.V1AttToV1Alpha1(agg.Message.Aggregate),
+				SelectionProof:  agg.Message.SelectionProof,
+			},
+			Signature: agg.Signature,
+		}
+		if err := vs.Broadcaster.Broadcast(ctx, v1alpha1Agg); err != nil {
+			broadcastFailed = true
+		}
+	}
+	if broadcastFailed {
+		return nil, status.Error(
+			codes.Internal,
+			"Could not broadcast one or more signed aggregated attestations")
+	}
+
+	return &emptypb.Empty{}, nil
 }
 
 // SubmitBeaconCommitteeSubscription searches using discv5 for peers related to the provided subnet information
 // and replaces current peers with those ones if necessary.
 func (vs *Server) SubmitBeaconCommitteeSubscription(ctx context.Context, req *v1.SubmitBeaconCommitteeSubscriptionsRequest) (*emptypb.Empty, error) {
-	return nil, errors.New("Unimplemented")
+	ctx, span := trace.StartSpan(ctx, "validatorv1.SubmitBeaconCommitteeSubscription")
+	defer span.End()
+
+	if vs.SyncChecker.Syncing() {
+		return nil, status.Error(codes.Unavailable, "Syncing to latest head, not ready to respond")
+	}
+
+	if len(req.Data) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "No subscriptions provided")
+	}
+
+	s, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+
+	// Verify validators at the beginning to return early if request is invalid.
+	for _, sub := range req.Data {
+		if sub == nil {
+			return nil, status.Error(codes.InvalidArgument, "Subscription can't be nil")
+		}
+		_, err := s.ValidatorAtIndexReadOnly(sub.ValidatorIndex)
+		if _, ok := err.(*statev1.ValidatorIndexOutOfRangeError); ok {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid validator index: %v", err)
+		} else if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get validator: %v", err)
+		}
+	}
+
+	fetchValsLen := func(slot types.Slot) (uint64, error) {
+		wantedEpoch := helpers.SlotToEpoch(slot)
+		vals, err := vs.HeadFetcher.HeadValidatorsIndices(ctx, wantedEpoch)
+		if err != nil {
+			return 0, err
+		}
+		return uint64(len(vals)), nil
+	}
+
+	// Request the head validator indices of epoch represented by the first requested slot.
+	currValsLen, err := fetchValsLen(req.Data[0].Slot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve head validator length: %v", err)
+	}
+	currEpoch := helpers.SlotToEpoch(req.Data[0].Slot)
+
+	for _, sub := range req.Data {
+		// If epoch has changed, re-request active validators length.
+		if currEpoch != helpers.SlotToEpoch(sub.Slot) {
+			currValsLen, err = fetchValsLen(sub.Slot)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not retrieve head validator length: %v", err)
+			}
+			currEpoch = helpers.SlotToEpoch(sub.Slot)
+		}
+		subnet := helpers.ComputeSubnetFromCommitteeAndSlot(currValsLen, sub.CommitteeIndex, sub.Slot)
+		cache.SubnetIDs.AddAttesterSubnetID(sub.Slot, subnet)
+		if sub.IsAggregator {
+			cache.SubnetIDs.AddAggregatorSubnetID(sub.Slot, subnet)
+		}
+	}
+
+	return &emptypb.Empty{}, nil
 }
 
 // attestationDependentRoot is get_block_root_at_slot(state, compute_start_slot_at_epoch(epoch - 1) - 1)
@@ -4,13 +4,30 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
 	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
+	mockp2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	mockPOW "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
+	v1alpha1validator "github.com/prysmaticlabs/prysm/beacon-chain/rpc/prysm/v1alpha1/validator"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
 	v1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbalpha "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/eth/v1alpha1/wrapper"
+	v2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
@@ -300,3 +317,536 @@ func TestGetProposerDuties_SyncNotReady(t *testing.T) {
 	_, err := vs.GetProposerDuties(context.Background(), &v1.ProposerDutiesRequest{})
 	assert.ErrorContains(t, "Syncing to latest head, not ready to respond", err)
 }
+
+func TestProduceBlock(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	ctx := context.Background()
+
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+
+	stateRoot, err := beaconState.HashTreeRoot(ctx)
+	require.NoError(t, err, "Could not hash genesis state")
+
+	genesis := blocks.NewGenesisBlock(stateRoot[:])
+	require.NoError(t, db.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(genesis)), "Could not save genesis block")
+
+	parentRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err, "Could not get signing root")
+	require.NoError(t, db.SaveState(ctx, beaconState, parentRoot), "Could not save genesis state")
+	require.NoError(t, db.SaveHeadBlockRoot(ctx, parentRoot), "Could not save genesis state")
+
+	v1Alpha1Server := &v1alpha1validator.Server{
+		HeadFetcher:       &mockChain.ChainService{State: beaconState, Root: parentRoot[:]},
+		SyncChecker:       &mockSync.Sync{IsSyncing: false},
+		BlockReceiver:     &mockChain.ChainService{},
+		ChainStartFetcher: &mockPOW.POWChain{},
+		Eth1InfoFetcher:   &mockPOW.POWChain{},
+		Eth1BlockFetcher:  &mockPOW.POWChain{},
+		MockEth1Votes:     true,
+		AttPool:           attestations.NewPool(),
+		SlashingsPool:     slashings.NewPool(),
+		ExitPool:          voluntaryexits.NewPool(),
+		StateGen:          stategen.New(db),
+	}
+	vs := &Server{V1Alpha1Server: v1Alpha1Server}
+
+	randaoReveal, err := testutil.RandaoReveal(beaconState, 0, privKeys)
+	require.NoError(t, err)
+	graffiti := bytesutil.ToBytes32([]byte("eth2"))
+	req := &v1.ProduceBlockRequest{
+		Slot:         1,
+		RandaoReveal: randaoReveal,
+		Graffiti:     graffiti[:],
+	}
+	resp, err := vs.ProduceBlock(ctx, req)
+	require.NoError(t, err)
+
+	assert.Equal(t, req.Slot, resp.Data.Slot, "Expected block to have slot of 1")
+	assert.DeepEqual(t, parentRoot[:], resp.Data.ParentRoot, "Expected block to have correct parent root")
+	assert.DeepEqual(t, randaoReveal, resp.Data.Body.RandaoReveal, "Expected block to have correct randao reveal")
+	assert.DeepEqual(t, req.Graffiti, resp.Data.Body.Graffiti, "Expected block to have correct graffiti")
+}
+
+func TestProduceBlockV2(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	ctx := context.Background()
+
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+
+	stateRoot, err := beaconState.HashTreeRoot(ctx)
+	require.NoError(t, err, "Could not hash genesis state")
+
+	genesis := blocks.NewGenesisBlock(stateRoot[:])
+	require.NoError(t, db.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(genesis)), "Could not save genesis block")
+
+	parentRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err, "Could not get signing root")
+	require.NoError(t, db.SaveState(ctx, beaconState, parentRoot), "Could not save genesis state")
+	require.NoError(t, db.SaveHeadBlockRoot(ctx, parentRoot), "Could not save genesis state")
+
+	v1Alpha1Server := &v1alpha1validator.Server{
+		SyncChecker:       &mockSync.Sync{IsSyncing: false},
+		BlockReceiver:     &mockChain.ChainService{},
+		ChainStartFetcher: &mockPOW.POWChain{},
+		Eth1InfoFetcher:   &mockPOW.POWChain{},
+		Eth1BlockFetcher:  &mockPOW.POWChain{},
+		MockEth1Votes:     true,
+		AttPool:           attestations.NewPool(),
+		SlashingsPool:     slashings.NewPool(),
+		ExitPool:          voluntaryexits.NewPool(),
+		SyncCommitteePool: synccommittee.NewPool(),
+		StateGen:          stategen.New(db),
+	}
+	vs := &Server{V1Alpha1Server: v1Alpha1Server}
+
+	randaoReveal, err := testutil.RandaoReveal(beaconState, 0, privKeys)
+	require.NoError(t, err)
+	graffiti := bytesutil.ToBytes32([]byte("eth2"))
+	req := &v1.ProduceBlockRequest{
+		Slot:         1,
+		RandaoReveal: randaoReveal,
+		Graffiti:     graffiti[:],
+	}
+
+	t.Run("phase0", func(t *testing.T) {
+		v1Alpha1Server.HeadFetcher = &mockChain.ChainService{State: beaconState.Copy(), Root: parentRoot[:]}
+		resp, err := vs.ProduceBlockV2(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, v2.Version_PHASE0, resp.Version)
+		block := resp.Data.GetPhase0Block()
+		require.NotNil(t, block)
+		assert.Equal(t, req.Slot, block.Slot, "Expected block to have slot of 1")
+		assert.DeepEqual(t, parentRoot[:], block.ParentRoot, "Expected block to have correct parent root")
+		assert.DeepEqual(t, randaoReveal, block.Body.RandaoReveal, "Expected block to have correct randao reveal")
+		assert.DeepEqual(t, req.Graffiti, block.Body.Graffiti, "Expected block to have correct graffiti")
+	})
+	t.Run("altair", func(t *testing.T) {
+		cfg := params.BeaconConfig().Copy()
+		cfg.AltairForkEpoch = 0
+		params.OverrideBeaconConfig(cfg)
+
+		v1Alpha1Server.HeadFetcher = &mockChain.ChainService{State: beaconState.Copy(), Root: parentRoot[:]}
+		resp, err := vs.ProduceBlockV2(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, v2.Version_ALTAIR, resp.Version)
+		block := resp.Data.GetAltairBlock()
+		require.NotNil(t, block)
+		assert.Equal(t, req.Slot, block.Slot, "Expected block to have slot of 1")
+		assert.DeepEqual(t, parentRoot[:], block.ParentRoot, "Expected block to have correct parent root")
+		assert.DeepEqual(t, randaoReveal, block.Body.RandaoReveal, "Expected block to have correct randao reveal")
+		require.NotNil(t, block.Body.SyncAggregate)
+		assert.Equal(t, uint64(0), block.Body.SyncAggregate.SyncCommitteeBits.Count())
+	})
+}
+
+func TestProduceBlock_SyncNotReady(t *testing.T) {
+	vs := &Server{
+		V1Alpha1Server: &v1alpha1validator.Server{SyncChecker: &mockSync.Sync{IsSyncing: true}},
+	}
+	_, err := vs.ProduceBlock(context.Background(), &v1.ProduceBlockRequest{})
+	assert.ErrorContains(t, "Syncing to latest head, not ready to respond", err)
+}
+
+func TestProduceAttestationData(t *testing.T) {
+	block := testutil.NewBeaconBlock()
+	block.Block.Slot = 3*params.BeaconConfig().SlotsPerEpoch + 1
+	justifiedBlock := testutil.NewBeaconBlock()
+	justifiedBlock.Block.Slot = 2 * params.BeaconConfig().SlotsPerEpoch
+	blockRoot, err := block.Block.HashTreeRoot()
+	require.NoError(t, err, "Could not hash beacon block")
+	justifiedRoot, err := justifiedBlock.Block.HashTreeRoot()
+	require.NoError(t, err, "Could not get signing root for justified block")
+	slot := 3*params.BeaconConfig().SlotsPerEpoch + 1
+	beaconState, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, beaconState.SetSlot(slot))
+	require.NoError(t, beaconState.SetCurrentJustifiedCheckpoint(&ethpbalpha.Checkpoint{
+		Epoch: 2,
+		Root:  justifiedRoot[:],
+	}))
+	blockRoots := beaconState.BlockRoots()
+	blockRoots[1] = blockRoot[:]
+	blockRoots[2*params.BeaconConfig().SlotsPerEpoch] = justifiedRoot[:]
+	require.NoError(t, beaconState.SetBlockRoots(blockRoots))
+
+	chainService := &mockChain.ChainService{}
+	offset := int64(slot.Mul(params.BeaconConfig().SecondsPerSlot))
+	v1Alpha1Server := &v1alpha1validator.Server{
+		SyncChecker:      &mockSync.Sync{IsSyncing: false},
+		AttestationCache: cache.NewAttestationCache(),
+		HeadFetcher: &mockChain.ChainService{
+			State: beaconState, Root: blockRoot[:],
+		},
+		FinalizationFetcher: &mockChain.ChainService{
+			CurrentJustifiedCheckPoint: beaconState.CurrentJustifiedCheckpoint(),
+		},
+		TimeFetcher: &mockChain.ChainService{
+			Genesis: time.Now().Add(time.Duration(-1*offset) * time.Second),
+		},
+		StateNotifier: chainService.StateNotifier(),
+	}
+	vs := &Server{V1Alpha1Server: v1Alpha1Server}
+
+	req := &v1.ProduceAttestationDataRequest{
+		CommitteeIndex: 0,
+		Slot:           slot,
+	}
+	resp, err := vs.ProduceAttestationData(context.Background(), req)
+	require.NoError(t, err)
+
+	assert.Equal(t, slot, resp.Data.Slot)
+	assert.Equal(t, types.CommitteeIndex(0), resp.Data.Index)
+	assert.DeepEqual(t, blockRoot[:], resp.Data.BeaconBlockRoot)
+	assert.Equal(t, types.Epoch(2), resp.Data.Source.Epoch)
+	assert.DeepEqual(t, justifiedRoot[:], resp.Data.Source.Root)
+	assert.Equal(t, types.Epoch(3), resp.Data.Target.Epoch)
+	assert.DeepEqual(t, blockRoot[:], resp.Data.Target.Root)
+}
+
+func TestGetAggregateAttestation(t *testing.T) {
+	ctx := context.Background()
+	root1 := bytesutil.PadTo([]byte("root1"), 32)
+	sig1 := bytesutil.PadTo([]byte("sig1"), 96)
+	attSlot1 := &ethpbalpha.Attestation{
+		AggregationBits: []byte{0, 1},
+		Data: &ethpbalpha.AttestationData{
+			Slot:            1,
+			CommitteeIndex:  1,
+			BeaconBlockRoot: root1,
+			Source: &ethpbalpha.Checkpoint{
+				Epoch: 1,
+				Root:  root1,
+			},
+			Target: &ethpbalpha.Checkpoint{
+				Epoch: 1,
+				Root:  root1,
+			},
+		},
+		Signature: sig1,
+	}
+	root21 := bytesutil.PadTo([]byte("root2_1"), 32)
+	sig21 := bytesutil.PadTo([]byte("sig2_1"), 96)
+	attSlot21 := &ethpbalpha.Attestation{
+		AggregationBits: []byte{0b1101},
+		Data: &ethpbalpha.AttestationData{
+			Slot:            2,
+			CommitteeIndex:  2,
+			BeaconBlockRoot: root21,
+			Source: &ethpbalpha.Checkpoint{
+				Epoch: 1,
+				Root:  root21,
+			},
+			Target: &ethpbalpha.Checkpoint{
+				Epoch: 1,
+				Root:  root21,
+			},
+		},
+		Signature: sig21,
+	}
+	sig22 := bytesutil.PadTo([]byte("sig2_2"), 96)
+	attSlot22 := &ethpbalpha.Attestation{
+		AggregationBits: []byte{0b1111},
+		Data:            attSlot21.Data,
+		Signature:       sig22,
+	}
+	pool := attestations.NewPool()
+	require.NoError(t, pool.SaveAggregatedAttestations([]*ethpbalpha.Attestation{attSlot1, attSlot21, attSlot22}))
+	vs := &Server{AttestationsPool: pool}
+
+	t.Run("OK", func(t *testing.T) {
+		reqRoot, err := attSlot22.Data.HashTreeRoot()
+		require.NoError(t, err)
+		req := &v1.AggregateAttestationRequest{
+			AttestationDataRoot: reqRoot[:],
+			Slot:                2,
+		}
+		att, err := vs.GetAggregateAttestation(ctx, req)
+		require.NoError(t, err)
+		require.NotNil(t, att)
+		require.NotNil(t, att.Data)
+		assert.DeepEqual(t, bitfield.Bitlist{0b1111}, att.Data.AggregationBits)
+		assert.DeepEqual(t, sig22, att.Data.Signature)
+		assert.Equal(t, types.Slot(2), att.Data.Data.Slot)
+		assert.Equal(t, types.CommitteeIndex(2), att.Data.Data.Index)
+		assert.DeepEqual(t, root21, att.Data.Data.BeaconBlockRoot)
+	})
+
+	t.Run("No matching attestation", func(t *testing.T) {
+		reqRoot, err := attSlot22.Data.HashTreeRoot()
+		require.NoError(t, err)
+		req := &v1.AggregateAttestationRequest{
+			AttestationDataRoot: reqRoot[:],
+			Slot:                1,
+		}
+		_, err = vs.GetAggregateAttestation(ctx, req)
+		assert.ErrorContains(t, "No matching attestation found", err)
+	})
+}
+
+func TestSubmitAggregateAndProofs(t *testing.T) {
+	ctx := context.Background()
+	params.SetupTestConfigCleanup(t)
+	c := params.BeaconNetworkConfig()
+	c.MaximumGossipClockDisparity = time.Hour
+	params.OverrideBeaconNetworkConfig(c)
+	root := bytesutil.PadTo([]byte("root"), 32)
+	sig := bytesutil.PadTo([]byte("sig"), 96)
+	proof := bytesutil.PadTo([]byte("proof"), 96)
+	att := &v1.Attestation{
+		AggregationBits: []byte{0, 1},
+		Data: &v1.AttestationData{
+			Slot:            1,
+			Index:           1,
+			BeaconBlockRoot: root,
+			Source: &v1.Checkpoint{
+				Epoch: 1,
+				Root:  root,
+			},
+			Target: &v1.Checkpoint{
+				Epoch: 1,
+				Root:  root,
+			},
+		},
+		Signature: sig,
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		chainSlot := types.Slot(0)
+		chain := &mockChain.ChainService{
+			Genesis: time.Now(), Slot: &chainSlot,
+		}
+		broadcaster := &mockp2p.MockBroadcaster{}
+		vs := &Server{
+			TimeFetcher: chain,
+			Broadcaster: broadcaster,
+		}
+
+		req := &v1.SubmitAggregateAndProofsRequest{
+			Data: []*v1.SignedAggregateAttestationAndProof{
+				{
+					Message: &v1.AggregateAttestationAndProof{
+						AggregatorIndex: 1,
+						Aggregate:       att,
+						SelectionProof:  proof,
+					},
+					Signature: sig,
+				},
+			},
+		}
+
+		_, err := vs.SubmitAggregateAndProofs(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, true, broadcaster.BroadcastCalled)
+		require.Equal(t, 1, len(broadcaster.BroadcastMessages))
+		msg, ok := broadcaster.BroadcastMessages[0].(*ethpbalpha.SignedAggregateAttestationAndProof)
+		require.Equal(t, true, ok, "Broadcast message has wrong type")
+		assert.Equal(t, types.ValidatorIndex(1), msg.Message.AggregatorIndex)
+		assert.DeepEqual(t, proof, msg.Message.SelectionProof)
+		assert.DeepEqual(t, root, msg.Message.Aggregate.Data.BeaconBlockRoot)
+	})
+
+	t.Run("nil aggregate", func(t *testing.T) {
+		broadcaster := &mockp2p.MockBroadcaster{}
+		vs := &Server{
+			Broadcaster: broadcaster,
+		}
+
+		req := &v1.SubmitAggregateAndProofsRequest{
+			Data: []*v1.SignedAggregateAttestationAndProof{
+				{
+					Message:   nil,
+					Signature: sig,
+				},
+			},
+		}
+		_, err := vs.SubmitAggregateAndProofs(ctx, req)
+		assert.ErrorContains(t, "Signed aggregate request can't be nil", err)
+		assert.Equal(t, false, broadcaster.BroadcastCalled)
+	})
+
+	t.Run("empty request", func(t *testing.T) {
+		vs := &Server{}
+		_, err := vs.SubmitAggregateAndProofs(ctx, &v1.SubmitAggregateAndProofsRequest{})
+		assert.ErrorContains(t, "No aggregate and proofs provided", err)
+	})
+
+	t.Run("zero signature", func(t *testing.T) {
+		broadcaster := &mockp2p.MockBroadcaster{}
+		vs := &Server{
+			Broadcaster: broadcaster,
+		}
+		req := &v1.SubmitAggregateAndProofsRequest{
+			Data: []*v1.SignedAggregateAttestationAndProof{
+				{
+					Message: &v1.AggregateAttestationAndProof{
+						AggregatorIndex: 1,
+						Aggregate:       att,
+						SelectionProof:  proof,
+					},
+					Signature: make([]byte, 96),
+				},
+			},
+		}
+		_, err := vs.SubmitAggregateAndProofs(ctx, req)
+		assert.ErrorContains(t, "Signed signatures can't be zero hashes", err)
+		assert.Equal(t, false, broadcaster.BroadcastCalled)
+	})
+
+	t.Run("wrong signature length", func(t *testing.T) {
+		broadcaster := &mockp2p.MockBroadcaster{}
+		vs := &Server{
+			Broadcaster: broadcaster,
+		}
+		req := &v1.SubmitAggregateAndProofsRequest{
+			Data: []*v1.SignedAggregateAttestationAndProof{
+				{
+					Message: &v1.AggregateAttestationAndProof{
+						AggregatorIndex: 1,
+						Aggregate:       att,
+						SelectionProof:  proof,
+					},
+					Signature: []byte("sig"),
+				},
+			},
+		}
+		_, err := vs.SubmitAggregateAndProofs(ctx, req)
+		assert.ErrorContains(t, "Incorrect signature length", err)
+		assert.Equal(t, false, broadcaster.BroadcastCalled)
+	})
+
+	t.Run("invalid attestation time", func(t *testing.T) {
+		chainSlot := types.Slot(0)
+		chain := &mockChain.ChainService{
+			Genesis: time.Now().Add(time.Hour * 2), Slot: &chainSlot,
+		}
+		broadcaster := &mockp2p.MockBroadcaster{}
+		vs := &Server{
+			TimeFetcher: chain,
+			Broadcaster: broadcaster,
+		}
+		req := &v1.SubmitAggregateAndProofsRequest{
+			Data: []*v1.SignedAggregateAttestationAndProof{
+				{
+					Message: &v1.AggregateAttestationAndProof{
+						AggregatorIndex: 1,
+						Aggregate:       att,
+						SelectionProof:  proof,
+					},
+					Signature: sig,
+				},
+			},
+		}
+		_, err := vs.SubmitAggregateAndProofs(ctx, req)
+		assert.ErrorContains(t, "Attestation slot is no longer valid from current time", err)
+		assert.Equal(t, false, broadcaster.BroadcastCalled)
+	})
+}
+
+func TestSubmitBeaconCommitteeSubscription(t *testing.T) {
+	ctx := context.Background()
+	genesis := testutil.NewBeaconBlock()
+	deposits, _, err := testutil.DeterministicDepositsAndKeys(64)
+	require.NoError(t, err)
+	eth1Data, err := testutil.DeterministicEth1Data(len(deposits))
+	require.NoError(t, err)
+	bs, err := state.GenesisBeaconState(context.Background(), deposits, 0, eth1Data)
+	require.NoError(t, err, "Could not set up genesis state")
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err, "Could not get signing root")
+
+	chainSlot := types.Slot(0)
+	chain := &mockChain.ChainService{
+		State: bs, Root: genesisRoot[:], Slot: &chainSlot,
+	}
+	vs := &Server{
+		HeadFetcher: chain,
+		TimeFetcher: chain,
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+	}
+
+	t.Run("Single subscription", func(t *testing.T) {
+		cache.SubnetIDs.EmptyAllCaches()
+		req := &v1.SubmitBeaconCommitteeSubscriptionsRequest{
+			Data: []*v1.BeaconCommitteeSubscribe{
+				{
+					ValidatorIndex: 1,
+					CommitteeIndex: 1,
+					Slot:           1,
+					IsAggregator:   false,
+				},
+			},
+		}
+		_, err := vs.SubmitBeaconCommitteeSubscription(ctx, req)
+		require.NoError(t, err)
+		subnets := cache.SubnetIDs.GetAttesterSubnetIDs(1)
+		require.Equal(t, 1, len(subnets))
+		assert.Equal(t, uint64(2), subnets[0])
+		assert.Equal(t, 0, len(cache.SubnetIDs.GetAggregatorSubnetIDs(1)))
+	})
+
+	t.Run("Multiple subscriptions", func(t *testing.T) {
+		cache.SubnetIDs.EmptyAllCaches()
+		req := &v1.SubmitBeaconCommitteeSubscriptionsRequest{
+			Data: []*v1.BeaconCommitteeSubscribe{
+				{
+					ValidatorIndex: 1,
+					CommitteeIndex: 1,
+					Slot:           1,
+					IsAggregator:   false,
+				},
+				{
+					ValidatorIndex: 1000,
+					CommitteeIndex: 16,
+					Slot:           1,
+					IsAggregator:   false,
+				},
+			},
+		}
+		_, err := vs.SubmitBeaconCommitteeSubscription(ctx, req)
+		assert.ErrorContains(t, "Invalid validator index", err)
+
+		req.Data[1].ValidatorIndex = 2
+		_, err = vs.SubmitBeaconCommitteeSubscription(ctx, req)
+		require.NoError(t, err)
+		subnets := cache.SubnetIDs.GetAttesterSubnetIDs(1)
+		require.Equal(t, 2, len(subnets))
+	})
+
+	t.Run("Is aggregator", func(t *testing.T) {
+		cache.SubnetIDs.EmptyAllCaches()
+		req := &v1.SubmitBeaconCommitteeSubscriptionsRequest{
+			Data: []*v1.BeaconCommitteeSubscribe{
+				{
+					ValidatorIndex: 1,
+					CommitteeIndex: 1,
+					Slot:           1,
+					IsAggregator:   true,
+				},
+			},
+		}
+		_, err := vs.SubmitBeaconCommitteeSubscription(ctx, req)
+		require.NoError(t, err)
+		ids := cache.SubnetIDs.GetAggregatorSubnetIDs(types.Slot(1))
+		assert.Equal(t, 1, len(ids))
+	})
+
+	t.Run("No subscriptions", func(t *testing.T) {
+		req := &v1.SubmitBeaconCommitteeSubscriptionsRequest{
+			Data: make([]*v1.BeaconCommitteeSubscribe, 0),
+		}
+		_, err = vs.SubmitBeaconCommitteeSubscription(ctx, req)
+		assert.ErrorContains(t, "No subscriptions provided", err)
+	})
+}
+
+func TestSubmitBeaconCommitteeSubscription_SyncNotReady(t *testing.T) {
+	vs := &Server{
+		SyncChecker: &mockSync.Sync{IsSyncing: true},
+	}
+	_, err := vs.SubmitBeaconCommitteeSubscription(context.Background(), &v1.SubmitBeaconCommitteeSubscriptionsRequest{})
+	assert.ErrorContains(t, "Syncing to latest head, not ready to respond", err)
+}
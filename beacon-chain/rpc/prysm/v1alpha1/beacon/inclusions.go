@@ -0,0 +1,313 @@
+package beacon
+
+import (
+	"context"
+	"sort"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/statefetcher"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/interfaces"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListAttestationInclusions retrieves the blocks which included an attestation, either by
+// attestation data root or for the attestation of a validator in an epoch. This requires the
+// beacon node to index the attestations of the blocks it saves.
+func (bs *Server) ListAttestationInclusions(
+	ctx context.Context, req *ethpb.ListAttestationInclusionsRequest,
+) (*ethpb.AttestationInclusions, error) {
+	if !bs.EnableInclusionIndices {
+		return nil, status.Error(
+			codes.FailedPrecondition,
+			"Attestation inclusion indices are disabled, restart the beacon node with --enable-inclusion-indices",
+		)
+	}
+	var inclusions []*ethpb.AttestationInclusions_Inclusion
+	var err error
+	switch q := req.QueryFilter.(type) {
+	case *ethpb.ListAttestationInclusionsRequest_DataRoot:
+		if len(q.DataRoot) != 32 {
+			return nil, status.Errorf(codes.InvalidArgument, "Data root must be 32 bytes, got %d", len(q.DataRoot))
+		}
+		inclusions, err = bs.dataRootInclusions(ctx, bytesutil.ToBytes32(q.DataRoot))
+	case *ethpb.ListAttestationInclusionsRequest_Validator:
+		if q.Validator == nil {
+			return nil, status.Error(codes.InvalidArgument, "Validator filter must not be empty")
+		}
+		inclusions, err = bs.validatorInclusions(ctx, q.Validator.ValidatorIndex, q.Validator.Epoch)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "Must specify a filter criteria for inclusions")
+	}
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(inclusions, func(i, j int) bool {
+		return inclusions[i].BlockSlot < inclusions[j].BlockSlot
+	})
+	return &ethpb.AttestationInclusions{Inclusions: inclusions}, nil
+}
+
+// GetBlockCreditedAttesters retrieves the validators whose attestations were included for the
+// first time, along the chain of the block, by the requested block.
+func (bs *Server) GetBlockCreditedAttesters(
+	ctx context.Context, req *ethpb.BlockCreditedAttestersRequest,
+) (*ethpb.BlockCreditedAttesters, error) {
+	if len(req.BlockRoot) != 32 {
+		return nil, status.Errorf(codes.InvalidArgument, "Block root must be 32 bytes, got %d", len(req.BlockRoot))
+	}
+	blk, err := bs.BeaconDB.Block(ctx, bytesutil.ToBytes32(req.BlockRoot))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve block: %v", err)
+	}
+	if blk == nil || blk.IsNil() {
+		return nil, status.Errorf(codes.NotFound, "Could not find block with root %#x", req.BlockRoot)
+	}
+	atts := blk.Block().Body().Attestations()
+	res := &ethpb.BlockCreditedAttesters{
+		BlockRoot:        req.BlockRoot,
+		Slot:             blk.Block().Slot(),
+		ValidatorIndices: make([]types.ValidatorIndex, 0),
+	}
+	if len(atts) == 0 {
+		return res, nil
+	}
+
+	// Attestations can only be included in blocks after their slot, so the ancestors which may have
+	// included the same attestations are the ones after the earliest attestation slot.
+	committees := make(map[committeeKey]bool, len(atts))
+	earliestSlot := blk.Block().Slot()
+	for _, att := range atts {
+		committees[committeeKey{slot: att.Data.Slot, index: att.Data.CommitteeIndex}] = true
+		if att.Data.Slot < earliestSlot {
+			earliestSlot = att.Data.Slot
+		}
+	}
+	states := make(map[types.Epoch]iface.BeaconState)
+	credited := make(map[types.ValidatorIndex]bool)
+	parentRoot := bytesutil.ToBytes32(blk.Block().ParentRoot())
+	for {
+		ancestor, err := bs.BeaconDB.Block(ctx, parentRoot)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve ancestor block: %v", err)
+		}
+		if ancestor == nil || ancestor.IsNil() || ancestor.Block().Slot() <= earliestSlot {
+			break
+		}
+		for _, att := range ancestor.Block().Body().Attestations() {
+			if !committees[committeeKey{slot: att.Data.Slot, index: att.Data.CommitteeIndex}] {
+				continue
+			}
+			indices, err := bs.attestingIndices(ctx, states, att)
+			if err != nil {
+				return nil, err
+			}
+			for _, i := range indices {
+				credited[types.ValidatorIndex(i)] = true
+			}
+		}
+		parentRoot = bytesutil.ToBytes32(ancestor.Block().ParentRoot())
+	}
+
+	for _, att := range atts {
+		indices, err := bs.attestingIndices(ctx, states, att)
+		if err != nil {
+			return nil, err
+		}
+		for _, i := range indices {
+			if credited[types.ValidatorIndex(i)] {
+				continue
+			}
+			credited[types.ValidatorIndex(i)] = true
+			res.ValidatorIndices = append(res.ValidatorIndices, types.ValidatorIndex(i))
+		}
+	}
+	sort.Slice(res.ValidatorIndices, func(i, j int) bool {
+		return res.ValidatorIndices[i] < res.ValidatorIndices[j]
+	})
+	return res, nil
+}
+
+type committeeKey struct {
+	slot  types.Slot
+	index types.CommitteeIndex
+}
+
+func (bs *Server) dataRootInclusions(ctx context.Context, dataRoot [32]byte) ([]*ethpb.AttestationInclusions_Inclusion, error) {
+	roots, err := bs.BeaconDB.AttestationInclusionBlockRoots(ctx, dataRoot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve inclusion indices: %v", err)
+	}
+	inclusions := make([]*ethpb.AttestationInclusions_Inclusion, 0, len(roots))
+	for _, root := range roots {
+		blk, err := bs.BeaconDB.Block(ctx, root)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve block: %v", err)
+		}
+		if blk == nil || blk.IsNil() {
+			continue
+		}
+		for _, att := range blk.Block().Body().Attestations() {
+			r, err := att.Data.HashTreeRoot()
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not hash attestation data: %v", err)
+			}
+			if r != dataRoot {
+				continue
+			}
+			inclusion, err := bs.inclusion(ctx, root, blk, att.Data, r)
+			if err != nil {
+				return nil, err
+			}
+			inclusions = append(inclusions, inclusion)
+			break
+		}
+	}
+	return inclusions, nil
+}
+
+func (bs *Server) validatorInclusions(
+	ctx context.Context, index types.ValidatorIndex, epoch types.Epoch,
+) ([]*ethpb.AttestationInclusions_Inclusion, error) {
+	currentEpoch := helpers.SlotToEpoch(bs.GenesisTimeFetcher.CurrentSlot())
+	if epoch > currentEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, errEpoch, currentEpoch, epoch)
+	}
+	st, err := bs.epochState(ctx, make(map[types.Epoch]iface.BeaconState), epoch)
+	if err != nil {
+		return nil, err
+	}
+	if uint64(index) >= uint64(st.NumValidators()) {
+		return nil, status.Errorf(codes.OutOfRange, "Validator index %d >= validator count %d", index, st.NumValidators())
+	}
+	assignments, _, err := helpers.CommitteeAssignments(st, epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute committee assignments: %v", err)
+	}
+	assignment, ok := assignments[index]
+	if !ok {
+		// The validator was not active in the epoch.
+		return []*ethpb.AttestationInclusions_Inclusion{}, nil
+	}
+	position := -1
+	for i, v := range assignment.Committee {
+		if v == index {
+			position = i
+			break
+		}
+	}
+	if position < 0 {
+		return nil, status.Errorf(codes.Internal, "Could not find validator %d in its committee", index)
+	}
+
+	roots, err := bs.BeaconDB.CommitteeInclusionBlockRoots(ctx, assignment.AttesterSlot, assignment.CommitteeIndex)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve inclusion indices: %v", err)
+	}
+	inclusions := make([]*ethpb.AttestationInclusions_Inclusion, 0, len(roots))
+	for _, root := range roots {
+		blk, err := bs.BeaconDB.Block(ctx, root)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve block: %v", err)
+		}
+		if blk == nil || blk.IsNil() {
+			continue
+		}
+		for _, att := range blk.Block().Body().Attestations() {
+			if att.Data.Slot != assignment.AttesterSlot || att.Data.CommitteeIndex != assignment.CommitteeIndex {
+				continue
+			}
+			if uint64(position) >= att.AggregationBits.Len() || !att.AggregationBits.BitAt(uint64(position)) {
+				continue
+			}
+			dataRoot, err := att.Data.HashTreeRoot()
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not hash attestation data: %v", err)
+			}
+			inclusion, err := bs.inclusion(ctx, root, blk, att.Data, dataRoot)
+			if err != nil {
+				return nil, err
+			}
+			inclusions = append(inclusions, inclusion)
+			break
+		}
+	}
+	return inclusions, nil
+}
+
+func (bs *Server) inclusion(
+	ctx context.Context,
+	blockRoot [32]byte,
+	blk interfaces.SignedBeaconBlock,
+	data *ethpb.AttestationData,
+	dataRoot [32]byte,
+) (*ethpb.AttestationInclusions_Inclusion, error) {
+	canonical, err := bs.CanonicalFetcher.IsCanonical(ctx, blockRoot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not determine if block is canonical: %v", err)
+	}
+	return &ethpb.AttestationInclusions_Inclusion{
+		BlockRoot:           blockRoot[:],
+		BlockSlot:           blk.Block().Slot(),
+		AttestationDataRoot: dataRoot[:],
+		AttestationSlot:     data.Slot,
+		CommitteeIndex:      data.CommitteeIndex,
+		InclusionDistance:   blk.Block().Slot() - data.Slot,
+		Canonical:           canonical,
+	}, nil
+}
+
+// attestingIndices returns the indices of the validators in the attestation, using the state
+// at the start of the attestation's epoch to compute its committee.
+func (bs *Server) attestingIndices(
+	ctx context.Context, states map[types.Epoch]iface.BeaconState, att *ethpb.Attestation,
+) ([]uint64, error) {
+	st, err := bs.epochState(ctx, states, helpers.SlotToEpoch(att.Data.Slot))
+	if err != nil {
+		return nil, err
+	}
+	committee, err := helpers.BeaconCommitteeFromState(st, att.Data.Slot, att.Data.CommitteeIndex)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get committee: %v", err)
+	}
+	indices, err := attestationutil.AttestingIndices(att.AggregationBits, committee)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get attesting indices: %v", err)
+	}
+	return indices, nil
+}
+
+func (bs *Server) epochState(
+	ctx context.Context, states map[types.Epoch]iface.BeaconState, epoch types.Epoch,
+) (iface.BeaconState, error) {
+	if st, ok := states[epoch]; ok {
+		return st, nil
+	}
+	startSlot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid epoch %d: %v", epoch, err)
+	}
+	var st iface.BeaconState
+	if bs.StateReplayer != nil {
+		finalizedSlot, err := helpers.StartSlot(bs.FinalizationFetcher.FinalizedCheckpt().Epoch)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get finalized slot: %v", err)
+		}
+		st, err = bs.StateReplayer.StateBySlot(ctx, startSlot, finalizedSlot)
+		if replayErr := statefetcher.ReplayErrorToStatus(err); replayErr != nil {
+			return nil, replayErr
+		}
+	} else {
+		st, err = bs.StateGen.StateBySlot(ctx, startSlot)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve archived state for epoch %d: %v", epoch, err)
+	}
+	states[epoch] = st
+	return st, nil
+}
@@ -0,0 +1,174 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/statefetcher"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/eth/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type inclusionsTestChain struct {
+	server    *Server
+	committee []types.ValidatorIndex
+	data      *ethpb.AttestationData
+	roots     [][32]byte
+}
+
+// setupInclusionsTestChain saves a genesis block followed by two blocks, which both include
+// attestations of the first committee of slot 1. The first block includes the attestation of the
+// first committee member, the second includes the attestations of both members.
+func setupInclusionsTestChain(t *testing.T) *inclusionsTestChain {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	helpers.ClearCache()
+	ctx := context.Background()
+	db, err := kv.NewKVStore(ctx, t.TempDir(), &kv.Config{EnableInclusionIndices: true})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	genesis := testutil.NewBeaconBlock()
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(genesis)))
+	require.NoError(t, db.SaveState(ctx, st, genesisRoot))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+
+	committee, err := helpers.BeaconCommitteeFromState(st, 1, 0)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(committee))
+	data := testutil.HydrateAttestationData(&ethpb.AttestationData{Slot: 1})
+	bits1 := bitfield.NewBitlist(2)
+	bits1.SetBitAt(0, true)
+	bits2 := bitfield.NewBitlist(2)
+	bits2.SetBitAt(0, true)
+	bits2.SetBitAt(1, true)
+
+	blk1 := testutil.NewBeaconBlock()
+	blk1.Block.Slot = 2
+	blk1.Block.ParentRoot = genesisRoot[:]
+	blk1.Block.Body.Attestations = []*ethpb.Attestation{testutil.HydrateAttestation(&ethpb.Attestation{AggregationBits: bits1, Data: data})}
+	root1, err := blk1.Block.HashTreeRoot()
+	require.NoError(t, err)
+	blk2 := testutil.NewBeaconBlock()
+	blk2.Block.Slot = 3
+	blk2.Block.ParentRoot = root1[:]
+	blk2.Block.Body.Attestations = []*ethpb.Attestation{testutil.HydrateAttestation(&ethpb.Attestation{AggregationBits: bits2, Data: data})}
+	root2, err := blk2.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(blk1)))
+	require.NoError(t, db.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(blk2)))
+
+	return &inclusionsTestChain{
+		server: &Server{
+			BeaconDB:           db,
+			GenesisTimeFetcher: &mock.ChainService{},
+			CanonicalFetcher:   &mock.ChainService{CanonicalRoots: map[[32]byte]bool{root1: true}},
+			StateGen:           stategen.New(db),
+
+			EnableInclusionIndices: true,
+		},
+		committee: committee,
+		data:      data,
+		roots:     [][32]byte{root1, root2},
+	}
+}
+
+func TestServer_ListAttestationInclusions_DataRoot(t *testing.T) {
+	c := setupInclusionsTestChain(t)
+	dataRoot, err := c.data.HashTreeRoot()
+	require.NoError(t, err)
+
+	res, err := c.server.ListAttestationInclusions(context.Background(), &ethpb.ListAttestationInclusionsRequest{
+		QueryFilter: &ethpb.ListAttestationInclusionsRequest_DataRoot{DataRoot: dataRoot[:]},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Inclusions))
+	assert.DeepEqual(t, c.roots[0][:], res.Inclusions[0].BlockRoot)
+	assert.Equal(t, types.Slot(1), res.Inclusions[0].InclusionDistance)
+	assert.Equal(t, true, res.Inclusions[0].Canonical)
+	assert.DeepEqual(t, c.roots[1][:], res.Inclusions[1].BlockRoot)
+	assert.Equal(t, types.Slot(2), res.Inclusions[1].InclusionDistance)
+	assert.Equal(t, false, res.Inclusions[1].Canonical)
+	assert.DeepEqual(t, dataRoot[:], res.Inclusions[1].AttestationDataRoot)
+
+	_, err = c.server.ListAttestationInclusions(context.Background(), &ethpb.ListAttestationInclusionsRequest{
+		QueryFilter: &ethpb.ListAttestationInclusionsRequest_DataRoot{DataRoot: []byte{'a'}},
+	})
+	assert.ErrorContains(t, "Data root must be 32 bytes", err)
+}
+
+func TestServer_ListAttestationInclusions_Disabled(t *testing.T) {
+	c := setupInclusionsTestChain(t)
+	c.server.EnableInclusionIndices = false
+	dataRoot, err := c.data.HashTreeRoot()
+	require.NoError(t, err)
+
+	_, err = c.server.ListAttestationInclusions(context.Background(), &ethpb.ListAttestationInclusionsRequest{
+		QueryFilter: &ethpb.ListAttestationInclusionsRequest_DataRoot{DataRoot: dataRoot[:]},
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.ErrorContains(t, "--enable-inclusion-indices", err)
+}
+
+func TestServer_ListAttestationInclusions_Validator(t *testing.T) {
+	c := setupInclusionsTestChain(t)
+	// Committees are computed from states regenerated within the replay limits.
+	c.server.FinalizationFetcher = &mock.ChainService{FinalizedCheckPoint: &ethpb.Checkpoint{}}
+	c.server.StateReplayer = statefetcher.NewStateReplayer(c.server.StateGen, statefetcher.DefaultReplayLimits(), nil)
+
+	res, err := c.server.ListAttestationInclusions(context.Background(), &ethpb.ListAttestationInclusionsRequest{
+		QueryFilter: &ethpb.ListAttestationInclusionsRequest_Validator{
+			Validator: &ethpb.ListAttestationInclusionsRequest_ValidatorEpochFilter{ValidatorIndex: c.committee[0]},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Inclusions))
+	assert.DeepEqual(t, c.roots[0][:], res.Inclusions[0].BlockRoot)
+	assert.DeepEqual(t, c.roots[1][:], res.Inclusions[1].BlockRoot)
+
+	res, err = c.server.ListAttestationInclusions(context.Background(), &ethpb.ListAttestationInclusionsRequest{
+		QueryFilter: &ethpb.ListAttestationInclusionsRequest_Validator{
+			Validator: &ethpb.ListAttestationInclusionsRequest_ValidatorEpochFilter{ValidatorIndex: c.committee[1]},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Inclusions))
+	assert.DeepEqual(t, c.roots[1][:], res.Inclusions[0].BlockRoot)
+
+	_, err = c.server.ListAttestationInclusions(context.Background(), &ethpb.ListAttestationInclusionsRequest{})
+	assert.ErrorContains(t, "Must specify a filter criteria", err)
+}
+
+func TestServer_GetBlockCreditedAttesters(t *testing.T) {
+	c := setupInclusionsTestChain(t)
+
+	res, err := c.server.GetBlockCreditedAttesters(context.Background(), &ethpb.BlockCreditedAttestersRequest{BlockRoot: c.roots[0][:]})
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(2), res.Slot)
+	assert.DeepEqual(t, []types.ValidatorIndex{c.committee[0]}, res.ValidatorIndices)
+
+	// The first committee member was already credited by the parent block.
+	res, err = c.server.GetBlockCreditedAttesters(context.Background(), &ethpb.BlockCreditedAttestersRequest{BlockRoot: c.roots[1][:]})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []types.ValidatorIndex{c.committee[1]}, res.ValidatorIndices)
+
+	_, err = c.server.GetBlockCreditedAttesters(context.Background(), &ethpb.BlockCreditedAttestersRequest{BlockRoot: make([]byte, 32)})
+	assert.ErrorContains(t, "Could not find block", err)
+}
@@ -12,6 +12,7 @@ import (
 	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	mockP2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	mockPOW "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
@@ -39,6 +40,15 @@ func TestNodeServer_GetSyncStatus(t *testing.T) {
 	assert.Equal(t, true, res.Syncing)
 }
 
+func TestNodeServer_GetETH1ConnectionStatus(t *testing.T) {
+	ns := &Server{
+		POWChainInfoFetcher: &mockPOW.POWChain{},
+	}
+	res, err := ns.GetETH1ConnectionStatus(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, true, res.Connected)
+}
+
 func TestNodeServer_GetGenesis(t *testing.T) {
 	db := dbutil.SetupDB(t)
 	ctx := context.Background()
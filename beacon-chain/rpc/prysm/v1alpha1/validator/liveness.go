@@ -0,0 +1,151 @@
+package validator
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"github.com/prysmaticlabs/prysm/shared/version"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetValidatorLiveness reports whether the requested validators were seen attesting or proposing
+// during the requested epoch. A validator is live if one of its attestations was included in the
+// head state, is held in the attestation pool, or if it proposed a block known to the node.
+func (vs *Server) GetValidatorLiveness(
+	ctx context.Context, req *ethpb.ValidatorLivenessRequest,
+) (*ethpb.ValidatorLivenessResponse, error) {
+	if vs.SyncChecker.Syncing() {
+		return nil, status.Errorf(codes.Unavailable, "Syncing to latest head, not ready to respond")
+	}
+	headState, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	currentEpoch := helpers.CurrentEpoch(headState)
+	if req.Epoch > currentEpoch || req.Epoch+1 < currentEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Liveness can only be requested for the current epoch %d or the previous epoch",
+			currentEpoch,
+		)
+	}
+
+	live := make(map[types.ValidatorIndex]bool)
+	if err := vs.includedAttesters(headState, req.Epoch == currentEpoch, live); err != nil {
+		return nil, err
+	}
+	if err := vs.pooledAttesters(headState, req.Epoch, live); err != nil {
+		return nil, err
+	}
+	if vs.BeaconDB != nil {
+		blks, _, err := vs.BeaconDB.Blocks(ctx, filters.NewFilter().SetStartEpoch(req.Epoch).SetEndEpoch(req.Epoch))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get blocks: %v", err)
+		}
+		for _, blk := range blks {
+			live[blk.Block().ProposerIndex()] = true
+		}
+	}
+
+	res := &ethpb.ValidatorLivenessResponse{
+		Epoch:    req.Epoch,
+		Liveness: make([]*ethpb.ValidatorLivenessResponse_Liveness, len(req.Indices)),
+	}
+	for i, idx := range req.Indices {
+		res.Liveness[i] = &ethpb.ValidatorLivenessResponse_Liveness{
+			Index:  idx,
+			IsLive: live[idx],
+		}
+	}
+	return res, nil
+}
+
+// includedAttesters marks the validators whose attestations for the current or previous epoch were
+// included in the head state.
+func (vs *Server) includedAttesters(
+	headState iface.BeaconState, current bool, live map[types.ValidatorIndex]bool,
+) error {
+	if headState.Version() == version.Phase0 {
+		var err error
+		var pending []*pbp2p.PendingAttestation
+		if current {
+			pending, err = headState.CurrentEpochAttestations()
+		} else {
+			pending, err = headState.PreviousEpochAttestations()
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "Could not get pending attestations: %v", err)
+		}
+		for _, att := range pending {
+			if err := markAttesters(headState, att.Data, att.AggregationBits, live); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	var participation []byte
+	var err error
+	if current {
+		participation, err = headState.CurrentEpochParticipation()
+	} else {
+		participation, err = headState.PreviousEpochParticipation()
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not get epoch participation: %v", err)
+	}
+	for i, flags := range participation {
+		if flags != 0 {
+			live[types.ValidatorIndex(i)] = true
+		}
+	}
+	return nil
+}
+
+// pooledAttesters marks the validators whose attestations for the epoch are held in the attestation pool,
+// which contains attestations observed over gossip that were not yet included in a block.
+func (vs *Server) pooledAttesters(headState iface.BeaconState, epoch types.Epoch, live map[types.ValidatorIndex]bool) error {
+	if vs.AttPool == nil {
+		return nil
+	}
+	unaggregated, err := vs.AttPool.UnaggregatedAttestations()
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not get unaggregated attestations: %v", err)
+	}
+	atts := append(unaggregated, vs.AttPool.AggregatedAttestations()...)
+	atts = append(atts, vs.AttPool.BlockAttestations()...)
+	for _, att := range atts {
+		if att.Data == nil || att.Data.Target == nil || att.Data.Target.Epoch != epoch {
+			continue
+		}
+		if err := markAttesters(headState, att.Data, att.AggregationBits, live); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func markAttesters(
+	headState iface.BeaconState, data *ethpb.AttestationData, bits bitfield.Bitfield, live map[types.ValidatorIndex]bool,
+) error {
+	committee, err := helpers.BeaconCommitteeFromState(headState, data.Slot, data.CommitteeIndex)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not get committee: %v", err)
+	}
+	indices, err := attestationutil.AttestingIndices(bits, committee)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not get attesting indices: %v", err)
+	}
+	for _, idx := range indices {
+		live[types.ValidatorIndex(idx)] = true
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/eth/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_GetValidatorLiveness(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	ctx := context.Background()
+	db := dbutil.SetupDB(t)
+
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	epochStart := 2 * params.BeaconConfig().SlotsPerEpoch
+	require.NoError(t, st.SetSlot(epochStart+2))
+
+	includedCommittee, err := helpers.BeaconCommitteeFromState(st, epochStart, 0)
+	require.NoError(t, err)
+	pooledCommittee, err := helpers.BeaconCommitteeFromState(st, epochStart+1, 0)
+	require.NoError(t, err)
+	bits := bitfield.NewBitlist(uint64(len(includedCommittee)))
+	bits.SetBitAt(0, true)
+	require.NoError(t, st.AppendCurrentEpochAttestations(&pbp2p.PendingAttestation{
+		AggregationBits: bits,
+		Data: testutil.HydrateAttestationData(&ethpb.AttestationData{
+			Slot:   epochStart,
+			Target: &ethpb.Checkpoint{Epoch: 2},
+		}),
+	}))
+
+	pool := attestations.NewPool()
+	pooledBits := bitfield.NewBitlist(uint64(len(pooledCommittee)))
+	pooledBits.SetBitAt(0, true)
+	require.NoError(t, pool.SaveUnaggregatedAttestation(testutil.HydrateAttestation(&ethpb.Attestation{
+		AggregationBits: pooledBits,
+		Data: &ethpb.AttestationData{
+			Slot:   epochStart + 1,
+			Target: &ethpb.Checkpoint{Epoch: 2},
+		},
+	})))
+
+	proposer := types.ValidatorIndex(7)
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = epochStart + 1
+	blk.Block.ProposerIndex = proposer
+	require.NoError(t, db.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(blk)))
+
+	live := map[types.ValidatorIndex]bool{
+		includedCommittee[0]: true,
+		pooledCommittee[0]:   true,
+		proposer:             true,
+	}
+	idle := types.ValidatorIndex(0)
+	for live[idle] {
+		idle++
+	}
+
+	vs := &Server{
+		HeadFetcher: &mockChain.ChainService{State: st},
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+		AttPool:     pool,
+		BeaconDB:    db,
+	}
+	req := &ethpb.ValidatorLivenessRequest{
+		Epoch:   2,
+		Indices: []types.ValidatorIndex{includedCommittee[0], pooledCommittee[0], proposer, idle},
+	}
+	res, err := vs.GetValidatorLiveness(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(2), res.Epoch)
+	require.Equal(t, 4, len(res.Liveness))
+	for i, l := range res.Liveness {
+		assert.Equal(t, req.Indices[i], l.Index)
+		assert.Equal(t, i < 3, l.IsLive, "Unexpected liveness for validator %d", l.Index)
+	}
+
+	// The previous epoch has no recorded activity.
+	res, err = vs.GetValidatorLiveness(ctx, &ethpb.ValidatorLivenessRequest{Epoch: 1, Indices: req.Indices})
+	require.NoError(t, err)
+	for _, l := range res.Liveness {
+		assert.Equal(t, false, l.IsLive)
+	}
+
+	_, err = vs.GetValidatorLiveness(ctx, &ethpb.ValidatorLivenessRequest{Epoch: 0})
+	assert.ErrorContains(t, "Liveness can only be requested", err)
+	_, err = vs.GetValidatorLiveness(ctx, &ethpb.ValidatorLivenessRequest{Epoch: 3})
+	assert.ErrorContains(t, "Liveness can only be requested", err)
+}
+
+func TestServer_GetValidatorLiveness_Syncing(t *testing.T) {
+	vs := &Server{SyncChecker: &mockSync.Sync{IsSyncing: true}}
+	_, err := vs.GetValidatorLiveness(context.Background(), &ethpb.ValidatorLivenessRequest{})
+	assert.ErrorContains(t, "Syncing to latest head", err)
+}
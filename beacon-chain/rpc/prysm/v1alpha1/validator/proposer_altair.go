@@ -0,0 +1,121 @@
+package validator
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/interop"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v2/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetBlockAltair is called by a proposer during its assigned slot in the Altair fork to request
+// a block to sign. The block carries the sync aggregate of the previous slot.
+func (vs *Server) GetBlockAltair(ctx context.Context, req *ethpb.BlockRequest) (*prysmv2.BeaconBlockAltair, error) {
+	ctx, span := trace.StartSpan(ctx, "ProposerServer.GetBlockAltair")
+	defer span.End()
+	span.AddAttributes(trace.Int64Attribute("slot", int64(req.Slot)))
+
+	blkData, err := vs.buildBlockData(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	syncAggregate, err := vs.getSyncAggregate(ctx, req.Slot-1, bytesutil.ToBytes32(blkData.ParentRoot))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get sync aggregate: %v", err)
+	}
+
+	// Use zero hash as stub for state root to compute later.
+	stateRoot := params.BeaconConfig().ZeroHash[:]
+
+	blk := &prysmv2.BeaconBlockAltair{
+		Slot:          req.Slot,
+		ParentRoot:    blkData.ParentRoot,
+		StateRoot:     stateRoot,
+		ProposerIndex: blkData.ProposerIdx,
+		Body: &prysmv2.BeaconBlockBodyAltair{
+			Eth1Data:          blkData.Eth1Data,
+			Deposits:          blkData.Deposits,
+			Attestations:      blkData.Attestations,
+			RandaoReveal:      req.RandaoReveal,
+			ProposerSlashings: blkData.ProposerSlashings,
+			AttesterSlashings: blkData.AttesterSlashings,
+			VoluntaryExits:    blkData.VoluntaryExits,
+			Graffiti:          blkData.Graffiti[:],
+			SyncAggregate:     syncAggregate,
+		},
+	}
+
+	// Compute state root with the newly constructed block.
+	wsb, err := wrapper.WrappedAltairSignedBeaconBlock(&prysmv2.SignedBeaconBlockAltair{Block: blk, Signature: make([]byte, 96)})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not wrap block: %v", err)
+	}
+	stateRoot, err = vs.computeStateRoot(ctx, wsb)
+	if err != nil {
+		interop.WriteBlockToDisk(wsb, true /*failed*/)
+		return nil, status.Errorf(codes.Internal, "Could not compute state root: %v", err)
+	}
+	blk.StateRoot = stateRoot
+
+	return blk, nil
+}
+
+// getSyncAggregate builds the sync aggregate of the given slot and block root out of the best
+// contribution of each sync subcommittee in the pool.
+func (vs *Server) getSyncAggregate(ctx context.Context, slot types.Slot, root [32]byte) (*prysmv2.SyncAggregate, error) {
+	_, span := trace.StartSpan(ctx, "ProposerServer.getSyncAggregate")
+	defer span.End()
+
+	var contributions []*prysmv2.SyncCommitteeContribution
+	if vs.SyncCommitteePool != nil {
+		var err error
+		contributions, err = vs.SyncCommitteePool.SyncCommitteeContributions(slot)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// Contributions have to match the parent root of the block.
+	proposerContributions := proposerSyncContributions(contributions).filterByBlockRoot(root)
+
+	subnetCount := params.BeaconConfig().SyncCommitteeSubnetCount
+	var syncBits []byte
+	sigs := make([]bls.Signature, 0, subnetCount)
+	for i := uint64(0); i < subnetCount; i++ {
+		deduped, err := proposerContributions.filterBySubIndex(i).dedup()
+		if err != nil {
+			return nil, err
+		}
+		c := deduped.mostProfitable()
+		if c == nil {
+			syncBits = append(syncBits, prysmv2.NewSyncCommitteeAggregationBits()...)
+			continue
+		}
+		sig, err := bls.SignatureFromBytes(c.Signature)
+		if err != nil {
+			return nil, err
+		}
+		syncBits = append(syncBits, c.AggregationBits...)
+		sigs = append(sigs, sig)
+	}
+
+	// An empty aggregate carries the point at infinity as its signature.
+	syncSig := make([]byte, params.BeaconConfig().BLSSignatureLength)
+	syncSig[0] = 0xC0
+	if aggregated := bls.AggregateSignatures(sigs); aggregated != nil {
+		syncSig = aggregated.Marshal()
+	}
+
+	return &prysmv2.SyncAggregate{
+		SyncCommitteeBits:      syncBits,
+		SyncCommitteeSignature: syncSig,
+	}, nil
+}
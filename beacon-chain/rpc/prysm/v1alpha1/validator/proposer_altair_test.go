@@ -0,0 +1,142 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
+	mockPOW "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/eth/v1alpha1/wrapper"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestProposer_GetBlockAltair_OK(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	ctx := context.Background()
+
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+
+	stateRoot, err := beaconState.HashTreeRoot(ctx)
+	require.NoError(t, err, "Could not hash genesis state")
+
+	genesis := b.NewGenesisBlock(stateRoot[:])
+	require.NoError(t, db.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(genesis)), "Could not save genesis block")
+
+	parentRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err, "Could not get signing root")
+	require.NoError(t, db.SaveState(ctx, beaconState, parentRoot), "Could not save genesis state")
+	require.NoError(t, db.SaveHeadBlockRoot(ctx, parentRoot), "Could not save genesis state")
+
+	pool := synccommittee.NewPool()
+	bits := bitfield.NewBitvector128()
+	bits.SetBitAt(3, true)
+	require.NoError(t, pool.SaveSyncCommitteeContribution(&prysmv2.SyncCommitteeContribution{
+		Slot:              0,
+		BlockRoot:         parentRoot[:],
+		SubcommitteeIndex: 1,
+		AggregationBits:   bits,
+		Signature:         bls.NewAggregateSignature().Marshal(),
+	}))
+
+	proposerServer := &Server{
+		HeadFetcher:       &mock.ChainService{State: beaconState, Root: parentRoot[:]},
+		SyncChecker:       &mockSync.Sync{IsSyncing: false},
+		BlockReceiver:     &mock.ChainService{},
+		ChainStartFetcher: &mockPOW.POWChain{},
+		Eth1InfoFetcher:   &mockPOW.POWChain{},
+		Eth1BlockFetcher:  &mockPOW.POWChain{},
+		MockEth1Votes:     true,
+		AttPool:           attestations.NewPool(),
+		SlashingsPool:     slashings.NewPool(),
+		ExitPool:          voluntaryexits.NewPool(),
+		SyncCommitteePool: pool,
+		StateGen:          stategen.New(db),
+	}
+
+	randaoReveal, err := testutil.RandaoReveal(beaconState, 0, privKeys)
+	require.NoError(t, err)
+
+	graffiti := bytesutil.ToBytes32([]byte("eth2"))
+	req := &ethpb.BlockRequest{
+		Slot:         1,
+		RandaoReveal: randaoReveal,
+		Graffiti:     graffiti[:],
+	}
+	block, err := proposerServer.GetBlockAltair(ctx, req)
+	require.NoError(t, err)
+
+	assert.Equal(t, req.Slot, block.Slot, "Expected block to have slot of 1")
+	assert.DeepEqual(t, parentRoot[:], block.ParentRoot, "Expected block to have correct parent root")
+	assert.DeepEqual(t, randaoReveal, block.Body.RandaoReveal, "Expected block to have correct randao reveal")
+	assert.DeepEqual(t, req.Graffiti, block.Body.Graffiti, "Expected block to have correct graffiti")
+	require.NotNil(t, block.Body.SyncAggregate)
+	assert.Equal(t, uint64(1), block.Body.SyncAggregate.SyncCommitteeBits.Count())
+	assert.Equal(t, true, block.Body.SyncAggregate.SyncCommitteeBits.BitAt(128+3))
+}
+
+func TestProposer_GetSyncAggregate(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	ctx := context.Background()
+	root := [32]byte{'a'}
+
+	t.Run("no contributions", func(t *testing.T) {
+		vs := &Server{SyncCommitteePool: synccommittee.NewPool()}
+		agg, err := vs.getSyncAggregate(ctx, 1, root)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(0), agg.SyncCommitteeBits.Count())
+		assert.Equal(t, int(params.BeaconConfig().SyncCommitteeSize/8), len(agg.SyncCommitteeBits))
+		infinity := make([]byte, 96)
+		infinity[0] = 0xC0
+		assert.DeepEqual(t, infinity, agg.SyncCommitteeSignature)
+	})
+
+	t.Run("most profitable contribution per subcommittee", func(t *testing.T) {
+		pool := synccommittee.NewPool()
+		sig1 := bls.NewAggregateSignature().Marshal()
+		key, err := bls.RandKey()
+		require.NoError(t, err)
+		sig2 := key.Sign([]byte("foo"))
+
+		small := bitfield.NewBitvector128()
+		small.SetBitAt(0, true)
+		large := bitfield.NewBitvector128()
+		large.SetBitAt(0, true)
+		large.SetBitAt(1, true)
+		other := bitfield.NewBitvector128()
+		other.SetBitAt(5, true)
+		for _, c := range []*prysmv2.SyncCommitteeContribution{
+			{Slot: 1, BlockRoot: root[:], SubcommitteeIndex: 0, AggregationBits: small, Signature: sig1},
+			{Slot: 1, BlockRoot: root[:], SubcommitteeIndex: 0, AggregationBits: large, Signature: sig2.Marshal()},
+			{Slot: 1, BlockRoot: []byte{'b'}, SubcommitteeIndex: 2, AggregationBits: other, Signature: sig1},
+		} {
+			require.NoError(t, pool.SaveSyncCommitteeContribution(c))
+		}
+
+		vs := &Server{SyncCommitteePool: pool}
+		agg, err := vs.getSyncAggregate(ctx, 1, root)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(2), agg.SyncCommitteeBits.Count())
+		assert.Equal(t, true, agg.SyncCommitteeBits.BitAt(0))
+		assert.Equal(t, true, agg.SyncCommitteeBits.BitAt(1))
+		assert.DeepEqual(t, sig2.Marshal(), agg.SyncCommitteeSignature)
+	})
+}
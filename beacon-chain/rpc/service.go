@@ -16,12 +16,14 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/statebudget"
 	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
 	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
@@ -41,6 +43,8 @@ import (
 	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	ethpbv1alpha1 "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/shared/apiauth"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/logutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -94,6 +98,7 @@ type Config struct {
 	AttestationsPool        attestations.Pool
 	ExitPool                voluntaryexits.PoolManager
 	SlashingsPool           slashings.PoolManager
+	SyncCommitteePool       synccommittee.Pool
 	SyncService             chainSync.Checker
 	Broadcaster             p2p.Broadcaster
 	PeersFetcher            p2p.PeersProvider
@@ -106,6 +111,11 @@ type Config struct {
 	OperationNotifier       opfeed.Notifier
 	StateGen                *stategen.State
 	MaxMsgSize              int
+	StateReplayLimits       statefetcher.ReplayLimits
+	StateBudget             *statebudget.Manager
+	EnableInclusionIndices  bool
+	EventReplayBufferSize   int
+	Authorizer              *apiauth.Authorizer
 }
 
 // NewService instantiates a new RPC service instance that will
@@ -132,24 +142,31 @@ func (s *Service) Start() {
 	s.listener = lis
 	log.WithField("address", address).Info("gRPC server listening on port")
 
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		recovery.StreamServerInterceptor(
+			recovery.WithRecoveryHandlerContext(traceutil.RecoveryHandlerFunc),
+		),
+		grpc_prometheus.StreamServerInterceptor,
+		grpc_opentracing.StreamServerInterceptor(),
+	}
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		recovery.UnaryServerInterceptor(
+			recovery.WithRecoveryHandlerContext(traceutil.RecoveryHandlerFunc),
+		),
+		grpc_prometheus.UnaryServerInterceptor,
+		grpc_opentracing.UnaryServerInterceptor(),
+	}
+	if s.cfg.Authorizer != nil {
+		streamInterceptors = append(streamInterceptors, s.cfg.Authorizer.StreamServerInterceptor())
+		unaryInterceptors = append(unaryInterceptors, s.cfg.Authorizer.UnaryServerInterceptor())
+	}
+	streamInterceptors = append(streamInterceptors, s.validatorStreamConnectionInterceptor)
+	unaryInterceptors = append(unaryInterceptors, s.validatorUnaryConnectionInterceptor)
+
 	opts := []grpc.ServerOption{
 		grpc.StatsHandler(&ocgrpc.ServerHandler{}),
-		grpc.StreamInterceptor(middleware.ChainStreamServer(
-			recovery.StreamServerInterceptor(
-				recovery.WithRecoveryHandlerContext(traceutil.RecoveryHandlerFunc),
-			),
-			grpc_prometheus.StreamServerInterceptor,
-			grpc_opentracing.StreamServerInterceptor(),
-			s.validatorStreamConnectionInterceptor,
-		)),
-		grpc.UnaryInterceptor(middleware.ChainUnaryServer(
-			recovery.UnaryServerInterceptor(
-				recovery.WithRecoveryHandlerContext(traceutil.RecoveryHandlerFunc),
-			),
-			grpc_prometheus.UnaryServerInterceptor,
-			grpc_opentracing.UnaryServerInterceptor(),
-			s.validatorUnaryConnectionInterceptor,
-		)),
+		grpc.StreamInterceptor(middleware.ChainStreamServer(streamInterceptors...)),
+		grpc.UnaryInterceptor(middleware.ChainUnaryServer(unaryInterceptors...)),
 		grpc.MaxRecvMsgSize(s.cfg.MaxMsgSize),
 	}
 	grpc_prometheus.EnableHandlingTimeHistogram()
@@ -190,12 +207,19 @@ func (s *Service) Start() {
 		Eth1BlockFetcher:       s.cfg.POWChainService,
 		PendingDepositsFetcher: s.cfg.PendingDepositFetcher,
 		SlashingsPool:          s.cfg.SlashingsPool,
+		SyncCommitteePool:      s.cfg.SyncCommitteePool,
 		StateGen:               s.cfg.StateGen,
+		BeaconDB:               s.cfg.BeaconDB,
 	}
 	validatorServerV1 := &validator.Server{
-		HeadFetcher: s.cfg.HeadFetcher,
-		TimeFetcher: s.cfg.GenesisTimeFetcher,
-		SyncChecker: s.cfg.SyncService,
+		HeadFetcher:       s.cfg.HeadFetcher,
+		TimeFetcher:       s.cfg.GenesisTimeFetcher,
+		SyncChecker:       s.cfg.SyncService,
+		AttestationsPool:  s.cfg.AttestationsPool,
+		SyncCommitteePool: s.cfg.SyncCommitteePool,
+		Broadcaster:       s.cfg.Broadcaster,
+		OperationNotifier: s.cfg.OperationNotifier,
+		V1Alpha1Server:    validatorServer,
 	}
 
 	nodeServer := &nodev1alpha1.Server{
@@ -208,6 +232,7 @@ func (s *Service) Start() {
 		PeersFetcher:         s.cfg.PeersFetcher,
 		PeerManager:          s.cfg.PeerManager,
 		GenesisFetcher:       s.cfg.GenesisFetcher,
+		POWChainInfoFetcher:  s.cfg.POWChainService,
 		BeaconMonitoringHost: s.cfg.BeaconMonitoringHost,
 		BeaconMonitoringPort: s.cfg.BeaconMonitoringPort,
 	}
@@ -222,6 +247,8 @@ func (s *Service) Start() {
 		HeadFetcher:        s.cfg.HeadFetcher,
 	}
 
+	// Historical states requested through the APIs share a single bounded pool of replay workers.
+	stateReplayer := statefetcher.NewStateReplayer(s.cfg.StateGen, s.cfg.StateReplayLimits, s.cfg.StateBudget)
 	beaconChainServer := &beaconv1alpha1.Server{
 		Ctx:                         s.ctx,
 		BeaconDB:                    s.cfg.BeaconDB,
@@ -240,6 +267,8 @@ func (s *Service) Start() {
 		AttestationNotifier:         s.cfg.OperationNotifier,
 		Broadcaster:                 s.cfg.Broadcaster,
 		StateGen:                    s.cfg.StateGen,
+		StateReplayer:               stateReplayer,
+		EnableInclusionIndices:      s.cfg.EnableInclusionIndices,
 		SyncChecker:                 s.cfg.SyncService,
 		ReceivedAttestationsBuffer:  make(chan *ethpbv1alpha1.Attestation, attestationBufferSize),
 		CollectedAttestationsBuffer: make(chan []*ethpbv1alpha1.Attestation, attestationBufferSize),
@@ -251,6 +280,7 @@ func (s *Service) Start() {
 		ChainInfoFetcher:   s.cfg.ChainInfoFetcher,
 		GenesisTimeFetcher: s.cfg.GenesisTimeFetcher,
 		BlockNotifier:      s.cfg.BlockNotifier,
+		OperationNotifier:  s.cfg.OperationNotifier,
 		Broadcaster:        s.cfg.Broadcaster,
 		BlockReceiver:      s.cfg.BlockReceiver,
 		StateGenService:    s.cfg.StateGen,
@@ -259,19 +289,23 @@ func (s *Service) Start() {
 			ChainInfoFetcher:   s.cfg.ChainInfoFetcher,
 			GenesisTimeFetcher: s.cfg.GenesisTimeFetcher,
 			StateGenService:    s.cfg.StateGen,
+			Replayer:           stateReplayer,
 		},
 		VoluntaryExitsPool: s.cfg.ExitPool,
+		SyncCommitteePool:  s.cfg.SyncCommitteePool,
 	}
 	ethpbv1alpha1.RegisterNodeServer(s.grpcServer, nodeServer)
 	ethpbv1.RegisterBeaconNodeServer(s.grpcServer, nodeServerV1)
 	pbrpc.RegisterHealthServer(s.grpcServer, nodeServer)
 	ethpbv1alpha1.RegisterBeaconChainServer(s.grpcServer, beaconChainServer)
 	ethpbv1.RegisterBeaconChainServer(s.grpcServer, beaconChainServerV1)
+	ethpbv2.RegisterBeaconChainServer(s.grpcServer, beaconChainServerV1)
 	ethpbv1.RegisterEventsServer(s.grpcServer, &events.Server{
 		Ctx:               s.ctx,
 		StateNotifier:     s.cfg.StateNotifier,
 		BlockNotifier:     s.cfg.BlockNotifier,
 		OperationNotifier: s.cfg.OperationNotifier,
+		ReplayBufferSize:  s.cfg.EventReplayBufferSize,
 	})
 	if s.cfg.EnableDebugRPCEndpoints {
 		log.Info("Enabled debug gRPC endpoints")
@@ -291,13 +325,16 @@ func (s *Service) Start() {
 				ChainInfoFetcher:   s.cfg.ChainInfoFetcher,
 				GenesisTimeFetcher: s.cfg.GenesisTimeFetcher,
 				StateGenService:    s.cfg.StateGen,
+				Replayer:           stateReplayer,
 			},
 		}
 		pbrpc.RegisterDebugServer(s.grpcServer, debugServer)
 		ethpbv1.RegisterBeaconDebugServer(s.grpcServer, debugServerV1)
+		ethpbv2.RegisterBeaconDebugServer(s.grpcServer, debugServerV1)
 	}
 	ethpbv1alpha1.RegisterBeaconNodeValidatorServer(s.grpcServer, validatorServer)
 	ethpbv1.RegisterBeaconValidatorServer(s.grpcServer, validatorServerV1)
+	ethpbv2.RegisterBeaconValidatorServer(s.grpcServer, validatorServerV1)
 	// Register reflection service on gRPC server.
 	reflection.Register(s.grpcServer)
 
@@ -15,6 +15,8 @@ import (
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // StateIdParseError represents an error scenario where a state ID could not be parsed.
@@ -68,6 +70,55 @@ func (e *StateRootNotFoundError) Error() string {
 	return e.message
 }
 
+// StateReplayBusyError represents an error scenario where a historical state could not be regenerated
+// because too many replays are already in progress or waiting for a worker.
+type StateReplayBusyError struct {
+	message string
+}
+
+// NewStateReplayBusyError creates a new error instance.
+func NewStateReplayBusyError(pending int) StateReplayBusyError {
+	return StateReplayBusyError{
+		message: fmt.Sprintf("%d historical state replays are already pending, try again later", pending),
+	}
+}
+
+// Error returns the underlying error message.
+func (e *StateReplayBusyError) Error() string {
+	return e.message
+}
+
+// StateReplayBudgetError represents an error scenario where regenerating a historical state
+// exceeds the replay budget of a single request.
+type StateReplayBudgetError struct {
+	message string
+}
+
+// NewStateReplayBudgetError creates a new error instance.
+func NewStateReplayBudgetError(reason string) StateReplayBudgetError {
+	return StateReplayBudgetError{
+		message: fmt.Sprintf("state replay budget exceeded: %s", reason),
+	}
+}
+
+// Error returns the underlying error message.
+func (e *StateReplayBudgetError) Error() string {
+	return e.message
+}
+
+// ReplayErrorToStatus returns the gRPC status error of a request which could not get a state
+// because of the replay limits, or nil if the error was not caused by them.
+func ReplayErrorToStatus(err error) error {
+	switch e := err.(type) {
+	case *StateReplayBusyError:
+		return status.Errorf(codes.ResourceExhausted, "Could not get state: %v", e)
+	case *StateReplayBudgetError:
+		return status.Errorf(codes.Unavailable, "Could not get state: %v", e)
+	default:
+		return nil
+	}
+}
+
 // Fetcher is responsible for retrieving info related with the beacon chain.
 type Fetcher interface {
 	State(ctx context.Context, stateId []byte) (iface.BeaconState, error)
@@ -80,6 +131,9 @@ type StateProvider struct {
 	ChainInfoFetcher   blockchain.ChainInfoFetcher
 	GenesisTimeFetcher blockchain.TimeFetcher
 	StateGenService    stategen.StateManager
+	// Replayer bounds the cost of states regenerated by slot. States are regenerated directly
+	// through StateGenService when it is nil.
+	Replayer *StateReplayer
 }
 
 // State returns the BeaconState for a given identifier. The identifier can be one of:
@@ -192,6 +246,13 @@ func (p *StateProvider) stateBySlot(ctx context.Context, slot types.Slot) (iface
 	if slot > currentSlot {
 		return nil, errors.New("slot cannot be in the future")
 	}
+	if p.Replayer != nil {
+		finalizedSlot, err := helpers.StartSlot(p.ChainInfoFetcher.FinalizedCheckpt().Epoch)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get finalized slot")
+		}
+		return p.Replayer.StateBySlot(ctx, slot, finalizedSlot)
+	}
 	state, err := p.StateGenService.StateBySlot(ctx, slot)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get state")
@@ -2,6 +2,7 @@ package statefetcher
 
 import (
 	"context"
+	"errors"
 	"strconv"
 	"strings"
 	"testing"
@@ -20,6 +21,8 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestGetState(t *testing.T) {
@@ -167,6 +170,31 @@ func TestGetState(t *testing.T) {
 		assert.Equal(t, stateRoot, sRoot)
 	})
 
+	t.Run("slot_replay_budget", func(t *testing.T) {
+		stateGen := stategen.NewMockService()
+		stateGen.StatesBySlot[headSlot] = state
+		finalizedEpoch := types.Epoch(10)
+		limits := DefaultReplayLimits()
+		limits.MaxSlots = 100
+
+		p := StateProvider{
+			ChainInfoFetcher:   &chainMock.ChainService{FinalizedCheckPoint: &eth.Checkpoint{Epoch: finalizedEpoch}},
+			GenesisTimeFetcher: &chainMock.ChainService{Slot: &headSlot},
+			StateGenService:    stateGen,
+			Replayer:           NewStateReplayer(stateGen, limits, nil),
+		}
+
+		_, err := p.State(ctx, []byte(strconv.FormatUint(uint64(headSlot), 10)))
+		_, ok := err.(*StateReplayBudgetError)
+		assert.Equal(t, true, ok, "Unexpected error %v", err)
+
+		finalizedEpoch = 0
+		p.ChainInfoFetcher = &chainMock.ChainService{FinalizedCheckPoint: &eth.Checkpoint{Epoch: finalizedEpoch}}
+		s, err := p.State(ctx, []byte(strconv.FormatUint(uint64(headSlot), 10)))
+		require.NoError(t, err)
+		assert.Equal(t, headSlot, s.Slot())
+	})
+
 	t.Run("slot_too_big", func(t *testing.T) {
 		p := StateProvider{
 			GenesisTimeFetcher: &chainMock.ChainService{
@@ -373,3 +401,11 @@ func TestNewStateNotFoundError(t *testing.T) {
 	e := NewStateNotFoundError(100)
 	assert.Equal(t, "state not found in the last 100 state roots", e.message)
 }
+
+func TestReplayErrorToStatus(t *testing.T) {
+	busyErr := NewStateReplayBusyError(8)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(ReplayErrorToStatus(&busyErr)))
+	budgetErr := NewStateReplayBudgetError("too many slots")
+	assert.Equal(t, codes.Unavailable, status.Code(ReplayErrorToStatus(&budgetErr)))
+	assert.NoError(t, ReplayErrorToStatus(errors.New("could not get state")))
+}
@@ -0,0 +1,29 @@
+package statefetcher
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	stateReplays = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "historical_state_replays_total",
+			Help: "The number of historical state requests by outcome: replayed, cache_hit, busy, over_budget, cancelled or failed.",
+		},
+		[]string{"result"},
+	)
+	stateReplayDuration = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "historical_state_replay_duration_seconds",
+			Help:    "The time spent replaying blocks to regenerate a historical state.",
+			Buckets: []float64{0.01, 0.1, 0.5, 1, 2, 5, 10, 30, 60},
+		},
+	)
+	stateReplaysPending = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "historical_state_replays_pending",
+			Help: "The number of historical state replays which are running or waiting for a worker.",
+		},
+	)
+)
@@ -0,0 +1,175 @@
+package statefetcher
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/statebudget"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// ReplayLimits bound the resources spent on regenerating historical states by replaying blocks.
+type ReplayLimits struct {
+	// Workers is the number of replays allowed to run at the same time.
+	Workers int
+	// QueueSize is the number of replays allowed to wait for a free worker. Requests beyond
+	// that are rejected straight away.
+	QueueSize int
+	// MaxSlots is the number of slots a single request may replay on top of the closest
+	// archived state. Zero means unlimited.
+	MaxSlots types.Slot
+	// Timeout is the time budget of a single request, including the time spent waiting for
+	// a worker. Zero means unlimited.
+	Timeout time.Duration
+	// CacheSize is the number of recently replayed states kept in memory.
+	CacheSize int
+}
+
+// DefaultReplayLimits returns the limits used when none are configured.
+func DefaultReplayLimits() ReplayLimits {
+	return ReplayLimits{
+		Workers:   2,
+		QueueSize: 8,
+		Timeout:   30 * time.Second,
+		CacheSize: 4,
+	}
+}
+
+// StateReplayer regenerates historical states through a bounded pool of workers. States which
+// precede the finalized checkpoint can't change anymore and are cached by slot once replayed.
+type StateReplayer struct {
+	stateGen stategen.StateManager
+	limits   ReplayLimits
+	tickets  chan struct{}
+	workers  chan struct{}
+	cache    *lru.Cache
+	tracker  *statebudget.Tracker
+	lock     sync.Mutex
+}
+
+// NewStateReplayer creates a replayer regenerating states with the state manager within the given limits.
+// The memory of the cached states is tracked by the given state budget manager.
+func NewStateReplayer(stateGen stategen.StateManager, limits ReplayLimits, stateBudget *statebudget.Manager) *StateReplayer {
+	if limits.Workers <= 0 {
+		limits.Workers = 1
+	}
+	if limits.QueueSize < 0 {
+		limits.QueueSize = 0
+	}
+	if limits.CacheSize <= 0 {
+		limits.CacheSize = 1
+	}
+	r := &StateReplayer{
+		stateGen: stateGen,
+		limits:   limits,
+		tickets:  make(chan struct{}, limits.Workers+limits.QueueSize),
+		workers:  make(chan struct{}, limits.Workers),
+	}
+	r.tracker = stateBudget.Register("historical_state", func(key interface{}) {
+		r.lock.Lock()
+		defer r.lock.Unlock()
+		r.cache.Remove(key)
+	})
+	cache, err := lru.NewWithEvict(limits.CacheSize, func(key interface{}, _ interface{}) {
+		r.tracker.Remove(key)
+	})
+	if err != nil {
+		panic(err)
+	}
+	r.cache = cache
+	return r
+}
+
+// StateBySlot returns the state at the given slot. Slots before the finalized slot are served from
+// the cache when possible and are subject to the replay slot budget, later slots are served from hot
+// states which are cheap to regenerate.
+func (r *StateReplayer) StateBySlot(ctx context.Context, slot, finalizedSlot types.Slot) (iface.BeaconState, error) {
+	finalized := slot < finalizedSlot
+	if finalized {
+		if st := r.cachedState(slot); st != nil {
+			stateReplays.WithLabelValues("cache_hit").Inc()
+			return st, nil
+		}
+		if cost := slot % params.BeaconConfig().SlotsPerArchivedPoint; r.limits.MaxSlots > 0 && cost > r.limits.MaxSlots {
+			stateReplays.WithLabelValues("over_budget").Inc()
+			e := NewStateReplayBudgetError(fmt.Sprintf("replaying %d slots exceeds the budget of %d slots", cost, r.limits.MaxSlots))
+			return nil, &e
+		}
+	}
+
+	replayCtx := ctx
+	if r.limits.Timeout > 0 {
+		var cancel context.CancelFunc
+		replayCtx, cancel = context.WithTimeout(ctx, r.limits.Timeout)
+		defer cancel()
+	}
+
+	select {
+	case r.tickets <- struct{}{}:
+		defer func() { <-r.tickets }()
+	default:
+		stateReplays.WithLabelValues("busy").Inc()
+		e := NewStateReplayBusyError(cap(r.tickets))
+		return nil, &e
+	}
+	stateReplaysPending.Inc()
+	defer stateReplaysPending.Dec()
+
+	select {
+	case r.workers <- struct{}{}:
+		defer func() { <-r.workers }()
+	case <-replayCtx.Done():
+		return nil, r.contextError(ctx)
+	}
+
+	start := time.Now()
+	st, err := r.stateGen.StateBySlot(replayCtx, slot)
+	stateReplayDuration.Observe(time.Since(start).Seconds())
+	if err != nil {
+		if replayCtx.Err() != nil {
+			return nil, r.contextError(ctx)
+		}
+		stateReplays.WithLabelValues("failed").Inc()
+		return nil, errors.Wrap(err, "could not replay state")
+	}
+	stateReplays.WithLabelValues("replayed").Inc()
+
+	if finalized && st != nil && !st.IsNil() {
+		cached := st.Copy()
+		r.lock.Lock()
+		r.cache.Add(slot, cached)
+		evictions := r.tracker.Put(slot, cached)
+		r.lock.Unlock()
+		evictions.Evict()
+	}
+	return st, nil
+}
+
+func (r *StateReplayer) cachedState(slot types.Slot) iface.BeaconState {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	item, ok := r.cache.Get(slot)
+	if !ok || item == nil {
+		return nil
+	}
+	r.tracker.Touch(slot)
+	return item.(iface.BeaconState).Copy()
+}
+
+// contextError tells apart a request cancelled by its caller from a request which ran out of its time budget.
+func (r *StateReplayer) contextError(ctx context.Context) error {
+	if ctx.Err() != nil {
+		stateReplays.WithLabelValues("cancelled").Inc()
+		return ctx.Err()
+	}
+	stateReplays.WithLabelValues("over_budget").Inc()
+	e := NewStateReplayBudgetError(fmt.Sprintf("replay did not complete within %v", r.limits.Timeout))
+	return &e
+}
@@ -0,0 +1,115 @@
+package statefetcher
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// blockingStateManager replays states once it is released, honoring the cancellation of the request.
+type blockingStateManager struct {
+	*stategen.MockStateManager
+	release chan struct{}
+	calls   int32
+}
+
+func (m *blockingStateManager) StateBySlot(ctx context.Context, slot types.Slot) (iface.BeaconState, error) {
+	atomic.AddInt32(&m.calls, 1)
+	if m.release != nil {
+		select {
+		case <-m.release:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	return m.MockStateManager.StateBySlot(ctx, slot)
+}
+
+func newBlockingStateManager(t *testing.T, slots ...types.Slot) *blockingStateManager {
+	m := &blockingStateManager{MockStateManager: stategen.NewMockService()}
+	for _, slot := range slots {
+		st, err := testutil.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, st.SetSlot(slot))
+		m.StatesBySlot[slot] = st
+	}
+	return m
+}
+
+func TestStateReplayer_CachesFinalizedStates(t *testing.T) {
+	stateGen := newBlockingStateManager(t, 10, 200)
+	r := NewStateReplayer(stateGen, DefaultReplayLimits(), nil)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		st, err := r.StateBySlot(ctx, 10, 100)
+		require.NoError(t, err)
+		assert.Equal(t, types.Slot(10), st.Slot())
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&stateGen.calls), "Finalized state was not cached")
+
+	for i := 0; i < 2; i++ {
+		_, err := r.StateBySlot(ctx, 200, 100)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(3), atomic.LoadInt32(&stateGen.calls), "Non-finalized state was cached")
+}
+
+func TestStateReplayer_SlotBudget(t *testing.T) {
+	stateGen := newBlockingStateManager(t, 10, 50)
+	limits := DefaultReplayLimits()
+	limits.MaxSlots = 20
+	r := NewStateReplayer(stateGen, limits, nil)
+
+	_, err := r.StateBySlot(context.Background(), 10, 100)
+	require.NoError(t, err)
+	_, err = r.StateBySlot(context.Background(), 50, 100)
+	_, ok := err.(*StateReplayBudgetError)
+	assert.Equal(t, true, ok, "Unexpected error %v", err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&stateGen.calls))
+}
+
+func TestStateReplayer_Busy(t *testing.T) {
+	stateGen := newBlockingStateManager(t, 10)
+	stateGen.release = make(chan struct{})
+	r := NewStateReplayer(stateGen, ReplayLimits{Workers: 1, QueueSize: 0}, nil)
+
+	done := make(chan error)
+	go func() {
+		_, err := r.StateBySlot(context.Background(), 10, 100)
+		done <- err
+	}()
+	for atomic.LoadInt32(&stateGen.calls) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	_, err := r.StateBySlot(context.Background(), 10, 100)
+	_, ok := err.(*StateReplayBusyError)
+	assert.Equal(t, true, ok, "Unexpected error %v", err)
+
+	close(stateGen.release)
+	require.NoError(t, <-done)
+}
+
+func TestStateReplayer_Timeout(t *testing.T) {
+	stateGen := newBlockingStateManager(t, 10)
+	stateGen.release = make(chan struct{})
+	r := NewStateReplayer(stateGen, ReplayLimits{Workers: 1, Timeout: 10 * time.Millisecond}, nil)
+
+	_, err := r.StateBySlot(context.Background(), 10, 100)
+	_, ok := err.(*StateReplayBudgetError)
+	assert.Equal(t, true, ok, "Unexpected error %v", err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = r.StateBySlot(ctx, 10, 100)
+	assert.ErrorContains(t, context.Canceled.Error(), err)
+}
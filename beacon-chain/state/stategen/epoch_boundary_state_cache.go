@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/statebudget"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	"k8s.io/client-go/tools/cache"
 )
@@ -55,15 +56,22 @@ type epochBoundaryState struct {
 	rootStateCache *cache.FIFO
 	slotRootCache  *cache.FIFO
 	lock           sync.RWMutex
+	tracker        *statebudget.Tracker
 }
 
 // newBoundaryStateCache creates a new block newBoundaryStateCache for storing and accessing epoch boundary states from
-// memory.
-func newBoundaryStateCache() *epochBoundaryState {
-	return &epochBoundaryState{
+// memory. The memory of the cached states is tracked by the given state budget manager.
+func newBoundaryStateCache(stateBudget *statebudget.Manager) *epochBoundaryState {
+	e := &epochBoundaryState{
 		rootStateCache: cache.NewFIFO(rootKeyFn),
 		slotRootCache:  cache.NewFIFO(slotKeyFn),
 	}
+	e.tracker = stateBudget.Register("epoch_boundary_state", func(key interface{}) {
+		if err := e.delete(key.([32]byte)); err != nil {
+			log.WithError(err).Error("Could not evict epoch boundary state")
+		}
+	})
+	return e
 }
 
 // get epoch boundary state by its block root. Returns copied state in state info object if exists. Otherwise returns nil.
@@ -82,6 +90,7 @@ func (e *epochBoundaryState) getByRoot(r [32]byte) (*rootStateInfo, bool, error)
 	if !ok {
 		return nil, false, errNotRootStateInfo
 	}
+	e.tracker.Touch(r)
 
 	return &rootStateInfo{
 		root:  r,
@@ -113,6 +122,11 @@ func (e *epochBoundaryState) getBySlot(s types.Slot) (*rootStateInfo, bool, erro
 // least recently added state info if the cache size has reached the max cache
 // size limit.
 func (e *epochBoundaryState) put(r [32]byte, s iface.BeaconState) error {
+	// States selected for eviction are evicted once the lock is released.
+	var evictions statebudget.Evictions
+	defer func() {
+		evictions.Evict()
+	}()
 	e.lock.Lock()
 	defer e.lock.Unlock()
 
@@ -122,28 +136,68 @@ func (e *epochBoundaryState) put(r [32]byte, s iface.BeaconState) error {
 	}); err != nil {
 		return err
 	}
+	copied := s.Copy()
 	if err := e.rootStateCache.AddIfNotPresent(&rootStateInfo{
 		root:  r,
-		state: s.Copy(),
+		state: copied,
 	}); err != nil {
 		return err
 	}
+	evictions = e.tracker.Put(r, copied)
 
-	trim(e.rootStateCache, maxCacheSize)
-	trim(e.slotRootCache, maxCacheSize)
+	trim(e.rootStateCache, maxCacheSize, e.popProcessUntrackFunc)
+	trim(e.slotRootCache, maxCacheSize, popProcessNoopFunc)
 
 	return nil
 }
 
+// delete removes the state of the input block root from the epoch boundary state cache.
+func (e *epochBoundaryState) delete(r [32]byte) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	obj, exists, err := e.rootStateCache.GetByKey(string(r[:]))
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+	info, ok := obj.(*rootStateInfo)
+	if !ok {
+		return errNotRootStateInfo
+	}
+	// Only remove the slot to root mapping if it still points to the removed state.
+	slotObj, exists, err := e.slotRootCache.GetByKey(slotToString(info.state.Slot()))
+	if err != nil {
+		return err
+	}
+	if slotInfo, ok := slotObj.(*slotRootInfo); exists && ok && slotInfo.root == r {
+		if err := e.slotRootCache.Delete(slotInfo); err != nil {
+			return err
+		}
+	}
+	e.tracker.Remove(r)
+	return e.rootStateCache.Delete(info)
+}
+
 // trim the FIFO queue to the maxSize.
-func trim(queue *cache.FIFO, maxSize uint64) {
+func trim(queue *cache.FIFO, maxSize uint64, process cache.PopProcessFunc) {
 	for s := uint64(len(queue.ListKeys())); s > maxSize; s-- {
-		if _, err := queue.Pop(popProcessNoopFunc); err != nil { // This never returns an error, but we'll handle anyway for sanity.
+		if _, err := queue.Pop(process); err != nil { // This never returns an error, but we'll handle anyway for sanity.
 			panic(err)
 		}
 	}
 }
 
+// popProcessUntrackFunc stops tracking the memory of a state trimmed from the cache.
+func (e *epochBoundaryState) popProcessUntrackFunc(obj interface{}) error {
+	if info, ok := obj.(*rootStateInfo); ok {
+		e.tracker.Remove(info.root)
+	}
+	return nil
+}
+
 // popProcessNoopFunc is a no-op function that never returns an error.
 func popProcessNoopFunc(_ interface{}) error {
 	return nil
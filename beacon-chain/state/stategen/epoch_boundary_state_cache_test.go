@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/statebudget"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
@@ -20,7 +21,7 @@ func TestEpochBoundaryStateCache_BadRootKey(t *testing.T) {
 }
 
 func TestEpochBoundaryStateCache_CanSave(t *testing.T) {
-	e := newBoundaryStateCache()
+	e := newBoundaryStateCache(nil)
 	s, err := testutil.NewBeaconState()
 	require.NoError(t, err)
 	require.NoError(t, s.SetSlot(1))
@@ -49,7 +50,7 @@ func TestEpochBoundaryStateCache_CanSave(t *testing.T) {
 }
 
 func TestEpochBoundaryStateCache_CanTrim(t *testing.T) {
-	e := newBoundaryStateCache()
+	e := newBoundaryStateCache(nil)
 	offSet := types.Slot(10)
 	for i := types.Slot(0); i < offSet.Add(maxCacheSize); i++ {
 		s, err := testutil.NewBeaconState()
@@ -69,3 +70,43 @@ func TestEpochBoundaryStateCache_CanTrim(t *testing.T) {
 		}
 	}
 }
+
+func TestEpochBoundaryStateCache_CanDelete(t *testing.T) {
+	e := newBoundaryStateCache(nil)
+	for i := types.Slot(1); i <= 2; i++ {
+		s, err := testutil.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, s.SetSlot(i))
+		require.NoError(t, e.put([32]byte{byte(i)}, s))
+	}
+
+	require.NoError(t, e.delete([32]byte{1}))
+	_, exists, err := e.getByRoot([32]byte{1})
+	require.NoError(t, err)
+	assert.Equal(t, false, exists, "Should not exist")
+	_, exists, err = e.getBySlot(1)
+	require.NoError(t, err)
+	assert.Equal(t, false, exists, "Should not exist")
+
+	_, exists, err = e.getBySlot(2)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists, "Should exist")
+
+	// Deleting a missing root is a no-op.
+	require.NoError(t, e.delete([32]byte{1}))
+}
+
+func TestEpochBoundaryStateCache_EvictedByBudget(t *testing.T) {
+	m := statebudget.NewManager(0)
+	e := newBoundaryStateCache(m)
+	s, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	r := [32]byte{'a'}
+	require.NoError(t, e.put(r, s))
+
+	m.SetBudget(1)
+
+	_, exists, err := e.getByRoot(r)
+	require.NoError(t, err)
+	assert.Equal(t, false, exists, "Should have been evicted")
+}
@@ -6,6 +6,7 @@ import (
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/statebudget"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 )
 
@@ -25,19 +26,26 @@ var (
 
 // hotStateCache is used to store the processed beacon state after finalized check point..
 type hotStateCache struct {
-	cache *lru.Cache
-	lock  sync.RWMutex
+	cache   *lru.Cache
+	lock    sync.RWMutex
+	tracker *statebudget.Tracker
 }
 
-// newHotStateCache initializes the map and underlying cache.
-func newHotStateCache() *hotStateCache {
-	cache, err := lru.New(hotStateCacheSize)
+// newHotStateCache initializes the map and underlying cache. The memory of the cached
+// states is tracked by the given state budget manager.
+func newHotStateCache(stateBudget *statebudget.Manager) *hotStateCache {
+	c := &hotStateCache{}
+	c.tracker = stateBudget.Register("hot_state", func(key interface{}) {
+		c.delete(key.([32]byte))
+	})
+	cache, err := lru.NewWithEvict(hotStateCacheSize, func(key interface{}, _ interface{}) {
+		c.tracker.Remove(key)
+	})
 	if err != nil {
 		panic(err)
 	}
-	return &hotStateCache{
-		cache: cache,
-	}
+	c.cache = cache
+	return c
 }
 
 // Get returns a cached response via input block root, if any.
@@ -49,6 +57,7 @@ func (c *hotStateCache) get(root [32]byte) iface.BeaconState {
 
 	if exists && item != nil {
 		hotStateCacheHit.Inc()
+		c.tracker.Touch(root)
 		return item.(iface.BeaconState).Copy()
 	}
 	hotStateCacheMiss.Inc()
@@ -62,6 +71,7 @@ func (c *hotStateCache) getWithoutCopy(root [32]byte) iface.BeaconState {
 	item, exists := c.cache.Get(root)
 	if exists && item != nil {
 		hotStateCacheHit.Inc()
+		c.tracker.Touch(root)
 		return item.(iface.BeaconState)
 	}
 	hotStateCacheMiss.Inc()
@@ -71,8 +81,10 @@ func (c *hotStateCache) getWithoutCopy(root [32]byte) iface.BeaconState {
 // put the response in the cache.
 func (c *hotStateCache) put(root [32]byte, state iface.BeaconState) {
 	c.lock.Lock()
-	defer c.lock.Unlock()
 	c.cache.Add(root, state)
+	evictions := c.tracker.Put(root, state)
+	c.lock.Unlock()
+	evictions.Evict()
 }
 
 // has returns true if the key exists in the cache.
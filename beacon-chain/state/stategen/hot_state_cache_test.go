@@ -3,6 +3,7 @@ package stategen
 import (
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/statebudget"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
@@ -11,7 +12,7 @@ import (
 )
 
 func TestHotStateCache_RoundTrip(t *testing.T) {
-	c := newHotStateCache()
+	c := newHotStateCache(nil)
 	root := [32]byte{'A'}
 	state := c.get(root)
 	assert.Equal(t, iface.BeaconState(nil), state)
@@ -32,3 +33,31 @@ func TestHotStateCache_RoundTrip(t *testing.T) {
 	c.delete(root)
 	assert.Equal(t, false, c.has(root), "Cache not supposed to have the object")
 }
+
+func TestHotStateCache_EvictedByBudget(t *testing.T) {
+	m := statebudget.NewManager(0)
+	c := newHotStateCache(m)
+	root := [32]byte{'A'}
+	state, err := v1.InitializeFromProto(&pb.BeaconState{
+		Slot: 10,
+	})
+	require.NoError(t, err)
+	c.put(root, state)
+	require.Equal(t, true, c.has(root))
+
+	m.SetBudget(1)
+
+	assert.Equal(t, false, c.has(root), "Cache not supposed to have the object")
+}
+
+func TestHotStateCache_PutOverBudget(t *testing.T) {
+	c := newHotStateCache(statebudget.NewManager(1))
+	root := [32]byte{'A'}
+	state, err := v1.InitializeFromProto(&pb.BeaconState{
+		Slot: 10,
+	})
+	require.NoError(t, err)
+	// The state is evicted as soon as it is put, without waiting on the cache lock held by put.
+	c.put(root, state)
+	assert.Equal(t, false, c.has(root), "Cache not supposed to have the object")
+}
@@ -9,6 +9,7 @@ import (
 	"sync"
 
 	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/statebudget"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	ethereum_beacon_p2p_v1 "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
@@ -48,6 +49,18 @@ type State struct {
 	finalizedInfo           *finalizedInfo
 	epochBoundaryStateCache *epochBoundaryState
 	saveHotStateDB          *saveHotStateDbConfig
+	stateBudget             *statebudget.Manager
+}
+
+// StateGenOption is a functional option for the state management object.
+type StateGenOption func(*State)
+
+// WithStateBudget tracks the memory of the states held by the state caches with the given
+// state budget manager.
+func WithStateBudget(m *statebudget.Manager) StateGenOption {
+	return func(s *State) {
+		s.stateBudget = m
+	}
 }
 
 // This tracks the config in the event of long non-finality,
@@ -70,17 +83,21 @@ type finalizedInfo struct {
 }
 
 // New returns a new state management object.
-func New(beaconDB db.NoHeadAccessDatabase) *State {
-	return &State{
-		beaconDB:                beaconDB,
-		hotStateCache:           newHotStateCache(),
-		finalizedInfo:           &finalizedInfo{slot: 0, root: params.BeaconConfig().ZeroHash},
-		slotsPerArchivedPoint:   params.BeaconConfig().SlotsPerArchivedPoint,
-		epochBoundaryStateCache: newBoundaryStateCache(),
+func New(beaconDB db.NoHeadAccessDatabase, opts ...StateGenOption) *State {
+	s := &State{
+		beaconDB:              beaconDB,
+		finalizedInfo:         &finalizedInfo{slot: 0, root: params.BeaconConfig().ZeroHash},
+		slotsPerArchivedPoint: params.BeaconConfig().SlotsPerArchivedPoint,
 		saveHotStateDB: &saveHotStateDbConfig{
 			duration: defaultHotStateDBInterval,
 		},
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.hotStateCache = newHotStateCache(s.stateBudget)
+	s.epochBoundaryStateCache = newBoundaryStateCache(s.stateBudget)
+	return s
 }
 
 // Resume resumes a new state management object from previously saved finalized check point in DB.
@@ -0,0 +1,388 @@
+package stateutil
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/htrutils"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// FieldRootHasher computes the roots of the state fields which are expensive to hash, which
+// lets a beacon state cache them. The roots of the other fields are computed by stateutil.
+type FieldRootHasher interface {
+	ArraysRoot(input [][]byte, length uint64, fieldName string) ([32]byte, error)
+	ValidatorRegistryRoot(validators []*ethpb.Validator) ([32]byte, error)
+	EpochAttestationsRoot(atts []*pb.PendingAttestation) ([32]byte, error)
+	Eth1DataRoot(eth1Data *ethpb.Eth1Data) ([32]byte, error)
+	Eth1DataVotesRoot(eth1DataVotes []*ethpb.Eth1Data) ([32]byte, error)
+}
+
+// noCacheHasher computes the roots of the state fields without any cache.
+type noCacheHasher struct {
+	hasher htrutils.HashFn
+}
+
+// ArraysRoot --
+func (h noCacheHasher) ArraysRoot(input [][]byte, length uint64, _ string) ([32]byte, error) {
+	return arraysRoot(h.hasher, input, length)
+}
+
+// ValidatorRegistryRoot --
+func (h noCacheHasher) ValidatorRegistryRoot(validators []*ethpb.Validator) ([32]byte, error) {
+	return validatorRegistryRoot(h.hasher, validators)
+}
+
+// EpochAttestationsRoot --
+func (h noCacheHasher) EpochAttestationsRoot(atts []*pb.PendingAttestation) ([32]byte, error) {
+	return epochAttestationsRoot(h.hasher, atts)
+}
+
+// Eth1DataRoot --
+func (h noCacheHasher) Eth1DataRoot(eth1Data *ethpb.Eth1Data) ([32]byte, error) {
+	return Eth1DataRootWithHasher(h.hasher, eth1Data)
+}
+
+// Eth1DataVotesRoot --
+func (h noCacheHasher) Eth1DataVotesRoot(eth1DataVotes []*ethpb.Eth1Data) ([32]byte, error) {
+	return Eth1DatasRoot(eth1DataVotes)
+}
+
+// ComputeFieldRootsPhase0 returns the hash tree root of every field of a phase 0 beacon state,
+// in the order of the fields. Unlike the beacon state trie, it uses no cache, which makes it
+// suitable for inspecting states outside of a running node.
+func ComputeFieldRootsPhase0(ctx context.Context, state *pb.BeaconState) ([][]byte, error) {
+	return ComputeFieldRootsWithHasherPhase0(ctx, noCacheHasher{hasher: hashutil.CustomSHA256Hasher()}, state)
+}
+
+// ComputeFieldRootsWithHasherPhase0 returns the hash tree root of every field of a phase 0 beacon
+// state, in the order of the fields, computing the roots of the expensive fields with the given hasher.
+func ComputeFieldRootsWithHasherPhase0(ctx context.Context, h FieldRootHasher, state *pb.BeaconState) ([][]byte, error) {
+	_, span := trace.StartSpan(ctx, "stateutil.ComputeFieldRootsWithHasherPhase0")
+	defer span.End()
+
+	if state == nil {
+		return nil, errors.New("nil state")
+	}
+	hasher := hashutil.CustomSHA256Hasher()
+	fieldRoots := make([][]byte, 0, params.BeaconConfig().BeaconStateFieldCount)
+	roots, err := headFieldRoots(h, state.GenesisTime, state.GenesisValidatorsRoot, uint64(state.Slot), state.Fork,
+		state.LatestBlockHeader, state.BlockRoots, state.StateRoots, state.HistoricalRoots, state.Eth1Data,
+		state.Eth1DataVotes, state.Eth1DepositIndex, state.Validators, state.Balances, state.RandaoMixes, state.Slashings)
+	if err != nil {
+		return nil, err
+	}
+	fieldRoots = append(fieldRoots, roots...)
+
+	// PreviousEpochAttestations slice root.
+	prevAttsRoot, err := h.EpochAttestationsRoot(state.PreviousEpochAttestations)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute previous epoch attestations merkleization")
+	}
+	fieldRoots = append(fieldRoots, prevAttsRoot[:])
+
+	// CurrentEpochAttestations slice root.
+	currAttsRoot, err := h.EpochAttestationsRoot(state.CurrentEpochAttestations)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute current epoch attestations merkleization")
+	}
+	fieldRoots = append(fieldRoots, currAttsRoot[:])
+
+	roots, err = checkpointFieldRoots(hasher, state.JustificationBits, state.PreviousJustifiedCheckpoint,
+		state.CurrentJustifiedCheckpoint, state.FinalizedCheckpoint)
+	if err != nil {
+		return nil, err
+	}
+	return append(fieldRoots, roots...), nil
+}
+
+// ComputeFieldRootsAltair returns the hash tree root of every field of an Altair beacon state,
+// in the order of the fields. Like ComputeFieldRootsPhase0, it uses no cache. The participation
+// fields are hashed as lists of the validator registry limit, as the specification defines them.
+func ComputeFieldRootsAltair(ctx context.Context, state *pb.BeaconStateAltair) ([][]byte, error) {
+	return ComputeFieldRootsWithHasherAltair(ctx, noCacheHasher{hasher: hashutil.CustomSHA256Hasher()}, state)
+}
+
+// ComputeFieldRootsWithHasherAltair returns the hash tree root of every field of an Altair beacon
+// state, in the order of the fields, computing the roots of the expensive fields with the given hasher.
+func ComputeFieldRootsWithHasherAltair(ctx context.Context, h FieldRootHasher, state *pb.BeaconStateAltair) ([][]byte, error) {
+	_, span := trace.StartSpan(ctx, "stateutil.ComputeFieldRootsWithHasherAltair")
+	defer span.End()
+
+	if state == nil {
+		return nil, errors.New("nil state")
+	}
+	hasher := hashutil.CustomSHA256Hasher()
+	fieldRoots := make([][]byte, 0, params.BeaconConfig().BeaconStateAltairFieldCount)
+	roots, err := headFieldRoots(h, state.GenesisTime, state.GenesisValidatorsRoot, uint64(state.Slot), state.Fork,
+		state.LatestBlockHeader, state.BlockRoots, state.StateRoots, state.HistoricalRoots, state.Eth1Data,
+		state.Eth1DataVotes, state.Eth1DepositIndex, state.Validators, state.Balances, state.RandaoMixes, state.Slashings)
+	if err != nil {
+		return nil, err
+	}
+	fieldRoots = append(fieldRoots, roots...)
+
+	// PreviousEpochParticipation slice root.
+	prevParticipationRoot, err := participationBitsRoot(hasher, state.PreviousEpochParticipation)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute previous epoch participation merkleization")
+	}
+	fieldRoots = append(fieldRoots, prevParticipationRoot[:])
+
+	// CurrentEpochParticipation slice root.
+	currParticipationRoot, err := participationBitsRoot(hasher, state.CurrentEpochParticipation)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute current epoch participation merkleization")
+	}
+	fieldRoots = append(fieldRoots, currParticipationRoot[:])
+
+	roots, err = checkpointFieldRoots(hasher, state.JustificationBits, state.PreviousJustifiedCheckpoint,
+		state.CurrentJustifiedCheckpoint, state.FinalizedCheckpoint)
+	if err != nil {
+		return nil, err
+	}
+	fieldRoots = append(fieldRoots, roots...)
+
+	// InactivityScores slice root.
+	inactivityScoresRoot, err := Uint64ListRootWithRegistryLimit(state.InactivityScores)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute inactivity scores merkleization")
+	}
+	fieldRoots = append(fieldRoots, inactivityScoresRoot[:])
+
+	// CurrentSyncCommittee data structure root.
+	currSyncCommitteeRoot, err := syncCommitteeRoot(state.CurrentSyncCommittee)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute current sync committee merkleization")
+	}
+	fieldRoots = append(fieldRoots, currSyncCommitteeRoot[:])
+
+	// NextSyncCommittee data structure root.
+	nextSyncCommitteeRoot, err := syncCommitteeRoot(state.NextSyncCommittee)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute next sync committee merkleization")
+	}
+	return append(fieldRoots, nextSyncCommitteeRoot[:]), nil
+}
+
+// headFieldRoots returns the roots of the fields from the genesis time to the slashings, which
+// the phase 0 and Altair states share.
+func headFieldRoots(
+	h FieldRootHasher,
+	genesisTime uint64,
+	genesisValidatorsRoot []byte,
+	slot uint64,
+	fork *pb.Fork,
+	latestBlockHeader *ethpb.BeaconBlockHeader,
+	blockRoots, stateRoots, historicalRoots [][]byte,
+	eth1Data *ethpb.Eth1Data,
+	eth1DataVotes []*ethpb.Eth1Data,
+	eth1DepositIndex uint64,
+	validators []*ethpb.Validator,
+	balances []uint64,
+	randaoMixes [][]byte,
+	slashings []uint64,
+) ([][]byte, error) {
+	fieldRoots := make([][]byte, 0, 15)
+
+	// Genesis time root.
+	genesisRoot := htrutils.Uint64Root(genesisTime)
+	fieldRoots = append(fieldRoots, genesisRoot[:])
+
+	// Genesis validator root.
+	r := bytesutil.ToBytes32(genesisValidatorsRoot)
+	fieldRoots = append(fieldRoots, r[:])
+
+	// Slot root.
+	slotRoot := htrutils.Uint64Root(slot)
+	fieldRoots = append(fieldRoots, slotRoot[:])
+
+	// Fork data structure root.
+	forkHashTreeRoot, err := htrutils.ForkRoot(fork)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute fork merkleization")
+	}
+	fieldRoots = append(fieldRoots, forkHashTreeRoot[:])
+
+	// BeaconBlockHeader data structure root.
+	headerHashTreeRoot, err := BlockHeaderRoot(latestBlockHeader)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute block header merkleization")
+	}
+	fieldRoots = append(fieldRoots, headerHashTreeRoot[:])
+
+	// BlockRoots array root.
+	blockRootsRoot, err := h.ArraysRoot(blockRoots, uint64(params.BeaconConfig().SlotsPerHistoricalRoot), "BlockRoots")
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute block roots merkleization")
+	}
+	fieldRoots = append(fieldRoots, blockRootsRoot[:])
+
+	// StateRoots array root.
+	stateRootsRoot, err := h.ArraysRoot(stateRoots, uint64(params.BeaconConfig().SlotsPerHistoricalRoot), "StateRoots")
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute state roots merkleization")
+	}
+	fieldRoots = append(fieldRoots, stateRootsRoot[:])
+
+	// HistoricalRoots slice root.
+	historicalRootsRt, err := htrutils.HistoricalRootsRoot(historicalRoots)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute historical roots merkleization")
+	}
+	fieldRoots = append(fieldRoots, historicalRootsRt[:])
+
+	// Eth1Data data structure root.
+	eth1HashTreeRoot, err := h.Eth1DataRoot(eth1Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute eth1data merkleization")
+	}
+	fieldRoots = append(fieldRoots, eth1HashTreeRoot[:])
+
+	// Eth1DataVotes slice root.
+	eth1VotesRoot, err := h.Eth1DataVotesRoot(eth1DataVotes)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute eth1data votes merkleization")
+	}
+	fieldRoots = append(fieldRoots, eth1VotesRoot[:])
+
+	// Eth1DepositIndex root.
+	eth1DepositIndexRoot := htrutils.Uint64Root(eth1DepositIndex)
+	fieldRoots = append(fieldRoots, eth1DepositIndexRoot[:])
+
+	// Validators slice root.
+	validatorsRoot, err := h.ValidatorRegistryRoot(validators)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute validator registry merkleization")
+	}
+	fieldRoots = append(fieldRoots, validatorsRoot[:])
+
+	// Balances slice root.
+	balancesRoot, err := Uint64ListRootWithRegistryLimit(balances)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute validator balances merkleization")
+	}
+	fieldRoots = append(fieldRoots, balancesRoot[:])
+
+	// RandaoMixes array root.
+	randaoRootsRoot, err := h.ArraysRoot(randaoMixes, uint64(params.BeaconConfig().EpochsPerHistoricalVector), "RandaoMixes")
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute randao roots merkleization")
+	}
+	fieldRoots = append(fieldRoots, randaoRootsRoot[:])
+
+	// Slashings array root.
+	slashingsRootsRoot, err := htrutils.SlashingsRoot(slashings)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute slashings merkleization")
+	}
+	return append(fieldRoots, slashingsRootsRoot[:]), nil
+}
+
+// checkpointFieldRoots returns the roots of the justification bits and of the checkpoints, which
+// the phase 0 and Altair states share.
+func checkpointFieldRoots(
+	hasher htrutils.HashFn,
+	justificationBits []byte,
+	previousJustified, currentJustified, finalized *ethpb.Checkpoint,
+) ([][]byte, error) {
+	fieldRoots := make([][]byte, 0, 4)
+
+	// JustificationBits root.
+	justifiedBitsRoot := bytesutil.ToBytes32(justificationBits)
+	fieldRoots = append(fieldRoots, justifiedBitsRoot[:])
+
+	// PreviousJustifiedCheckpoint data structure root.
+	prevCheckRoot, err := htrutils.CheckpointRoot(hasher, previousJustified)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute previous justified checkpoint merkleization")
+	}
+	fieldRoots = append(fieldRoots, prevCheckRoot[:])
+
+	// CurrentJustifiedCheckpoint data structure root.
+	currJustRoot, err := htrutils.CheckpointRoot(hasher, currentJustified)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute current justified checkpoint merkleization")
+	}
+	fieldRoots = append(fieldRoots, currJustRoot[:])
+
+	// FinalizedCheckpoint data structure root.
+	finalRoot, err := htrutils.CheckpointRoot(hasher, finalized)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute finalized checkpoint merkleization")
+	}
+	return append(fieldRoots, finalRoot[:]), nil
+}
+
+func arraysRoot(hasher htrutils.HashFn, input [][]byte, length uint64) ([32]byte, error) {
+	return htrutils.BitwiseMerkleize(hasher, input, uint64(len(input)), length)
+}
+
+func validatorRegistryRoot(hasher htrutils.HashFn, validators []*ethpb.Validator) ([32]byte, error) {
+	roots, err := HandleValidatorSlice(validators, nil, true)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	validatorsRootsRoot, err := htrutils.BitwiseMerkleizeArrays(hasher, roots, uint64(len(roots)), params.BeaconConfig().ValidatorRegistryLimit)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return mixInLength(validatorsRootsRoot, uint64(len(validators))), nil
+}
+
+func epochAttestationsRoot(hasher htrutils.HashFn, atts []*pb.PendingAttestation) ([32]byte, error) {
+	max := uint64(params.BeaconConfig().SlotsPerEpoch.Mul(params.BeaconConfig().MaxAttestations))
+	if uint64(len(atts)) > max {
+		return [32]byte{}, errors.Errorf("epoch attestation exceeds max length %d", max)
+	}
+	roots := make([][]byte, len(atts))
+	for i := 0; i < len(atts); i++ {
+		if atts[i] == nil {
+			return [32]byte{}, errors.New("nil pending attestation")
+		}
+		pendingRoot, err := PendingAttRootWithHasher(hasher, atts[i])
+		if err != nil {
+			return [32]byte{}, errors.Wrap(err, "could not attestation merkleization")
+		}
+		roots[i] = pendingRoot[:]
+	}
+	attsRootsRoot, err := htrutils.BitwiseMerkleize(hasher, roots, uint64(len(roots)), max)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return mixInLength(attsRootsRoot, uint64(len(atts))), nil
+}
+
+// participationBitsRoot computes the root of a list of participation flags, one byte per
+// validator, with the validator registry limit.
+func participationBitsRoot(hasher htrutils.HashFn, bits []byte) ([32]byte, error) {
+	chunks, err := htrutils.Pack([][]byte{bits})
+	if err != nil {
+		return [32]byte{}, err
+	}
+	limit := (params.BeaconConfig().ValidatorRegistryLimit + 31) / 32
+	bitsRoot, err := htrutils.BitwiseMerkleize(hasher, chunks, uint64(len(chunks)), limit)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return mixInLength(bitsRoot, uint64(len(bits))), nil
+}
+
+func syncCommitteeRoot(committee *pb.SyncCommittee) ([32]byte, error) {
+	if committee == nil {
+		return [32]byte{}, errors.New("nil sync committee")
+	}
+	return committee.HashTreeRoot()
+}
+
+func mixInLength(root [32]byte, length uint64) [32]byte {
+	lengthRoot := make([]byte, 32)
+	binary.LittleEndian.PutUint64(lengthRoot, length)
+	return htrutils.MixInLength(root, lengthRoot)
+}
@@ -0,0 +1,146 @@
+package stateutil_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/htrutils"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+func TestComputeFieldRootsPhase0(t *testing.T) {
+	st, err := testutil.NewBeaconState(func(state *pb.BeaconState) error {
+		state.Slot = 100
+		state.Validators = fieldRootsValidators(16)
+		state.Balances = make([]uint64, 16)
+		for i := range state.Balances {
+			state.Balances[i] = uint64(i) * 1e9
+		}
+		state.Eth1DataVotes = []*ethpb.Eth1Data{state.Eth1Data}
+		state.CurrentEpochAttestations = []*pb.PendingAttestation{{
+			AggregationBits: bitfield.NewBitlist(8),
+			Data:            testutil.HydrateAttestationData(&ethpb.AttestationData{Slot: 99}),
+		}}
+		return nil
+	})
+	require.NoError(t, err)
+	inner, ok := st.InnerStateUnsafe().(*pb.BeaconState)
+	require.Equal(t, true, ok)
+	fieldRoots, err := stateutil.ComputeFieldRootsPhase0(context.Background(), inner)
+	require.NoError(t, err)
+	assert.Equal(t, params.BeaconConfig().BeaconStateFieldCount, len(fieldRoots))
+	want, err := inner.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, want, containerRoot(t, fieldRoots))
+
+	_, err = stateutil.ComputeFieldRootsPhase0(context.Background(), nil)
+	assert.ErrorContains(t, "nil state", err)
+}
+
+func TestComputeFieldRootsAltair(t *testing.T) {
+	phase0, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	p, ok := phase0.InnerStateUnsafe().(*pb.BeaconState)
+	require.Equal(t, true, ok)
+	syncCommittee := &pb.SyncCommittee{
+		Pubkeys:         make([][]byte, params.BeaconConfig().SyncCommitteeSize),
+		AggregatePubkey: bytesutil.PadTo([]byte{1}, 48),
+	}
+	for i := range syncCommittee.Pubkeys {
+		syncCommittee.Pubkeys[i] = bytesutil.PadTo([]byte{byte(i)}, 48)
+	}
+	st := &pb.BeaconStateAltair{
+		GenesisValidatorsRoot:       make([]byte, 32),
+		Slot:                        100,
+		Fork:                        p.Fork,
+		LatestBlockHeader:           p.LatestBlockHeader,
+		BlockRoots:                  p.BlockRoots,
+		StateRoots:                  p.StateRoots,
+		Eth1Data:                    p.Eth1Data,
+		Validators:                  fieldRootsValidators(40),
+		Balances:                    make([]uint64, 40),
+		RandaoMixes:                 p.RandaoMixes,
+		Slashings:                   p.Slashings,
+		PreviousEpochParticipation:  make([]byte, 40),
+		CurrentEpochParticipation:   make([]byte, 40),
+		JustificationBits:           bitfield.Bitvector4{0x3},
+		PreviousJustifiedCheckpoint: p.PreviousJustifiedCheckpoint,
+		CurrentJustifiedCheckpoint:  p.CurrentJustifiedCheckpoint,
+		FinalizedCheckpoint:         p.FinalizedCheckpoint,
+		InactivityScores:            make([]uint64, 40),
+		CurrentSyncCommittee:        syncCommittee,
+		NextSyncCommittee:           syncCommittee,
+	}
+	for i := range st.CurrentEpochParticipation {
+		st.CurrentEpochParticipation[i] = byte(i % 8)
+		st.InactivityScores[i] = uint64(i)
+	}
+	fieldRoots, err := stateutil.ComputeFieldRootsAltair(context.Background(), st)
+	require.NoError(t, err)
+	assert.Equal(t, params.BeaconConfig().BeaconStateAltairFieldCount, len(fieldRoots))
+
+	// The fields shared with phase 0 have the same roots.
+	p.Slot = st.Slot
+	p.Validators = st.Validators
+	p.Balances = st.Balances
+	p.JustificationBits = st.JustificationBits
+	phase0Roots, err := stateutil.ComputeFieldRootsPhase0(context.Background(), p)
+	require.NoError(t, err)
+	assert.DeepEqual(t, phase0Roots[:15], fieldRoots[:15])
+	assert.DeepEqual(t, phase0Roots[17:], fieldRoots[17:21])
+
+	// The 40 participation flags are packed in 2 chunks, merkleized to the depth of the validator
+	// registry limit and mixed in with their count.
+	chunks := make([]byte, 64)
+	copy(chunks, st.CurrentEpochParticipation)
+	participationRoot := hashutil.Hash(chunks)
+	depth := htrutils.Depth(params.BeaconConfig().ValidatorRegistryLimit / 32)
+	for i := uint8(1); i < depth; i++ {
+		participationRoot = hashutil.Hash(append(participationRoot[:], trieutil.ZeroHashes[i][:]...))
+	}
+	length := make([]byte, 32)
+	length[0] = 40
+	participationRoot = hashutil.Hash(append(participationRoot[:], length...))
+	assert.DeepEqual(t, participationRoot[:], fieldRoots[16])
+
+	inactivityScoresRoot, err := stateutil.Uint64ListRootWithRegistryLimit(st.InactivityScores)
+	require.NoError(t, err)
+	assert.DeepEqual(t, inactivityScoresRoot[:], fieldRoots[21])
+	syncCommitteeRoot, err := syncCommittee.HashTreeRoot()
+	require.NoError(t, err)
+	assert.DeepEqual(t, syncCommitteeRoot[:], fieldRoots[22])
+	assert.DeepEqual(t, syncCommitteeRoot[:], fieldRoots[23])
+
+	st.NextSyncCommittee = nil
+	_, err = stateutil.ComputeFieldRootsAltair(context.Background(), st)
+	assert.ErrorContains(t, "nil sync committee", err)
+}
+
+func fieldRootsValidators(count int) []*ethpb.Validator {
+	validators := make([]*ethpb.Validator, count)
+	for i := range validators {
+		validators[i] = &ethpb.Validator{
+			PublicKey:             bytesutil.PadTo([]byte{byte(i)}, 48),
+			WithdrawalCredentials: make([]byte, 32),
+			EffectiveBalance:      params.BeaconConfig().MaxEffectiveBalance,
+			ExitEpoch:             params.BeaconConfig().FarFutureEpoch,
+		}
+	}
+	return validators
+}
+
+func containerRoot(t *testing.T, fieldRoots [][]byte) [32]byte {
+	root, err := htrutils.BitwiseMerkleize(hashutil.CustomSHA256Hasher(), fieldRoots, uint64(len(fieldRoots)), uint64(len(fieldRoots)))
+	require.NoError(t, err)
+	return root
+}
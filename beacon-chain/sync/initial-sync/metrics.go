@@ -0,0 +1,37 @@
+package initialsync
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	pipelineProcessedBlocks = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "initial_sync_pipeline_processed_blocks_total",
+			Help: "Count of blocks which passed a stage of the initial sync pipeline.",
+		},
+		[]string{"stage"},
+	)
+	pipelineFailedBatches = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "initial_sync_pipeline_failed_batches_total",
+			Help: "Count of block batches which failed a stage of the initial sync pipeline.",
+		},
+		[]string{"stage"},
+	)
+	pipelineStageDuration = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "initial_sync_pipeline_stage_duration_seconds",
+			Help:    "Captures the time taken by a block batch in a stage of the initial sync pipeline.",
+			Buckets: []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+		},
+		[]string{"stage"},
+	)
+	pipelinePendingBatches = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "initial_sync_pipeline_pending_batches",
+			Help: "The number of executed block batches waiting to be verified and committed.",
+		},
+	)
+)
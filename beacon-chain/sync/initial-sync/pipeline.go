@@ -0,0 +1,236 @@
+package initialsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/proto/interfaces"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// pipelineDepth is the number of block batches which may be executed ahead of the last committed batch.
+const pipelineDepth = 8
+
+var errStaleBatch = errors.New("batch was executed on top of a failed batch")
+
+// pipelineBatch is an executed block batch going through the verification and commit stages.
+type pipelineBatch struct {
+	generation uint64
+	pid        peer.ID
+	batch      *blockchain.BlockBatch
+	verified   chan error
+}
+
+// batchPipeline processes the block batches fetched by the queue in three stages, which run concurrently
+// with each other and with the fetching of the next batches:
+//   1. Execute - batches are transitioned through in order without verifying signatures, each on top of
+//      the post state of the previous batch.
+//   2. Verify - signature sets of the executed batches are verified by several workers at once.
+//   3. Commit - verified batches are saved in order, which advances the head.
+// The execute stage is blocked once pipelineDepth batches are waiting to be committed.
+type batchPipeline struct {
+	s        *Service
+	genesis  time.Time
+	executor blockchain.BlockBatchExecutor
+	verify   func(set *bls.SignatureSet) (bool, error)
+	workers  int
+	// generation is increased whenever a batch fails verification or cannot be committed, so that the
+	// batches executed on top of it are discarded.
+	generation uint64
+}
+
+func newBatchPipeline(s *Service, genesis time.Time, executor blockchain.BlockBatchExecutor) *batchPipeline {
+	workers := runtime.NumCPU() - 1
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > pipelineDepth {
+		workers = pipelineDepth
+	}
+	return &batchPipeline{
+		s:        s,
+		genesis:  genesis,
+		executor: executor,
+		verify: func(set *bls.SignatureSet) (bool, error) {
+			return set.Verify()
+		},
+		workers: workers,
+	}
+}
+
+// run processes fetched data until the channel is closed, and returns once all the executed batches
+// have been committed or discarded.
+func (p *batchPipeline) run(ctx context.Context, fetched <-chan *blocksQueueFetchedData) {
+	toVerify := make(chan *pipelineBatch, pipelineDepth)
+	toCommit := make(chan *pipelineBatch, pipelineDepth)
+
+	var wg sync.WaitGroup
+	for i := 0; i < p.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			p.verifyBatches(ctx, toVerify)
+		}()
+	}
+	committed := make(chan struct{})
+	go func() {
+		defer close(committed)
+		p.commitBatches(ctx, toCommit)
+	}()
+
+	p.executeBatches(ctx, fetched, toVerify, toCommit)
+	close(toVerify)
+	close(toCommit)
+	wg.Wait()
+	<-committed
+}
+
+func (p *batchPipeline) executeBatches(ctx context.Context, fetched <-chan *blocksQueueFetchedData,
+	toVerify, toCommit chan<- *pipelineBatch) {
+	var tip *blockchain.BlockBatch
+	tipGeneration := atomic.LoadUint64(&p.generation)
+	for data := range fetched {
+		pipelineProcessedBlocks.WithLabelValues("fetch").Add(float64(len(data.blocks)))
+		generation := atomic.LoadUint64(&p.generation)
+		if generation != tipGeneration {
+			// A batch failed after the tip was executed, which may have been built on top of it.
+			tip, tipGeneration = nil, generation
+		}
+
+		start := time.Now()
+		batch, err := p.execute(ctx, tip, data.blocks)
+		if err != nil {
+			pipelineFailedBatches.WithLabelValues("execute").Inc()
+			log.WithError(err).Warn("Batch is not processed")
+			continue
+		}
+		pipelineStageDuration.WithLabelValues("execute").Observe(time.Since(start).Seconds())
+		pipelineProcessedBlocks.WithLabelValues("execute").Add(float64(len(batch.Blocks)))
+		tip = batch
+
+		item := &pipelineBatch{
+			generation: generation,
+			pid:        data.pid,
+			batch:      batch,
+			verified:   make(chan error, 1),
+		}
+		// Batches are queued for commit first, so that execution waits for the commit stage to catch up.
+		select {
+		case toCommit <- item:
+		case <-ctx.Done():
+			return
+		}
+		pipelinePendingBatches.Inc()
+		toVerify <- item
+	}
+}
+
+// execute trims the blocks which were already processed or executed from the batch, and executes the
+// remaining ones on top of the tip if they descend from it, or else on top of their parent's saved state.
+func (p *batchPipeline) execute(ctx context.Context, tip *blockchain.BlockBatch,
+	blks []interfaces.SignedBeaconBlock) (*blockchain.BlockBatch, error) {
+	if len(blks) == 0 {
+		return nil, errors.New("0 blocks provided into method")
+	}
+	var tipSlot types.Slot
+	var tipRoot [32]byte
+	if tip != nil {
+		tipSlot = tip.Blocks[len(tip.Blocks)-1].Block().Slot()
+		tipRoot = tip.Roots[len(tip.Roots)-1]
+	}
+	headSlot := p.s.cfg.Chain.HeadSlot()
+	var firstRoot [32]byte
+	for {
+		var err error
+		firstRoot, err = blks[0].Block().HashTreeRoot()
+		if err != nil {
+			return nil, err
+		}
+		slot := blks[0].Block().Slot()
+		processed := headSlot >= slot && p.s.isProcessedBlock(ctx, blks[0], firstRoot)
+		executed := tip != nil && slot <= tipSlot
+		if !processed && !executed {
+			break
+		}
+		if len(blks) == 1 {
+			return nil, errors.New("no good blocks in batch")
+		}
+		blks = blks[1:]
+	}
+	p.s.logBatchSyncStatus(p.genesis, blks, firstRoot)
+
+	parent := tip
+	parentRoot := bytesutil.ToBytes32(blks[0].Block().ParentRoot())
+	if tip == nil || parentRoot != tipRoot {
+		parent = nil
+		if !p.s.cfg.DB.HasBlock(ctx, parentRoot) && !p.s.cfg.Chain.HasInitSyncBlock(parentRoot) {
+			return nil, fmt.Errorf("%w: %#x", errParentDoesNotExist, parentRoot)
+		}
+	}
+	blockRoots, err := linearBlockRoots(blks, firstRoot)
+	if err != nil {
+		return nil, err
+	}
+	return p.executor.ExecuteBlockBatch(ctx, parent, blks, blockRoots)
+}
+
+func (p *batchPipeline) verifyBatches(ctx context.Context, toVerify <-chan *pipelineBatch) {
+	for item := range toVerify {
+		if ctx.Err() != nil {
+			item.verified <- ctx.Err()
+			continue
+		}
+		if item.generation != atomic.LoadUint64(&p.generation) {
+			item.verified <- errStaleBatch
+			continue
+		}
+		start := time.Now()
+		verified, err := p.verify(item.batch.SignatureSet)
+		if err == nil && !verified {
+			err = errors.New("batch block signature verification failed")
+		}
+		if err != nil {
+			pipelineFailedBatches.WithLabelValues("verify").Inc()
+		} else {
+			pipelineStageDuration.WithLabelValues("verify").Observe(time.Since(start).Seconds())
+			pipelineProcessedBlocks.WithLabelValues("verify").Add(float64(len(item.batch.Blocks)))
+		}
+		item.verified <- err
+	}
+}
+
+func (p *batchPipeline) commitBatches(ctx context.Context, toCommit <-chan *pipelineBatch) {
+	for item := range toCommit {
+		err := <-item.verified
+		pipelinePendingBatches.Dec()
+		if ctx.Err() != nil || item.generation != atomic.LoadUint64(&p.generation) {
+			continue
+		}
+		if err != nil {
+			atomic.AddUint64(&p.generation, 1)
+			log.WithError(err).Warn("Batch is not processed")
+			continue
+		}
+
+		startSlot := p.s.cfg.Chain.HeadSlot()
+		start := time.Now()
+		if err := p.executor.CommitBlockBatch(ctx, item.batch); err != nil {
+			atomic.AddUint64(&p.generation, 1)
+			pipelineFailedBatches.WithLabelValues("commit").Inc()
+			log.WithError(err).Warn("Batch is not processed")
+		} else {
+			pipelineStageDuration.WithLabelValues("commit").Observe(time.Since(start).Seconds())
+			pipelineProcessedBlocks.WithLabelValues("commit").Add(float64(len(item.batch.Blocks)))
+		}
+		p.s.updatePeerScorerStats(item.pid, startSlot)
+	}
+}
@@ -0,0 +1,121 @@
+package initialsync
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	p2pt "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	eth "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/eth/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/proto/interfaces"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// mockBatchExecutor executes batches by checking they are linear, and commits them to the mock chain service.
+type mockBatchExecutor struct {
+	chain *mock.ChainService
+}
+
+func (e *mockBatchExecutor) ExecuteBlockBatch(_ context.Context, parent *blockchain.BlockBatch,
+	blks []interfaces.SignedBeaconBlock, roots [][32]byte) (*blockchain.BlockBatch, error) {
+	if parent != nil && bytesutil.ToBytes32(blks[0].Block().ParentRoot()) != parent.Roots[len(parent.Roots)-1] {
+		return nil, errParentDoesNotExist
+	}
+	return &blockchain.BlockBatch{Blocks: blks, Roots: roots, SignatureSet: bls.NewSet()}, nil
+}
+
+func (e *mockBatchExecutor) CommitBlockBatch(ctx context.Context, batch *blockchain.BlockBatch) error {
+	return e.chain.ReceiveBlockBatch(ctx, batch.Blocks, batch.Roots)
+}
+
+// failingBatchExecutor adds an invalid signature to the batch starting at the given slot.
+type failingBatchExecutor struct {
+	mockBatchExecutor
+	failSlot types.Slot
+}
+
+func (e *failingBatchExecutor) ExecuteBlockBatch(ctx context.Context, parent *blockchain.BlockBatch,
+	blks []interfaces.SignedBeaconBlock, roots [][32]byte) (*blockchain.BlockBatch, error) {
+	batch, err := e.mockBatchExecutor.ExecuteBlockBatch(ctx, parent, blks, roots)
+	if err != nil {
+		return nil, err
+	}
+	if blks[0].Block().Slot() == e.failSlot {
+		batch.SignatureSet.Signatures = append(batch.SignatureSet.Signatures, make([]byte, 96))
+	}
+	return batch, nil
+}
+
+func TestBatchPipeline_run(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	genesisBlk := testutil.NewBeaconBlock()
+	genesisBlkRoot, err := genesisBlk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(genesisBlk)))
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	chain := &mock.ChainService{
+		State: st,
+		Root:  genesisBlkRoot[:],
+		DB:    beaconDB,
+		FinalizedCheckPoint: &eth.Checkpoint{
+			Epoch: 0,
+		},
+	}
+	s := NewService(ctx, &Config{
+		P2P:           p2pt.NewTestP2P(t),
+		DB:            beaconDB,
+		Chain:         chain,
+		StateNotifier: &mock.MockStateNotifier{},
+	})
+
+	var batches [][]interfaces.SignedBeaconBlock
+	parentRoot := genesisBlkRoot
+	for i := 0; i < 3; i++ {
+		var batch []interfaces.SignedBeaconBlock
+		for slot := types.Slot(i*10 + 1); slot <= types.Slot(i*10+10); slot++ {
+			root := parentRoot
+			blk := testutil.NewBeaconBlock()
+			blk.Block.Slot = slot
+			blk.Block.ParentRoot = root[:]
+			parentRoot, err = blk.Block.HashTreeRoot()
+			require.NoError(t, err)
+			batch = append(batch, wrapper.WrappedPhase0SignedBeaconBlock(blk))
+		}
+		batches = append(batches, batch)
+	}
+	fetch := func(batches ...[]interfaces.SignedBeaconBlock) <-chan *blocksQueueFetchedData {
+		fetched := make(chan *blocksQueueFetchedData, len(batches))
+		for _, batch := range batches {
+			fetched <- &blocksQueueFetchedData{blocks: batch}
+		}
+		close(fetched)
+		return fetched
+	}
+
+	p := newBatchPipeline(s, makeGenesisTime(32), &mockBatchExecutor{chain: chain})
+
+	// The second batch fails verification, so the third one, executed on top of it, is discarded.
+	p.verify = func(set *bls.SignatureSet) (bool, error) {
+		return len(set.Signatures) == 0, nil
+	}
+	p.executor = &failingBatchExecutor{mockBatchExecutor: mockBatchExecutor{chain: chain}, failSlot: 11}
+	p.run(ctx, fetch(batches...))
+	assert.Equal(t, types.Slot(10), chain.HeadSlot())
+	assert.Equal(t, 10, len(chain.BlocksReceived))
+
+	// Fetching again from the head skips the processed blocks, and executes the next batches on top of each other.
+	p.executor = &mockBatchExecutor{chain: chain}
+	p.run(ctx, fetch(append(batches[0][5:], batches[1]...), batches[2]))
+	assert.Equal(t, types.Slot(30), chain.HeadSlot())
+	assert.Equal(t, 30, len(chain.BlocksReceived))
+}
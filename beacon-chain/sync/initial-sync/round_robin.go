@@ -11,10 +11,12 @@ import (
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/paulbellamy/ratecounter"
 	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/proto/interfaces"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/sirupsen/logrus"
 )
 
@@ -82,8 +84,13 @@ func (s *Service) syncToFinalizedEpoch(ctx context.Context, genesis time.Time) e
 		return err
 	}
 
-	for data := range queue.fetchedData {
-		s.processFetchedData(ctx, genesis, s.cfg.Chain.HeadSlot(), data)
+	executor, ok := s.cfg.Chain.(blockchain.BlockBatchExecutor)
+	if ok && featureconfig.Get().EnablePipelinedInitialSync {
+		newBatchPipeline(s, genesis, executor).run(ctx, queue.fetchedData)
+	} else {
+		for data := range queue.fetchedData {
+			s.processFetchedData(ctx, genesis, s.cfg.Chain.HeadSlot(), data)
+		}
 	}
 
 	log.WithFields(logrus.Fields{
@@ -266,21 +273,30 @@ func (s *Service) processBatchedBlocks(ctx context.Context, genesis time.Time,
 	if !s.cfg.DB.HasBlock(ctx, parentRoot) && !s.cfg.Chain.HasInitSyncBlock(parentRoot) {
 		return fmt.Errorf("%w: %#x", errParentDoesNotExist, firstBlock.Block().ParentRoot())
 	}
+	blockRoots, err := linearBlockRoots(blks, blkRoot)
+	if err != nil {
+		return err
+	}
+	return bFunc(ctx, blks, blockRoots)
+}
+
+// linearBlockRoots computes the roots of a linear block list, given the root of its first block.
+func linearBlockRoots(blks []interfaces.SignedBeaconBlock, firstRoot [32]byte) ([][32]byte, error) {
 	blockRoots := make([][32]byte, len(blks))
-	blockRoots[0] = blkRoot
+	blockRoots[0] = firstRoot
 	for i := 1; i < len(blks); i++ {
 		b := blks[i]
 		if !bytes.Equal(b.Block().ParentRoot(), blockRoots[i-1][:]) {
-			return fmt.Errorf("expected linear block list with parent root of %#x but received %#x",
+			return nil, fmt.Errorf("expected linear block list with parent root of %#x but received %#x",
 				blockRoots[i-1][:], b.Block().ParentRoot())
 		}
 		blkRoot, err := b.Block().HashTreeRoot()
 		if err != nil {
-			return err
+			return nil, err
 		}
 		blockRoots[i] = blkRoot
 	}
-	return bFunc(ctx, blks, blockRoots)
+	return blockRoots, nil
 }
 
 // updatePeerScorerStats adjusts monitored metrics for a peer.
@@ -11,6 +11,8 @@ import (
 	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	pb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
@@ -67,6 +69,11 @@ func (s *Service) registerSubscribers() {
 		s.validateAttesterSlashing,
 		s.attesterSlashingSubscriber,
 	)
+	s.subscribeDynamicWithSyncSubnets(
+		p2p.SyncCommitteeSubnetTopicFormat,
+		s.validateSyncCommitteeMessage,   /* validator */
+		s.syncCommitteeMessageSubscriber, /* message handler */
+	)
 	if flags.Get().SubscribeToAllSubnets {
 		s.subscribeStaticWithSubnets(
 			"/eth2/%x/beacon_attestation_%d",
@@ -288,6 +295,52 @@ func (s *Service) subscribeDynamicWithSubnets(
 	}()
 }
 
+// subscribe to the sync committee subnets requested by the sync committee subscriptions of
+// validators. Subnets are only joined from the Altair fork epoch onwards, and left again once
+// their subscriptions expire.
+func (s *Service) subscribeDynamicWithSyncSubnets(
+	topicFormat string,
+	validate pubsub.ValidatorEx,
+	handle subHandler,
+) {
+	base := p2p.GossipTopicMappings[topicFormat]
+	if base == nil {
+		log.Fatalf("%s is not mapped to any message in GossipTopicMappings", topicFormat)
+	}
+	digest, err := s.forkDigest()
+	if err != nil {
+		log.WithError(err).Fatal("Could not compute fork digest")
+	}
+	subscriptions := make(map[uint64]*pubsub.Subscription, params.BeaconConfig().SyncCommitteeSubnetCount)
+	genesis := s.cfg.Chain.GenesisTime()
+	ticker := slotutil.NewSlotTicker(genesis, params.BeaconConfig().SecondsPerSlot)
+
+	go func() {
+		for {
+			select {
+			case <-s.ctx.Done():
+				ticker.Done()
+				return
+			case currentSlot := <-ticker.C():
+				if s.chainStarted.IsSet() && s.cfg.InitialSync.Syncing() {
+					continue
+				}
+				currentEpoch := helpers.SlotToEpoch(currentSlot)
+				if currentEpoch < params.BeaconConfig().AltairForkEpoch {
+					continue
+				}
+				wantedSubs := cache.SyncSubnetIDs.GetAllSubnets(currentEpoch)
+				s.reValidateSubscriptions(subscriptions, wantedSubs, topicFormat, digest)
+				for _, idx := range wantedSubs {
+					if _, exists := subscriptions[idx]; !exists {
+						subscriptions[idx] = s.subscribeWithBase(fmt.Sprintf(topicFormat, digest, idx), validate, handle)
+					}
+				}
+			}
+		}
+	}()
+}
+
 // revalidate that our currently connected subnets are valid.
 func (s *Service) reValidateSubscriptions(subscriptions map[uint64]*pubsub.Subscription,
 	wantedSubs []uint64, topicFormat string, digest [4]byte) {
@@ -0,0 +1,30 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"google.golang.org/protobuf/proto"
+)
+
+// syncCommitteeMessageSubscriber forwards the incoming validated sync committee message to the
+// sync committee pool, where it is picked up by sync committee aggregators.
+func (s *Service) syncCommitteeMessageSubscriber(_ context.Context, msg proto.Message) error {
+	m, ok := msg.(*prysmv2.SyncCommitteeMessage)
+	if !ok {
+		return fmt.Errorf("message was not type *prysmv2.SyncCommitteeMessage, type=%T", msg)
+	}
+	if err := s.cfg.SyncCommsPool.SaveSyncCommitteeMessage(m); err != nil {
+		return err
+	}
+	s.cfg.OperationNotifier.OperationFeed().Send(&feed.Event{
+		Type: operation.SyncCommitteeMessageReceived,
+		Data: &operation.SyncCommitteeMessageReceivedData{
+			Message: m,
+		},
+	})
+	return nil
+}
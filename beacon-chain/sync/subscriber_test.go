@@ -81,11 +81,12 @@ func TestSubscribe_ReceivesAttesterSlashing(t *testing.T) {
 	r := Service{
 		ctx: ctx,
 		cfg: &Config{
-			P2P:          p2pService,
-			InitialSync:  &mockSync.Sync{IsSyncing: false},
-			SlashingPool: slashings.NewPool(),
-			Chain:        chainService,
-			DB:           d,
+			P2P:               p2pService,
+			InitialSync:       &mockSync.Sync{IsSyncing: false},
+			SlashingPool:      slashings.NewPool(),
+			Chain:             chainService,
+			DB:                d,
+			OperationNotifier: chainService.OperationNotifier(),
 		},
 		seenAttesterSlashingCache: make(map[uint64]bool),
 		chainStarted:              abool.New(),
@@ -135,11 +136,12 @@ func TestSubscribe_ReceivesProposerSlashing(t *testing.T) {
 	r := Service{
 		ctx: ctx,
 		cfg: &Config{
-			P2P:          p2pService,
-			InitialSync:  &mockSync.Sync{IsSyncing: false},
-			SlashingPool: slashings.NewPool(),
-			Chain:        chainService,
-			DB:           d,
+			P2P:               p2pService,
+			InitialSync:       &mockSync.Sync{IsSyncing: false},
+			SlashingPool:      slashings.NewPool(),
+			Chain:             chainService,
+			DB:                d,
+			OperationNotifier: chainService.OperationNotifier(),
 		},
 		seenProposerSlashingCache: c,
 		chainStarted:              abool.New(),
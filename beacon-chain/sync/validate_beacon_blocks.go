@@ -74,6 +74,12 @@ func (s *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 			SignedBlock: blk,
 		},
 	})
+	s.cfg.BlockNotifier.BlockFeed().Send(&feed.Event{
+		Type: blockfeed.ReceivedGossipBlock,
+		Data: &blockfeed.ReceivedBlockData{
+			SignedBlock: blk,
+		},
+	})
 
 	// Verify the block is the first block received for the proposer for the slot.
 	if s.hasSeenBlockIndexSlot(blk.Block().Slot(), blk.Block().ProposerIndex()) {
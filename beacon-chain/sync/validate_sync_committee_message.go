@@ -0,0 +1,113 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"go.opencensus.io/trace"
+)
+
+// Validation
+// - The message's slot is for the current slot, with a MAXIMUM_GOSSIP_CLOCK_DISPARITY allowance.
+// - The block being signed over (sync_committee_message.beacon_block_root) has been seen.
+// - The validator producing the message is part of the sync subcommittee of the subnet the message was received on.
+// - The signature of the message is valid.
+func (s *Service) validateSyncCommitteeMessage(ctx context.Context, pid peer.ID, msg *pubsub.Message) pubsub.ValidationResult {
+	if pid == s.cfg.P2P.PeerID() {
+		return pubsub.ValidationAccept
+	}
+	if s.cfg.InitialSync.Syncing() {
+		return pubsub.ValidationIgnore
+	}
+	ctx, span := trace.StartSpan(ctx, "sync.validateSyncCommitteeMessage")
+	defer span.End()
+
+	if msg.Topic == nil {
+		return pubsub.ValidationReject
+	}
+
+	// Override topic for decoding.
+	originalTopic := msg.Topic
+	format := p2p.GossipTypeMapping[reflect.TypeOf(&prysmv2.SyncCommitteeMessage{})]
+	msg.Topic = &format
+
+	raw, err := s.decodePubsubMessage(msg)
+	if err != nil {
+		log.WithError(err).Debug("Could not decode message")
+		traceutil.AnnotateError(span, err)
+		return pubsub.ValidationReject
+	}
+	// Restore topic.
+	msg.Topic = originalTopic
+
+	m, ok := raw.(*prysmv2.SyncCommitteeMessage)
+	if !ok {
+		return pubsub.ValidationReject
+	}
+
+	genesis := uint64(s.cfg.Chain.GenesisTime().Unix())
+	if err := helpers.VerifySlotTime(genesis, m.Slot, params.BeaconNetworkConfig().MaximumGossipClockDisparity); err != nil {
+		traceutil.AnnotateError(span, err)
+		return pubsub.ValidationIgnore
+	}
+	if m.Slot+1 < s.cfg.Chain.CurrentSlot() {
+		return pubsub.ValidationIgnore
+	}
+	blockRoot := bytesutil.ToBytes32(m.BlockRoot)
+	if !s.cfg.Chain.HasInitSyncBlock(blockRoot) && !s.cfg.DB.HasBlock(ctx, blockRoot) {
+		return pubsub.ValidationIgnore
+	}
+
+	headState, err := s.cfg.Chain.HeadState(ctx)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return pubsub.ValidationIgnore
+	}
+	epoch := helpers.SlotToEpoch(m.Slot)
+	committee, err := helpers.SyncCommitteeForEpoch(headState, epoch)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return pubsub.ValidationIgnore
+	}
+	val, err := headState.ValidatorAtIndexReadOnly(m.ValidatorIndex)
+	if err != nil {
+		return pubsub.ValidationReject
+	}
+	pubkey := val.PublicKey()
+	digest, err := s.forkDigest()
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return pubsub.ValidationIgnore
+	}
+	var onSubnet bool
+	for _, subnet := range helpers.SyncSubnetsFromCommitteeIndices(helpers.SyncCommitteeIndices(committee, pubkey[:])) {
+		if strings.HasPrefix(*originalTopic, fmt.Sprintf(format, digest, subnet)) {
+			onSubnet = true
+			break
+		}
+	}
+	if !onSubnet {
+		return pubsub.ValidationReject
+	}
+
+	signedRoot := p2ptypes.SSZBytes(m.BlockRoot)
+	if err := helpers.ComputeDomainVerifySigningRoot(headState, m.ValidatorIndex, epoch,
+		&signedRoot, params.BeaconConfig().DomainSyncCommittee, m.Signature); err != nil {
+		traceutil.AnnotateError(span, err)
+		return pubsub.ValidationReject
+	}
+
+	msg.ValidatorData = m
+	return pubsub.ValidationAccept
+}
@@ -0,0 +1,129 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/proto/eth/v1alpha1/wrapper"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_validateSyncCommitteeMessage(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig()
+	cfg.AltairForkEpoch = 0
+	params.OverrideBeaconConfig(cfg)
+
+	ctx := context.Background()
+	p := p2ptest.NewTestP2P(t)
+	db := dbtest.SetupDB(t)
+
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = 1
+	require.NoError(t, db.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(blk)))
+	validBlockRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	beaconState, keys := testutil.DeterministicGenesisState(t, 200)
+	require.NoError(t, beaconState.SetSlot(1))
+	st, err := testutil.DeterministicSyncCommitteeState(beaconState)
+	require.NoError(t, err)
+
+	chain := &mockChain.ChainService{
+		// 1 slot ago.
+		Genesis:        time.Now().Add(time.Duration(-1*int64(params.BeaconConfig().SecondsPerSlot)) * time.Second),
+		ValidatorsRoot: [32]byte{'A'},
+		State:          st,
+	}
+	s := &Service{
+		cfg: &Config{
+			InitialSync: &mockSync.Sync{IsSyncing: false},
+			P2P:         p,
+			DB:          db,
+			Chain:       chain,
+		},
+	}
+	digest, err := s.forkDigest()
+	require.NoError(t, err)
+
+	sign := func(idx uint64, root []byte) []byte {
+		signedRoot := p2ptypes.SSZBytes(root)
+		domain, err := helpers.Domain(st.Fork(), 0, params.BeaconConfig().DomainSyncCommittee, st.GenesisValidatorRoot())
+		require.NoError(t, err)
+		signingRoot, err := helpers.ComputeSigningRoot(&signedRoot, domain)
+		require.NoError(t, err)
+		return keys[idx].Sign(signingRoot[:]).Marshal()
+	}
+
+	// Validator 3 holds positions 3, 203 and 403 of the current sync committee, which are in subnets 0, 1 and 3.
+	tests := []struct {
+		name  string
+		msg   *prysmv2.SyncCommitteeMessage
+		topic string
+		want  pubsub.ValidationResult
+	}{
+		{
+			name:  "valid message",
+			msg:   &prysmv2.SyncCommitteeMessage{Slot: 1, BlockRoot: validBlockRoot[:], ValidatorIndex: 3, Signature: sign(3, validBlockRoot[:])},
+			topic: fmt.Sprintf("/eth2/%x/sync_committee_0", digest),
+			want:  pubsub.ValidationAccept,
+		},
+		{
+			name:  "wrong subnet",
+			msg:   &prysmv2.SyncCommitteeMessage{Slot: 1, BlockRoot: validBlockRoot[:], ValidatorIndex: 3, Signature: sign(3, validBlockRoot[:])},
+			topic: fmt.Sprintf("/eth2/%x/sync_committee_2", digest),
+			want:  pubsub.ValidationReject,
+		},
+		{
+			name:  "invalid signature",
+			msg:   &prysmv2.SyncCommitteeMessage{Slot: 1, BlockRoot: validBlockRoot[:], ValidatorIndex: 3, Signature: sign(4, validBlockRoot[:])},
+			topic: fmt.Sprintf("/eth2/%x/sync_committee_0", digest),
+			want:  pubsub.ValidationReject,
+		},
+		{
+			name:  "unknown block",
+			msg:   &prysmv2.SyncCommitteeMessage{Slot: 1, BlockRoot: bytesutil.PadTo([]byte("missing"), 32), ValidatorIndex: 3, Signature: make([]byte, 96)},
+			topic: fmt.Sprintf("/eth2/%x/sync_committee_0", digest),
+			want:  pubsub.ValidationIgnore,
+		},
+		{
+			name:  "future slot",
+			msg:   &prysmv2.SyncCommitteeMessage{Slot: 100, BlockRoot: validBlockRoot[:], ValidatorIndex: 3, Signature: make([]byte, 96)},
+			topic: fmt.Sprintf("/eth2/%x/sync_committee_0", digest),
+			want:  pubsub.ValidationIgnore,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			_, err := p.Encoding().EncodeGossip(buf, tt.msg)
+			require.NoError(t, err)
+			m := &pubsub.Message{
+				Message: &pubsubpb.Message{
+					Data:  buf.Bytes(),
+					Topic: &tt.topic,
+				},
+			}
+			assert.Equal(t, tt.want, s.validateSyncCommitteeMessage(ctx, "" /*peerID*/, m))
+			if tt.want == pubsub.ValidationAccept {
+				assert.NotNil(t, m.ValidatorData, "Expected validator data to be set")
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package db
 
 import (
 	beacondb "github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/tos"
 	"github.com/sirupsen/logrus"
@@ -31,5 +32,20 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name:        "backfill-inclusion-indices",
+			Description: `indexes the attestations of the blocks saved before the inclusion indices were enabled`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				flags.InclusionBackfillStartSlot,
+				flags.InclusionBackfillEndSlot,
+			}),
+			Action: func(cliCtx *cli.Context) error {
+				if err := beacondb.BackfillInclusionIndices(cliCtx); err != nil {
+					log.Fatalf("Could not backfill inclusion indices: %v", err)
+				}
+				return nil
+			},
+		},
 	},
 }
@@ -3,6 +3,9 @@
 package flags
 
 import (
+	"math"
+	"time"
+
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/urfave/cli/v2"
 )
@@ -115,6 +118,76 @@ var (
 		Usage: "The slot durations of when an archived state gets saved in the DB.",
 		Value: 2048,
 	}
+	// StateCacheMemoryBudget specifies the memory budget shared by the in-memory beacon state caches.
+	StateCacheMemoryBudget = &cli.Uint64Flag{
+		Name: "state-cache-memory-budget",
+		Usage: "The estimated memory, in megabytes, that cached beacon states may retain across all state caches. " +
+			"Once exceeded, the most expensive and least recently used states are evicted. 0 means unlimited.",
+		Value: 0,
+	}
+	// HistoricalStateReplayWorkers specifies the number of historical states which may be regenerated at the same time.
+	HistoricalStateReplayWorkers = &cli.IntFlag{
+		Name:  "historical-state-replay-workers",
+		Usage: "The number of historical states requested through the beacon API which may be regenerated by replaying blocks at the same time.",
+		Value: 2,
+	}
+	// HistoricalStateReplayQueueSize specifies the number of historical state requests which may wait for a replay worker.
+	HistoricalStateReplayQueueSize = &cli.IntFlag{
+		Name:  "historical-state-replay-queue-size",
+		Usage: "The number of historical state requests which may wait for a free replay worker. Further requests are answered with 429 Too Many Requests.",
+		Value: 8,
+	}
+	// HistoricalStateReplayMaxSlots specifies the number of slots a single historical state request may replay.
+	HistoricalStateReplayMaxSlots = &cli.Uint64Flag{
+		Name: "historical-state-replay-max-slots",
+		Usage: "The number of slots a single historical state request may replay on top of the closest archived state. " +
+			"Requests exceeding it are answered with 503 Service Unavailable. 0 means unlimited.",
+		Value: 0,
+	}
+	// HistoricalStateReplayTimeout specifies the time budget of a single historical state request.
+	HistoricalStateReplayTimeout = &cli.DurationFlag{
+		Name: "historical-state-replay-timeout",
+		Usage: "The time a single historical state request may spend waiting for and replaying blocks. " +
+			"Requests exceeding it are cancelled and answered with 503 Service Unavailable. 0 means unlimited.",
+		Value: 30 * time.Second,
+	}
+	// HistoricalStateCacheSize specifies the number of recently replayed historical states kept in memory.
+	HistoricalStateCacheSize = &cli.IntFlag{
+		Name:  "historical-state-cache-size",
+		Usage: "The number of recently replayed finalized states kept in memory to serve repeated historical state requests.",
+		Value: 4,
+	}
+	// EventStreamReplayBufferSize specifies the number of recent events kept for resuming event streams.
+	EventStreamReplayBufferSize = &cli.IntFlag{
+		Name: "event-stream-replay-buffer-size",
+		Usage: "The number of recent events kept in memory, which event stream subscribers reconnecting " +
+			"with a Last-Event-ID receive before new events. 0 disables resuming streams.",
+		Value: 1000,
+	}
+	// APIAuthConfigFile specifies the file listing the credentials accepted by the beacon node APIs.
+	APIAuthConfigFile = &cli.StringFlag{
+		Name: "api-auth-config-file",
+		Usage: "A YAML file listing the API keys or bearer tokens which are accepted by the gRPC and REST APIs, along " +
+			"with the gRPC methods and REST path prefixes each may call and its request rate. Calls without a valid " +
+			"token are rejected when set. Validator clients can pass a token with --grpc-headers=authorization=Bearer <token>.",
+	}
+	// EnableInclusionIndices indexes the attestations of saved blocks for the inclusion explorer API.
+	EnableInclusionIndices = &cli.BoolFlag{
+		Name: "enable-inclusion-indices",
+		Usage: "Indexes the attestations of blocks as they are saved, to look up the blocks which included an " +
+			"attestation. Blocks saved before enabling the indices can be indexed with the db backfill-inclusion-indices command.",
+	}
+	// InclusionBackfillStartSlot specifies the first slot indexed by the inclusion indices backfill.
+	InclusionBackfillStartSlot = &cli.Uint64Flag{
+		Name:  "start-slot",
+		Usage: "The first slot of the blocks to index.",
+	}
+	// InclusionBackfillEndSlot specifies the last slot indexed by the inclusion indices backfill.
+	InclusionBackfillEndSlot = &cli.Uint64Flag{
+		Name:  "end-slot",
+		Usage: "The last slot of the blocks to index. Defaults to the highest slot of a saved block.",
+		Value: math.MaxUint64,
+	}
 	// DisableDiscv5 disables running discv5.
 	DisableDiscv5 = &cli.BoolFlag{
 		Name:  "disable-discv5",
@@ -54,6 +54,15 @@ var appFlags = []cli.Flag{
 	flags.InteropNumValidatorsFlag,
 	flags.InteropGenesisTimeFlag,
 	flags.SlotsPerArchivedPoint,
+	flags.StateCacheMemoryBudget,
+	flags.HistoricalStateReplayWorkers,
+	flags.HistoricalStateReplayQueueSize,
+	flags.HistoricalStateReplayMaxSlots,
+	flags.HistoricalStateReplayTimeout,
+	flags.HistoricalStateCacheSize,
+	flags.EventStreamReplayBufferSize,
+	flags.APIAuthConfigFile,
+	flags.EnableInclusionIndices,
 	flags.EnableDebugRPCEndpoints,
 	flags.SubscribeToAllSubnets,
 	flags.HistoricalSlasherNode,
@@ -106,6 +115,7 @@ var appFlags = []cli.Flag{
 	cmd.EnableUPnPFlag,
 	cmd.ConfigFileFlag,
 	cmd.ChainConfigFileFlag,
+	cmd.NetworkDirFlag,
 	cmd.GrpcMaxCallRecvMsgSizeFlag,
 	cmd.AcceptTosFlag,
 	cmd.RestoreSourceFileFlag,
@@ -68,6 +68,7 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.ClearDB,
 			cmd.ConfigFileFlag,
 			cmd.ChainConfigFileFlag,
+			cmd.NetworkDirFlag,
 			cmd.GrpcMaxCallRecvMsgSizeFlag,
 			cmd.AcceptTosFlag,
 			cmd.RestoreSourceFileFlag,
@@ -110,6 +111,15 @@ var appHelpFlagGroups = []flagGroup{
 			flags.HeadSync,
 			flags.DisableSync,
 			flags.SlotsPerArchivedPoint,
+			flags.StateCacheMemoryBudget,
+			flags.HistoricalStateReplayWorkers,
+			flags.HistoricalStateReplayQueueSize,
+			flags.HistoricalStateReplayMaxSlots,
+			flags.HistoricalStateReplayTimeout,
+			flags.HistoricalStateCacheSize,
+			flags.EventStreamReplayBufferSize,
+			flags.APIAuthConfigFile,
+			flags.EnableInclusionIndices,
 			flags.DisableDiscv5,
 			flags.BlockBatchLimit,
 			flags.BlockBatchLimitBurstFactor,
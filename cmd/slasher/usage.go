@@ -63,6 +63,7 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.ForceClearDB,
 			cmd.ClearDB,
 			cmd.ConfigFileFlag,
+			cmd.NetworkDirFlag,
 			cmd.AcceptTosFlag,
 		},
 	},
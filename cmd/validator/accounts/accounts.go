@@ -114,6 +114,45 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name: "export",
+			Description: "export accounts of a derived (HD) wallet into EIP-2335 compliant keystore.json files " +
+				"along with their EIP-3076 slashing protection history, to move them to another machine or a remote " +
+				"signer. The keys are derived again from the mnemonic of the wallet, and accounts to export can " +
+				"be specified by their index with --export-indices or by their public key with --export-public-keys",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.WalletDirFlag,
+				flags.WalletPasswordFileFlag,
+				flags.MnemonicFileFlag,
+				flags.Mnemonic25thWordFileFlag,
+				flags.SkipMnemonic25thWordCheckFlag,
+				flags.ExportDirFlag,
+				flags.ExportIndicesFlag,
+				flags.ExportPublicKeysFlag,
+				flags.ExportPasswordFileFlag,
+				flags.DisableExportedKeysFlag,
+				flags.KeyConfigFileFlag,
+				cmd.DataDirFlag,
+				featureconfig.Mainnet,
+				featureconfig.PyrmontTestnet,
+				featureconfig.ToledoTestnet,
+				featureconfig.PraterTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				featureconfig.ConfigureValidator(cliCtx)
+				if err := accounts.ExportAccountsCli(cliCtx); err != nil {
+					log.Fatalf("Could not export accounts: %v", err)
+				}
+				return nil
+			},
+		},
 		{
 			Name:        "import",
 			Description: `imports Ethereum validator accounts stored in EIP-2335 keystore.json files from an external directory`,
@@ -158,6 +197,7 @@ var Commands = &cli.Command{
 				flags.GrpcRetriesFlag,
 				flags.GrpcRetryDelayFlag,
 				flags.ExitAllFlag,
+				flags.ExitEpochFlag,
 				featureconfig.Mainnet,
 				featureconfig.PyrmontTestnet,
 				featureconfig.ToledoTestnet,
@@ -31,6 +31,35 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name:        "check",
+			Description: `checks the slashing protection history in the database for inconsistencies`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				cmd.RepairDBFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := validatordb.Check(cliCtx); err != nil {
+					log.Fatalf("Database check failed: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:        "compact",
+			Description: `prunes the slashing protection history to a safe horizon and rewrites the database file, keeping the original file as validator.db.bak`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := validatordb.Compact(cliCtx); err != nil {
+					log.Fatalf("Could not compact database: %v", err)
+				}
+				return nil
+			},
+		},
 		{
 			Name:     "migrate",
 			Category: "db",
@@ -28,10 +28,17 @@ var (
 	}
 	// BeaconRPCProviderFlag defines a beacon node RPC endpoint.
 	BeaconRPCProviderFlag = &cli.StringFlag{
-		Name:  "beacon-rpc-provider",
-		Usage: "Beacon node RPC provider endpoint",
+		Name: "beacon-rpc-provider",
+		Usage: "Beacon node RPC provider endpoint. A comma separated list of endpoints can be given, in which case " +
+			"the healthiest beacon node is used, with failover to the others",
 		Value: "127.0.0.1:4000",
 	}
+	// BeaconRPCBroadcastFlag enables broadcasting signed objects to all beacon nodes.
+	BeaconRPCBroadcastFlag = &cli.BoolFlag{
+		Name: "beacon-rpc-broadcast",
+		Usage: "Submits signed blocks, attestations and aggregates to every healthy beacon node listed in " +
+			"--beacon-rpc-provider, instead of only the healthiest one",
+	}
 	// BeaconRPCGatewayProviderFlag defines a beacon node JSON-RPC endpoint.
 	BeaconRPCGatewayProviderFlag = &cli.StringFlag{
 		Name:  "beacon-rpc-gateway-provider",
@@ -212,6 +219,12 @@ var (
 		Name:  "exit-all",
 		Usage: "Exit all validators. This will still require the staker to confirm a prompt for the action",
 	}
+	// ExitEpochFlag schedules the voluntary exits for an epoch instead of submitting them immediately.
+	ExitEpochFlag = &cli.Uint64Flag{
+		Name: "exit-epoch",
+		Usage: "Sign the voluntary exits for the given epoch and schedule them, instead of submitting them immediately. " +
+			"A running validator client with the same wallet submits the exits once the epoch is reached",
+	}
 	// BackupPasswordFile for encrypting accounts a user wishes to back up.
 	BackupPasswordFile = &cli.StringFlag{
 		Name:  "backup-password-file",
@@ -224,11 +237,64 @@ var (
 		Usage: "Path to a directory where accounts will be backed up into a zip file",
 		Value: DefaultValidatorDir(),
 	}
+	// ExportPublicKeysFlag defines a comma-separated list of hex string public keys
+	// for accounts which a user desires to export from their derived wallet.
+	ExportPublicKeysFlag = &cli.StringFlag{
+		Name:  "export-public-keys",
+		Usage: "Comma-separated list of public key hex strings to specify which validator accounts to export",
+		Value: "",
+	}
+	// ExportIndicesFlag defines a comma-separated list of account indices to export from a derived wallet.
+	ExportIndicesFlag = &cli.StringFlag{
+		Name: "export-indices",
+		Usage: "Comma-separated list of account indices to export, the index being the account_index of the " +
+			"EIP-2334 derivation path m/12381/3600/account_index/0/0",
+		Value: "",
+	}
+	// ExportPasswordFileFlag for encrypting accounts a user wishes to export.
+	ExportPasswordFileFlag = &cli.StringFlag{
+		Name:  "export-password-file",
+		Usage: "Path to a plain-text, .txt file containing the desired password for your exported keystores",
+		Value: "",
+	}
+	// ExportDirFlag defines the path of the directory the keystores are exported to.
+	ExportDirFlag = &cli.StringFlag{
+		Name:  "export-dir",
+		Usage: "Path to a directory where the keystores and slashing protection history of the accounts will be exported",
+		Value: DefaultValidatorDir(),
+	}
+	// DisableExportedKeysFlag disables the exported accounts in the key configuration file.
+	DisableExportedKeysFlag = &cli.BoolFlag{
+		Name: "disable-exported-keys",
+		Usage: "Disable the exported accounts in the file given by --key-config-file, so that a validator client " +
+			"running with the wallet stops performing duties with them",
+	}
 	// SlashingProtectionJSONFileFlag is used to enter the file path of the slashing protection JSON.
 	SlashingProtectionJSONFileFlag = &cli.StringFlag{
 		Name:  "slashing-protection-json-file",
 		Usage: "Path to an EIP-3076 compliant JSON file containing a user's slashing protection history",
 	}
+	// SlashingProtectionJSONFilesFlag is used to enter the file paths of several slashing protection JSON files.
+	SlashingProtectionJSONFilesFlag = &cli.StringFlag{
+		Name:  "slashing-protection-json-files",
+		Usage: "Comma-separated list of paths to EIP-3076 compliant JSON files to merge",
+	}
+	// SlashingProtectionOutputFileFlag is used to enter the file path of a slashing protection JSON to write.
+	SlashingProtectionOutputFileFlag = &cli.StringFlag{
+		Name:  "slashing-protection-output-file",
+		Usage: "Path of the EIP-3076 compliant JSON file to write",
+	}
+	// SlashingProtectionPublicKeysFlag restricts slashing protection histories to the given public keys.
+	SlashingProtectionPublicKeysFlag = &cli.StringFlag{
+		Name:  "slashing-protection-public-keys",
+		Usage: "Comma-separated list of public key hex strings whose slashing protection history should be kept",
+	}
+	// SlashingProtectionMinimalFlag reduces slashing protection histories to a single record per public key.
+	SlashingProtectionMinimalFlag = &cli.BoolFlag{
+		Name: "slashing-protection-minimal",
+		Usage: "Reduce the slashing protection history of each public key to a single attestation at the " +
+			"highest source and target epochs and a single block at the highest slot",
+	}
 	// KeysDirFlag defines the path for a directory where keystores to be imported at stored.
 	KeysDirFlag = &cli.StringFlag{
 		Name:  "keys-dir",
@@ -297,12 +363,89 @@ var (
 		Name:  "graffiti-file",
 		Usage: "The path to a YAML file with graffiti values",
 	}
+	// KeyConfigFileFlag specifies the file path of the per key configuration.
+	KeyConfigFileFlag = &cli.StringFlag{
+		Name: "key-config-file",
+		Usage: "The path to a YAML or JSON file with settings for individual validating keys, such as their graffiti " +
+			"or whether they perform duties. The file is watched for changes and can be edited through the validator API",
+	}
+	// ObserverKeysFlag runs the validator client in observer mode for the listed public keys.
+	ObserverKeysFlag = &cli.StringFlag{
+		Name: "observer-keys",
+		Usage: "Path or http(s) URL of a list of validating public keys to follow in observer mode, instead of the keys " +
+			"of a wallet. The validator client tracks the duties of the keys and reports whether the beacon chain includes " +
+			"them, without holding secret keys or signing anything",
+	}
 	// EnableDutyCountDown enables more verbose logging for counting down to duty.
 	EnableDutyCountDown = &cli.BoolFlag{
 		Name:  "enable-duty-count-down",
 		Usage: "Enables more verbose logging for counting down to duty",
 		Value: false,
 	}
+	// DoppelGangerEpochsFlag defines how many epochs the duties of a newly activated key are held back.
+	DoppelGangerEpochsFlag = &cli.Uint64Flag{
+		Name: "doppelganger-epochs",
+		Usage: "The number of epochs the liveness of a newly activated or added key is tracked before it " +
+			"performs its duties. Only used with --enable-doppelganger",
+		Value: 2,
+	}
+	// DoppelGangerDisableKeyFlag disables only the affected key when a doppelganger is detected.
+	DoppelGangerDisableKeyFlag = &cli.BoolFlag{
+		Name: "doppelganger-disable-key",
+		Usage: "Disables only the affected key when another instance of it is detected, " +
+			"instead of shutting down the validator client",
+	}
+	// SlashingProtectionRemoteFlag defines the endpoint of a slashing protection server shared
+	// by several validator clients.
+	SlashingProtectionRemoteFlag = &cli.StringFlag{
+		Name: "slashing-protection-remote",
+		Usage: "Host:port of a slashing protection server keeping the slashing protection history. " +
+			"The validator client refuses to sign if the server cannot be reached",
+	}
+	// SlashingProtectionRemoteCertFlag defines the TLS certificate of the slashing protection server.
+	SlashingProtectionRemoteCertFlag = &cli.StringFlag{
+		Name:  "slashing-protection-remote-cert",
+		Usage: "Certificate for a secure gRPC connection to the slashing protection server",
+	}
+	// SlashingProtectionTokenFileFlag defines the file containing the API token for the slashing protection server.
+	SlashingProtectionTokenFileFlag = &cli.StringFlag{
+		Name:  "slashing-protection-token-file",
+		Usage: "Path to a file containing the API token used to authenticate to the slashing protection server",
+	}
+	// SlashingProtectionHostFlag defines the host the slashing protection server listens on.
+	SlashingProtectionHostFlag = &cli.StringFlag{
+		Name:  "slashing-protection-host",
+		Usage: "Host on which the slashing protection server should listen",
+		Value: "127.0.0.1",
+	}
+	// SlashingProtectionPortFlag defines the port the slashing protection server listens on.
+	SlashingProtectionPortFlag = &cli.IntFlag{
+		Name:  "slashing-protection-port",
+		Usage: "Port on which the slashing protection server should listen",
+		Value: 7600,
+	}
+	// SlashingProtectionTLSCertFlag defines the TLS certificate of the slashing protection server.
+	SlashingProtectionTLSCertFlag = &cli.StringFlag{
+		Name:  "slashing-protection-tls-cert",
+		Usage: "Certificate for the slashing protection server. Pass this and the tls-key flag in order to use gRPC securely",
+	}
+	// SlashingProtectionTLSKeyFlag defines the TLS key of the slashing protection server.
+	SlashingProtectionTLSKeyFlag = &cli.StringFlag{
+		Name:  "slashing-protection-tls-key",
+		Usage: "Key for the slashing protection server. Pass this and the tls-cert flag in order to use gRPC securely",
+	}
+	// SlashingProtectionAuthConfigFlag defines the API token configuration of the slashing protection server.
+	SlashingProtectionAuthConfigFlag = &cli.StringFlag{
+		Name: "slashing-protection-auth-config",
+		Usage: "Path to a YAML file with the API tokens allowed to use the slashing protection server " +
+			"and the gRPC methods each of them may call",
+	}
+	// SlashingProtectionInsecureNoAuthFlag allows the slashing protection server to run without API tokens.
+	SlashingProtectionInsecureNoAuthFlag = &cli.BoolFlag{
+		Name: "slashing-protection-insecure-no-auth",
+		Usage: "Runs the slashing protection server without an API token configuration, letting any client " +
+			"reaching it sign requests on behalf of the protected keys",
+	}
 )
 
 // DefaultValidatorDir returns OS-specific default validator directory.
@@ -47,6 +47,7 @@ func startNode(ctx *cli.Context) error {
 
 var appFlags = []cli.Flag{
 	flags.BeaconRPCProviderFlag,
+	flags.BeaconRPCBroadcastFlag,
 	flags.BeaconRPCGatewayProviderFlag,
 	flags.CertFlag,
 	flags.GraffitiFlag,
@@ -72,7 +73,14 @@ var appFlags = []cli.Flag{
 	flags.WalletDirFlag,
 	flags.EnableWebFlag,
 	flags.GraffitiFileFlag,
+	flags.KeyConfigFileFlag,
+	flags.ObserverKeysFlag,
 	flags.EnableDutyCountDown,
+	flags.DoppelGangerEpochsFlag,
+	flags.DoppelGangerDisableKeyFlag,
+	flags.SlashingProtectionRemoteFlag,
+	flags.SlashingProtectionRemoteCertFlag,
+	flags.SlashingProtectionTokenFileFlag,
 	cmd.BackupWebhookOutputDir,
 	cmd.EnableBackupWebhookFlag,
 	cmd.MinimalConfigFlag,
@@ -89,6 +97,7 @@ var appFlags = []cli.Flag{
 	cmd.LogFileName,
 	cmd.ConfigFileFlag,
 	cmd.ChainConfigFileFlag,
+	cmd.NetworkDirFlag,
 	cmd.GrpcMaxCallRecvMsgSizeFlag,
 	cmd.BoltMMapInitialSizeFlag,
 	debug.PProfFlag,
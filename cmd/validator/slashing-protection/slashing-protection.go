@@ -21,6 +21,9 @@ var Commands = &cli.Command{
 			Flags: cmd.WrapFlags([]cli.Flag{
 				cmd.DataDirFlag,
 				flags.SlashingProtectionExportDirFlag,
+				flags.SlashingProtectionRemoteFlag,
+				flags.SlashingProtectionRemoteCertFlag,
+				flags.SlashingProtectionTokenFileFlag,
 			}),
 			Before: func(cliCtx *cli.Context) error {
 				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
@@ -39,6 +42,10 @@ var Commands = &cli.Command{
 			Flags: cmd.WrapFlags([]cli.Flag{
 				cmd.DataDirFlag,
 				flags.SlashingProtectionJSONFileFlag,
+				flags.SlashingProtectionMinimalFlag,
+				flags.SlashingProtectionRemoteFlag,
+				flags.SlashingProtectionRemoteCertFlag,
+				flags.SlashingProtectionTokenFileFlag,
 				featureconfig.Mainnet,
 				featureconfig.PyrmontTestnet,
 				featureconfig.ToledoTestnet,
@@ -56,5 +63,51 @@ var Commands = &cli.Command{
 				return slashingprotection.ImportSlashingProtectionCLI(cliCtx)
 			},
 		},
+		{
+			Name: "merge",
+			Description: `merges EIP-3076 compliant slashing protection JSON files from the same chain into one, ` +
+				`optionally keeping only some public keys and reducing each history to a single record`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.SlashingProtectionJSONFilesFlag,
+				flags.SlashingProtectionOutputFileFlag,
+				flags.SlashingProtectionPublicKeysFlag,
+				flags.SlashingProtectionMinimalFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				return cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				return slashingprotection.MergeSlashingProtectionJSONCli(cliCtx)
+			},
+		},
+		{
+			Name: "serve",
+			Description: `runs a slashing protection server over the validator database in the datadir, ` +
+				`which can be shared by several validator clients using --slashing-protection-remote`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				flags.SlashingProtectionHostFlag,
+				flags.SlashingProtectionPortFlag,
+				flags.SlashingProtectionTLSCertFlag,
+				flags.SlashingProtectionTLSKeyFlag,
+				flags.SlashingProtectionAuthConfigFlag,
+				flags.SlashingProtectionInsecureNoAuthFlag,
+				featureconfig.Mainnet,
+				featureconfig.PyrmontTestnet,
+				featureconfig.ToledoTestnet,
+				featureconfig.PraterTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				featureconfig.ConfigureValidator(cliCtx)
+				return slashingprotection.ServeSlashingProtectionCli(cliCtx)
+			},
+		},
 	},
 }
@@ -63,6 +63,7 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.LogFileName,
 			cmd.ConfigFileFlag,
 			cmd.ChainConfigFileFlag,
+			cmd.NetworkDirFlag,
 			cmd.GrpcMaxCallRecvMsgSizeFlag,
 			cmd.AcceptTosFlag,
 			cmd.BoltMMapInitialSizeFlag,
@@ -85,6 +86,7 @@ var appHelpFlagGroups = []flagGroup{
 		Name: "validator",
 		Flags: []cli.Flag{
 			flags.BeaconRPCProviderFlag,
+			flags.BeaconRPCBroadcastFlag,
 			flags.BeaconRPCGatewayProviderFlag,
 			flags.CertFlag,
 			flags.EnableWebFlag,
@@ -105,7 +107,14 @@ var appHelpFlagGroups = []flagGroup{
 			flags.WalletDirFlag,
 			flags.WalletPasswordFileFlag,
 			flags.GraffitiFileFlag,
+			flags.KeyConfigFileFlag,
+			flags.ObserverKeysFlag,
 			flags.EnableDutyCountDown,
+			flags.DoppelGangerEpochsFlag,
+			flags.DoppelGangerDisableKeyFlag,
+			flags.SlashingProtectionRemoteFlag,
+			flags.SlashingProtectionRemoteCertFlag,
+			flags.SlashingProtectionTokenFileFlag,
 		},
 	},
 	{
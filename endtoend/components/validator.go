@@ -147,6 +147,8 @@ func (v *ValidatorNode) Start(ctx context.Context) error {
 	// Only apply e2e flags to the current branch. New flags may not exist in previous release.
 	if !v.config.UsePrysmShValidator {
 		args = append(args, featureconfig.E2EValidatorFlags...)
+		// Keys are not held back by doppelganger protection, so that they attest from genesis.
+		args = append(args, fmt.Sprintf("--%s=0", flags.DoppelGangerEpochsFlag.Name))
 	}
 	args = append(args, config.ValidatorFlags...)
 
@@ -12,7 +12,7 @@ import (
 	sync "sync"
 
 	proto "github.com/golang/protobuf/proto"
-	_ "github.com/golang/protobuf/ptypes/empty"
+	empty "github.com/golang/protobuf/ptypes/empty"
 	github_com_prysmaticlabs_eth2_types "github.com/prysmaticlabs/eth2-types"
 	_ "github.com/prysmaticlabs/prysm/proto/eth/ext"
 	v1alpha1 "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
@@ -35,6 +35,61 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+type DetectedSlashing_Status int32
+
+const (
+	DetectedSlashing_UNKNOWN DetectedSlashing_Status = 0
+	// The slashing was not included on chain yet.
+	DetectedSlashing_ACTIVE DetectedSlashing_Status = 1
+	// The slashing was included in a block.
+	DetectedSlashing_INCLUDED DetectedSlashing_Status = 2
+	// The block including the slashing was reverted.
+	DetectedSlashing_REVERTED DetectedSlashing_Status = 3
+)
+
+// Enum value maps for DetectedSlashing_Status.
+var (
+	DetectedSlashing_Status_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "ACTIVE",
+		2: "INCLUDED",
+		3: "REVERTED",
+	}
+	DetectedSlashing_Status_value = map[string]int32{
+		"UNKNOWN":  0,
+		"ACTIVE":   1,
+		"INCLUDED": 2,
+		"REVERTED": 3,
+	}
+)
+
+func (x DetectedSlashing_Status) Enum() *DetectedSlashing_Status {
+	p := new(DetectedSlashing_Status)
+	*p = x
+	return p
+}
+
+func (x DetectedSlashing_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DetectedSlashing_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_beacon_rpc_v1_slasher_proto_enumTypes[0].Descriptor()
+}
+
+func (DetectedSlashing_Status) Type() protoreflect.EnumType {
+	return &file_proto_beacon_rpc_v1_slasher_proto_enumTypes[0]
+}
+
+func (x DetectedSlashing_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DetectedSlashing_Status.Descriptor instead.
+func (DetectedSlashing_Status) EnumDescriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_slasher_proto_rawDescGZIP(), []int{8, 0}
+}
+
 type AttesterSlashingResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -286,6 +341,381 @@ func (x *HighestAttestation) GetHighestTargetEpoch() github_com_prysmaticlabs_et
 	return github_com_prysmaticlabs_eth2_types.Epoch(0)
 }
 
+type StreamSlashingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only stream the slashings of these validators. All slashings are streamed if empty.
+	ValidatorIndices []github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,1,rep,packed,name=validator_indices,json=validatorIndices,proto3" json:"validator_indices,omitempty" cast-type:"github.com/prysmaticlabs/eth2-types.ValidatorIndex"`
+}
+
+func (x *StreamSlashingsRequest) Reset() {
+	*x = StreamSlashingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_slasher_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamSlashingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamSlashingsRequest) ProtoMessage() {}
+
+func (x *StreamSlashingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_slasher_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamSlashingsRequest.ProtoReflect.Descriptor instead.
+func (*StreamSlashingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_slasher_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StreamSlashingsRequest) GetValidatorIndices() []github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if x != nil {
+		return x.ValidatorIndices
+	}
+	return []github_com_prysmaticlabs_eth2_types.ValidatorIndex(nil)
+}
+
+type ListSlashingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to QueryFilter:
+	//	*ListSlashingsRequest_Epoch
+	QueryFilter isListSlashingsRequest_QueryFilter `protobuf_oneof:"query_filter"`
+	// Only return the slashings of these validators. Slashings of every validator are returned if empty.
+	ValidatorIndices []github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,2,rep,packed,name=validator_indices,json=validatorIndices,proto3" json:"validator_indices,omitempty" cast-type:"github.com/prysmaticlabs/eth2-types.ValidatorIndex"`
+}
+
+func (x *ListSlashingsRequest) Reset() {
+	*x = ListSlashingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_slasher_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSlashingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSlashingsRequest) ProtoMessage() {}
+
+func (x *ListSlashingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_slasher_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSlashingsRequest.ProtoReflect.Descriptor instead.
+func (*ListSlashingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_slasher_proto_rawDescGZIP(), []int{6}
+}
+
+func (m *ListSlashingsRequest) GetQueryFilter() isListSlashingsRequest_QueryFilter {
+	if m != nil {
+		return m.QueryFilter
+	}
+	return nil
+}
+
+func (x *ListSlashingsRequest) GetEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if x, ok := x.GetQueryFilter().(*ListSlashingsRequest_Epoch); ok {
+		return x.Epoch
+	}
+	return github_com_prysmaticlabs_eth2_types.Epoch(0)
+}
+
+func (x *ListSlashingsRequest) GetValidatorIndices() []github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if x != nil {
+		return x.ValidatorIndices
+	}
+	return []github_com_prysmaticlabs_eth2_types.ValidatorIndex(nil)
+}
+
+type isListSlashingsRequest_QueryFilter interface {
+	isListSlashingsRequest_QueryFilter()
+}
+
+type ListSlashingsRequest_Epoch struct {
+	// Epoch of the offences to return slashings for. Slashings of every epoch are returned if unset.
+	Epoch github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,1,opt,name=epoch,proto3,oneof" cast-type:"github.com/prysmaticlabs/eth2-types.Epoch"`
+}
+
+func (*ListSlashingsRequest_Epoch) isListSlashingsRequest_QueryFilter() {}
+
+type ListSlashingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slashings []*DetectedSlashing `protobuf:"bytes,1,rep,name=slashings,proto3" json:"slashings,omitempty"`
+}
+
+func (x *ListSlashingsResponse) Reset() {
+	*x = ListSlashingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_slasher_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSlashingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSlashingsResponse) ProtoMessage() {}
+
+func (x *ListSlashingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_slasher_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSlashingsResponse.ProtoReflect.Descriptor instead.
+func (*ListSlashingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_slasher_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListSlashingsResponse) GetSlashings() []*DetectedSlashing {
+	if x != nil {
+		return x.Slashings
+	}
+	return nil
+}
+
+// DetectedSlashing is a slashing detected by slasher, along with the evidence of the offence.
+type DetectedSlashing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Evidence of a double vote or surround vote. Unset for proposer slashings.
+	AttesterSlashing *v1alpha1.AttesterSlashing `protobuf:"bytes,1,opt,name=attester_slashing,json=attesterSlashing,proto3" json:"attester_slashing,omitempty"`
+	// Evidence of a double proposal. Unset for attester slashings.
+	ProposerSlashing *v1alpha1.ProposerSlashing `protobuf:"bytes,2,opt,name=proposer_slashing,json=proposerSlashing,proto3" json:"proposer_slashing,omitempty"`
+	// Indices of the validators who committed the offence.
+	OffenderIndices []github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,3,rep,packed,name=offender_indices,json=offenderIndices,proto3" json:"offender_indices,omitempty" cast-type:"github.com/prysmaticlabs/eth2-types.ValidatorIndex"`
+	// Epoch of the offence: the highest target epoch of the attestations, or the epoch of the proposals.
+	Epoch  github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,4,opt,name=epoch,proto3" json:"epoch,omitempty" cast-type:"github.com/prysmaticlabs/eth2-types.Epoch"`
+	Status DetectedSlashing_Status                   `protobuf:"varint,5,opt,name=status,proto3,enum=ethereum.beacon.rpc.v1.DetectedSlashing_Status" json:"status,omitempty"`
+}
+
+func (x *DetectedSlashing) Reset() {
+	*x = DetectedSlashing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_slasher_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DetectedSlashing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectedSlashing) ProtoMessage() {}
+
+func (x *DetectedSlashing) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_slasher_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectedSlashing.ProtoReflect.Descriptor instead.
+func (*DetectedSlashing) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_slasher_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DetectedSlashing) GetAttesterSlashing() *v1alpha1.AttesterSlashing {
+	if x != nil {
+		return x.AttesterSlashing
+	}
+	return nil
+}
+
+func (x *DetectedSlashing) GetProposerSlashing() *v1alpha1.ProposerSlashing {
+	if x != nil {
+		return x.ProposerSlashing
+	}
+	return nil
+}
+
+func (x *DetectedSlashing) GetOffenderIndices() []github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if x != nil {
+		return x.OffenderIndices
+	}
+	return []github_com_prysmaticlabs_eth2_types.ValidatorIndex(nil)
+}
+
+func (x *DetectedSlashing) GetEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if x != nil {
+		return x.Epoch
+	}
+	return github_com_prysmaticlabs_eth2_types.Epoch(0)
+}
+
+func (x *DetectedSlashing) GetStatus() DetectedSlashing_Status {
+	if x != nil {
+		return x.Status
+	}
+	return DetectedSlashing_UNKNOWN
+}
+
+type HistoricalDetectionStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Status of the detection service: Started, Syncing, HistoricalDetection or Ready.
+	DetectionStatus string `protobuf:"bytes,1,opt,name=detection_status,json=detectionStatus,proto3" json:"detection_status,omitempty"`
+	// Whether detection on historical chain data is enabled.
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Whether detection on historical chain data completed.
+	Completed bool `protobuf:"varint,3,opt,name=completed,proto3" json:"completed,omitempty"`
+	// First epoch of the historical chain data, the chain head stored by a previous run.
+	StartEpoch github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,4,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty" cast-type:"github.com/prysmaticlabs/eth2-types.Epoch"`
+	// Next epoch to run detection on. Detection ran on the epochs from start_epoch up to this epoch.
+	NextEpoch github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,5,opt,name=next_epoch,json=nextEpoch,proto3" json:"next_epoch,omitempty" cast-type:"github.com/prysmaticlabs/eth2-types.Epoch"`
+	// Head epoch of the beacon node, up to which detection runs.
+	HeadEpoch github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,6,opt,name=head_epoch,json=headEpoch,proto3" json:"head_epoch,omitempty" cast-type:"github.com/prysmaticlabs/eth2-types.Epoch"`
+	// Number of attestations processed.
+	AttestationsProcessed uint64 `protobuf:"varint,7,opt,name=attestations_processed,json=attestationsProcessed,proto3" json:"attestations_processed,omitempty"`
+	// Number of attester slashings detected.
+	SlashingsDetected uint64 `protobuf:"varint,8,opt,name=slashings_detected,json=slashingsDetected,proto3" json:"slashings_detected,omitempty"`
+	// Error which stopped detection on historical chain data, if any.
+	Error string `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *HistoricalDetectionStatusResponse) Reset() {
+	*x = HistoricalDetectionStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_slasher_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HistoricalDetectionStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoricalDetectionStatusResponse) ProtoMessage() {}
+
+func (x *HistoricalDetectionStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_slasher_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoricalDetectionStatusResponse.ProtoReflect.Descriptor instead.
+func (*HistoricalDetectionStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_slasher_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *HistoricalDetectionStatusResponse) GetDetectionStatus() string {
+	if x != nil {
+		return x.DetectionStatus
+	}
+	return ""
+}
+
+func (x *HistoricalDetectionStatusResponse) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *HistoricalDetectionStatusResponse) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *HistoricalDetectionStatusResponse) GetStartEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if x != nil {
+		return x.StartEpoch
+	}
+	return github_com_prysmaticlabs_eth2_types.Epoch(0)
+}
+
+func (x *HistoricalDetectionStatusResponse) GetNextEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if x != nil {
+		return x.NextEpoch
+	}
+	return github_com_prysmaticlabs_eth2_types.Epoch(0)
+}
+
+func (x *HistoricalDetectionStatusResponse) GetHeadEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if x != nil {
+		return x.HeadEpoch
+	}
+	return github_com_prysmaticlabs_eth2_types.Epoch(0)
+}
+
+func (x *HistoricalDetectionStatusResponse) GetAttestationsProcessed() uint64 {
+	if x != nil {
+		return x.AttestationsProcessed
+	}
+	return 0
+}
+
+func (x *HistoricalDetectionStatusResponse) GetSlashingsDetected() uint64 {
+	if x != nil {
+		return x.SlashingsDetected
+	}
+	return 0
+}
+
+func (x *HistoricalDetectionStatusResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 var File_proto_beacon_rpc_v1_slasher_proto protoreflect.FileDescriptor
 
 var file_proto_beacon_rpc_v1_slasher_proto_rawDesc = []byte{
@@ -342,41 +772,160 @@ var file_proto_beacon_rpc_v1_slasher_proto_rawDesc = []byte{
 	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c,
 	0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45,
 	0x70, 0x6f, 0x63, 0x68, 0x52, 0x12, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x54, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x32, 0x94, 0x04, 0x0a, 0x07, 0x53, 0x6c, 0x61,
-	0x73, 0x68, 0x65, 0x72, 0x12, 0xae, 0x01, 0x0a, 0x16, 0x49, 0x73, 0x53, 0x6c, 0x61, 0x73, 0x68,
-	0x61, 0x62, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x29, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x41,
-	0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x30, 0x2e, 0x65, 0x74, 0x68,
+	0x67, 0x65, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x7d, 0x0a, 0x16, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x63, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f,
+	0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x42, 0x36, 0x82,
+	0xb5, 0x18, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72,
+	0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32,
+	0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x49, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x22, 0xd2, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x45, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x2d, 0x82, 0xb5, 0x18, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74,
+	0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x48, 0x00,
+	0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x63, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x04, 0x42, 0x36, 0x82, 0xb5, 0x18, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73,
+	0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x42, 0x0e, 0x0a, 0x0c,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x5f, 0x0a, 0x15,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x09, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e,
+	0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72,
+	0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69,
+	0x6e, 0x67, 0x52, 0x09, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xee, 0x03,
+	0x0a, 0x10, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69,
+	0x6e, 0x67, 0x12, 0x54, 0x0a, 0x11, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73,
+	0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x53, 0x6c,
+	0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72,
+	0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x54, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65,
+	0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x65, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x61,
+	0x0a, 0x10, 0x6f, 0x66, 0x66, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x69, 0x63,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x42, 0x36, 0x82, 0xb5, 0x18, 0x32, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74,
+	0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x52, 0x0f, 0x6f, 0x66, 0x66, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x65,
+	0x73, 0x12, 0x43, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x2d, 0x82, 0xb5, 0x18, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65,
+	0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52,
+	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x47, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75,
+	0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x3d, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45,
+	0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4e, 0x43, 0x4c, 0x55, 0x44, 0x45, 0x44, 0x10, 0x02,
+	0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x56, 0x45, 0x52, 0x54, 0x45, 0x44, 0x10, 0x03, 0x22, 0xee,
+	0x03, 0x0a, 0x21, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x44, 0x65, 0x74,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x4e, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0x82, 0xb5,
+	0x18, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79,
+	0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x0a, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x4c, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0x82, 0xb5, 0x18,
+	0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73,
+	0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x09, 0x6e, 0x65, 0x78, 0x74,
+	0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x4c, 0x0a, 0x0a, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0x82, 0xb5, 0x18, 0x29, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61,
+	0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x09, 0x68, 0x65, 0x61, 0x64, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x12, 0x35, 0x0a, 0x16, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x15, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x6c,
+	0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67,
+	0x73, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x32,
+	0xf1, 0x07, 0x0a, 0x07, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x72, 0x12, 0xae, 0x01, 0x0a, 0x16,
+	0x49, 0x73, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75,
+	0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x1a, 0x30, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x65, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x22, 0x2c, 0x2f, 0x65, 0x74,
+	0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68,
+	0x65, 0x72, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
+	0x73, 0x6c, 0x61, 0x73, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0xa4, 0x01, 0x0a,
+	0x10, 0x49, 0x73, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x2e, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x1a, 0x30, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x65, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x65, 0x74,
+	0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68,
+	0x65, 0x72, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x61,
+	0x62, 0x6c, 0x65, 0x12, 0xb0, 0x01, 0x0a, 0x13, 0x48, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x41,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x31, 0x2e, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32,
+	0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x41,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x65, 0x74, 0x68,
+	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65,
+	0x72, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x68,
+	0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x12, 0x9d, 0x01, 0x0a, 0x0f, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2e, 0x2e, 0x65, 0x74, 0x68,
 	0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63,
-	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x53, 0x6c, 0x61, 0x73,
-	0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x31, 0x22, 0x2c, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x72, 0x2f, 0x61, 0x74, 0x74, 0x65,
-	0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x61, 0x62,
-	0x6c, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0xa4, 0x01, 0x0a, 0x10, 0x49, 0x73, 0x53, 0x6c, 0x61, 0x73,
-	0x68, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2e, 0x2e, 0x65, 0x74, 0x68,
-	0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x1a, 0x30, 0x2e, 0x65, 0x74, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x65, 0x74, 0x68,
 	0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63,
-	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x53, 0x6c, 0x61, 0x73,
-	0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x72, 0x2f, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x73, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x12, 0xb0, 0x01, 0x0a,
-	0x13, 0x48, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x31, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x6c, 0x61, 0x73,
+	0x68, 0x69, 0x6e, 0x67, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x65,
+	0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x6c, 0x61, 0x73,
+	0x68, 0x65, 0x72, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x30, 0x01, 0x12, 0x95, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2c, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72,
+	0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75,
+	0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f,
+	0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x6c, 0x61,
+	0x73, 0x68, 0x65, 0x72, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x12, 0xa2,
+	0x01, 0x0a, 0x19, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x44, 0x65, 0x74,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x39, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e,
 	0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x69,
-	0x67, 0x68, 0x65, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65,
-	0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
-	0x2e, 0x48, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x72, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x72, 0x2f, 0x64,
+	0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69,
+	0x63, 0x61, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -391,33 +940,51 @@ func file_proto_beacon_rpc_v1_slasher_proto_rawDescGZIP() []byte {
 	return file_proto_beacon_rpc_v1_slasher_proto_rawDescData
 }
 
-var file_proto_beacon_rpc_v1_slasher_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_proto_beacon_rpc_v1_slasher_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_beacon_rpc_v1_slasher_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_proto_beacon_rpc_v1_slasher_proto_goTypes = []interface{}{
-	(*AttesterSlashingResponse)(nil),         // 0: ethereum.beacon.rpc.v1.AttesterSlashingResponse
-	(*ProposerSlashingResponse)(nil),         // 1: ethereum.beacon.rpc.v1.ProposerSlashingResponse
-	(*HighestAttestationRequest)(nil),        // 2: ethereum.beacon.rpc.v1.HighestAttestationRequest
-	(*HighestAttestationResponse)(nil),       // 3: ethereum.beacon.rpc.v1.HighestAttestationResponse
-	(*HighestAttestation)(nil),               // 4: ethereum.beacon.rpc.v1.HighestAttestation
-	(*v1alpha1.AttesterSlashing)(nil),        // 5: ethereum.eth.v1alpha1.AttesterSlashing
-	(*v1alpha1.ProposerSlashing)(nil),        // 6: ethereum.eth.v1alpha1.ProposerSlashing
-	(*v1alpha1.IndexedAttestation)(nil),      // 7: ethereum.eth.v1alpha1.IndexedAttestation
-	(*v1alpha1.SignedBeaconBlockHeader)(nil), // 8: ethereum.eth.v1alpha1.SignedBeaconBlockHeader
+	(DetectedSlashing_Status)(0),              // 0: ethereum.beacon.rpc.v1.DetectedSlashing.Status
+	(*AttesterSlashingResponse)(nil),          // 1: ethereum.beacon.rpc.v1.AttesterSlashingResponse
+	(*ProposerSlashingResponse)(nil),          // 2: ethereum.beacon.rpc.v1.ProposerSlashingResponse
+	(*HighestAttestationRequest)(nil),         // 3: ethereum.beacon.rpc.v1.HighestAttestationRequest
+	(*HighestAttestationResponse)(nil),        // 4: ethereum.beacon.rpc.v1.HighestAttestationResponse
+	(*HighestAttestation)(nil),                // 5: ethereum.beacon.rpc.v1.HighestAttestation
+	(*StreamSlashingsRequest)(nil),            // 6: ethereum.beacon.rpc.v1.StreamSlashingsRequest
+	(*ListSlashingsRequest)(nil),              // 7: ethereum.beacon.rpc.v1.ListSlashingsRequest
+	(*ListSlashingsResponse)(nil),             // 8: ethereum.beacon.rpc.v1.ListSlashingsResponse
+	(*DetectedSlashing)(nil),                  // 9: ethereum.beacon.rpc.v1.DetectedSlashing
+	(*HistoricalDetectionStatusResponse)(nil), // 10: ethereum.beacon.rpc.v1.HistoricalDetectionStatusResponse
+	(*v1alpha1.AttesterSlashing)(nil),         // 11: ethereum.eth.v1alpha1.AttesterSlashing
+	(*v1alpha1.ProposerSlashing)(nil),         // 12: ethereum.eth.v1alpha1.ProposerSlashing
+	(*v1alpha1.IndexedAttestation)(nil),       // 13: ethereum.eth.v1alpha1.IndexedAttestation
+	(*v1alpha1.SignedBeaconBlockHeader)(nil),  // 14: ethereum.eth.v1alpha1.SignedBeaconBlockHeader
+	(*empty.Empty)(nil),                       // 15: google.protobuf.Empty
 }
 var file_proto_beacon_rpc_v1_slasher_proto_depIdxs = []int32{
-	5, // 0: ethereum.beacon.rpc.v1.AttesterSlashingResponse.attester_slashing:type_name -> ethereum.eth.v1alpha1.AttesterSlashing
-	6, // 1: ethereum.beacon.rpc.v1.ProposerSlashingResponse.proposer_slashing:type_name -> ethereum.eth.v1alpha1.ProposerSlashing
-	4, // 2: ethereum.beacon.rpc.v1.HighestAttestationResponse.attestations:type_name -> ethereum.beacon.rpc.v1.HighestAttestation
-	7, // 3: ethereum.beacon.rpc.v1.Slasher.IsSlashableAttestation:input_type -> ethereum.eth.v1alpha1.IndexedAttestation
-	8, // 4: ethereum.beacon.rpc.v1.Slasher.IsSlashableBlock:input_type -> ethereum.eth.v1alpha1.SignedBeaconBlockHeader
-	2, // 5: ethereum.beacon.rpc.v1.Slasher.HighestAttestations:input_type -> ethereum.beacon.rpc.v1.HighestAttestationRequest
-	0, // 6: ethereum.beacon.rpc.v1.Slasher.IsSlashableAttestation:output_type -> ethereum.beacon.rpc.v1.AttesterSlashingResponse
-	1, // 7: ethereum.beacon.rpc.v1.Slasher.IsSlashableBlock:output_type -> ethereum.beacon.rpc.v1.ProposerSlashingResponse
-	3, // 8: ethereum.beacon.rpc.v1.Slasher.HighestAttestations:output_type -> ethereum.beacon.rpc.v1.HighestAttestationResponse
-	6, // [6:9] is the sub-list for method output_type
-	3, // [3:6] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	11, // 0: ethereum.beacon.rpc.v1.AttesterSlashingResponse.attester_slashing:type_name -> ethereum.eth.v1alpha1.AttesterSlashing
+	12, // 1: ethereum.beacon.rpc.v1.ProposerSlashingResponse.proposer_slashing:type_name -> ethereum.eth.v1alpha1.ProposerSlashing
+	5,  // 2: ethereum.beacon.rpc.v1.HighestAttestationResponse.attestations:type_name -> ethereum.beacon.rpc.v1.HighestAttestation
+	9,  // 3: ethereum.beacon.rpc.v1.ListSlashingsResponse.slashings:type_name -> ethereum.beacon.rpc.v1.DetectedSlashing
+	11, // 4: ethereum.beacon.rpc.v1.DetectedSlashing.attester_slashing:type_name -> ethereum.eth.v1alpha1.AttesterSlashing
+	12, // 5: ethereum.beacon.rpc.v1.DetectedSlashing.proposer_slashing:type_name -> ethereum.eth.v1alpha1.ProposerSlashing
+	0,  // 6: ethereum.beacon.rpc.v1.DetectedSlashing.status:type_name -> ethereum.beacon.rpc.v1.DetectedSlashing.Status
+	13, // 7: ethereum.beacon.rpc.v1.Slasher.IsSlashableAttestation:input_type -> ethereum.eth.v1alpha1.IndexedAttestation
+	14, // 8: ethereum.beacon.rpc.v1.Slasher.IsSlashableBlock:input_type -> ethereum.eth.v1alpha1.SignedBeaconBlockHeader
+	3,  // 9: ethereum.beacon.rpc.v1.Slasher.HighestAttestations:input_type -> ethereum.beacon.rpc.v1.HighestAttestationRequest
+	6,  // 10: ethereum.beacon.rpc.v1.Slasher.StreamSlashings:input_type -> ethereum.beacon.rpc.v1.StreamSlashingsRequest
+	7,  // 11: ethereum.beacon.rpc.v1.Slasher.ListSlashings:input_type -> ethereum.beacon.rpc.v1.ListSlashingsRequest
+	15, // 12: ethereum.beacon.rpc.v1.Slasher.HistoricalDetectionStatus:input_type -> google.protobuf.Empty
+	1,  // 13: ethereum.beacon.rpc.v1.Slasher.IsSlashableAttestation:output_type -> ethereum.beacon.rpc.v1.AttesterSlashingResponse
+	2,  // 14: ethereum.beacon.rpc.v1.Slasher.IsSlashableBlock:output_type -> ethereum.beacon.rpc.v1.ProposerSlashingResponse
+	4,  // 15: ethereum.beacon.rpc.v1.Slasher.HighestAttestations:output_type -> ethereum.beacon.rpc.v1.HighestAttestationResponse
+	9,  // 16: ethereum.beacon.rpc.v1.Slasher.StreamSlashings:output_type -> ethereum.beacon.rpc.v1.DetectedSlashing
+	8,  // 17: ethereum.beacon.rpc.v1.Slasher.ListSlashings:output_type -> ethereum.beacon.rpc.v1.ListSlashingsResponse
+	10, // 18: ethereum.beacon.rpc.v1.Slasher.HistoricalDetectionStatus:output_type -> ethereum.beacon.rpc.v1.HistoricalDetectionStatusResponse
+	13, // [13:19] is the sub-list for method output_type
+	7,  // [7:13] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_proto_beacon_rpc_v1_slasher_proto_init() }
@@ -486,19 +1053,83 @@ func file_proto_beacon_rpc_v1_slasher_proto_init() {
 				return nil
 			}
 		}
+		file_proto_beacon_rpc_v1_slasher_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamSlashingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_slasher_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSlashingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_slasher_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSlashingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_slasher_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DetectedSlashing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_slasher_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HistoricalDetectionStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_proto_beacon_rpc_v1_slasher_proto_msgTypes[6].OneofWrappers = []interface{}{
+		(*ListSlashingsRequest_Epoch)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_beacon_rpc_v1_slasher_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   5,
+			NumEnums:      1,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_beacon_rpc_v1_slasher_proto_goTypes,
 		DependencyIndexes: file_proto_beacon_rpc_v1_slasher_proto_depIdxs,
+		EnumInfos:         file_proto_beacon_rpc_v1_slasher_proto_enumTypes,
 		MessageInfos:      file_proto_beacon_rpc_v1_slasher_proto_msgTypes,
 	}.Build()
 	File_proto_beacon_rpc_v1_slasher_proto = out.File
@@ -522,6 +1153,9 @@ type SlasherClient interface {
 	IsSlashableAttestation(ctx context.Context, in *v1alpha1.IndexedAttestation, opts ...grpc.CallOption) (*AttesterSlashingResponse, error)
 	IsSlashableBlock(ctx context.Context, in *v1alpha1.SignedBeaconBlockHeader, opts ...grpc.CallOption) (*ProposerSlashingResponse, error)
 	HighestAttestations(ctx context.Context, in *HighestAttestationRequest, opts ...grpc.CallOption) (*HighestAttestationResponse, error)
+	StreamSlashings(ctx context.Context, in *StreamSlashingsRequest, opts ...grpc.CallOption) (Slasher_StreamSlashingsClient, error)
+	ListSlashings(ctx context.Context, in *ListSlashingsRequest, opts ...grpc.CallOption) (*ListSlashingsResponse, error)
+	HistoricalDetectionStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*HistoricalDetectionStatusResponse, error)
 }
 
 type slasherClient struct {
@@ -559,11 +1193,64 @@ func (c *slasherClient) HighestAttestations(ctx context.Context, in *HighestAtte
 	return out, nil
 }
 
+func (c *slasherClient) StreamSlashings(ctx context.Context, in *StreamSlashingsRequest, opts ...grpc.CallOption) (Slasher_StreamSlashingsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Slasher_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.Slasher/StreamSlashings", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &slasherStreamSlashingsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Slasher_StreamSlashingsClient interface {
+	Recv() (*DetectedSlashing, error)
+	grpc.ClientStream
+}
+
+type slasherStreamSlashingsClient struct {
+	grpc.ClientStream
+}
+
+func (x *slasherStreamSlashingsClient) Recv() (*DetectedSlashing, error) {
+	m := new(DetectedSlashing)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *slasherClient) ListSlashings(ctx context.Context, in *ListSlashingsRequest, opts ...grpc.CallOption) (*ListSlashingsResponse, error) {
+	out := new(ListSlashingsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Slasher/ListSlashings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slasherClient) HistoricalDetectionStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*HistoricalDetectionStatusResponse, error) {
+	out := new(HistoricalDetectionStatusResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Slasher/HistoricalDetectionStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SlasherServer is the server API for Slasher service.
 type SlasherServer interface {
 	IsSlashableAttestation(context.Context, *v1alpha1.IndexedAttestation) (*AttesterSlashingResponse, error)
 	IsSlashableBlock(context.Context, *v1alpha1.SignedBeaconBlockHeader) (*ProposerSlashingResponse, error)
 	HighestAttestations(context.Context, *HighestAttestationRequest) (*HighestAttestationResponse, error)
+	StreamSlashings(*StreamSlashingsRequest, Slasher_StreamSlashingsServer) error
+	ListSlashings(context.Context, *ListSlashingsRequest) (*ListSlashingsResponse, error)
+	HistoricalDetectionStatus(context.Context, *empty.Empty) (*HistoricalDetectionStatusResponse, error)
 }
 
 // UnimplementedSlasherServer can be embedded to have forward compatible implementations.
@@ -579,6 +1266,15 @@ func (*UnimplementedSlasherServer) IsSlashableBlock(context.Context, *v1alpha1.S
 func (*UnimplementedSlasherServer) HighestAttestations(context.Context, *HighestAttestationRequest) (*HighestAttestationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HighestAttestations not implemented")
 }
+func (*UnimplementedSlasherServer) StreamSlashings(*StreamSlashingsRequest, Slasher_StreamSlashingsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamSlashings not implemented")
+}
+func (*UnimplementedSlasherServer) ListSlashings(context.Context, *ListSlashingsRequest) (*ListSlashingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSlashings not implemented")
+}
+func (*UnimplementedSlasherServer) HistoricalDetectionStatus(context.Context, *empty.Empty) (*HistoricalDetectionStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HistoricalDetectionStatus not implemented")
+}
 
 func RegisterSlasherServer(s *grpc.Server, srv SlasherServer) {
 	s.RegisterService(&_Slasher_serviceDesc, srv)
@@ -638,6 +1334,63 @@ func _Slasher_HighestAttestations_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Slasher_StreamSlashings_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamSlashingsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SlasherServer).StreamSlashings(m, &slasherStreamSlashingsServer{stream})
+}
+
+type Slasher_StreamSlashingsServer interface {
+	Send(*DetectedSlashing) error
+	grpc.ServerStream
+}
+
+type slasherStreamSlashingsServer struct {
+	grpc.ServerStream
+}
+
+func (x *slasherStreamSlashingsServer) Send(m *DetectedSlashing) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Slasher_ListSlashings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSlashingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlasherServer).ListSlashings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Slasher/ListSlashings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlasherServer).ListSlashings(ctx, req.(*ListSlashingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Slasher_HistoricalDetectionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlasherServer).HistoricalDetectionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Slasher/HistoricalDetectionStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlasherServer).HistoricalDetectionStatus(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Slasher_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethereum.beacon.rpc.v1.Slasher",
 	HandlerType: (*SlasherServer)(nil),
@@ -654,7 +1407,21 @@ var _Slasher_serviceDesc = grpc.ServiceDesc{
 			MethodName: "HighestAttestations",
 			Handler:    _Slasher_HighestAttestations_Handler,
 		},
+		{
+			MethodName: "ListSlashings",
+			Handler:    _Slasher_ListSlashings_Handler,
+		},
+		{
+			MethodName: "HistoricalDetectionStatus",
+			Handler:    _Slasher_HistoricalDetectionStatus_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamSlashings",
+			Handler:       _Slasher_StreamSlashings_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/beacon/rpc/v1/slasher.proto",
 }
@@ -0,0 +1,284 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.15.8
+// source: proto/eth/v2/beacon_debug_service.proto
+
+package v2
+
+import (
+	context "context"
+	reflect "reflect"
+	sync "sync"
+
+	proto "github.com/golang/protobuf/proto"
+	v1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type BeaconStateResponseV2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version Version               `protobuf:"varint,1,opt,name=version,proto3,enum=ethereum.eth.v2.Version" json:"version,omitempty"`
+	Data    *BeaconStateContainer `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *BeaconStateResponseV2) Reset() {
+	*x = BeaconStateResponseV2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eth_v2_beacon_debug_service_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BeaconStateResponseV2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeaconStateResponseV2) ProtoMessage() {}
+
+func (x *BeaconStateResponseV2) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eth_v2_beacon_debug_service_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeaconStateResponseV2.ProtoReflect.Descriptor instead.
+func (*BeaconStateResponseV2) Descriptor() ([]byte, []int) {
+	return file_proto_eth_v2_beacon_debug_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BeaconStateResponseV2) GetVersion() Version {
+	if x != nil {
+		return x.Version
+	}
+	return Version_PHASE0
+}
+
+func (x *BeaconStateResponseV2) GetData() *BeaconStateContainer {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_proto_eth_v2_beacon_debug_service_proto protoreflect.FileDescriptor
+
+var file_proto_eth_v2_beacon_debug_service_proto_rawDesc = []byte{
+	0x0a, 0x27, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x32, 0x2f, 0x62,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x65, 0x74, 0x68, 0x65, 0x72,
+	0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x32, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x27, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x32, 0x2f,
+	0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x32,
+	0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x86,
+	0x01, 0x0a, 0x15, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x32, 0x12, 0x32, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x65, 0x74, 0x68, 0x65,
+	0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x32, 0x2e, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x65, 0x74, 0x68,
+	0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x99, 0x01, 0x0a, 0x0b, 0x42, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x12, 0x89, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x56, 0x32, 0x12, 0x1d, 0x2e, 0x65,
+	0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x56, 0x32, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x65, 0x74,
+	0x68, 0x2f, 0x76, 0x32, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x62, 0x65, 0x61, 0x63, 0x6f,
+	0x6e, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f,
+	0x69, 0x64, 0x7d, 0x42, 0x7a, 0x0a, 0x13, 0x6f, 0x72, 0x67, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72,
+	0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x32, 0x42, 0x10, 0x42, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2b,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d,
+	0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x32, 0xaa, 0x02, 0x0f, 0x45, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x45, 0x74, 0x68, 0x2e, 0x76, 0x32, 0xca, 0x02, 0x0f,
+	0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x5c, 0x45, 0x74, 0x68, 0x5c, 0x76, 0x32, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_eth_v2_beacon_debug_service_proto_rawDescOnce sync.Once
+	file_proto_eth_v2_beacon_debug_service_proto_rawDescData = file_proto_eth_v2_beacon_debug_service_proto_rawDesc
+)
+
+func file_proto_eth_v2_beacon_debug_service_proto_rawDescGZIP() []byte {
+	file_proto_eth_v2_beacon_debug_service_proto_rawDescOnce.Do(func() {
+		file_proto_eth_v2_beacon_debug_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_eth_v2_beacon_debug_service_proto_rawDescData)
+	})
+	return file_proto_eth_v2_beacon_debug_service_proto_rawDescData
+}
+
+var file_proto_eth_v2_beacon_debug_service_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_proto_eth_v2_beacon_debug_service_proto_goTypes = []interface{}{
+	(*BeaconStateResponseV2)(nil), // 0: ethereum.eth.v2.BeaconStateResponseV2
+	(Version)(0),                  // 1: ethereum.eth.v2.Version
+	(*BeaconStateContainer)(nil),  // 2: ethereum.eth.v2.BeaconStateContainer
+	(*v1.StateRequest)(nil),       // 3: ethereum.eth.v1.StateRequest
+}
+var file_proto_eth_v2_beacon_debug_service_proto_depIdxs = []int32{
+	1, // 0: ethereum.eth.v2.BeaconStateResponseV2.version:type_name -> ethereum.eth.v2.Version
+	2, // 1: ethereum.eth.v2.BeaconStateResponseV2.data:type_name -> ethereum.eth.v2.BeaconStateContainer
+	3, // 2: ethereum.eth.v2.BeaconDebug.GetBeaconStateV2:input_type -> ethereum.eth.v1.StateRequest
+	0, // 3: ethereum.eth.v2.BeaconDebug.GetBeaconStateV2:output_type -> ethereum.eth.v2.BeaconStateResponseV2
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_proto_eth_v2_beacon_debug_service_proto_init() }
+func file_proto_eth_v2_beacon_debug_service_proto_init() {
+	if File_proto_eth_v2_beacon_debug_service_proto != nil {
+		return
+	}
+	file_proto_eth_v2_beacon_state_proto_init()
+	file_proto_eth_v2_version_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_proto_eth_v2_beacon_debug_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BeaconStateResponseV2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_eth_v2_beacon_debug_service_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_eth_v2_beacon_debug_service_proto_goTypes,
+		DependencyIndexes: file_proto_eth_v2_beacon_debug_service_proto_depIdxs,
+		MessageInfos:      file_proto_eth_v2_beacon_debug_service_proto_msgTypes,
+	}.Build()
+	File_proto_eth_v2_beacon_debug_service_proto = out.File
+	file_proto_eth_v2_beacon_debug_service_proto_rawDesc = nil
+	file_proto_eth_v2_beacon_debug_service_proto_goTypes = nil
+	file_proto_eth_v2_beacon_debug_service_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// BeaconDebugClient is the client API for BeaconDebug service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BeaconDebugClient interface {
+	GetBeaconStateV2(ctx context.Context, in *v1.StateRequest, opts ...grpc.CallOption) (*BeaconStateResponseV2, error)
+}
+
+type beaconDebugClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBeaconDebugClient(cc grpc.ClientConnInterface) BeaconDebugClient {
+	return &beaconDebugClient{cc}
+}
+
+func (c *beaconDebugClient) GetBeaconStateV2(ctx context.Context, in *v1.StateRequest, opts ...grpc.CallOption) (*BeaconStateResponseV2, error) {
+	out := new(BeaconStateResponseV2)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v2.BeaconDebug/GetBeaconStateV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BeaconDebugServer is the server API for BeaconDebug service.
+type BeaconDebugServer interface {
+	GetBeaconStateV2(context.Context, *v1.StateRequest) (*BeaconStateResponseV2, error)
+}
+
+// UnimplementedBeaconDebugServer can be embedded to have forward compatible implementations.
+type UnimplementedBeaconDebugServer struct {
+}
+
+func (*UnimplementedBeaconDebugServer) GetBeaconStateV2(context.Context, *v1.StateRequest) (*BeaconStateResponseV2, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBeaconStateV2 not implemented")
+}
+
+func RegisterBeaconDebugServer(s *grpc.Server, srv BeaconDebugServer) {
+	s.RegisterService(&_BeaconDebug_serviceDesc, srv)
+}
+
+func _BeaconDebug_GetBeaconStateV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.StateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconDebugServer).GetBeaconStateV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v2.BeaconDebug/GetBeaconStateV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconDebugServer).GetBeaconStateV2(ctx, req.(*v1.StateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _BeaconDebug_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.eth.v2.BeaconDebug",
+	HandlerType: (*BeaconDebugServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetBeaconStateV2",
+			Handler:    _BeaconDebug_GetBeaconStateV2_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/eth/v2/beacon_debug_service.proto",
+}
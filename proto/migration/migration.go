@@ -2,9 +2,12 @@ package migration
 
 import (
 	"github.com/pkg/errors"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	ethpb_alpha "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
 	"github.com/prysmaticlabs/prysm/proto/interfaces"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -310,3 +313,127 @@ func SignedBeaconBlock(block interfaces.SignedBeaconBlock) (*ethpb.SignedBeaconB
 
 	return v1Block, nil
 }
+
+// V1Alpha1BeaconBlockAltairToV2 converts a v1alpha1 Altair beacon block to a v2 Altair block.
+func V1Alpha1BeaconBlockAltairToV2(v1alpha1Block *prysmv2.BeaconBlockAltair) (*ethpbv2.BeaconBlockAltair, error) {
+	marshaledBlk, err := proto.Marshal(v1alpha1Block)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal block")
+	}
+	v2Block := &ethpbv2.BeaconBlockAltair{}
+	if err := proto.Unmarshal(marshaledBlk, v2Block); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal block")
+	}
+	return v2Block, nil
+}
+
+// AltairSignedBeaconBlock converts a signed beacon block interface of the Altair fork to a v2 Altair block.
+func AltairSignedBeaconBlock(block interfaces.SignedBeaconBlock) (*ethpbv2.SignedBeaconBlockAltair, error) {
+	if block == nil || block.IsNil() {
+		return nil, errors.New("could not find requested block")
+	}
+	blk, err := block.PbAltairBlock()
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get raw block")
+	}
+
+	marshaledBlk, err := proto.Marshal(blk)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal block")
+	}
+	v2Block := &ethpbv2.SignedBeaconBlockAltair{}
+	if err := proto.Unmarshal(marshaledBlk, v2Block); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal block")
+	}
+	return v2Block, nil
+}
+
+// BeaconStateAltairToV2 converts an Altair beacon state to a v2 beacon state.
+func BeaconStateAltairToV2(altairState *pbp2p.BeaconStateAltair) (*ethpbv2.BeaconStateV2, error) {
+	marshaledState, err := proto.Marshal(altairState)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal state")
+	}
+	v2State := &ethpbv2.BeaconStateV2{}
+	if err := proto.Unmarshal(marshaledState, v2State); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal state")
+	}
+	return v2State, nil
+}
+
+// V2SyncCommitteeMessageToV1Alpha1 converts a v2 sync committee message to its v1alpha1 equivalent.
+func V2SyncCommitteeMessageToV1Alpha1(v2Msg *ethpbv2.SyncCommitteeMessage) *prysmv2.SyncCommitteeMessage {
+	if v2Msg == nil {
+		return &prysmv2.SyncCommitteeMessage{}
+	}
+	return &prysmv2.SyncCommitteeMessage{
+		Slot:           v2Msg.Slot,
+		BlockRoot:      v2Msg.BeaconBlockRoot,
+		ValidatorIndex: v2Msg.ValidatorIndex,
+		Signature:      v2Msg.Signature,
+	}
+}
+
+// V1Alpha1SyncCommitteeContributionToV2 converts a v1alpha1 sync committee contribution to its v2 equivalent.
+func V1Alpha1SyncCommitteeContributionToV2(contribution *prysmv2.SyncCommitteeContribution) *ethpbv2.SyncCommitteeContribution {
+	if contribution == nil {
+		return &ethpbv2.SyncCommitteeContribution{}
+	}
+	return &ethpbv2.SyncCommitteeContribution{
+		Slot:              contribution.Slot,
+		BeaconBlockRoot:   contribution.BlockRoot,
+		SubcommitteeIndex: contribution.SubcommitteeIndex,
+		AggregationBits:   contribution.AggregationBits,
+		Signature:         contribution.Signature,
+	}
+}
+
+// V2SignedContributionAndProofToV1Alpha1 converts a v2 signed contribution and proof to its v1alpha1 equivalent.
+func V2SignedContributionAndProofToV1Alpha1(v2Contribution *ethpbv2.SignedContributionAndProof) *prysmv2.SignedContributionAndProof {
+	if v2Contribution == nil || v2Contribution.Message == nil || v2Contribution.Message.Contribution == nil {
+		return &prysmv2.SignedContributionAndProof{}
+	}
+	c := v2Contribution.Message.Contribution
+	return &prysmv2.SignedContributionAndProof{
+		Message: &prysmv2.ContributionAndProof{
+			AggregatorIndex: v2Contribution.Message.AggregatorIndex,
+			Contribution: &prysmv2.SyncCommitteeContribution{
+				Slot:              c.Slot,
+				BlockRoot:         c.BeaconBlockRoot,
+				SubcommitteeIndex: c.SubcommitteeIndex,
+				AggregationBits:   c.AggregationBits,
+				Signature:         c.Signature,
+			},
+			SelectionProof: v2Contribution.Message.SelectionProof,
+		},
+		Signature: v2Contribution.Signature,
+	}
+}
+
+// V1Alpha1SyncCommitteeMessageToV2 converts a v1alpha1 sync committee message to its v2 equivalent.
+func V1Alpha1SyncCommitteeMessageToV2(alphaMsg *prysmv2.SyncCommitteeMessage) *ethpbv2.SyncCommitteeMessage {
+	if alphaMsg == nil {
+		return &ethpbv2.SyncCommitteeMessage{}
+	}
+	return &ethpbv2.SyncCommitteeMessage{
+		Slot:            alphaMsg.Slot,
+		BeaconBlockRoot: alphaMsg.BlockRoot,
+		ValidatorIndex:  alphaMsg.ValidatorIndex,
+		Signature:       alphaMsg.Signature,
+	}
+}
+
+// V1Alpha1SignedContributionAndProofToV2 converts a v1alpha1 signed contribution and proof to its v2 equivalent.
+func V1Alpha1SignedContributionAndProofToV2(alphaContribution *prysmv2.SignedContributionAndProof) *ethpbv2.SignedContributionAndProof {
+	if alphaContribution == nil || alphaContribution.Message == nil {
+		return &ethpbv2.SignedContributionAndProof{}
+	}
+	return &ethpbv2.SignedContributionAndProof{
+		Message: &ethpbv2.ContributionAndProof{
+			AggregatorIndex: alphaContribution.Message.AggregatorIndex,
+			Contribution:    V1Alpha1SyncCommitteeContributionToV2(alphaContribution.Message.Contribution),
+			SelectionProof:  alphaContribution.Message.SelectionProof,
+		},
+		Signature: alphaContribution.Signature,
+	}
+}
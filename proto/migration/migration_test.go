@@ -5,9 +5,13 @@ import (
 
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/go-bitfield"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	ethpb_alpha "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/eth/v1alpha1/wrapper"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	wrapperv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2/wrapper"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
@@ -355,3 +359,153 @@ func Test_BlockInterfaceToV1Block(t *testing.T) {
 	require.NoError(t, err)
 	assert.DeepEqual(t, v1Root, v1Alpha1Root)
 }
+
+func Test_V1Alpha1BeaconBlockAltairToV2(t *testing.T) {
+	alphaBlock := testutil.HydrateBeaconBlockAltair(&prysmv2.BeaconBlockAltair{})
+	alphaBlock.Slot = slot
+	alphaBlock.ProposerIndex = validatorIndex
+	alphaBlock.ParentRoot = parentRoot
+	alphaBlock.StateRoot = stateRoot
+	alphaBlock.Body.RandaoReveal = randaoReveal
+	alphaBlock.Body.SyncAggregate.SyncCommitteeSignature = signature
+
+	v2Block, err := V1Alpha1BeaconBlockAltairToV2(alphaBlock)
+	require.NoError(t, err)
+	alphaRoot, err := alphaBlock.HashTreeRoot()
+	require.NoError(t, err)
+	v2Root, err := v2Block.HashTreeRoot()
+	require.NoError(t, err)
+	assert.DeepEqual(t, alphaRoot, v2Root)
+}
+
+func Test_AltairSignedBeaconBlock(t *testing.T) {
+	alphaBlock := testutil.HydrateSignedBeaconBlockAltair(&prysmv2.SignedBeaconBlockAltair{})
+	alphaBlock.Block.Slot = slot
+	alphaBlock.Block.ProposerIndex = validatorIndex
+	alphaBlock.Block.ParentRoot = parentRoot
+	alphaBlock.Block.StateRoot = stateRoot
+	alphaBlock.Signature = signature
+	wsb, err := wrapperv2.WrappedAltairSignedBeaconBlock(alphaBlock)
+	require.NoError(t, err)
+
+	v2Block, err := AltairSignedBeaconBlock(wsb)
+	require.NoError(t, err)
+	alphaRoot, err := alphaBlock.HashTreeRoot()
+	require.NoError(t, err)
+	v2Root, err := v2Block.HashTreeRoot()
+	require.NoError(t, err)
+	assert.DeepEqual(t, alphaRoot, v2Root)
+
+	_, err = AltairSignedBeaconBlock(wrapper.WrappedPhase0SignedBeaconBlock(testutil.NewBeaconBlock()))
+	assert.NotNil(t, err)
+}
+
+func Test_BeaconStateAltairToV2(t *testing.T) {
+	altairState := &pbp2p.BeaconStateAltair{
+		Slot:             slot,
+		InactivityScores: []uint64{1, 2, 3},
+		CurrentSyncCommittee: &pbp2p.SyncCommittee{
+			AggregatePubkey: bytesutil.PadTo([]byte("pubkey"), 48),
+		},
+	}
+
+	v2State, err := BeaconStateAltairToV2(altairState)
+	require.NoError(t, err)
+	assert.Equal(t, slot, v2State.Slot)
+	assert.DeepEqual(t, altairState.InactivityScores, v2State.InactivityScores)
+	assert.DeepEqual(t, altairState.CurrentSyncCommittee.AggregatePubkey, v2State.CurrentSyncCommittee.AggregatePubkey)
+}
+
+func Test_V2SyncCommitteeMessageToV1Alpha1(t *testing.T) {
+	v2Msg := &ethpbv2.SyncCommitteeMessage{
+		Slot:            slot,
+		BeaconBlockRoot: beaconBlockRoot,
+		ValidatorIndex:  validatorIndex,
+		Signature:       signature,
+	}
+	alphaMsg := V2SyncCommitteeMessageToV1Alpha1(v2Msg)
+	v2Root, err := v2Msg.HashTreeRoot()
+	require.NoError(t, err)
+	alphaRoot, err := alphaMsg.HashTreeRoot()
+	require.NoError(t, err)
+	assert.DeepEqual(t, v2Root, alphaRoot)
+}
+
+func Test_V1Alpha1SyncCommitteeContributionToV2(t *testing.T) {
+	bits := prysmv2.NewSyncCommitteeAggregationBits()
+	bits.SetBitAt(3, true)
+	alphaContribution := &prysmv2.SyncCommitteeContribution{
+		Slot:              slot,
+		BlockRoot:         beaconBlockRoot,
+		SubcommitteeIndex: 2,
+		AggregationBits:   bits,
+		Signature:         signature,
+	}
+	v2Contribution := V1Alpha1SyncCommitteeContributionToV2(alphaContribution)
+	alphaRoot, err := alphaContribution.HashTreeRoot()
+	require.NoError(t, err)
+	v2Root, err := v2Contribution.HashTreeRoot()
+	require.NoError(t, err)
+	assert.DeepEqual(t, alphaRoot, v2Root)
+}
+
+func Test_V2SignedContributionAndProofToV1Alpha1(t *testing.T) {
+	v2Contribution := &ethpbv2.SignedContributionAndProof{
+		Message: &ethpbv2.ContributionAndProof{
+			AggregatorIndex: validatorIndex,
+			Contribution: &ethpbv2.SyncCommitteeContribution{
+				Slot:              slot,
+				BeaconBlockRoot:   beaconBlockRoot,
+				SubcommitteeIndex: 2,
+				AggregationBits:   prysmv2.NewSyncCommitteeAggregationBits(),
+				Signature:         signature,
+			},
+			SelectionProof: signature,
+		},
+		Signature: signature,
+	}
+	alphaContribution := V2SignedContributionAndProofToV1Alpha1(v2Contribution)
+	v2Root, err := v2Contribution.HashTreeRoot()
+	require.NoError(t, err)
+	alphaRoot, err := alphaContribution.HashTreeRoot()
+	require.NoError(t, err)
+	assert.DeepEqual(t, v2Root, alphaRoot)
+}
+
+func Test_V1Alpha1SyncCommitteeMessageToV2(t *testing.T) {
+	alphaMsg := &prysmv2.SyncCommitteeMessage{
+		Slot:           slot,
+		BlockRoot:      beaconBlockRoot,
+		ValidatorIndex: validatorIndex,
+		Signature:      signature,
+	}
+	v2Msg := V1Alpha1SyncCommitteeMessageToV2(alphaMsg)
+	alphaRoot, err := alphaMsg.HashTreeRoot()
+	require.NoError(t, err)
+	v2Root, err := v2Msg.HashTreeRoot()
+	require.NoError(t, err)
+	assert.DeepEqual(t, alphaRoot, v2Root)
+}
+
+func Test_V1Alpha1SignedContributionAndProofToV2(t *testing.T) {
+	alphaContribution := &prysmv2.SignedContributionAndProof{
+		Message: &prysmv2.ContributionAndProof{
+			AggregatorIndex: validatorIndex,
+			Contribution: &prysmv2.SyncCommitteeContribution{
+				Slot:              slot,
+				BlockRoot:         beaconBlockRoot,
+				SubcommitteeIndex: 2,
+				AggregationBits:   prysmv2.NewSyncCommitteeAggregationBits(),
+				Signature:         signature,
+			},
+			SelectionProof: signature,
+		},
+		Signature: signature,
+	}
+	v2Contribution := V1Alpha1SignedContributionAndProofToV2(alphaContribution)
+	alphaRoot, err := alphaContribution.HashTreeRoot()
+	require.NoError(t, err)
+	v2Root, err := v2Contribution.HashTreeRoot()
+	require.NoError(t, err)
+	assert.DeepEqual(t, alphaRoot, v2Root)
+}
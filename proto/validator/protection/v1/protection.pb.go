@@ -0,0 +1,982 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.15.8
+// source: proto/validator/protection/v1/protection.proto
+
+package ethereum_validator_protection_v1
+
+import (
+	context "context"
+	reflect "reflect"
+	sync "sync"
+
+	proto "github.com/golang/protobuf/proto"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	github_com_prysmaticlabs_eth2_types "github.com/prysmaticlabs/eth2-types"
+	_ "github.com/prysmaticlabs/prysm/proto/eth/ext"
+	v1alpha1 "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type ProtectionResponse_SlashingKind int32
+
+const (
+	ProtectionResponse_NOT_SLASHABLE    ProtectionResponse_SlashingKind = 0
+	ProtectionResponse_DOUBLE_VOTE      ProtectionResponse_SlashingKind = 1
+	ProtectionResponse_SURROUNDING_VOTE ProtectionResponse_SlashingKind = 2
+	ProtectionResponse_SURROUNDED_VOTE  ProtectionResponse_SlashingKind = 3
+	ProtectionResponse_DOUBLE_PROPOSAL  ProtectionResponse_SlashingKind = 4
+	ProtectionResponse_BELOW_MINIMUM    ProtectionResponse_SlashingKind = 5
+	ProtectionResponse_BLACKLISTED      ProtectionResponse_SlashingKind = 6
+)
+
+// Enum value maps for ProtectionResponse_SlashingKind.
+var (
+	ProtectionResponse_SlashingKind_name = map[int32]string{
+		0: "NOT_SLASHABLE",
+		1: "DOUBLE_VOTE",
+		2: "SURROUNDING_VOTE",
+		3: "SURROUNDED_VOTE",
+		4: "DOUBLE_PROPOSAL",
+		5: "BELOW_MINIMUM",
+		6: "BLACKLISTED",
+	}
+	ProtectionResponse_SlashingKind_value = map[string]int32{
+		"NOT_SLASHABLE":    0,
+		"DOUBLE_VOTE":      1,
+		"SURROUNDING_VOTE": 2,
+		"SURROUNDED_VOTE":  3,
+		"DOUBLE_PROPOSAL":  4,
+		"BELOW_MINIMUM":    5,
+		"BLACKLISTED":      6,
+	}
+)
+
+func (x ProtectionResponse_SlashingKind) Enum() *ProtectionResponse_SlashingKind {
+	p := new(ProtectionResponse_SlashingKind)
+	*p = x
+	return p
+}
+
+func (x ProtectionResponse_SlashingKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProtectionResponse_SlashingKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_validator_protection_v1_protection_proto_enumTypes[0].Descriptor()
+}
+
+func (ProtectionResponse_SlashingKind) Type() protoreflect.EnumType {
+	return &file_proto_validator_protection_v1_protection_proto_enumTypes[0]
+}
+
+func (x ProtectionResponse_SlashingKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProtectionResponse_SlashingKind.Descriptor instead.
+func (ProtectionResponse_SlashingKind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_validator_protection_v1_protection_proto_rawDescGZIP(), []int{2, 0}
+}
+
+type AttestationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicKey   []byte                       `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty" ssz-size:"48"`
+	SigningRoot []byte                       `protobuf:"bytes,2,opt,name=signing_root,json=signingRoot,proto3" json:"signing_root,omitempty" ssz-size:"32"`
+	Attestation *v1alpha1.IndexedAttestation `protobuf:"bytes,3,opt,name=attestation,proto3" json:"attestation,omitempty"`
+}
+
+func (x *AttestationRequest) Reset() {
+	*x = AttestationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_validator_protection_v1_protection_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttestationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttestationRequest) ProtoMessage() {}
+
+func (x *AttestationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_validator_protection_v1_protection_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttestationRequest.ProtoReflect.Descriptor instead.
+func (*AttestationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_validator_protection_v1_protection_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AttestationRequest) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *AttestationRequest) GetSigningRoot() []byte {
+	if x != nil {
+		return x.SigningRoot
+	}
+	return nil
+}
+
+func (x *AttestationRequest) GetAttestation() *v1alpha1.IndexedAttestation {
+	if x != nil {
+		return x.Attestation
+	}
+	return nil
+}
+
+type ProposalRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicKey   []byte                                   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty" ssz-size:"48"`
+	SigningRoot []byte                                   `protobuf:"bytes,2,opt,name=signing_root,json=signingRoot,proto3" json:"signing_root,omitempty" ssz-size:"32"`
+	Slot        github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty" cast-type:"github.com/prysmaticlabs/eth2-types.Slot"`
+}
+
+func (x *ProposalRequest) Reset() {
+	*x = ProposalRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_validator_protection_v1_protection_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProposalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposalRequest) ProtoMessage() {}
+
+func (x *ProposalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_validator_protection_v1_protection_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposalRequest.ProtoReflect.Descriptor instead.
+func (*ProposalRequest) Descriptor() ([]byte, []int) {
+	return file_proto_validator_protection_v1_protection_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ProposalRequest) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *ProposalRequest) GetSigningRoot() []byte {
+	if x != nil {
+		return x.SigningRoot
+	}
+	return nil
+}
+
+func (x *ProposalRequest) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if x != nil {
+		return x.Slot
+	}
+	return github_com_prysmaticlabs_eth2_types.Slot(0)
+}
+
+type ProtectionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slashable bool                            `protobuf:"varint,1,opt,name=slashable,proto3" json:"slashable,omitempty"`
+	Kind      ProtectionResponse_SlashingKind `protobuf:"varint,2,opt,name=kind,proto3,enum=ethereum.validator.protection.v1.ProtectionResponse_SlashingKind" json:"kind,omitempty"`
+	Reason    string                          `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ProtectionResponse) Reset() {
+	*x = ProtectionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_validator_protection_v1_protection_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtectionResponse) ProtoMessage() {}
+
+func (x *ProtectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_validator_protection_v1_protection_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtectionResponse.ProtoReflect.Descriptor instead.
+func (*ProtectionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_validator_protection_v1_protection_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProtectionResponse) GetSlashable() bool {
+	if x != nil {
+		return x.Slashable
+	}
+	return false
+}
+
+func (x *ProtectionResponse) GetKind() ProtectionResponse_SlashingKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ProtectionResponse_NOT_SLASHABLE
+}
+
+func (x *ProtectionResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ImportSlashingProtectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SlashingProtectionJson []byte `protobuf:"bytes,1,opt,name=slashing_protection_json,json=slashingProtectionJson,proto3" json:"slashing_protection_json,omitempty"`
+}
+
+func (x *ImportSlashingProtectionRequest) Reset() {
+	*x = ImportSlashingProtectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_validator_protection_v1_protection_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportSlashingProtectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSlashingProtectionRequest) ProtoMessage() {}
+
+func (x *ImportSlashingProtectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_validator_protection_v1_protection_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSlashingProtectionRequest.ProtoReflect.Descriptor instead.
+func (*ImportSlashingProtectionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_validator_protection_v1_protection_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ImportSlashingProtectionRequest) GetSlashingProtectionJson() []byte {
+	if x != nil {
+		return x.SlashingProtectionJson
+	}
+	return nil
+}
+
+type ExportSlashingProtectionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SlashingProtectionJson []byte `protobuf:"bytes,1,opt,name=slashing_protection_json,json=slashingProtectionJson,proto3" json:"slashing_protection_json,omitempty"`
+}
+
+func (x *ExportSlashingProtectionResponse) Reset() {
+	*x = ExportSlashingProtectionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_validator_protection_v1_protection_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportSlashingProtectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportSlashingProtectionResponse) ProtoMessage() {}
+
+func (x *ExportSlashingProtectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_validator_protection_v1_protection_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportSlashingProtectionResponse.ProtoReflect.Descriptor instead.
+func (*ExportSlashingProtectionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_validator_protection_v1_protection_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ExportSlashingProtectionResponse) GetSlashingProtectionJson() []byte {
+	if x != nil {
+		return x.SlashingProtectionJson
+	}
+	return nil
+}
+
+var File_proto_validator_protection_v1_protection_proto protoreflect.FileDescriptor
+
+var file_proto_validator_protection_v1_protection_proto_rawDesc = []byte{
+	0x0a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x20, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x1a, 0x1b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x65, 0x78,
+	0x74, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x25, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xb3, 0x01, 0x0a, 0x12, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0a, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x06,
+	0x8a, 0xb5, 0x18, 0x02, 0x34, 0x38, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
+	0x79, 0x12, 0x29, 0x0a, 0x0c, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x6f, 0x6f,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x33, 0x32, 0x52,
+	0x0b, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x4b, 0x0a, 0x0b,
+	0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x64, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa5, 0x01, 0x0a, 0x0f, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a,
+	0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x34, 0x38, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x4b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x0c, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f,
+	0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02,
+	0x33, 0x32, 0x52, 0x0b, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6f, 0x74, 0x12,
+	0x40, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2c, 0x82,
+	0xb5, 0x18, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72,
+	0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32,
+	0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x04, 0x73, 0x6c, 0x6f,
+	0x74, 0x22, 0xba, 0x02, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x6c, 0x61, 0x73,
+	0x68, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x6c, 0x61,
+	0x73, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x55, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x41, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x6c, 0x61, 0x73, 0x68,
+	0x69, 0x6e, 0x67, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x96, 0x01, 0x0a, 0x0c, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69,
+	0x6e, 0x67, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x4c,
+	0x41, 0x53, 0x48, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x44, 0x4f, 0x55,
+	0x42, 0x4c, 0x45, 0x5f, 0x56, 0x4f, 0x54, 0x45, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x55,
+	0x52, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x56, 0x4f, 0x54, 0x45, 0x10, 0x02,
+	0x12, 0x13, 0x0a, 0x0f, 0x53, 0x55, 0x52, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x45, 0x44, 0x5f, 0x56,
+	0x4f, 0x54, 0x45, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x4f, 0x55, 0x42, 0x4c, 0x45, 0x5f,
+	0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x42, 0x45,
+	0x4c, 0x4f, 0x57, 0x5f, 0x4d, 0x49, 0x4e, 0x49, 0x4d, 0x55, 0x4d, 0x10, 0x05, 0x12, 0x0f, 0x0a,
+	0x0b, 0x42, 0x4c, 0x41, 0x43, 0x4b, 0x4c, 0x49, 0x53, 0x54, 0x45, 0x44, 0x10, 0x06, 0x22, 0x5b,
+	0x0a, 0x1f, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67,
+	0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x38, 0x0a, 0x18, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72,
+	0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x16, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f,
+	0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x73, 0x6f, 0x6e, 0x22, 0x5c, 0x0a, 0x20, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f,
+	0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x38, 0x0a, 0x18, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x6f, 0x74,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x16, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x73, 0x6f, 0x6e, 0x32, 0xe8, 0x07, 0x0a, 0x12, 0x53, 0x6c,
+	0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x87, 0x01, 0x0a, 0x19, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x61,
+	0x62, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34,
+	0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x18, 0x53, 0x61,
+	0x76, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72,
+	0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x34, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75,
+	0x6d, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x85, 0x01, 0x0a, 0x17, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x6e,
+	0x64, 0x53, 0x61, 0x76, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x34, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75,
+	0x6d, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x81, 0x01, 0x0a,
+	0x16, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x31, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65,
+	0x75, 0x6d, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x65, 0x74, 0x68,
+	0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x62, 0x0a, 0x15, 0x53, 0x61, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x46, 0x6f, 0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x31, 0x2e, 0x65, 0x74, 0x68, 0x65,
+	0x72, 0x65, 0x75, 0x6d, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x7f, 0x0a, 0x14, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x6e, 0x64,
+	0x53, 0x61, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x31, 0x2e, 0x65,
+	0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x34, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a, 0x18, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53,
+	0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x41, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68,
+	0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x76, 0x0a, 0x18,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x50, 0x72,
+	0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x42, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69,
+	0x6e, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_validator_protection_v1_protection_proto_rawDescOnce sync.Once
+	file_proto_validator_protection_v1_protection_proto_rawDescData = file_proto_validator_protection_v1_protection_proto_rawDesc
+)
+
+func file_proto_validator_protection_v1_protection_proto_rawDescGZIP() []byte {
+	file_proto_validator_protection_v1_protection_proto_rawDescOnce.Do(func() {
+		file_proto_validator_protection_v1_protection_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_validator_protection_v1_protection_proto_rawDescData)
+	})
+	return file_proto_validator_protection_v1_protection_proto_rawDescData
+}
+
+var file_proto_validator_protection_v1_protection_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_validator_protection_v1_protection_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_proto_validator_protection_v1_protection_proto_goTypes = []interface{}{
+	(ProtectionResponse_SlashingKind)(0),     // 0: ethereum.validator.protection.v1.ProtectionResponse.SlashingKind
+	(*AttestationRequest)(nil),               // 1: ethereum.validator.protection.v1.AttestationRequest
+	(*ProposalRequest)(nil),                  // 2: ethereum.validator.protection.v1.ProposalRequest
+	(*ProtectionResponse)(nil),               // 3: ethereum.validator.protection.v1.ProtectionResponse
+	(*ImportSlashingProtectionRequest)(nil),  // 4: ethereum.validator.protection.v1.ImportSlashingProtectionRequest
+	(*ExportSlashingProtectionResponse)(nil), // 5: ethereum.validator.protection.v1.ExportSlashingProtectionResponse
+	(*v1alpha1.IndexedAttestation)(nil),      // 6: ethereum.eth.v1alpha1.IndexedAttestation
+	(*empty.Empty)(nil),                      // 7: google.protobuf.Empty
+}
+var file_proto_validator_protection_v1_protection_proto_depIdxs = []int32{
+	6,  // 0: ethereum.validator.protection.v1.AttestationRequest.attestation:type_name -> ethereum.eth.v1alpha1.IndexedAttestation
+	0,  // 1: ethereum.validator.protection.v1.ProtectionResponse.kind:type_name -> ethereum.validator.protection.v1.ProtectionResponse.SlashingKind
+	1,  // 2: ethereum.validator.protection.v1.SlashingProtection.CheckSlashableAttestation:input_type -> ethereum.validator.protection.v1.AttestationRequest
+	1,  // 3: ethereum.validator.protection.v1.SlashingProtection.SaveAttestationForPubKey:input_type -> ethereum.validator.protection.v1.AttestationRequest
+	1,  // 4: ethereum.validator.protection.v1.SlashingProtection.CheckAndSaveAttestation:input_type -> ethereum.validator.protection.v1.AttestationRequest
+	2,  // 5: ethereum.validator.protection.v1.SlashingProtection.CheckSlashableProposal:input_type -> ethereum.validator.protection.v1.ProposalRequest
+	2,  // 6: ethereum.validator.protection.v1.SlashingProtection.SaveProposalForPubKey:input_type -> ethereum.validator.protection.v1.ProposalRequest
+	2,  // 7: ethereum.validator.protection.v1.SlashingProtection.CheckAndSaveProposal:input_type -> ethereum.validator.protection.v1.ProposalRequest
+	4,  // 8: ethereum.validator.protection.v1.SlashingProtection.ImportSlashingProtection:input_type -> ethereum.validator.protection.v1.ImportSlashingProtectionRequest
+	7,  // 9: ethereum.validator.protection.v1.SlashingProtection.ExportSlashingProtection:input_type -> google.protobuf.Empty
+	3,  // 10: ethereum.validator.protection.v1.SlashingProtection.CheckSlashableAttestation:output_type -> ethereum.validator.protection.v1.ProtectionResponse
+	7,  // 11: ethereum.validator.protection.v1.SlashingProtection.SaveAttestationForPubKey:output_type -> google.protobuf.Empty
+	3,  // 12: ethereum.validator.protection.v1.SlashingProtection.CheckAndSaveAttestation:output_type -> ethereum.validator.protection.v1.ProtectionResponse
+	3,  // 13: ethereum.validator.protection.v1.SlashingProtection.CheckSlashableProposal:output_type -> ethereum.validator.protection.v1.ProtectionResponse
+	7,  // 14: ethereum.validator.protection.v1.SlashingProtection.SaveProposalForPubKey:output_type -> google.protobuf.Empty
+	3,  // 15: ethereum.validator.protection.v1.SlashingProtection.CheckAndSaveProposal:output_type -> ethereum.validator.protection.v1.ProtectionResponse
+	7,  // 16: ethereum.validator.protection.v1.SlashingProtection.ImportSlashingProtection:output_type -> google.protobuf.Empty
+	5,  // 17: ethereum.validator.protection.v1.SlashingProtection.ExportSlashingProtection:output_type -> ethereum.validator.protection.v1.ExportSlashingProtectionResponse
+	10, // [10:18] is the sub-list for method output_type
+	2,  // [2:10] is the sub-list for method input_type
+	2,  // [2:2] is the sub-list for extension type_name
+	2,  // [2:2] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_proto_validator_protection_v1_protection_proto_init() }
+func file_proto_validator_protection_v1_protection_proto_init() {
+	if File_proto_validator_protection_v1_protection_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_validator_protection_v1_protection_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttestationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_validator_protection_v1_protection_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProposalRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_validator_protection_v1_protection_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtectionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_validator_protection_v1_protection_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportSlashingProtectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_validator_protection_v1_protection_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportSlashingProtectionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_validator_protection_v1_protection_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_validator_protection_v1_protection_proto_goTypes,
+		DependencyIndexes: file_proto_validator_protection_v1_protection_proto_depIdxs,
+		EnumInfos:         file_proto_validator_protection_v1_protection_proto_enumTypes,
+		MessageInfos:      file_proto_validator_protection_v1_protection_proto_msgTypes,
+	}.Build()
+	File_proto_validator_protection_v1_protection_proto = out.File
+	file_proto_validator_protection_v1_protection_proto_rawDesc = nil
+	file_proto_validator_protection_v1_protection_proto_goTypes = nil
+	file_proto_validator_protection_v1_protection_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// SlashingProtectionClient is the client API for SlashingProtection service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SlashingProtectionClient interface {
+	CheckSlashableAttestation(ctx context.Context, in *AttestationRequest, opts ...grpc.CallOption) (*ProtectionResponse, error)
+	SaveAttestationForPubKey(ctx context.Context, in *AttestationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	CheckAndSaveAttestation(ctx context.Context, in *AttestationRequest, opts ...grpc.CallOption) (*ProtectionResponse, error)
+	CheckSlashableProposal(ctx context.Context, in *ProposalRequest, opts ...grpc.CallOption) (*ProtectionResponse, error)
+	SaveProposalForPubKey(ctx context.Context, in *ProposalRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	CheckAndSaveProposal(ctx context.Context, in *ProposalRequest, opts ...grpc.CallOption) (*ProtectionResponse, error)
+	ImportSlashingProtection(ctx context.Context, in *ImportSlashingProtectionRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	ExportSlashingProtection(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ExportSlashingProtectionResponse, error)
+}
+
+type slashingProtectionClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSlashingProtectionClient(cc grpc.ClientConnInterface) SlashingProtectionClient {
+	return &slashingProtectionClient{cc}
+}
+
+func (c *slashingProtectionClient) CheckSlashableAttestation(ctx context.Context, in *AttestationRequest, opts ...grpc.CallOption) (*ProtectionResponse, error) {
+	out := new(ProtectionResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.protection.v1.SlashingProtection/CheckSlashableAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slashingProtectionClient) SaveAttestationForPubKey(ctx context.Context, in *AttestationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.protection.v1.SlashingProtection/SaveAttestationForPubKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slashingProtectionClient) CheckAndSaveAttestation(ctx context.Context, in *AttestationRequest, opts ...grpc.CallOption) (*ProtectionResponse, error) {
+	out := new(ProtectionResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.protection.v1.SlashingProtection/CheckAndSaveAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slashingProtectionClient) CheckSlashableProposal(ctx context.Context, in *ProposalRequest, opts ...grpc.CallOption) (*ProtectionResponse, error) {
+	out := new(ProtectionResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.protection.v1.SlashingProtection/CheckSlashableProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slashingProtectionClient) SaveProposalForPubKey(ctx context.Context, in *ProposalRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.protection.v1.SlashingProtection/SaveProposalForPubKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slashingProtectionClient) CheckAndSaveProposal(ctx context.Context, in *ProposalRequest, opts ...grpc.CallOption) (*ProtectionResponse, error) {
+	out := new(ProtectionResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.protection.v1.SlashingProtection/CheckAndSaveProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slashingProtectionClient) ImportSlashingProtection(ctx context.Context, in *ImportSlashingProtectionRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.protection.v1.SlashingProtection/ImportSlashingProtection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slashingProtectionClient) ExportSlashingProtection(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ExportSlashingProtectionResponse, error) {
+	out := new(ExportSlashingProtectionResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.protection.v1.SlashingProtection/ExportSlashingProtection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SlashingProtectionServer is the server API for SlashingProtection service.
+type SlashingProtectionServer interface {
+	CheckSlashableAttestation(context.Context, *AttestationRequest) (*ProtectionResponse, error)
+	SaveAttestationForPubKey(context.Context, *AttestationRequest) (*empty.Empty, error)
+	CheckAndSaveAttestation(context.Context, *AttestationRequest) (*ProtectionResponse, error)
+	CheckSlashableProposal(context.Context, *ProposalRequest) (*ProtectionResponse, error)
+	SaveProposalForPubKey(context.Context, *ProposalRequest) (*empty.Empty, error)
+	CheckAndSaveProposal(context.Context, *ProposalRequest) (*ProtectionResponse, error)
+	ImportSlashingProtection(context.Context, *ImportSlashingProtectionRequest) (*empty.Empty, error)
+	ExportSlashingProtection(context.Context, *empty.Empty) (*ExportSlashingProtectionResponse, error)
+}
+
+// UnimplementedSlashingProtectionServer can be embedded to have forward compatible implementations.
+type UnimplementedSlashingProtectionServer struct {
+}
+
+func (*UnimplementedSlashingProtectionServer) CheckSlashableAttestation(context.Context, *AttestationRequest) (*ProtectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckSlashableAttestation not implemented")
+}
+func (*UnimplementedSlashingProtectionServer) SaveAttestationForPubKey(context.Context, *AttestationRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveAttestationForPubKey not implemented")
+}
+func (*UnimplementedSlashingProtectionServer) CheckAndSaveAttestation(context.Context, *AttestationRequest) (*ProtectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAndSaveAttestation not implemented")
+}
+func (*UnimplementedSlashingProtectionServer) CheckSlashableProposal(context.Context, *ProposalRequest) (*ProtectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckSlashableProposal not implemented")
+}
+func (*UnimplementedSlashingProtectionServer) SaveProposalForPubKey(context.Context, *ProposalRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveProposalForPubKey not implemented")
+}
+func (*UnimplementedSlashingProtectionServer) CheckAndSaveProposal(context.Context, *ProposalRequest) (*ProtectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAndSaveProposal not implemented")
+}
+func (*UnimplementedSlashingProtectionServer) ImportSlashingProtection(context.Context, *ImportSlashingProtectionRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportSlashingProtection not implemented")
+}
+func (*UnimplementedSlashingProtectionServer) ExportSlashingProtection(context.Context, *empty.Empty) (*ExportSlashingProtectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportSlashingProtection not implemented")
+}
+
+func RegisterSlashingProtectionServer(s *grpc.Server, srv SlashingProtectionServer) {
+	s.RegisterService(&_SlashingProtection_serviceDesc, srv)
+}
+
+func _SlashingProtection_CheckSlashableAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlashingProtectionServer).CheckSlashableAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.protection.v1.SlashingProtection/CheckSlashableAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlashingProtectionServer).CheckSlashableAttestation(ctx, req.(*AttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SlashingProtection_SaveAttestationForPubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlashingProtectionServer).SaveAttestationForPubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.protection.v1.SlashingProtection/SaveAttestationForPubKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlashingProtectionServer).SaveAttestationForPubKey(ctx, req.(*AttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SlashingProtection_CheckAndSaveAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlashingProtectionServer).CheckAndSaveAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.protection.v1.SlashingProtection/CheckAndSaveAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlashingProtectionServer).CheckAndSaveAttestation(ctx, req.(*AttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SlashingProtection_CheckSlashableProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlashingProtectionServer).CheckSlashableProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.protection.v1.SlashingProtection/CheckSlashableProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlashingProtectionServer).CheckSlashableProposal(ctx, req.(*ProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SlashingProtection_SaveProposalForPubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlashingProtectionServer).SaveProposalForPubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.protection.v1.SlashingProtection/SaveProposalForPubKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlashingProtectionServer).SaveProposalForPubKey(ctx, req.(*ProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SlashingProtection_CheckAndSaveProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlashingProtectionServer).CheckAndSaveProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.protection.v1.SlashingProtection/CheckAndSaveProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlashingProtectionServer).CheckAndSaveProposal(ctx, req.(*ProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SlashingProtection_ImportSlashingProtection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportSlashingProtectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlashingProtectionServer).ImportSlashingProtection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.protection.v1.SlashingProtection/ImportSlashingProtection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlashingProtectionServer).ImportSlashingProtection(ctx, req.(*ImportSlashingProtectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SlashingProtection_ExportSlashingProtection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlashingProtectionServer).ExportSlashingProtection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.protection.v1.SlashingProtection/ExportSlashingProtection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlashingProtectionServer).ExportSlashingProtection(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _SlashingProtection_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.validator.protection.v1.SlashingProtection",
+	HandlerType: (*SlashingProtectionServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CheckSlashableAttestation",
+			Handler:    _SlashingProtection_CheckSlashableAttestation_Handler,
+		},
+		{
+			MethodName: "SaveAttestationForPubKey",
+			Handler:    _SlashingProtection_SaveAttestationForPubKey_Handler,
+		},
+		{
+			MethodName: "CheckAndSaveAttestation",
+			Handler:    _SlashingProtection_CheckAndSaveAttestation_Handler,
+		},
+		{
+			MethodName: "CheckSlashableProposal",
+			Handler:    _SlashingProtection_CheckSlashableProposal_Handler,
+		},
+		{
+			MethodName: "SaveProposalForPubKey",
+			Handler:    _SlashingProtection_SaveProposalForPubKey_Handler,
+		},
+		{
+			MethodName: "CheckAndSaveProposal",
+			Handler:    _SlashingProtection_CheckAndSaveProposal_Handler,
+		},
+		{
+			MethodName: "ImportSlashingProtection",
+			Handler:    _SlashingProtection_ImportSlashingProtection_Handler,
+		},
+		{
+			MethodName: "ExportSlashingProtection",
+			Handler:    _SlashingProtection_ExportSlashingProtection_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/validator/protection/v1/protection.proto",
+}
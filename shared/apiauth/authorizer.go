@@ -0,0 +1,184 @@
+// Package apiauth authenticates API calls made to the beacon node with static API keys or bearer tokens,
+// and enforces the set of gRPC methods and REST paths each token may call, along with its request rate.
+package apiauth
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"math"
+	"strings"
+
+	"github.com/kevinms/leakybucket-go"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// AuthorizationHeader carries a bearer token, in the form "Bearer <token>".
+	AuthorizationHeader = "Authorization"
+	// APIKeyHeader carries a raw API key.
+	APIKeyHeader = "X-Api-Key"
+
+	bearerPrefix = "Bearer "
+)
+
+var (
+	// ErrMissingCredentials is returned when a call does not carry a token.
+	ErrMissingCredentials = errors.New("missing API credentials")
+	// ErrInvalidCredentials is returned when a call carries an unknown token.
+	ErrInvalidCredentials = errors.New("invalid API credentials")
+	// ErrNotAllowed is returned when a token is not allowed to make a call.
+	ErrNotAllowed = errors.New("API call not allowed for token")
+	// ErrRateLimited is returned when a token has exceeded its request rate.
+	ErrRateLimited = errors.New("API request rate exceeded for token")
+)
+
+type principal struct {
+	name        string
+	grpcMethods []string
+	httpPaths   []string
+	limiter     *leakybucket.Collector
+}
+
+// Authorizer checks whether API calls are allowed, based on the credentials they carry.
+type Authorizer struct {
+	principals map[string]*principal
+	// internalToken is used by the gateway to call the gRPC server on behalf of REST clients
+	// which it has already authorized.
+	internalToken string
+}
+
+// NewAuthorizer creates an authorizer for the tokens in the config.
+func NewAuthorizer(cfg *Config) (*Authorizer, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	internal := make([]byte, 32)
+	if _, err := rand.Read(internal); err != nil {
+		return nil, errors.Wrap(err, "could not generate internal token")
+	}
+	a := &Authorizer{
+		principals:    make(map[string]*principal, len(cfg.Tokens)),
+		internalToken: hex.EncodeToString(internal),
+	}
+	for _, t := range cfg.Tokens {
+		p := &principal{
+			name:        t.Name,
+			grpcMethods: t.GRPCMethods,
+			httpPaths:   t.HTTPPaths,
+		}
+		if t.RequestsPerSecond > 0 {
+			burst := t.Burst
+			if burst == 0 {
+				burst = int64(math.Ceil(t.RequestsPerSecond))
+			}
+			p.limiter = leakybucket.NewCollector(t.RequestsPerSecond, burst, false /* deleteEmptyBuckets */)
+		}
+		a.principals[t.Token] = p
+	}
+	return a, nil
+}
+
+// AuthorizeGRPC checks whether the token may call the given gRPC method, and returns the name of the token.
+func (a *Authorizer) AuthorizeGRPC(token, method string) (string, error) {
+	if a.isInternal(token) {
+		return "", nil
+	}
+	p, err := a.principal(token)
+	if err != nil {
+		return "", err
+	}
+	if !matchesMethod(p.grpcMethods, method) {
+		return p.name, ErrNotAllowed
+	}
+	return p.name, p.take()
+}
+
+// AuthorizeHTTP checks whether the token may call the given REST method and path, and returns the name of the token.
+func (a *Authorizer) AuthorizeHTTP(token, method, path string) (string, error) {
+	p, err := a.principal(token)
+	if err != nil {
+		return "", err
+	}
+	if !matchesPath(p.httpPaths, method, path) {
+		return p.name, ErrNotAllowed
+	}
+	return p.name, p.take()
+}
+
+func (a *Authorizer) principal(token string) (*principal, error) {
+	if token == "" {
+		return nil, ErrMissingCredentials
+	}
+	p, ok := a.principals[token]
+	if !ok {
+		return nil, ErrInvalidCredentials
+	}
+	return p, nil
+}
+
+func (a *Authorizer) isInternal(token string) bool {
+	return subtle.ConstantTimeCompare([]byte(token), []byte(a.internalToken)) == 1
+}
+
+// take consumes a single request from the rate limit of the principal.
+func (p *principal) take() error {
+	if p.limiter == nil {
+		return nil
+	}
+	if p.limiter.Add(p.name, 1) == 0 {
+		return ErrRateLimited
+	}
+	return nil
+}
+
+// tokenFromValues extracts the token from the values of the authorization and API key headers.
+func tokenFromValues(authorization, apiKey []string) string {
+	for _, v := range authorization {
+		if strings.HasPrefix(v, bearerPrefix) {
+			return strings.TrimSpace(strings.TrimPrefix(v, bearerPrefix))
+		}
+	}
+	for _, v := range apiKey {
+		if v != "" {
+			return strings.TrimSpace(v)
+		}
+	}
+	return ""
+}
+
+func matchesMethod(patterns []string, method string) bool {
+	for _, p := range patterns {
+		if p == "*" || p == method {
+			return true
+		}
+		if strings.HasSuffix(p, "*") && strings.HasPrefix(method, strings.TrimSuffix(p, "*")) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesPath(patterns []string, method, path string) bool {
+	for _, p := range patterns {
+		m, prefix := splitHTTPPath(p)
+		if (m == "" || m == method) && strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// logDenied writes an audit log entry for a denied call.
+func logDenied(name, call, remoteAddr string, err error) {
+	fields := logrus.Fields{
+		"call":       call,
+		"remoteAddr": remoteAddr,
+		"reason":     err.Error(),
+	}
+	if name != "" {
+		fields["token"] = name
+	}
+	log.WithFields(fields).Warn("Denied API call")
+}
@@ -0,0 +1,98 @@
+package apiauth
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func testAuthorizer(t *testing.T) *Authorizer {
+	a, err := NewAuthorizer(&Config{Tokens: []*TokenConfig{
+		{
+			Name:        "explorer",
+			Token:       "abc",
+			GRPCMethods: []string{"/ethereum.eth.v1.BeaconChain/Get*", "/ethereum.eth.v1.Node/GetVersion"},
+			HTTPPaths:   []string{"GET /eth/v1/beacon/", "/eth/v1/node/version"},
+		},
+		{
+			Name:              "limited",
+			Token:             "def",
+			GRPCMethods:       []string{"*"},
+			HTTPPaths:         []string{"/"},
+			RequestsPerSecond: 1,
+			Burst:             2,
+		},
+	}})
+	require.NoError(t, err)
+	return a
+}
+
+func TestAuthorizer_AuthorizeGRPC(t *testing.T) {
+	a := testAuthorizer(t)
+
+	name, err := a.AuthorizeGRPC("abc", "/ethereum.eth.v1.BeaconChain/GetBlock")
+	require.NoError(t, err)
+	assert.Equal(t, "explorer", name)
+	_, err = a.AuthorizeGRPC("abc", "/ethereum.eth.v1.Node/GetVersion")
+	require.NoError(t, err)
+
+	name, err = a.AuthorizeGRPC("abc", "/ethereum.eth.v1.BeaconChain/SubmitPoolAttestations")
+	assert.ErrorContains(t, ErrNotAllowed.Error(), err)
+	assert.Equal(t, "explorer", name)
+	_, err = a.AuthorizeGRPC("abc", "/ethereum.eth.v1.Node/GetHealth")
+	assert.ErrorContains(t, ErrNotAllowed.Error(), err)
+
+	_, err = a.AuthorizeGRPC("", "/ethereum.eth.v1.BeaconChain/GetBlock")
+	assert.ErrorContains(t, ErrMissingCredentials.Error(), err)
+	_, err = a.AuthorizeGRPC("xyz", "/ethereum.eth.v1.BeaconChain/GetBlock")
+	assert.ErrorContains(t, ErrInvalidCredentials.Error(), err)
+
+	_, err = a.AuthorizeGRPC(a.internalToken, "/ethereum.eth.v1.BeaconChain/SubmitPoolAttestations")
+	require.NoError(t, err)
+}
+
+func TestAuthorizer_AuthorizeHTTP(t *testing.T) {
+	a := testAuthorizer(t)
+
+	name, err := a.AuthorizeHTTP("abc", "GET", "/eth/v1/beacon/genesis")
+	require.NoError(t, err)
+	assert.Equal(t, "explorer", name)
+	_, err = a.AuthorizeHTTP("abc", "POST", "/eth/v1/node/version")
+	require.NoError(t, err)
+	_, err = a.AuthorizeHTTP("abc", "POST", "/eth/v1/beacon/blocks")
+	assert.ErrorContains(t, ErrNotAllowed.Error(), err)
+	_, err = a.AuthorizeHTTP("abc", "GET", "/eth/v1/debug/beacon/states/head")
+	assert.ErrorContains(t, ErrNotAllowed.Error(), err)
+	_, err = a.AuthorizeHTTP("", "GET", "/eth/v1/beacon/genesis")
+	assert.ErrorContains(t, ErrMissingCredentials.Error(), err)
+	// The internal token is only accepted by the gRPC server.
+	_, err = a.AuthorizeHTTP(a.internalToken, "GET", "/eth/v1/beacon/genesis")
+	assert.ErrorContains(t, ErrInvalidCredentials.Error(), err)
+}
+
+func TestAuthorizer_RateLimit(t *testing.T) {
+	a := testAuthorizer(t)
+
+	_, err := a.AuthorizeGRPC("def", "/ethereum.eth.v1.BeaconChain/GetBlock")
+	require.NoError(t, err)
+	_, err = a.AuthorizeHTTP("def", "GET", "/eth/v1/beacon/genesis")
+	require.NoError(t, err)
+	name, err := a.AuthorizeGRPC("def", "/ethereum.eth.v1.BeaconChain/GetBlock")
+	assert.ErrorContains(t, ErrRateLimited.Error(), err)
+	assert.Equal(t, "limited", name)
+
+	// Tokens without a rate are never limited.
+	for i := 0; i < 10; i++ {
+		_, err := a.AuthorizeGRPC("abc", "/ethereum.eth.v1.BeaconChain/GetBlock")
+		require.NoError(t, err)
+	}
+}
+
+func TestTokenFromValues(t *testing.T) {
+	assert.Equal(t, "abc", tokenFromValues([]string{"Bearer abc"}, nil))
+	assert.Equal(t, "abc", tokenFromValues(nil, []string{"abc"}))
+	assert.Equal(t, "abc", tokenFromValues([]string{"Bearer abc"}, []string{"def"}))
+	assert.Equal(t, "def", tokenFromValues([]string{"Basic abc"}, []string{"def"}))
+	assert.Equal(t, "", tokenFromValues(nil, nil))
+}
@@ -0,0 +1,95 @@
+package apiauth
+
+import (
+	"io/ioutil"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// Config lists the credentials which are accepted by the API.
+type Config struct {
+	Tokens []*TokenConfig `yaml:"tokens"`
+}
+
+// TokenConfig defines a single API key or bearer token, along with the API calls it may make.
+//
+// GRPCMethods are full gRPC method names such as "/ethereum.eth.v1.BeaconChain/GetBlock".
+// A trailing "*" matches any method with the given prefix, and "*" alone matches every method.
+// HTTPPaths are REST path prefixes such as "/eth/v1/beacon/", optionally preceded by an HTTP method
+// and a space, e.g. "GET /eth/v1/beacon/", to only allow calls with that method.
+// RequestsPerSecond limits the rate of calls made with the token, with bursts of up to Burst calls.
+// A rate of 0 disables rate limiting for the token.
+type TokenConfig struct {
+	Name              string   `yaml:"name"`
+	Token             string   `yaml:"token"`
+	GRPCMethods       []string `yaml:"grpc_methods"`
+	HTTPPaths         []string `yaml:"http_paths"`
+	RequestsPerSecond float64  `yaml:"requests_per_second"`
+	Burst             int64    `yaml:"burst"`
+}
+
+// LoadConfigFile reads and validates the API authentication config from a YAML file.
+func LoadConfigFile(path string) (*Config, error) {
+	enc, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read API auth config file")
+	}
+	cfg := &Config{}
+	if err := yaml.Unmarshal(enc, cfg); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal API auth config file")
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func (c *Config) validate() error {
+	if len(c.Tokens) == 0 {
+		return errors.New("no tokens defined")
+	}
+	names := make(map[string]bool, len(c.Tokens))
+	tokens := make(map[string]bool, len(c.Tokens))
+	for i, t := range c.Tokens {
+		if t == nil {
+			return errors.Errorf("token %d is empty", i)
+		}
+		if t.Name == "" {
+			return errors.Errorf("token %d has no name", i)
+		}
+		if names[t.Name] {
+			return errors.Errorf("duplicate token name %s", t.Name)
+		}
+		names[t.Name] = true
+		if strings.TrimSpace(t.Token) == "" {
+			return errors.Errorf("token %s has no value", t.Name)
+		}
+		if tokens[t.Token] {
+			return errors.Errorf("token %s reuses the value of another token", t.Name)
+		}
+		tokens[t.Token] = true
+		if t.RequestsPerSecond < 0 {
+			return errors.Errorf("token %s has a negative request rate", t.Name)
+		}
+		if t.Burst < 0 {
+			return errors.Errorf("token %s has a negative burst", t.Name)
+		}
+		for _, p := range t.HTTPPaths {
+			if _, prefix := splitHTTPPath(p); !strings.HasPrefix(prefix, "/") {
+				return errors.Errorf("HTTP path %s of token %s must start with /", p, t.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// splitHTTPPath splits an HTTP path entry into its optional method and its path prefix.
+func splitHTTPPath(p string) (string, string) {
+	parts := strings.SplitN(p, " ", 2)
+	if len(parts) == 2 {
+		return strings.ToUpper(parts[0]), strings.TrimSpace(parts[1])
+	}
+	return "", p
+}
@@ -0,0 +1,94 @@
+package apiauth
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestLoadConfigFile(t *testing.T) {
+	enc := []byte(`tokens:
+  - name: explorer
+    token: abc
+    grpc_methods:
+      - "/ethereum.eth.v1.BeaconChain/*"
+    http_paths:
+      - "GET /eth/v1/beacon/"
+    requests_per_second: 5
+    burst: 10
+  - name: validator
+    token: def
+    grpc_methods:
+      - "*"
+`)
+	path := filepath.Join(t.TempDir(), "auth.yaml")
+	require.NoError(t, ioutil.WriteFile(path, enc, 0600))
+
+	cfg, err := LoadConfigFile(path)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(cfg.Tokens))
+	assert.Equal(t, "explorer", cfg.Tokens[0].Name)
+	assert.Equal(t, "abc", cfg.Tokens[0].Token)
+	assert.DeepEqual(t, []string{"/ethereum.eth.v1.BeaconChain/*"}, cfg.Tokens[0].GRPCMethods)
+	assert.DeepEqual(t, []string{"GET /eth/v1/beacon/"}, cfg.Tokens[0].HTTPPaths)
+	assert.Equal(t, float64(5), cfg.Tokens[0].RequestsPerSecond)
+	assert.Equal(t, int64(10), cfg.Tokens[0].Burst)
+	assert.DeepEqual(t, []string{"*"}, cfg.Tokens[1].GRPCMethods)
+}
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		tokens  []*TokenConfig
+		wantErr string
+	}{
+		{
+			name:    "no tokens",
+			wantErr: "no tokens defined",
+		},
+		{
+			name:    "no name",
+			tokens:  []*TokenConfig{{Token: "abc"}},
+			wantErr: "token 0 has no name",
+		},
+		{
+			name:    "no value",
+			tokens:  []*TokenConfig{{Name: "a"}},
+			wantErr: "token a has no value",
+		},
+		{
+			name:    "duplicate name",
+			tokens:  []*TokenConfig{{Name: "a", Token: "abc"}, {Name: "a", Token: "def"}},
+			wantErr: "duplicate token name a",
+		},
+		{
+			name:    "duplicate value",
+			tokens:  []*TokenConfig{{Name: "a", Token: "abc"}, {Name: "b", Token: "abc"}},
+			wantErr: "token b reuses the value of another token",
+		},
+		{
+			name:    "negative rate",
+			tokens:  []*TokenConfig{{Name: "a", Token: "abc", RequestsPerSecond: -1}},
+			wantErr: "token a has a negative request rate",
+		},
+		{
+			name:    "relative path",
+			tokens:  []*TokenConfig{{Name: "a", Token: "abc", HTTPPaths: []string{"eth/v1/"}}},
+			wantErr: "HTTP path eth/v1/ of token a must start with /",
+		},
+		{
+			name:    "relative path with method",
+			tokens:  []*TokenConfig{{Name: "a", Token: "abc", HTTPPaths: []string{"GET eth/v1/"}}},
+			wantErr: "HTTP path GET eth/v1/ of token a must start with /",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{Tokens: tt.tokens}
+			assert.ErrorContains(t, tt.wantErr, cfg.validate())
+		})
+	}
+}
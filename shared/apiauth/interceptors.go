@@ -0,0 +1,117 @@
+package apiauth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor rejects unary gRPC calls which are not allowed for the credentials they carry.
+func (a *Authorizer) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if err := a.authorizeGRPCContext(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor rejects streaming gRPC calls which are not allowed for the credentials they carry.
+func (a *Authorizer) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if err := a.authorizeGRPCContext(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+func (a *Authorizer) authorizeGRPCContext(ctx context.Context, method string) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	token := tokenFromValues(md.Get(strings.ToLower(AuthorizationHeader)), md.Get(strings.ToLower(APIKeyHeader)))
+	name, err := a.AuthorizeGRPC(token, method)
+	if err == nil {
+		return nil
+	}
+	remoteAddr := ""
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		remoteAddr = p.Addr.String()
+	}
+	logDenied(name, method, remoteAddr, err)
+	return status.Error(grpcCode(err), err.Error())
+}
+
+// HTTPMiddleware rejects REST calls which are not allowed for the credentials they carry.
+// Allowed calls are forwarded with an internal token, so that the gRPC server does not
+// authorize or rate limit them a second time.
+func (a *Authorizer) HTTPMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		token := tokenFromValues(req.Header.Values(AuthorizationHeader), req.Header.Values(APIKeyHeader))
+		name, err := a.AuthorizeHTTP(token, req.Method, req.URL.Path)
+		if err != nil {
+			logDenied(name, req.Method+" "+req.URL.Path, req.RemoteAddr, err)
+			writeHTTPError(w, httpCode(err), err.Error())
+			return
+		}
+		req.Header.Del(APIKeyHeader)
+		req.Header.Set(AuthorizationHeader, bearerPrefix+a.internalToken)
+		next.ServeHTTP(w, req)
+	})
+}
+
+func grpcCode(err error) codes.Code {
+	switch {
+	case errors.Is(err, ErrNotAllowed):
+		return codes.PermissionDenied
+	case errors.Is(err, ErrRateLimited):
+		return codes.ResourceExhausted
+	default:
+		return codes.Unauthenticated
+	}
+}
+
+func httpCode(err error) int {
+	switch {
+	case errors.Is(err, ErrNotAllowed):
+		return http.StatusForbidden
+	case errors.Is(err, ErrRateLimited):
+		return http.StatusTooManyRequests
+	default:
+		return http.StatusUnauthorized
+	}
+}
+
+// writeHTTPError writes the error in the format of the API middleware's error responses.
+func writeHTTPError(w http.ResponseWriter, code int, message string) {
+	enc, err := json.Marshal(struct {
+		Message string `json:"message"`
+		Code    int    `json:"code"`
+	}{Message: message, Code: code})
+	if err != nil {
+		log.WithError(err).Error("Could not marshal error message")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if _, err := w.Write(enc); err != nil {
+		log.WithError(err).Error("Could not write error message")
+	}
+}
@@ -0,0 +1,95 @@
+package apiauth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	hook := logTest.NewGlobal()
+	a := testAuthorizer(t)
+	interceptor := a.UnaryServerInterceptor()
+	handler := func(_ context.Context, _ interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	call := func(md metadata.MD, method string) error {
+		ctx := metadata.NewIncomingContext(context.Background(), md)
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+
+	require.NoError(t, call(metadata.Pairs("authorization", "Bearer abc"), "/ethereum.eth.v1.BeaconChain/GetBlock"))
+	require.NoError(t, call(metadata.Pairs("x-api-key", "abc"), "/ethereum.eth.v1.BeaconChain/GetBlock"))
+
+	err := call(metadata.MD{}, "/ethereum.eth.v1.BeaconChain/GetBlock")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	err = call(metadata.Pairs("authorization", "Bearer abc"), "/ethereum.eth.v1.BeaconChain/SubmitPoolAttestations")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.LogsContain(t, hook, "Denied API call")
+	assert.LogsContain(t, hook, "SubmitPoolAttestations")
+	assert.LogsContain(t, hook, "explorer")
+
+	require.NoError(t, call(metadata.Pairs("authorization", "Bearer def"), "/ethereum.eth.v1.BeaconChain/SubmitPoolAttestations"))
+	require.NoError(t, call(metadata.Pairs("authorization", "Bearer def"), "/ethereum.eth.v1.BeaconChain/SubmitPoolAttestations"))
+	err = call(metadata.Pairs("authorization", "Bearer def"), "/ethereum.eth.v1.BeaconChain/SubmitPoolAttestations")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestHTTPMiddleware(t *testing.T) {
+	a := testAuthorizer(t)
+	var forwarded *http.Request
+	handler := a.HTTPMiddleware(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		forwarded = req
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	t.Run("allowed", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "http://foo.example/eth/v1/beacon/genesis", nil)
+		req.Header.Set(APIKeyHeader, "abc")
+		writer := httptest.NewRecorder()
+		handler.ServeHTTP(writer, req)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		require.NotNil(t, forwarded)
+		assert.Equal(t, "", forwarded.Header.Get(APIKeyHeader))
+		assert.Equal(t, "Bearer "+a.internalToken, forwarded.Header.Get(AuthorizationHeader))
+	})
+	t.Run("unauthenticated", func(t *testing.T) {
+		forwarded = nil
+		req := httptest.NewRequest("GET", "http://foo.example/eth/v1/beacon/genesis", nil)
+		writer := httptest.NewRecorder()
+		handler.ServeHTTP(writer, req)
+		assert.Equal(t, http.StatusUnauthorized, writer.Code)
+		assert.Equal(t, true, forwarded == nil)
+		assert.Equal(t, "{\"message\":\"missing API credentials\",\"code\":401}", writer.Body.String())
+	})
+	t.Run("forbidden", func(t *testing.T) {
+		forwarded = nil
+		req := httptest.NewRequest("POST", "http://foo.example/eth/v1/beacon/blocks", nil)
+		req.Header.Set(AuthorizationHeader, "Bearer abc")
+		writer := httptest.NewRecorder()
+		handler.ServeHTTP(writer, req)
+		assert.Equal(t, http.StatusForbidden, writer.Code)
+		assert.Equal(t, true, forwarded == nil)
+	})
+	t.Run("rate limited", func(t *testing.T) {
+		statuses := make([]int, 3)
+		for i := range statuses {
+			req := httptest.NewRequest("GET", "http://foo.example/eth/v1/node/health", nil)
+			req.Header.Set(AuthorizationHeader, "Bearer def")
+			writer := httptest.NewRecorder()
+			handler.ServeHTTP(writer, req)
+			statuses[i] = writer.Code
+		}
+		assert.DeepEqual(t, []int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests}, statuses)
+	})
+}
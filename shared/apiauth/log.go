@@ -0,0 +1,5 @@
+package apiauth
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "apiauth")
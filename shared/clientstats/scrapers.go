@@ -284,5 +284,27 @@ func populateValidatorStats(pf metricMap) ValidatorStats {
 		}
 	}
 
+	f, err = pf.getFamily("validator_sync_eth2_fallback_configured")
+	if err != nil {
+		log.WithError(err).Debug("Failed to get validator_sync_eth2_fallback_configured")
+	} else {
+		m := f.Metric[0]
+		vs.SyncEth2FallbackConfigured = false
+		if int64(m.Gauge.GetValue()) == 1 {
+			vs.SyncEth2FallbackConfigured = true
+		}
+	}
+
+	f, err = pf.getFamily("validator_sync_eth2_fallback_connected")
+	if err != nil {
+		log.WithError(err).Debug("Failed to get validator_sync_eth2_fallback_connected")
+	} else {
+		m := f.Metric[0]
+		vs.SyncEth2FallbackConnected = false
+		if int64(m.Gauge.GetValue()) == 1 {
+			vs.SyncEth2FallbackConnected = true
+		}
+	}
+
 	return vs
 }
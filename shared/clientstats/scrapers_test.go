@@ -142,6 +142,18 @@ func TestValidatorScraper(t *testing.T) {
 	require.Equal(t, int64(1), vs.ValidatorActive)
 }
 
+func TestValidatorScraperEth2Fallback(t *testing.T) {
+	vScraper := validatorScraper{}
+	vScraper.tripper = &mockRT{body: statusFixtureOneOfEach + eth2FallbackFixture + prometheusTestBody}
+	r, err := vScraper.Scrape()
+	require.NoError(t, err, "Unexpected error calling validatorScraper.Scrape")
+	vs := &ValidatorStats{}
+	err = json.NewDecoder(r).Decode(vs)
+	require.NoError(t, err, "Unexpected error decoding result of validatorScraper.Scrape")
+	require.Equal(t, true, vs.SyncEth2FallbackConfigured)
+	require.Equal(t, false, vs.SyncEth2FallbackConnected)
+}
+
 func TestValidatorScraperAllActive(t *testing.T) {
 	vScraper := validatorScraper{}
 	vScraper.tripper = &mockRT{body: statusFixtureAllActive + prometheusTestBody}
@@ -294,3 +306,11 @@ validator_statuses{pubkey="pk3"} 4
 validator_statuses{pubkey="pk4"} 5
 validator_statuses{pubkey="pk5"} 6
 `
+
+var eth2FallbackFixture = `# HELP validator_sync_eth2_fallback_configured Boolean recording whether a fallback beacon node was configured: 0=false, 1=true.
+# TYPE validator_sync_eth2_fallback_configured gauge
+validator_sync_eth2_fallback_configured 1
+# HELP validator_sync_eth2_fallback_connected Boolean indicating whether a fallback beacon node is currently used: 0=false, 1=true.
+# TYPE validator_sync_eth2_fallback_connected gauge
+validator_sync_eth2_fallback_connected 0
+`
@@ -29,13 +29,11 @@ type CommonStats struct {
 	ClientName             string `json:"client_name"`
 	ClientVersion          string `json:"client_version"`
 	ClientBuild            int64  `json:"client_build"`
-	// TODO(#8849): parse the grpc connection string to determine
-	// if multiple addresses are present
+	// Only reported by the validator, which is configured with a fallback when
+	// more than one beacon node endpoint is given.
 	SyncEth2FallbackConfigured bool `json:"sync_eth2_fallback_configured"`
-	// N/A -- when multiple addresses are provided to grpc, requests are
-	// load-balanced between the provided endpoints.
-	// This is different from a "fallback" configuration where
-	// the second address is treated as a failover.
+	// Only reported by the validator, true when it has failed over from the
+	// first configured beacon node to a fallback.
 	SyncEth2FallbackConnected bool `json:"sync_eth2_fallback_connected"`
 	APIMessage                `json:",inline"`
 }
@@ -213,6 +213,12 @@ var (
 		Name:  "chain-config-file",
 		Usage: "The path to a YAML file with chain config values",
 	}
+	// NetworkDirFlag specifies the directory of a custom network to run on.
+	NetworkDirFlag = &cli.StringFlag{
+		Name: "network-dir",
+		Usage: "The path to the directory of a custom network, holding its config.yaml, genesis.ssz, " +
+			"bootstrap_nodes.txt, deploy_block.txt and deposit_contract.txt",
+	}
 	// GrpcMaxCallRecvMsgSizeFlag defines the max call message size for GRPC
 	GrpcMaxCallRecvMsgSizeFlag = &cli.IntFlag{
 		Name:  "grpc-max-msg-size",
@@ -236,6 +242,11 @@ var (
 		Usage: "Target directory of the restored database",
 		Value: DefaultDataDir(),
 	}
+	// RepairDBFlag rebuilds the derived buckets of the validator database when checking it.
+	RepairDBFlag = &cli.BoolFlag{
+		Name:  "repair",
+		Usage: "Raise the lowest and highest signed epochs and slots of the database to the recorded history, never lowering them",
+	}
 	// BoltMMapInitialSizeFlag specifies the initial size in bytes of boltdb's mmap syscall.
 	BoltMMapInitialSizeFlag = &cli.IntFlag{
 		Name:  "bolt-mmap-initial-size",
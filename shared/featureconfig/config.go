@@ -57,6 +57,9 @@ type Flags struct {
 	DisableLookback           bool // DisableLookback updates slasher to not use the lookback and update validator histories until epoch 0.
 	DisableBroadcastSlashings bool // DisableBroadcastSlashings disables p2p broadcasting of proposer and attester slashings.
 
+	// Sync toggles.
+	EnablePipelinedInitialSync bool // EnablePipelinedInitialSync overlaps fetching, verifying and processing block batches in initial sync.
+
 	// Cache toggles.
 	EnableSSZCache           bool // EnableSSZCache see https://github.com/prysmaticlabs/prysm/pull/4558.
 	EnableNextSlotStateCache bool // EnableNextSlotStateCache enables next slot state cache to improve validator performance.
@@ -183,6 +186,10 @@ func ConfigureBeaconChain(ctx *cli.Context) {
 		log.WithField(enableNextSlotStateCache.Name, enableNextSlotStateCache.Usage).Warn(enabledFeatureFlag)
 		cfg.EnableNextSlotStateCache = true
 	}
+	if ctx.Bool(enablePipelinedInitialSync.Name) {
+		log.WithField(enablePipelinedInitialSync.Name, enablePipelinedInitialSync.Usage).Warn(enabledFeatureFlag)
+		cfg.EnablePipelinedInitialSync = true
+	}
 	cfg.UpdateHeadTimely = true
 	if ctx.Bool(disableUpdateHeadTimely.Name) {
 		log.WithField(disableUpdateHeadTimely.Name, disableUpdateHeadTimely.Usage).Warn(enabledFeatureFlag)
@@ -122,6 +122,11 @@ var (
 		Name:  "disable-optimized-balance-update",
 		Usage: "Disable the optimized method of updating validator balances.",
 	}
+	enablePipelinedInitialSync = &cli.BoolFlag{
+		Name: "enable-pipelined-initial-sync",
+		Usage: "Overlaps the download, the signature verification and the state transition of block batches " +
+			"during initial sync, verifying signatures on multiple cores",
+	}
 	enableDoppelGangerProtection = &cli.BoolFlag{
 		Name: "enable-doppelganger",
 		Usage: "Enables the validator to perform a doppelganger check. (Warning): This is not " +
@@ -135,6 +140,7 @@ var devModeFlags = []cli.Flag{
 	enableLargerGossipHistory,
 	enableNextSlotStateCache,
 	forceOptMaxCoverAggregationStategy,
+	enablePipelinedInitialSync,
 }
 
 // ValidatorFlags contains a list of all the feature flags that apply to the validator client.
@@ -187,6 +193,7 @@ var BeaconChainFlags = append(deprecatedFlags, []cli.Flag{
 	disableUpdateHeadTimely,
 	disableProposerAttsSelectionUsingMaxCover,
 	disableOptimizedBalanceUpdate,
+	enablePipelinedInitialSync,
 }...)
 
 // E2EBeaconChainFlags contains a list of the beacon chain feature flags to be tested in E2E.
@@ -51,11 +51,18 @@ type Hook = func(endpoint Endpoint, w http.ResponseWriter, req *http.Request) Er
 // essentially replacing the whole default request/response logic with custom logic for a specific endpoint.
 type CustomHandler = func(m *ApiProxyMiddleware, endpoint Endpoint, w http.ResponseWriter, req *http.Request) (handled bool)
 
+// RunDefault is returned by hooks which can replace a default step of the request/response cycle.
+// When true, the default step is run after the hook.
+type RunDefault bool
+
 // HookCollection contains handlers/hooks that can be used to amend the default request/response cycle with custom logic for a specific endpoint.
 type HookCollection struct {
 	CustomHandlers                            []CustomHandler
 	OnPostStart                               []Hook
 	OnPostDeserializeRequestBodyIntoContainer []Hook
+	// OnPreSerializeMiddlewareResponseIntoJson can serialize the processed response container itself,
+	// e.g. to give it a different structure than the container's.
+	OnPreSerializeMiddlewareResponseIntoJson func(responseContainer interface{}) (RunDefault, []byte, ErrorJson)
 }
 
 // fieldProcessor applies the processing function f to a value when the tag is present on the field.
@@ -89,7 +96,8 @@ func (m *ApiProxyMiddleware) handleApiPath(path string, endpointFactory Endpoint
 			}
 		}
 
-		if req.Method == "POST" {
+		// SSZ request bodies are forwarded to grpc-gateway as they are.
+		if req.Method == "POST" && !SSZPosted(req) {
 			for _, hook := range endpoint.Hooks.OnPostStart {
 				if errJson := hook(*endpoint, w, req); errJson != nil {
 					WriteError(w, errJson, nil)
@@ -132,6 +140,18 @@ func (m *ApiProxyMiddleware) handleApiPath(path string, endpointFactory Endpoint
 			WriteError(w, errJson, nil)
 			return
 		}
+		// SSZ responses are forwarded to the client as they are. Errors are always returned as JSON.
+		if SSZRequested(req) && grpcResponse.StatusCode < http.StatusBadRequest {
+			if errJson := WriteMiddlewareResponseHeadersAndBody(req, grpcResponse, grpcResponseBody, w); errJson != nil {
+				WriteError(w, errJson, nil)
+				return
+			}
+			if errJson := Cleanup(grpcResponse.Body); errJson != nil {
+				WriteError(w, errJson, nil)
+				return
+			}
+			return
+		}
 		if errJson := DeserializeGrpcResponseBodyIntoErrorJson(endpoint.Err, grpcResponseBody); errJson != nil {
 			WriteError(w, errJson, nil)
 			return
@@ -141,7 +161,7 @@ func (m *ApiProxyMiddleware) handleApiPath(path string, endpointFactory Endpoint
 		if endpoint.Err.Msg() != "" {
 			HandleGrpcResponseError(endpoint.Err, grpcResponse, w)
 			return
-		} else if !GrpcResponseIsStatusCodeOnly(req, endpoint.GetResponse) {
+		} else if !GrpcResponseIsStatusCodeOnly(req, endpoint.GetResponse) && (req.Method == "GET" || endpoint.PostResponse != nil) {
 			var response interface{}
 			if req.Method == "GET" {
 				response = endpoint.GetResponse
@@ -156,11 +176,22 @@ func (m *ApiProxyMiddleware) handleApiPath(path string, endpointFactory Endpoint
 				WriteError(w, errJson, nil)
 				return
 			}
-			var errJson ErrorJson
-			responseJson, errJson = SerializeMiddlewareResponseIntoJson(response)
-			if errJson != nil {
-				WriteError(w, errJson, nil)
-				return
+			runDefault := RunDefault(true)
+			if endpoint.Hooks.OnPreSerializeMiddlewareResponseIntoJson != nil {
+				var errJson ErrorJson
+				runDefault, responseJson, errJson = endpoint.Hooks.OnPreSerializeMiddlewareResponseIntoJson(response)
+				if errJson != nil {
+					WriteError(w, errJson, nil)
+					return
+				}
+			}
+			if runDefault {
+				var errJson ErrorJson
+				responseJson, errJson = SerializeMiddlewareResponseIntoJson(response)
+				if errJson != nil {
+					WriteError(w, errJson, nil)
+					return
+				}
 			}
 		}
 
@@ -54,11 +54,40 @@ func SetRequestBodyToRequestContainer(requestContainer interface{}, req *http.Re
 	return nil
 }
 
+// SSZRequested returns true if the client accepts an SSZ-encoded response.
+func SSZRequested(req *http.Request) bool {
+	return hasMediaType(req.Header.Values("Accept"), SSZContentType)
+}
+
+// SSZPosted returns true if the body of the request is SSZ-encoded.
+func SSZPosted(req *http.Request) bool {
+	return hasMediaType(req.Header.Values("Content-Type"), SSZContentType)
+}
+
+// hasMediaType checks whether any of the header values lists the media type, ignoring media type parameters.
+func hasMediaType(headerValues []string, mediaType string) bool {
+	for _, v := range headerValues {
+		for _, t := range strings.Split(v, ",") {
+			if strings.TrimSpace(strings.Split(t, ";")[0]) == mediaType {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // PrepareRequestForProxying applies additional logic to the request so that it can be correctly proxied to grpc-gateway.
 func (m *ApiProxyMiddleware) PrepareRequestForProxying(endpoint Endpoint, req *http.Request) ErrorJson {
 	req.URL.Scheme = "http"
 	req.URL.Host = m.GatewayAddress
 	req.RequestURI = ""
+	// grpc-gateway picks the response marshaler based on the Accept header,
+	// so we make sure it only ever sees the two formats that the middleware can return.
+	if SSZRequested(req) {
+		req.Header.Set("Accept", SSZContentType)
+	} else {
+		req.Header.Set("Accept", JSONContentType)
+	}
 	if errJson := HandleURLParameters(endpoint.Path, req, endpoint.RequestURLLiterals); errJson != nil {
 		return errJson
 	}
@@ -183,6 +212,8 @@ func WriteMiddlewareResponseHeadersAndBody(req *http.Request, grpcResp *http.Res
 			return InternalServerErrorWithMessage(err, "could not write response message")
 		}
 	} else {
+		// The body of the grpc-gateway response is not forwarded, so we can't forward its length either.
+		w.Header().Set("Content-Length", "0")
 		w.WriteHeader(grpcResp.StatusCode)
 	}
 	return nil
@@ -136,6 +136,66 @@ func TestPrepareRequestForProxying(t *testing.T) {
 	assert.Equal(t, "http", request.URL.Scheme)
 	assert.Equal(t, middleware.GatewayAddress, request.URL.Host)
 	assert.Equal(t, "", request.RequestURI)
+	assert.Equal(t, JSONContentType, request.Header.Get("Accept"))
+
+	t.Run("ssz_requested", func(t *testing.T) {
+		request := httptest.NewRequest("GET", "http://foo.example?query_param=bar", &body)
+		request.Header.Set("Accept", "application/json;q=0.5, application/octet-stream")
+
+		errJson := middleware.PrepareRequestForProxying(endpoint, request)
+		require.Equal(t, true, errJson == nil)
+		assert.Equal(t, SSZContentType, request.Header.Get("Accept"))
+	})
+}
+
+func TestSSZRequested(t *testing.T) {
+	t.Run("ssz_requested", func(t *testing.T) {
+		request := httptest.NewRequest("GET", "http://foo.example", nil)
+		request.Header["Accept"] = []string{"application/octet-stream"}
+		assert.Equal(t, true, SSZRequested(request))
+	})
+
+	t.Run("multiple_content_types", func(t *testing.T) {
+		request := httptest.NewRequest("GET", "http://foo.example", nil)
+		request.Header["Accept"] = []string{"application/json", "application/octet-stream"}
+		assert.Equal(t, true, SSZRequested(request))
+	})
+
+	t.Run("content_type_list_with_parameters", func(t *testing.T) {
+		request := httptest.NewRequest("GET", "http://foo.example", nil)
+		request.Header["Accept"] = []string{"application/json;q=0.9, application/octet-stream;q=1"}
+		assert.Equal(t, true, SSZRequested(request))
+	})
+
+	t.Run("no_header", func(t *testing.T) {
+		request := httptest.NewRequest("GET", "http://foo.example", nil)
+		assert.Equal(t, false, SSZRequested(request))
+	})
+
+	t.Run("other_content_type", func(t *testing.T) {
+		request := httptest.NewRequest("GET", "http://foo.example", nil)
+		request.Header["Accept"] = []string{"application/json"}
+		assert.Equal(t, false, SSZRequested(request))
+	})
+}
+
+func TestSSZPosted(t *testing.T) {
+	t.Run("ssz_posted", func(t *testing.T) {
+		request := httptest.NewRequest("POST", "http://foo.example", nil)
+		request.Header.Set("Content-Type", "application/octet-stream")
+		assert.Equal(t, true, SSZPosted(request))
+	})
+
+	t.Run("json_posted", func(t *testing.T) {
+		request := httptest.NewRequest("POST", "http://foo.example", nil)
+		request.Header.Set("Content-Type", "application/json")
+		assert.Equal(t, false, SSZPosted(request))
+	})
+
+	t.Run("no_header", func(t *testing.T) {
+		request := httptest.NewRequest("POST", "http://foo.example", nil)
+		assert.Equal(t, false, SSZPosted(request))
+	})
 }
 
 func TestReadGrpcResponseBody(t *testing.T) {
@@ -350,6 +410,27 @@ func TestWriteMiddlewareResponseHeadersAndBody(t *testing.T) {
 		assert.Equal(t, 204, writer.Code)
 	})
 
+	t.Run("no_response_body", func(t *testing.T) {
+		request := httptest.NewRequest("POST", "http://foo.example", &body)
+		response := &http.Response{
+			Header: http.Header{
+				"Content-Length": []string{"2"},
+			},
+			StatusCode: 200,
+		}
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		errJson := WriteMiddlewareResponseHeadersAndBody(request, response, nil, writer)
+		require.Equal(t, true, errJson == nil)
+		v, ok := writer.Header()["Content-Length"]
+		require.Equal(t, true, ok, "header not found")
+		require.Equal(t, 1, len(v), "wrong number of header values")
+		assert.Equal(t, "0", v[0])
+		assert.Equal(t, 200, writer.Code)
+		assert.Equal(t, 0, writer.Body.Len())
+	})
+
 	t.Run("POST_with_response_body", func(t *testing.T) {
 		request := httptest.NewRequest("POST", "http://foo.example", &body)
 		response := &http.Response{
@@ -13,6 +13,7 @@ import (
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/apiauth"
 	"github.com/rs/cors"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/connectivity"
@@ -51,6 +52,7 @@ type Gateway struct {
 	startFailure                 error
 	remoteAddr                   string
 	allowedOrigins               []string
+	authorizer                   *apiauth.Authorizer
 }
 
 // New returns a new instance of the Gateway.
@@ -104,6 +106,12 @@ func (g *Gateway) WithApiMiddleware(address string, endpointFactory EndpointFact
 	return g
 }
 
+// WithAuthorizer allows restricting the REST API to authorized callers.
+func (g *Gateway) WithAuthorizer(a *apiauth.Authorizer) *Gateway {
+	g.authorizer = a
+	return g
+}
+
 // Start the gateway service.
 func (g *Gateway) Start() {
 	ctx, cancel := context.WithCancel(g.ctx)
@@ -135,9 +143,13 @@ func (g *Gateway) Start() {
 		g.muxHandler(corsMux, w, r)
 	})
 
+	handler := corsMux
+	if g.authorizer != nil {
+		handler = g.corsMiddleware(g.authorizer.HTTPMiddleware(g.mux))
+	}
 	g.server = &http.Server{
 		Addr:    g.gatewayAddr,
-		Handler: corsMux,
+		Handler: handler,
 	}
 
 	go func() {
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/shared/apiauth"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	logTest "github.com/sirupsen/logrus/hooks/test"
@@ -36,6 +37,8 @@ func TestGateway_Customized(t *testing.T) {
 	size := uint64(100)
 	middlewareAddr := "middleware"
 	endpointFactory := &mockEndpointFactory{}
+	authorizer, err := apiauth.NewAuthorizer(&apiauth.Config{Tokens: []*apiauth.TokenConfig{{Name: "a", Token: "abc"}}})
+	require.NoError(t, err)
 
 	g := New(
 		context.Background(),
@@ -49,7 +52,8 @@ func TestGateway_Customized(t *testing.T) {
 		WithRemoteCert(cert).
 		WithAllowedOrigins(origins).
 		WithMaxCallRecvMsgSize(size).
-		WithApiMiddleware(middlewareAddr, endpointFactory)
+		WithApiMiddleware(middlewareAddr, endpointFactory).
+		WithAuthorizer(authorizer)
 
 	assert.Equal(t, mux, g.mux)
 	assert.Equal(t, cert, g.remoteCert)
@@ -58,6 +62,7 @@ func TestGateway_Customized(t *testing.T) {
 	assert.Equal(t, size, g.maxCallRecvMsgSize)
 	assert.Equal(t, middlewareAddr, g.apiMiddlewareAddr)
 	assert.Equal(t, endpointFactory, g.apiMiddlewareEndpointFactory)
+	assert.Equal(t, authorizer, g.authorizer)
 }
 
 func TestGateway_StartStop(t *testing.T) {
@@ -0,0 +1,588 @@
+package gateway
+
+import (
+	"encoding/binary"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// bytesPerLengthOffset is the size of an offset pointing at a variable-size part of an SSZ object.
+const bytesPerLengthOffset = 4
+
+// errNoSSZForm is returned for values that can't be represented in SSZ.
+var errNoSSZForm = errors.New("value has no SSZ form")
+
+var protoEnumType = reflect.TypeOf((*protoreflect.Enum)(nil)).Elem()
+
+// sszMarshaler is implemented by types with generated SSZ serialization code.
+type sszMarshaler interface {
+	MarshalSSZ() ([]byte, error)
+}
+
+// sszUnmarshaler is implemented by types with generated SSZ deserialization code.
+type sszUnmarshaler interface {
+	UnmarshalSSZ(buf []byte) error
+}
+
+// sszDims holds the dimensions declared in the ssz-size and ssz-max tags of a field.
+// "?" in ssz-size marks a list whose maximum length is taken from ssz-max.
+type sszDims struct {
+	size []string
+	max  []string
+}
+
+// sszField is a single field of an SSZ container. A oneof field takes the SSZ form of its populated member.
+type sszField struct {
+	index int
+	dims  sszDims
+	oneof bool
+}
+
+// inner returns the dimensions of elements of a sequence.
+func (d sszDims) inner() sszDims {
+	var i sszDims
+	if len(d.size) > 0 {
+		i.size = d.size[1:]
+	}
+	if len(d.max) > 0 {
+		i.max = d.max[1:]
+	}
+	return i
+}
+
+// vectorLength returns the length of a sequence if the sequence is a fixed-length vector.
+func (d sszDims) vectorLength() (int, bool) {
+	if len(d.size) == 0 {
+		return 0, false
+	}
+	n, err := strconv.Atoi(d.size[0])
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
+
+// maxLength returns the maximum length of a list, if one is declared.
+func (d sszDims) maxLength() (int, bool) {
+	if len(d.max) == 0 {
+		return 0, false
+	}
+	n, err := strconv.Atoi(d.max[0])
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
+
+// marshalSSZ serializes a protobuf message into SSZ. Messages consisting only of a data field
+// (and optionally a version field) are represented by the SSZ form of that field.
+func marshalSSZ(v interface{}) ([]byte, error) {
+	if m, ok := v.(sszMarshaler); ok {
+		return m.MarshalSSZ()
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return nil, errNoSSZForm
+	}
+	data, dims, ok, err := dataField(rv.Elem())
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return encodeSSZ(data, dims)
+	}
+	return encodeSSZ(rv, sszDims{})
+}
+
+// unmarshalSSZ deserializes SSZ into a protobuf message. Messages consisting only of a data field
+// are populated by deserializing the SSZ into that field.
+func unmarshalSSZ(b []byte, v interface{}) error {
+	if u, ok := v.(sszUnmarshaler); ok {
+		return u.UnmarshalSSZ(b)
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return errors.New("SSZ can only be deserialized into a non-nil pointer")
+	}
+	if rv.Elem().Kind() == reflect.Struct {
+		data, dims, ok, err := dataField(rv.Elem())
+		if err != nil {
+			return err
+		}
+		if ok {
+			return decodeSSZ(data, dims, b)
+		}
+		return decodeSSZContainer(rv.Elem(), b)
+	}
+	return decodeSSZ(rv.Elem(), sszDims{}, b)
+}
+
+// dataField returns the data field of a struct, provided it is the struct's only field.
+// A version field accompanying the data of fork-versioned responses is not part of the SSZ form.
+func dataField(s reflect.Value) (reflect.Value, sszDims, bool, error) {
+	fields, err := sszFields(s.Type())
+	if err != nil {
+		return reflect.Value{}, sszDims{}, false, err
+	}
+	if len(fields) == 2 && hasProtoName(s.Type().Field(fields[0].index), "version") {
+		fields = fields[1:]
+	}
+	if len(fields) != 1 || !hasProtoName(s.Type().Field(fields[0].index), "data") {
+		return reflect.Value{}, sszDims{}, false, nil
+	}
+	return s.Field(fields[0].index), fields[0].dims, true, nil
+}
+
+// hasProtoName reports whether a struct field is the protobuf field with the given name.
+func hasProtoName(f reflect.StructField, name string) bool {
+	return strings.Contains(f.Tag.Get("protobuf"), ",name="+name+",")
+}
+
+// oneofMember returns the populated member of a oneof field.
+func oneofMember(s reflect.Value, f sszField) (reflect.Value, error) {
+	v := s.Field(f.index)
+	if v.IsNil() {
+		return reflect.Value{}, fmt.Errorf("oneof field %s is not set", s.Type().Field(f.index).Name)
+	}
+	// Members are wrapped in a single-field struct.
+	return v.Elem().Elem().Field(0), nil
+}
+
+// sszFields returns the fields of a protobuf message struct in SSZ order, which is the order of declaration.
+func sszFields(t reflect.Type) ([]sszField, error) {
+	var fields []sszField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+		if _, ok := f.Tag.Lookup("protobuf_oneof"); ok {
+			fields = append(fields, sszField{index: i, oneof: true})
+			continue
+		}
+		if _, ok := f.Tag.Lookup("protobuf"); !ok {
+			continue
+		}
+		var dims sszDims
+		if size, ok := f.Tag.Lookup("ssz-size"); ok {
+			dims.size = strings.Split(size, ",")
+		}
+		if max, ok := f.Tag.Lookup("ssz-max"); ok {
+			dims.max = strings.Split(max, ",")
+		}
+		fields = append(fields, sszField{index: i, dims: dims})
+	}
+	return fields, nil
+}
+
+// isFixedSize reports whether values of the type have a fixed SSZ size.
+func isFixedSize(t reflect.Type, dims sszDims) (bool, error) {
+	switch t.Kind() {
+	case reflect.Bool, reflect.Uint8, reflect.Uint32, reflect.Uint64:
+		return true, nil
+	case reflect.Int32:
+		if !t.Implements(protoEnumType) {
+			return false, errNoSSZForm
+		}
+		return true, nil
+	case reflect.Ptr:
+		if t.Elem().Kind() != reflect.Struct {
+			return false, errNoSSZForm
+		}
+		fields, err := sszFields(t.Elem())
+		if err != nil {
+			return false, err
+		}
+		fixed := true
+		for _, f := range fields {
+			if f.oneof {
+				// The size depends on which member is populated.
+				fixed = false
+				continue
+			}
+			fieldFixed, err := isFixedSize(t.Elem().Field(f.index).Type, f.dims)
+			if err != nil {
+				return false, err
+			}
+			fixed = fixed && fieldFixed
+		}
+		return fixed, nil
+	case reflect.Slice:
+		elemFixed, err := isFixedSize(t.Elem(), dims.inner())
+		if err != nil {
+			return false, err
+		}
+		_, isVector := dims.vectorLength()
+		return isVector && elemFixed, nil
+	default:
+		return false, errNoSSZForm
+	}
+}
+
+// fixedSize returns the SSZ size of values of a fixed-size type.
+func fixedSize(t reflect.Type, dims sszDims) (int, error) {
+	switch t.Kind() {
+	case reflect.Bool, reflect.Uint8:
+		return 1, nil
+	case reflect.Uint32:
+		return 4, nil
+	case reflect.Uint64, reflect.Int32:
+		return 8, nil
+	case reflect.Ptr:
+		fields, err := sszFields(t.Elem())
+		if err != nil {
+			return 0, err
+		}
+		size := 0
+		for _, f := range fields {
+			fieldSize, err := fixedSize(t.Elem().Field(f.index).Type, f.dims)
+			if err != nil {
+				return 0, err
+			}
+			size += fieldSize
+		}
+		return size, nil
+	case reflect.Slice:
+		n, ok := dims.vectorLength()
+		if !ok {
+			return 0, errors.New("list has no fixed size")
+		}
+		elemSize, err := fixedSize(t.Elem(), dims.inner())
+		if err != nil {
+			return 0, err
+		}
+		return n * elemSize, nil
+	default:
+		return 0, errNoSSZForm
+	}
+}
+
+func encodeSSZ(v reflect.Value, dims sszDims) ([]byte, error) {
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			return []byte{1}, nil
+		}
+		return []byte{0}, nil
+	case reflect.Uint8:
+		return []byte{uint8(v.Uint())}, nil
+	case reflect.Uint32:
+		b := make([]byte, 4)
+		binary.LittleEndian.PutUint32(b, uint32(v.Uint()))
+		return b, nil
+	case reflect.Uint64:
+		b := make([]byte, 8)
+		binary.LittleEndian.PutUint64(b, v.Uint())
+		return b, nil
+	case reflect.Int32:
+		if !v.Type().Implements(protoEnumType) {
+			return nil, errNoSSZForm
+		}
+		b := make([]byte, 8)
+		binary.LittleEndian.PutUint64(b, uint64(v.Int()))
+		return b, nil
+	case reflect.Ptr:
+		if v.Type().Elem().Kind() != reflect.Struct {
+			return nil, errNoSSZForm
+		}
+		if v.IsNil() {
+			v = reflect.New(v.Type().Elem())
+		}
+		if m, ok := v.Interface().(sszMarshaler); ok {
+			return m.MarshalSSZ()
+		}
+		return encodeSSZContainer(v.Elem())
+	case reflect.Slice:
+		if n, ok := dims.vectorLength(); ok && v.Len() != n {
+			return nil, fmt.Errorf("expected vector of length %d, got %d", n, v.Len())
+		}
+		if n, ok := dims.maxLength(); ok && v.Len() > n {
+			return nil, fmt.Errorf("list length %d exceeds maximum %d", v.Len(), n)
+		}
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return append([]byte{}, v.Bytes()...), nil
+		}
+		return encodeSSZSequence(v, dims.inner())
+	default:
+		return nil, errNoSSZForm
+	}
+}
+
+func encodeSSZSequence(v reflect.Value, elemDims sszDims) ([]byte, error) {
+	fixed, err := isFixedSize(v.Type().Elem(), elemDims)
+	if err != nil {
+		return nil, err
+	}
+	parts := make([][]byte, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		if parts[i], err = encodeSSZ(v.Index(i), elemDims); err != nil {
+			return nil, err
+		}
+	}
+	var b []byte
+	if !fixed {
+		offset := bytesPerLengthOffset * len(parts)
+		for _, p := range parts {
+			b = appendOffset(b, offset)
+			offset += len(p)
+		}
+	}
+	for _, p := range parts {
+		b = append(b, p...)
+	}
+	return b, nil
+}
+
+// encodeSSZContainer encodes a message as an SSZ container. A message consisting only of a oneof
+// is encoded as its populated member.
+func encodeSSZContainer(s reflect.Value) ([]byte, error) {
+	fields, err := sszFields(s.Type())
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 1 && fields[0].oneof {
+		member, err := oneofMember(s, fields[0])
+		if err != nil {
+			return nil, err
+		}
+		return encodeSSZ(member, sszDims{})
+	}
+	fixedParts := make([][]byte, len(fields))
+	variableParts := make([][]byte, len(fields))
+	fixedLength := 0
+	for i, f := range fields {
+		v := s.Field(f.index)
+		if f.oneof {
+			if v, err = oneofMember(s, f); err != nil {
+				return nil, err
+			}
+		}
+		fieldFixed, err := isFixedSize(v.Type(), f.dims)
+		if err != nil {
+			return nil, err
+		}
+		enc, err := encodeSSZ(v, f.dims)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not encode field %s", s.Type().Field(f.index).Name)
+		}
+		if fieldFixed {
+			fixedParts[i] = enc
+			fixedLength += len(enc)
+		} else {
+			variableParts[i] = enc
+			fixedLength += bytesPerLengthOffset
+		}
+	}
+	var b []byte
+	offset := fixedLength
+	for i := range fields {
+		if fixedParts[i] != nil {
+			b = append(b, fixedParts[i]...)
+		} else {
+			b = appendOffset(b, offset)
+			offset += len(variableParts[i])
+		}
+	}
+	for _, p := range variableParts {
+		b = append(b, p...)
+	}
+	return b, nil
+}
+
+func appendOffset(b []byte, offset int) []byte {
+	o := make([]byte, bytesPerLengthOffset)
+	binary.LittleEndian.PutUint32(o, uint32(offset))
+	return append(b, o...)
+}
+
+func readOffset(b []byte) int {
+	return int(binary.LittleEndian.Uint32(b[:bytesPerLengthOffset]))
+}
+
+func decodeSSZ(v reflect.Value, dims sszDims, b []byte) error {
+	switch v.Kind() {
+	case reflect.Bool:
+		if len(b) != 1 || b[0] > 1 {
+			return errors.New("invalid boolean")
+		}
+		v.SetBool(b[0] == 1)
+	case reflect.Uint8:
+		if len(b) != 1 {
+			return errors.New("invalid uint8 size")
+		}
+		v.SetUint(uint64(b[0]))
+	case reflect.Uint32:
+		if len(b) != 4 {
+			return errors.New("invalid uint32 size")
+		}
+		v.SetUint(uint64(binary.LittleEndian.Uint32(b)))
+	case reflect.Uint64:
+		if len(b) != 8 {
+			return errors.New("invalid uint64 size")
+		}
+		v.SetUint(binary.LittleEndian.Uint64(b))
+	case reflect.Int32:
+		if !v.Type().Implements(protoEnumType) {
+			return errNoSSZForm
+		}
+		if len(b) != 8 {
+			return errors.New("invalid enum size")
+		}
+		v.SetInt(int64(binary.LittleEndian.Uint64(b)))
+	case reflect.Ptr:
+		if v.Type().Elem().Kind() != reflect.Struct {
+			return errNoSSZForm
+		}
+		nv := reflect.New(v.Type().Elem())
+		if u, ok := nv.Interface().(sszUnmarshaler); ok {
+			if err := u.UnmarshalSSZ(b); err != nil {
+				return err
+			}
+		} else if err := decodeSSZContainer(nv.Elem(), b); err != nil {
+			return err
+		}
+		v.Set(nv)
+	case reflect.Slice:
+		return decodeSSZSequence(v, dims, b)
+	default:
+		return errNoSSZForm
+	}
+	return nil
+}
+
+func decodeSSZSequence(v reflect.Value, dims sszDims, b []byte) error {
+	elemType := v.Type().Elem()
+	if elemType.Kind() == reflect.Uint8 {
+		if n, ok := dims.vectorLength(); ok && len(b) != n {
+			return fmt.Errorf("expected vector of length %d, got %d", n, len(b))
+		}
+		if n, ok := dims.maxLength(); ok && len(b) > n {
+			return fmt.Errorf("list length %d exceeds maximum %d", len(b), n)
+		}
+		v.SetBytes(append([]byte{}, b...))
+		return nil
+	}
+	elemDims := dims.inner()
+	fixed, err := isFixedSize(elemType, elemDims)
+	if err != nil {
+		return err
+	}
+
+	var chunks [][]byte
+	if fixed {
+		size, err := fixedSize(elemType, elemDims)
+		if err != nil {
+			return err
+		}
+		if size == 0 || len(b)%size != 0 {
+			return fmt.Errorf("sequence size %d is not a multiple of element size %d", len(b), size)
+		}
+		for i := 0; i < len(b); i += size {
+			chunks = append(chunks, b[i:i+size])
+		}
+	} else if len(b) > 0 {
+		if len(b) < bytesPerLengthOffset {
+			return errors.New("sequence too short")
+		}
+		first := readOffset(b)
+		if first == 0 || first%bytesPerLengthOffset != 0 || first > len(b) {
+			return errors.New("invalid first offset")
+		}
+		offsets := make([]int, first/bytesPerLengthOffset)
+		for i := range offsets {
+			offsets[i] = readOffset(b[i*bytesPerLengthOffset:])
+			if offsets[i] > len(b) || (i > 0 && offsets[i] < offsets[i-1]) {
+				return errors.New("invalid offset")
+			}
+		}
+		for i, o := range offsets {
+			end := len(b)
+			if i+1 < len(offsets) {
+				end = offsets[i+1]
+			}
+			chunks = append(chunks, b[o:end])
+		}
+	}
+
+	if n, ok := dims.vectorLength(); ok && len(chunks) != n {
+		return fmt.Errorf("expected vector of length %d, got %d", n, len(chunks))
+	}
+	if n, ok := dims.maxLength(); ok && len(chunks) > n {
+		return fmt.Errorf("list length %d exceeds maximum %d", len(chunks), n)
+	}
+	s := reflect.MakeSlice(v.Type(), len(chunks), len(chunks))
+	for i, c := range chunks {
+		if err := decodeSSZ(s.Index(i), elemDims, c); err != nil {
+			return err
+		}
+	}
+	v.Set(s)
+	return nil
+}
+
+func decodeSSZContainer(s reflect.Value, b []byte) error {
+	fields, err := sszFields(s.Type())
+	if err != nil {
+		return err
+	}
+	for _, f := range fields {
+		if f.oneof {
+			// SSZ does not record which member of a oneof was serialized.
+			return errNoSSZForm
+		}
+	}
+	var variable []sszField
+	var offsets []int
+	pos := 0
+	for _, f := range fields {
+		fieldFixed, err := isFixedSize(s.Field(f.index).Type(), f.dims)
+		if err != nil {
+			return err
+		}
+		size := bytesPerLengthOffset
+		if fieldFixed {
+			if size, err = fixedSize(s.Field(f.index).Type(), f.dims); err != nil {
+				return err
+			}
+		}
+		if pos+size > len(b) {
+			return errors.New("container too short")
+		}
+		if fieldFixed {
+			if err := decodeSSZ(s.Field(f.index), f.dims, b[pos:pos+size]); err != nil {
+				return errors.Wrapf(err, "could not decode field %s", s.Type().Field(f.index).Name)
+			}
+		} else {
+			variable = append(variable, f)
+			offsets = append(offsets, readOffset(b[pos:]))
+		}
+		pos += size
+	}
+	if len(variable) == 0 {
+		if pos != len(b) {
+			return errors.New("unexpected container size")
+		}
+		return nil
+	}
+	if offsets[0] != pos {
+		return errors.New("invalid first offset")
+	}
+	for i, f := range variable {
+		end := len(b)
+		if i+1 < len(offsets) {
+			end = offsets[i+1]
+		}
+		if offsets[i] > end || end > len(b) {
+			return errors.New("invalid offset")
+		}
+		if err := decodeSSZ(s.Field(f.index), f.dims, b[offsets[i]:end]); err != nil {
+			return errors.Wrapf(err, "could not decode field %s", s.Type().Field(f.index).Name)
+		}
+	}
+	return nil
+}
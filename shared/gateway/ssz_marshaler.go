@@ -0,0 +1,85 @@
+package gateway
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// SSZContentType is the content type of SSZ-encoded requests and responses.
+const SSZContentType = "application/octet-stream"
+
+// JSONContentType is the content type of JSON-encoded requests and responses.
+const JSONContentType = "application/json"
+
+// rpcStatusName is the full name of the protobuf message used by grpc-gateway to describe errors.
+const rpcStatusName = "google.rpc.Status"
+
+// SSZMarshaler is a grpc-gateway marshaler which reads and writes payloads in their SSZ form.
+// A message consisting only of a data field is represented by the SSZ form of that field,
+// any other message is represented by its own SSZ form. Errors have no SSZ form,
+// so they are written using the fallback marshaler.
+type SSZMarshaler struct {
+	Fallback gwruntime.Marshaler
+}
+
+// Marshal serializes v into SSZ. Responses without an SSZ form result in a 406 Not Acceptable error.
+func (m *SSZMarshaler) Marshal(v interface{}) ([]byte, error) {
+	if isRPCStatus(v) {
+		return m.Fallback.Marshal(v)
+	}
+	b, err := marshalSSZ(v)
+	if err != nil {
+		return nil, &gwruntime.HTTPStatusError{
+			HTTPStatus: http.StatusNotAcceptable,
+			Err:        status.Errorf(codes.Unimplemented, "Could not serialize response into SSZ: %v", err),
+		}
+	}
+	return b, nil
+}
+
+// Unmarshal deserializes SSZ data into v.
+func (m *SSZMarshaler) Unmarshal(data []byte, v interface{}) error {
+	return unmarshalSSZ(data, v)
+}
+
+// NewDecoder returns a decoder which reads the whole SSZ payload from r.
+func (m *SSZMarshaler) NewDecoder(r io.Reader) gwruntime.Decoder {
+	return gwruntime.DecoderFunc(func(v interface{}) error {
+		b, err := ioutil.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		return m.Unmarshal(b, v)
+	})
+}
+
+// NewEncoder returns an encoder which writes the SSZ form of values to w.
+func (m *SSZMarshaler) NewEncoder(w io.Writer) gwruntime.Encoder {
+	return gwruntime.EncoderFunc(func(v interface{}) error {
+		b, err := m.Marshal(v)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(b)
+		return err
+	})
+}
+
+// ContentType returns the content type of the serialized value.
+func (m *SSZMarshaler) ContentType(v interface{}) string {
+	if isRPCStatus(v) {
+		return m.Fallback.ContentType(v)
+	}
+	return SSZContentType
+}
+
+func isRPCStatus(v interface{}) bool {
+	msg, ok := v.(proto.Message)
+	return ok && msg.ProtoReflect().Descriptor().FullName() == rpcStatusName
+}
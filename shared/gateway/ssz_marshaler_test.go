@@ -0,0 +1,243 @@
+package gateway
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"net/http"
+	"testing"
+
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func testAttestation(slot uint64) *ethpb.Attestation {
+	return &ethpb.Attestation{
+		AggregationBits: []byte{0x0f},
+		Data: &ethpb.AttestationData{
+			Slot:            1,
+			Index:           2,
+			BeaconBlockRoot: bytes.Repeat([]byte{'a'}, 32),
+			Source:          &ethpb.Checkpoint{Epoch: 3, Root: bytes.Repeat([]byte{'b'}, 32)},
+			Target:          &ethpb.Checkpoint{Epoch: 4, Root: bytes.Repeat([]byte{'c'}, 32)},
+		},
+		Signature: bytes.Repeat([]byte{byte(slot)}, 96),
+	}
+}
+
+func offset(o int) []byte {
+	b := make([]byte, 4)
+	binary.LittleEndian.PutUint32(b, uint32(o))
+	return b
+}
+
+func TestSSZMarshaler_Marshal(t *testing.T) {
+	m := &SSZMarshaler{Fallback: &gwruntime.JSONPb{}}
+
+	t.Run("generated_code", func(t *testing.T) {
+		att := testAttestation(1)
+		expected, err := att.MarshalSSZ()
+		require.NoError(t, err)
+		b, err := m.Marshal(att)
+		require.NoError(t, err)
+		assert.DeepEqual(t, expected, b)
+		assert.Equal(t, SSZContentType, m.ContentType(att))
+	})
+
+	t.Run("data_list_of_variable_size_elements", func(t *testing.T) {
+		att1, att2 := testAttestation(1), testAttestation(2)
+		enc1, err := att1.MarshalSSZ()
+		require.NoError(t, err)
+		enc2, err := att2.MarshalSSZ()
+		require.NoError(t, err)
+		var expected []byte
+		expected = append(expected, offset(8)...)
+		expected = append(expected, offset(8+len(enc1))...)
+		expected = append(expected, enc1...)
+		expected = append(expected, enc2...)
+
+		b, err := m.Marshal(&ethpb.SubmitAttestationsRequest{Data: []*ethpb.Attestation{att1, att2}})
+		require.NoError(t, err)
+		assert.DeepEqual(t, expected, b)
+	})
+
+	t.Run("data_bytes", func(t *testing.T) {
+		b, err := m.Marshal(&ethpb.BlockSSZResponse{Data: []byte("ssz")})
+		require.NoError(t, err)
+		assert.DeepEqual(t, []byte("ssz"), b)
+	})
+
+	t.Run("container_without_generated_code", func(t *testing.T) {
+		header := &ethpb.SignedBeaconBlockHeader{
+			Message: &ethpb.BeaconBlockHeader{
+				Slot:          1,
+				ProposerIndex: 2,
+				ParentRoot:    bytes.Repeat([]byte{'a'}, 32),
+				StateRoot:     bytes.Repeat([]byte{'b'}, 32),
+				BodyRoot:      bytes.Repeat([]byte{'c'}, 32),
+			},
+			Signature: bytes.Repeat([]byte{'d'}, 96),
+		}
+		encHeader, err := header.MarshalSSZ()
+		require.NoError(t, err)
+		container := &ethpb.BlockHeaderContainer{
+			Root:      bytes.Repeat([]byte{'e'}, 32),
+			Canonical: true,
+			Header:    &ethpb.BeaconBlockHeaderContainer{Message: header.Message, Signature: header.Signature},
+		}
+		var expected []byte
+		expected = append(expected, container.Root...)
+		expected = append(expected, 1)
+		expected = append(expected, encHeader...)
+
+		b, err := m.Marshal(&ethpb.BlockHeaderResponse{Data: container})
+		require.NoError(t, err)
+		assert.DeepEqual(t, expected, b)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		b, err := m.Marshal(&emptypb.Empty{})
+		require.NoError(t, err)
+		assert.Equal(t, 0, len(b))
+	})
+
+	t.Run("versioned_oneof_data", func(t *testing.T) {
+		block := testBlock()
+		expected, err := (&ethpb.SignedBeaconBlock{Block: block, Signature: make([]byte, 96)}).MarshalSSZ()
+		require.NoError(t, err)
+		b, err := m.Marshal(&ethpbv2.BlockResponseV2{
+			Version: ethpbv2.Version_PHASE0,
+			Data: &ethpbv2.SignedBeaconBlockContainerV2{
+				Message:   &ethpbv2.SignedBeaconBlockContainerV2_Phase0Block{Phase0Block: block},
+				Signature: make([]byte, 96),
+			},
+		})
+		require.NoError(t, err)
+		assert.DeepEqual(t, expected, b)
+	})
+
+	t.Run("oneof_only_message", func(t *testing.T) {
+		block := testBlock()
+		expected, err := block.MarshalSSZ()
+		require.NoError(t, err)
+		b, err := m.Marshal(&ethpbv2.ProduceBlockResponseV2{
+			Version: ethpbv2.Version_PHASE0,
+			Data: &ethpbv2.BeaconBlockContainerV2{
+				Block: &ethpbv2.BeaconBlockContainerV2_Phase0Block{Phase0Block: block},
+			},
+		})
+		require.NoError(t, err)
+		assert.DeepEqual(t, expected, b)
+	})
+
+	t.Run("unset_oneof", func(t *testing.T) {
+		_, err := m.Marshal(&ethpbv2.ProduceBlockResponseV2{Data: &ethpbv2.BeaconBlockContainerV2{}})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("no_ssz_form", func(t *testing.T) {
+		_, err := m.Marshal(&ethpb.GenesisResponse{Data: &ethpb.GenesisResponse_Genesis{}})
+		require.NotNil(t, err)
+		statusErr := &gwruntime.HTTPStatusError{}
+		require.Equal(t, true, errors.As(err, &statusErr))
+		assert.Equal(t, http.StatusNotAcceptable, statusErr.HTTPStatus)
+	})
+
+	t.Run("wrong_vector_length", func(t *testing.T) {
+		_, err := m.Marshal(&ethpb.BlockHeaderResponse{Data: &ethpb.BlockHeaderContainer{Root: []byte("foo")}})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("error_status", func(t *testing.T) {
+		s := status.New(codes.NotFound, "not found").Proto()
+		b, err := m.Marshal(s)
+		require.NoError(t, err)
+		assert.Equal(t, true, bytes.Contains(b, []byte("not found")))
+		assert.Equal(t, "application/json", m.ContentType(s))
+	})
+}
+
+func TestSSZMarshaler_Unmarshal(t *testing.T) {
+	m := &SSZMarshaler{Fallback: &gwruntime.JSONPb{}}
+
+	t.Run("data_list_of_variable_size_elements", func(t *testing.T) {
+		req := &ethpb.SubmitAttestationsRequest{Data: []*ethpb.Attestation{testAttestation(1), testAttestation(2)}}
+		b, err := m.Marshal(req)
+		require.NoError(t, err)
+		decoded := &ethpb.SubmitAttestationsRequest{}
+		require.NoError(t, m.NewDecoder(bytes.NewReader(b)).Decode(decoded))
+		assert.Equal(t, true, proto.Equal(req, decoded))
+	})
+
+	t.Run("empty_list", func(t *testing.T) {
+		decoded := &ethpb.SubmitAttestationsRequest{}
+		require.NoError(t, m.Unmarshal([]byte{}, decoded))
+		assert.Equal(t, 0, len(decoded.Data))
+	})
+
+	t.Run("container_without_generated_code", func(t *testing.T) {
+		resp := &ethpb.StateValidatorsResponse{Data: []*ethpb.ValidatorContainer{
+			{
+				Index:   1,
+				Balance: 32,
+				Status:  ethpb.ValidatorStatus_ACTIVE,
+				Validator: &ethpb.Validator{
+					Pubkey:                bytes.Repeat([]byte{'a'}, 48),
+					WithdrawalCredentials: bytes.Repeat([]byte{'b'}, 32),
+					EffectiveBalance:      32,
+				},
+			},
+			{
+				Index:   2,
+				Balance: 31,
+				Status:  ethpb.ValidatorStatus_EXITED,
+				Validator: &ethpb.Validator{
+					Pubkey:                bytes.Repeat([]byte{'c'}, 48),
+					WithdrawalCredentials: bytes.Repeat([]byte{'d'}, 32),
+					Slashed:               true,
+				},
+			},
+		}}
+		b, err := m.Marshal(resp)
+		require.NoError(t, err)
+		decoded := &ethpb.StateValidatorsResponse{}
+		require.NoError(t, m.Unmarshal(b, decoded))
+		assert.Equal(t, true, proto.Equal(resp, decoded))
+	})
+
+	t.Run("invalid_data", func(t *testing.T) {
+		decoded := &ethpb.SubmitAttestationsRequest{}
+		assert.NotNil(t, m.Unmarshal([]byte{0xff, 0xff, 0xff, 0xff}, decoded))
+	})
+
+	t.Run("oneof", func(t *testing.T) {
+		b, err := testBlock().MarshalSSZ()
+		require.NoError(t, err)
+		decoded := &ethpbv2.ProduceBlockResponseV2{}
+		assert.NotNil(t, m.Unmarshal(b, decoded))
+	})
+}
+
+func testBlock() *ethpb.BeaconBlock {
+	return &ethpb.BeaconBlock{
+		Slot:       1,
+		ParentRoot: bytes.Repeat([]byte{'a'}, 32),
+		StateRoot:  bytes.Repeat([]byte{'b'}, 32),
+		Body: &ethpb.BeaconBlockBody{
+			RandaoReveal: bytes.Repeat([]byte{'c'}, 96),
+			Eth1Data: &ethpb.Eth1Data{
+				DepositRoot: bytes.Repeat([]byte{'d'}, 32),
+				BlockHash:   bytes.Repeat([]byte{'e'}, 32),
+			},
+			Graffiti:     bytes.Repeat([]byte{'f'}, 32),
+			Attestations: []*ethpb.Attestation{testAttestation(1)},
+		},
+	}
+}
@@ -78,6 +78,26 @@ func (mr *MockBeaconChainClientMockRecorder) GetBeaconConfig(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBeaconConfig", reflect.TypeOf((*MockBeaconChainClient)(nil).GetBeaconConfig), varargs...)
 }
 
+// GetBlockCreditedAttesters mocks base method
+func (m *MockBeaconChainClient) GetBlockCreditedAttesters(arg0 context.Context, arg1 *v1alpha1.BlockCreditedAttestersRequest, arg2 ...grpc.CallOption) (*v1alpha1.BlockCreditedAttesters, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBlockCreditedAttesters", varargs...)
+	ret0, _ := ret[0].(*v1alpha1.BlockCreditedAttesters)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockCreditedAttesters indicates an expected call of GetBlockCreditedAttesters
+func (mr *MockBeaconChainClientMockRecorder) GetBlockCreditedAttesters(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockCreditedAttesters", reflect.TypeOf((*MockBeaconChainClient)(nil).GetBlockCreditedAttesters), varargs...)
+}
+
 // GetChainHead mocks base method
 func (m *MockBeaconChainClient) GetChainHead(arg0 context.Context, arg1 *emptypb.Empty, arg2 ...grpc.CallOption) (*v1alpha1.ChainHead, error) {
 	m.ctrl.T.Helper()
@@ -238,6 +258,26 @@ func (mr *MockBeaconChainClientMockRecorder) GetWeakSubjectivityCheckpoint(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWeakSubjectivityCheckpoint", reflect.TypeOf((*MockBeaconChainClient)(nil).GetWeakSubjectivityCheckpoint), varargs...)
 }
 
+// ListAttestationInclusions mocks base method
+func (m *MockBeaconChainClient) ListAttestationInclusions(arg0 context.Context, arg1 *v1alpha1.ListAttestationInclusionsRequest, arg2 ...grpc.CallOption) (*v1alpha1.AttestationInclusions, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAttestationInclusions", varargs...)
+	ret0, _ := ret[0].(*v1alpha1.AttestationInclusions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAttestationInclusions indicates an expected call of ListAttestationInclusions
+func (mr *MockBeaconChainClientMockRecorder) ListAttestationInclusions(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttestationInclusions", reflect.TypeOf((*MockBeaconChainClient)(nil).ListAttestationInclusions), varargs...)
+}
+
 // ListAttestations mocks base method
 func (m *MockBeaconChainClient) ListAttestations(arg0 context.Context, arg1 *v1alpha1.ListAttestationsRequest, arg2 ...grpc.CallOption) (*v1alpha1.ListAttestationsResponse, error) {
 	m.ctrl.T.Helper()
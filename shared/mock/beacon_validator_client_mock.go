@@ -138,6 +138,26 @@ func (mr *MockBeaconNodeValidatorClientMockRecorder) GetDuties(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDuties", reflect.TypeOf((*MockBeaconNodeValidatorClient)(nil).GetDuties), varargs...)
 }
 
+// GetValidatorLiveness mocks base method
+func (m *MockBeaconNodeValidatorClient) GetValidatorLiveness(arg0 context.Context, arg1 *v1alpha1.ValidatorLivenessRequest, arg2 ...grpc.CallOption) (*v1alpha1.ValidatorLivenessResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetValidatorLiveness", varargs...)
+	ret0, _ := ret[0].(*v1alpha1.ValidatorLivenessResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValidatorLiveness indicates an expected call of GetValidatorLiveness
+func (mr *MockBeaconNodeValidatorClientMockRecorder) GetValidatorLiveness(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorLiveness", reflect.TypeOf((*MockBeaconNodeValidatorClient)(nil).GetValidatorLiveness), varargs...)
+}
+
 // MultipleValidatorStatus mocks base method
 func (m *MockBeaconNodeValidatorClient) MultipleValidatorStatus(arg0 context.Context, arg1 *v1alpha1.MultipleValidatorStatusRequest, arg2 ...grpc.CallOption) (*v1alpha1.MultipleValidatorStatusResponse, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,5 @@
+package networkdir
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "networkdir")
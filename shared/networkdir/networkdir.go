@@ -0,0 +1,253 @@
+// Package networkdir loads a custom network from a directory laid out as in the network
+// configuration repositories of Ethereum consensus networks:
+//
+//   config.yaml          - the beacon chain config of the network (required).
+//   genesis.ssz          - the SSZ encoded genesis state.
+//   bootstrap_nodes.txt  - the ENRs of the bootstrap nodes, one per line.
+//   deploy_block.txt     - the eth1 block in which the deposit contract was deployed.
+//   deposit_contract.txt - the address of the deposit contract.
+package networkdir
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/pkg/errors"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+	"gopkg.in/yaml.v2"
+)
+
+// File names of the network directory layout.
+const (
+	ConfigFileName          = "config.yaml"
+	GenesisStateFileName    = "genesis.ssz"
+	BootstrapNodesFileName  = "bootstrap_nodes.txt"
+	DeployBlockFileName     = "deploy_block.txt"
+	DepositContractFileName = "deposit_contract.txt"
+)
+
+// Network is a custom network loaded from a network directory.
+type Network struct {
+	Dir           string
+	BeaconConfig  *params.BeaconChainConfig
+	NetworkConfig *params.NetworkConfig
+	// GenesisStatePath is the path of the genesis state of the network, empty if the directory has none.
+	GenesisStatePath string
+}
+
+// Load reads a network directory, and checks that its files are consistent with each other. Only the
+// config file is required, a network without bootstrap nodes or deploy block leaves them empty.
+func Load(dir string) (*Network, error) {
+	expanded, err := fileutil.ExpandPath(dir)
+	if err != nil {
+		return nil, err
+	}
+	if info, err := os.Stat(expanded); err != nil {
+		return nil, errors.Wrapf(err, "could not read network directory %s", expanded)
+	} else if !info.IsDir() {
+		return nil, fmt.Errorf("network directory %s is not a directory", expanded)
+	}
+	n := &Network{
+		Dir:           expanded,
+		NetworkConfig: params.BeaconNetworkConfig().Copy(),
+	}
+	// The bootstrap nodes and deploy block of the current network are not the ones of a custom network.
+	n.NetworkConfig.BootstrapNodes = nil
+	n.NetworkConfig.ContractDeploymentBlock = 0
+
+	configPath := filepath.Join(expanded, ConfigFileName)
+	if !fileutil.FileExists(configPath) {
+		return nil, fmt.Errorf("network directory %s has no %s", expanded, ConfigFileName)
+	}
+	n.BeaconConfig, err = params.UnmarshalConfigFile(configPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not load %s", configPath)
+	}
+	if err := n.loadDepositContract(configPath); err != nil {
+		return nil, err
+	}
+	if err := n.loadDeployBlock(); err != nil {
+		return nil, err
+	}
+	if err := n.loadBootstrapNodes(); err != nil {
+		return nil, err
+	}
+	if err := n.checkGenesisState(); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// ConfigureFromCLI loads the network directory set with --network-dir if any, and applies it. The
+// network directory replaces the named networks and the chain config file, so it cannot be used with
+// their flags, while the flags setting individual values of the network still override them.
+func ConfigureFromCLI(cliCtx *cli.Context) (*Network, error) {
+	if !cliCtx.IsSet(cmd.NetworkDirFlag.Name) {
+		return nil, nil
+	}
+	conflicts := []cli.Flag{
+		cmd.ChainConfigFileFlag,
+		featureconfig.Mainnet,
+		featureconfig.PyrmontTestnet,
+		featureconfig.ToledoTestnet,
+		featureconfig.PraterTestnet,
+	}
+	for _, flag := range conflicts {
+		if name := flag.Names()[0]; cliCtx.IsSet(name) {
+			return nil, fmt.Errorf("--%s cannot be used with --%s", cmd.NetworkDirFlag.Name, name)
+		}
+	}
+	n, err := Load(cliCtx.String(cmd.NetworkDirFlag.Name))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load network directory")
+	}
+	n.Apply()
+	log.WithFields(logrus.Fields{
+		"dir":             n.Dir,
+		"configName":      n.BeaconConfig.ConfigName,
+		"depositContract": n.BeaconConfig.DepositContractAddress,
+		"bootstrapNodes":  len(n.NetworkConfig.BootstrapNodes),
+		"genesisState":    n.GenesisStatePath != "",
+	}).Info("Running on a custom network")
+	return n, nil
+}
+
+// Apply overrides the beacon chain config and the network config with the ones of the network.
+func (n *Network) Apply() {
+	params.OverrideBeaconConfig(n.BeaconConfig)
+	params.OverrideBeaconNetworkConfig(n.NetworkConfig)
+}
+
+// loadDepositContract sets the deposit contract address of the config, which the config file may set
+// as well as long as both are the same.
+func (n *Network) loadDepositContract(configPath string) error {
+	enc, ok, err := n.readFile(DepositContractFileName)
+	if err != nil || !ok {
+		return err
+	}
+	address := strings.TrimSpace(string(enc))
+	if !common.IsHexAddress(address) {
+		return fmt.Errorf("%s does not hold a valid address: %q", DepositContractFileName, address)
+	}
+	configEnc, err := ioutil.ReadFile(configPath)
+	if err != nil {
+		return err
+	}
+	values := make(map[string]interface{})
+	if err := yaml.Unmarshal(configEnc, values); err != nil {
+		return errors.Wrapf(err, "could not parse %s", configPath)
+	}
+	if _, ok := values["DEPOSIT_CONTRACT_ADDRESS"]; ok &&
+		common.HexToAddress(n.BeaconConfig.DepositContractAddress) != common.HexToAddress(address) {
+		return fmt.Errorf("deposit contract address %s in %s does not match %s in %s",
+			address, DepositContractFileName, n.BeaconConfig.DepositContractAddress, ConfigFileName)
+	}
+	n.BeaconConfig.DepositContractAddress = address
+	return nil
+}
+
+func (n *Network) loadDeployBlock() error {
+	enc, ok, err := n.readFile(DeployBlockFileName)
+	if err != nil || !ok {
+		return err
+	}
+	block, err := strconv.ParseUint(strings.TrimSpace(string(enc)), 10, 64)
+	if err != nil {
+		return errors.Wrapf(err, "%s does not hold a valid block number", DeployBlockFileName)
+	}
+	n.NetworkConfig.ContractDeploymentBlock = block
+	return nil
+}
+
+// loadBootstrapNodes reads one ENR per line, ignoring empty lines and comments. Lines may be written
+// as YAML list items, as they are in some network directories.
+func (n *Network) loadBootstrapNodes() error {
+	enc, ok, err := n.readFile(BootstrapNodesFileName)
+	if err != nil || !ok {
+		return err
+	}
+	var nodes []string
+	scanner := bufio.NewScanner(bytes.NewReader(enc))
+	for line := 1; scanner.Scan(); line++ {
+		node := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(scanner.Text()), "- "))
+		if node == "" || strings.HasPrefix(node, "#") {
+			continue
+		}
+		if _, err := enode.Parse(enode.ValidSchemes, node); err != nil {
+			return errors.Wrapf(err, "invalid bootstrap node on line %d of %s", line, BootstrapNodesFileName)
+		}
+		nodes = append(nodes, node)
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	n.NetworkConfig.BootstrapNodes = nodes
+	return nil
+}
+
+// checkGenesisState checks that the genesis state is a valid genesis state for the config, as defined by
+// is_valid_genesis_state, and was created with the genesis fork version of the config.
+func (n *Network) checkGenesisState() error {
+	enc, ok, err := n.readFile(GenesisStateFileName)
+	if err != nil || !ok {
+		return err
+	}
+	st := &pb.BeaconState{}
+	if err := st.UnmarshalSSZ(enc); err != nil {
+		return errors.Wrapf(err, "could not unmarshal %s", GenesisStateFileName)
+	}
+	if st.Slot != 0 {
+		return fmt.Errorf("%s is a state at slot %d", GenesisStateFileName, st.Slot)
+	}
+	if st.Fork == nil || !bytes.Equal(st.Fork.CurrentVersion, n.BeaconConfig.GenesisForkVersion) {
+		var version []byte
+		if st.Fork != nil {
+			version = st.Fork.CurrentVersion
+		}
+		return fmt.Errorf("fork version %#x of %s does not match genesis fork version %#x in %s",
+			version, GenesisStateFileName, n.BeaconConfig.GenesisForkVersion, ConfigFileName)
+	}
+	if st.GenesisTime < n.BeaconConfig.MinGenesisTime {
+		return fmt.Errorf("genesis time %d of %s is before the minimum genesis time %d in %s",
+			st.GenesisTime, GenesisStateFileName, n.BeaconConfig.MinGenesisTime, ConfigFileName)
+	}
+	active := uint64(0)
+	for _, v := range st.Validators {
+		if v.ActivationEpoch == 0 && v.ExitEpoch > 0 {
+			active++
+		}
+	}
+	if active < n.BeaconConfig.MinGenesisActiveValidatorCount {
+		return fmt.Errorf("%s has %d active validators, fewer than the minimum of %d in %s",
+			GenesisStateFileName, active, n.BeaconConfig.MinGenesisActiveValidatorCount, ConfigFileName)
+	}
+	n.GenesisStatePath = filepath.Join(n.Dir, GenesisStateFileName)
+	return nil
+}
+
+// readFile reads a file of the network directory, returning false if the directory has no such file.
+func (n *Network) readFile(name string) ([]byte, bool, error) {
+	path := filepath.Join(n.Dir, name)
+	if !fileutil.FileExists(path) {
+		return nil, false, nil
+	}
+	enc, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "could not read %s", path)
+	}
+	return enc, true, nil
+}
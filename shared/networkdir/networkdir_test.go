@@ -0,0 +1,189 @@
+package networkdir
+
+import (
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	testConfig = `CONFIG_NAME: "devnet"
+MIN_GENESIS_ACTIVE_VALIDATOR_COUNT: 1
+MIN_GENESIS_TIME: 1600000000
+GENESIS_FORK_VERSION: 0x00000fff
+SECONDS_PER_SLOT: 6
+`
+	testDepositContract = "0x4242424242424242424242424242424242424242"
+	testBootstrapNode   = "enr:-Ku4QFmUkNp0g9bsLX2PfVeIyT-9WO-PZlrqZBNtEyofOOfLMScDjaTzGxIb1Ns9Wo5Pm_8nlq-SZwcQfTH2cgO-s88Bh2F0dG5ldHOIAAAAAAAAAACEZXRoMpDkvpOTAAAQIP__________gmlkgnY0gmlwhBLf22SJc2VjcDI1NmsxoQLV_jMOIxKbjHFKgrkFvwDvpexo6Nd58TK5k7ss4Vt0IoN1ZHCCG1g"
+)
+
+func writeNetworkDir(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+	for name, content := range files {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), []byte(content), params.BeaconIoConfig().ReadWritePermissions))
+	}
+	return dir
+}
+
+func genesisState(t *testing.T, forkVersion []byte, genesisTime uint64) string {
+	st, err := testutil.NewBeaconState(func(state *pb.BeaconState) error {
+		state.GenesisTime = genesisTime
+		state.Fork = &pb.Fork{
+			PreviousVersion: forkVersion,
+			CurrentVersion:  forkVersion,
+		}
+		state.Validators = []*ethpb.Validator{{
+			PublicKey:             make([]byte, 48),
+			WithdrawalCredentials: make([]byte, 32),
+			ExitEpoch:             params.BeaconConfig().FarFutureEpoch,
+			WithdrawableEpoch:     params.BeaconConfig().FarFutureEpoch,
+		}}
+		state.Balances = []uint64{params.BeaconConfig().MaxEffectiveBalance}
+		return nil
+	})
+	require.NoError(t, err)
+	enc, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	return string(enc)
+}
+
+func TestLoad(t *testing.T) {
+	dir := writeNetworkDir(t, map[string]string{
+		ConfigFileName:          testConfig,
+		DepositContractFileName: testDepositContract + "\n",
+		DeployBlockFileName:     "1234\n",
+		BootstrapNodesFileName:  "# Bootnode\n\n- " + testBootstrapNode + "\n",
+		GenesisStateFileName:    genesisState(t, []byte{0, 0, 0x0f, 0xff}, 1600000000),
+	})
+	n, err := Load(dir)
+	require.NoError(t, err)
+	assert.Equal(t, "devnet", n.BeaconConfig.ConfigName)
+	assert.Equal(t, uint64(6), n.BeaconConfig.SecondsPerSlot)
+	assert.DeepEqual(t, []byte{0, 0, 0x0f, 0xff}, n.BeaconConfig.GenesisForkVersion)
+	assert.Equal(t, testDepositContract, n.BeaconConfig.DepositContractAddress)
+	assert.Equal(t, uint64(1234), n.NetworkConfig.ContractDeploymentBlock)
+	assert.DeepEqual(t, []string{testBootstrapNode}, n.NetworkConfig.BootstrapNodes)
+	assert.Equal(t, filepath.Join(dir, GenesisStateFileName), n.GenesisStatePath)
+	// Values of the network config which are not set by the directory are kept.
+	assert.Equal(t, params.BeaconNetworkConfig().MaxChunkSize, n.NetworkConfig.MaxChunkSize)
+
+	// Only the config file is required.
+	n, err = Load(writeNetworkDir(t, map[string]string{ConfigFileName: testConfig}))
+	require.NoError(t, err)
+	assert.Equal(t, "", n.GenesisStatePath)
+	assert.Equal(t, 0, len(n.NetworkConfig.BootstrapNodes))
+	assert.Equal(t, uint64(0), n.NetworkConfig.ContractDeploymentBlock)
+}
+
+func TestLoad_Inconsistent(t *testing.T) {
+	tests := []struct {
+		name      string
+		files     map[string]string
+		wantedErr string
+	}{
+		{
+			name:      "no config",
+			files:     map[string]string{DeployBlockFileName: "1"},
+			wantedErr: "has no config.yaml",
+		},
+		{
+			name: "deposit contract mismatch",
+			files: map[string]string{
+				ConfigFileName:          testConfig + "DEPOSIT_CONTRACT_ADDRESS: 0x1111111111111111111111111111111111111111\n",
+				DepositContractFileName: testDepositContract,
+			},
+			wantedErr: "does not match 0x1111111111111111111111111111111111111111 in config.yaml",
+		},
+		{
+			name: "invalid deposit contract",
+			files: map[string]string{
+				ConfigFileName:          testConfig,
+				DepositContractFileName: "0x42",
+			},
+			wantedErr: "deposit_contract.txt does not hold a valid address",
+		},
+		{
+			name: "invalid deploy block",
+			files: map[string]string{
+				ConfigFileName:      testConfig,
+				DeployBlockFileName: "latest",
+			},
+			wantedErr: "deploy_block.txt does not hold a valid block number",
+		},
+		{
+			name: "invalid bootstrap node",
+			files: map[string]string{
+				ConfigFileName:         testConfig,
+				BootstrapNodesFileName: testBootstrapNode + "\nenr:-invalid\n",
+			},
+			wantedErr: "invalid bootstrap node on line 2 of bootstrap_nodes.txt",
+		},
+		{
+			name: "genesis fork version mismatch",
+			files: map[string]string{
+				ConfigFileName:       testConfig,
+				GenesisStateFileName: genesisState(t, []byte{0, 0, 0, 1}, 1600000000),
+			},
+			wantedErr: "fork version 0x00000001 of genesis.ssz does not match genesis fork version 0x00000fff in config.yaml",
+		},
+		{
+			name: "genesis before minimum genesis time",
+			files: map[string]string{
+				ConfigFileName:       testConfig,
+				GenesisStateFileName: genesisState(t, []byte{0, 0, 0x0f, 0xff}, 1500000000),
+			},
+			wantedErr: "genesis time 1500000000 of genesis.ssz is before the minimum genesis time 1600000000",
+		},
+		{
+			name: "too few genesis validators",
+			files: map[string]string{
+				ConfigFileName:       testConfig + "MIN_GENESIS_ACTIVE_VALIDATOR_COUNT: 2\n",
+				GenesisStateFileName: genesisState(t, []byte{0, 0, 0x0f, 0xff}, 1600000000),
+			},
+			wantedErr: "genesis.ssz has 1 active validators, fewer than the minimum of 2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Load(writeNetworkDir(t, tt.files))
+			assert.ErrorContains(t, tt.wantedErr, err)
+		})
+	}
+}
+
+func TestConfigureFromCLI(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	dir := writeNetworkDir(t, map[string]string{
+		ConfigFileName:         testConfig,
+		BootstrapNodesFileName: testBootstrapNode,
+	})
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(cmd.NetworkDirFlag.Name, dir, "")
+	set.Bool(featureconfig.PraterTestnet.Name, true, "")
+	require.NoError(t, set.Set(cmd.NetworkDirFlag.Name, dir))
+	require.NoError(t, set.Set(featureconfig.PraterTestnet.Name, "true"))
+	_, err := ConfigureFromCLI(cli.NewContext(&app, set, nil))
+	assert.ErrorContains(t, "--network-dir cannot be used with --prater", err)
+
+	set = flag.NewFlagSet("test", 0)
+	set.String(cmd.NetworkDirFlag.Name, dir, "")
+	require.NoError(t, set.Set(cmd.NetworkDirFlag.Name, dir))
+	n, err := ConfigureFromCLI(cli.NewContext(&app, set, nil))
+	require.NoError(t, err)
+	assert.Equal(t, dir, n.Dir)
+	assert.Equal(t, "devnet", params.BeaconConfig().ConfigName)
+	assert.DeepEqual(t, []string{testBootstrapNode}, params.BeaconNetworkConfig().BootstrapNodes)
+}
@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"strings"
 
+	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v2"
 )
@@ -12,9 +13,20 @@ import (
 // LoadChainConfigFile load, convert hex values into valid param yaml format,
 // unmarshal , and apply beacon chain config file.
 func LoadChainConfigFile(chainConfigFileName string) {
+	conf, err := UnmarshalConfigFile(chainConfigFileName)
+	if err != nil {
+		log.WithError(err).Fatal("Failed to load chain config file.")
+	}
+	log.Debugf("Config file values: %+v", conf)
+	OverrideBeaconConfig(conf)
+}
+
+// UnmarshalConfigFile reads a beacon chain config file, with values which are not set in the file
+// defaulting to the mainnet config.
+func UnmarshalConfigFile(chainConfigFileName string) (*BeaconChainConfig, error) {
 	yamlFile, err := ioutil.ReadFile(chainConfigFileName)
 	if err != nil {
-		log.WithError(err).Fatal("Failed to read chain config file.")
+		return nil, errors.Wrap(err, "failed to read chain config file")
 	}
 	// Convert 0x hex inputs to fixed bytes arrays
 	lines := strings.Split(string(yamlFile), "\n")
@@ -29,12 +41,11 @@ func LoadChainConfigFile(chainConfigFileName string) {
 		}
 	}
 	yamlFile = []byte(strings.Join(lines, "\n"))
-	conf := MainnetConfig()
+	conf := MainnetConfig().Copy()
 	if err := yaml.Unmarshal(yamlFile, conf); err != nil {
-		log.WithError(err).Fatal("Failed to parse chain config yaml file.")
+		return nil, errors.Wrap(err, "failed to parse chain config yaml file")
 	}
-	log.Debugf("Config file values: %+v", conf)
-	OverrideBeaconConfig(conf)
+	return conf, nil
 }
 
 func replaceHexStringWithYAMLFormat(line string) []string {
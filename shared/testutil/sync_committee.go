@@ -0,0 +1,74 @@
+package testutil
+
+import (
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/protobuf/proto"
+)
+
+// SyncCommitteeState wraps a beacon state with a current and a next sync committee. It stands in
+// for an Altair beacon state in tests of code which only reads sync committees from the state.
+type SyncCommitteeState struct {
+	iface.BeaconState
+	Current *pb.SyncCommittee
+	Next    *pb.SyncCommittee
+}
+
+// CurrentSyncCommittee of the state.
+func (s *SyncCommitteeState) CurrentSyncCommittee() (*pb.SyncCommittee, error) {
+	return s.Current, nil
+}
+
+// NextSyncCommittee of the state.
+func (s *SyncCommitteeState) NextSyncCommittee() (*pb.SyncCommittee, error) {
+	return s.Next, nil
+}
+
+// Copy the state along with its sync committees.
+func (s *SyncCommitteeState) Copy() iface.BeaconState {
+	return &SyncCommitteeState{
+		BeaconState: s.BeaconState.Copy(),
+		Current:     proto.Clone(s.Current).(*pb.SyncCommittee),
+		Next:        proto.Clone(s.Next).(*pb.SyncCommittee),
+	}
+}
+
+// DeterministicSyncCommitteeState wraps the state with a current and a next sync committee. The
+// current committee is made of the state's validators in index order starting at validator 0 and
+// the next committee starts at validator 1, wrapping around the validator registry as needed.
+func DeterministicSyncCommitteeState(st iface.BeaconState) (*SyncCommitteeState, error) {
+	current, err := syncCommitteeFromOffset(st, 0)
+	if err != nil {
+		return nil, err
+	}
+	next, err := syncCommitteeFromOffset(st, 1)
+	if err != nil {
+		return nil, err
+	}
+	return &SyncCommitteeState{BeaconState: st, Current: current, Next: next}, nil
+}
+
+func syncCommitteeFromOffset(st iface.ReadOnlyBeaconState, offset uint64) (*pb.SyncCommittee, error) {
+	numVals := uint64(st.NumValidators())
+	if numVals == 0 {
+		return nil, errors.New("state has no validators")
+	}
+	size := params.BeaconConfig().SyncCommitteeSize
+	pubkeys := make([][]byte, size)
+	for i := uint64(0); i < size; i++ {
+		pk := st.PubkeyAtIndex(types.ValidatorIndex((i + offset) % numVals))
+		pubkeys[i] = pk[:]
+	}
+	aggregate, err := bls.AggregatePublicKeys(pubkeys)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SyncCommittee{
+		Pubkeys:         pubkeys,
+		AggregatePubkey: aggregate.Marshal(),
+	}, nil
+}
@@ -2,7 +2,8 @@
 Package detection defines a service that reacts to incoming blocks/attestations
 by running slashing detection for double proposals, double votes, and surround votes
 according to the Ethereum Beacon Chain specification. As soon as slashing objects are found, they are
-sent over a feed for the beaconclient service to submit to a beacon node via gRPC.
+sent over a feed for the beaconclient service to submit to a beacon node via gRPC, and for the
+RPC service to stream to its clients.
 */
 package detection
 
@@ -2,8 +2,9 @@ package detection
 
 import (
 	"context"
-	"errors"
+	"sync"
 
+	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/event"
@@ -56,6 +57,20 @@ type Service struct {
 	minMaxSpanDetector iface.SpanDetector
 	proposalsDetector  proposerIface.ProposalsDetector
 	status             Status
+	historicalLock     sync.RWMutex
+	historical         HistoricalProgress
+}
+
+// HistoricalProgress is the progress of the detection on historical chain data.
+type HistoricalProgress struct {
+	Enabled               bool
+	Completed             bool
+	StartEpoch            types.Epoch
+	NextEpoch             types.Epoch // detection ran on the epochs from StartEpoch up to NextEpoch.
+	HeadEpoch             types.Epoch // head epoch of the beacon node, up to which detection runs.
+	AttestationsProcessed uint64
+	SlashingsDetected     uint64
+	Err                   error
 }
 
 // Config options for the detection service.
@@ -81,6 +96,7 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		minMaxSpanDetector: attestations.NewSpanDetector(cfg.SlasherDB),
 		proposalsDetector:  proposals.NewProposeDetector(cfg.SlasherDB),
 		status:             None,
+		historical:         HistoricalProgress{Enabled: cfg.HistoricalDetection},
 	}
 }
 
@@ -99,6 +115,30 @@ func (s *Service) Status() error {
 	return errors.New(s.status.String())
 }
 
+// DetectionStatus returns the status of the detection service.
+func (s *Service) DetectionStatus() Status {
+	return s.status
+}
+
+// HistoricalProgress returns the progress of the detection on historical chain data.
+func (s *Service) HistoricalProgress() HistoricalProgress {
+	s.historicalLock.RLock()
+	defer s.historicalLock.RUnlock()
+	return s.historical
+}
+
+func (s *Service) updateHistoricalProgress(update func(p *HistoricalProgress)) {
+	s.historicalLock.Lock()
+	defer s.historicalLock.Unlock()
+	update(&s.historical)
+}
+
+func (s *Service) historicalDetectionFailed(err error) {
+	s.updateHistoricalProgress(func(p *HistoricalProgress) {
+		p.Err = err
+	})
+}
+
 // Start the detection service runtime.
 func (s *Service) Start() {
 	// We wait for the gRPC beacon client to be ready and the beacon node
@@ -134,17 +174,24 @@ func (s *Service) detectHistoricalChainData(ctx context.Context) {
 	latestStoredHead, err := s.cfg.SlasherDB.ChainHead(ctx)
 	if err != nil {
 		log.WithError(err).Error("Could not retrieve chain head from DB")
+		s.historicalDetectionFailed(errors.Wrap(err, "could not retrieve chain head from DB"))
 		return
 	}
 	currentChainHead, err := s.cfg.ChainFetcher.ChainHead(ctx)
 	if err != nil {
 		log.WithError(err).Error("Cannot retrieve chain head from beacon node")
+		s.historicalDetectionFailed(errors.Wrap(err, "could not retrieve chain head from beacon node"))
 		return
 	}
 	var latestStoredEpoch types.Epoch
 	if latestStoredHead != nil {
 		latestStoredEpoch = latestStoredHead.HeadEpoch
 	}
+	s.updateHistoricalProgress(func(p *HistoricalProgress) {
+		p.StartEpoch = latestStoredEpoch
+		p.NextEpoch = latestStoredEpoch
+		p.HeadEpoch = currentChainHead.HeadEpoch
+	})
 	log.Infof("Performing historical detection from epoch %d to %d", latestStoredEpoch, currentChainHead.HeadEpoch)
 
 	// We retrieve historical chain data from the last persisted chain head in the
@@ -155,21 +202,25 @@ func (s *Service) detectHistoricalChainData(ctx context.Context) {
 	for epoch := latestStoredEpoch; epoch < currentChainHead.HeadEpoch; epoch++ {
 		if ctx.Err() != nil {
 			log.WithError(err).Errorf("Could not fetch attestations for epoch: %d", epoch)
+			s.historicalDetectionFailed(ctx.Err())
 			return
 		}
 		indexedAtts, err := s.cfg.BeaconClient.RequestHistoricalAttestations(ctx, epoch)
 		if err != nil {
 			log.WithError(err).Errorf("Could not fetch attestations for epoch: %d", epoch)
+			s.historicalDetectionFailed(errors.Wrapf(err, "could not fetch attestations for epoch %d", epoch))
 			return
 		}
 		if err := s.cfg.SlasherDB.SaveIndexedAttestations(ctx, indexedAtts); err != nil {
 			log.WithError(err).Error("could not save indexed attestations")
+			s.historicalDetectionFailed(errors.Wrap(err, "could not save indexed attestations"))
 			return
 		}
 
 		for _, att := range indexedAtts {
 			if ctx.Err() == context.Canceled {
 				log.WithError(ctx.Err()).Error("context has been canceled, ending detection")
+				s.historicalDetectionFailed(ctx.Err())
 				return
 			}
 			slashings, err := s.DetectAttesterSlashings(ctx, att)
@@ -187,6 +238,10 @@ func (s *Service) detectHistoricalChainData(ctx context.Context) {
 			if err := s.UpdateHighestAttestation(ctx, att); err != nil {
 				log.WithError(err).Errorf("Could not update highest attestation")
 			}
+			s.updateHistoricalProgress(func(p *HistoricalProgress) {
+				p.AttestationsProcessed++
+				p.SlashingsDetected += uint64(len(slashings))
+			})
 		}
 		latestStoredHead = &ethpb.ChainHead{HeadEpoch: epoch}
 		if err := s.cfg.SlasherDB.SaveChainHead(ctx, latestStoredHead); err != nil {
@@ -194,17 +249,27 @@ func (s *Service) detectHistoricalChainData(ctx context.Context) {
 		}
 		storedEpoch = epoch
 		s.cfg.SlasherDB.RemoveOldestFromCache(ctx)
+		s.updateHistoricalProgress(func(p *HistoricalProgress) {
+			p.NextEpoch = epoch + 1
+		})
 		if epoch == currentChainHead.HeadEpoch-1 {
 			currentChainHead, err = s.cfg.ChainFetcher.ChainHead(ctx)
 			if err != nil {
 				log.WithError(err).Error("Cannot retrieve chain head from beacon node")
+				s.historicalDetectionFailed(errors.Wrap(err, "could not retrieve chain head from beacon node"))
 				return
 			}
+			s.updateHistoricalProgress(func(p *HistoricalProgress) {
+				p.HeadEpoch = currentChainHead.HeadEpoch
+			})
 			if epoch != currentChainHead.HeadEpoch-1 {
 				log.Infof("Continuing historical detection from epoch %d to %d", epoch, currentChainHead.HeadEpoch)
 			}
 		}
 	}
+	s.updateHistoricalProgress(func(p *HistoricalProgress) {
+		p.Completed = true
+	})
 	log.Infof("Completed slashing detection on historical chain data up to epoch %d", storedEpoch)
 }
 
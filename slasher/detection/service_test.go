@@ -0,0 +1,57 @@
+package detection
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	testDB "github.com/prysmaticlabs/prysm/slasher/db/testing"
+)
+
+type mockChainFetcher struct {
+	head *ethpb.ChainHead
+	err  error
+}
+
+func (m *mockChainFetcher) ChainHead(_ context.Context) (*ethpb.ChainHead, error) {
+	return m.head, m.err
+}
+
+func TestService_HistoricalProgress_UpToDate(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupSlasherDB(t, false)
+	require.NoError(t, db.SaveChainHead(ctx, &ethpb.ChainHead{HeadEpoch: 5}))
+	ds := NewService(ctx, &Config{
+		SlasherDB:           db,
+		ChainFetcher:        &mockChainFetcher{head: &ethpb.ChainHead{HeadEpoch: 5}},
+		HistoricalDetection: true,
+	})
+	assert.Equal(t, true, ds.HistoricalProgress().Enabled)
+	assert.Equal(t, false, ds.HistoricalProgress().Completed)
+
+	ds.detectHistoricalChainData(ctx)
+	progress := ds.HistoricalProgress()
+	assert.Equal(t, true, progress.Completed)
+	assert.Equal(t, types.Epoch(5), progress.StartEpoch)
+	assert.Equal(t, types.Epoch(5), progress.NextEpoch)
+	assert.Equal(t, types.Epoch(5), progress.HeadEpoch)
+	assert.NoError(t, progress.Err)
+}
+
+func TestService_HistoricalProgress_ChainHeadError(t *testing.T) {
+	ctx := context.Background()
+	ds := NewService(ctx, &Config{
+		SlasherDB:           testDB.SetupSlasherDB(t, false),
+		ChainFetcher:        &mockChainFetcher{err: errors.New("connection refused")},
+		HistoricalDetection: true,
+	})
+
+	ds.detectHistoricalChainData(ctx)
+	progress := ds.HistoricalProgress()
+	assert.Equal(t, false, progress.Completed)
+	assert.ErrorContains(t, "could not retrieve chain head from beacon node: connection refused", progress.Err)
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/debug"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/networkdir"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/prereq"
 	"github.com/prysmaticlabs/prysm/shared/prometheus"
@@ -74,6 +75,9 @@ func New(cliCtx *cli.Context) (*SlasherNode, error) {
 
 	featureconfig.ConfigureSlasher(cliCtx)
 	cmd.ConfigureSlasher(cliCtx)
+	if _, err := networkdir.ConfigureFromCLI(cliCtx); err != nil {
+		return nil, err
+	}
 	registry := shared.NewServiceRegistry()
 
 	ctx, cancel := context.WithCancel(cliCtx.Context)
@@ -270,13 +274,15 @@ func (n *SlasherNode) registerRPCService() error {
 	cert := n.cliCtx.String(flags.CertFlag.Name)
 	key := n.cliCtx.String(flags.KeyFlag.Name)
 	rpcService := rpc.NewService(n.ctx, &rpc.Config{
-		Host:         host,
-		Port:         port,
-		CertFlag:     cert,
-		KeyFlag:      key,
-		Detector:     detectionService,
-		SlasherDB:    n.db,
-		BeaconClient: bs,
+		Host:                  host,
+		Port:                  port,
+		CertFlag:              cert,
+		KeyFlag:               key,
+		Detector:              detectionService,
+		SlasherDB:             n.db,
+		BeaconClient:          bs,
+		AttesterSlashingsFeed: n.attesterSlashingsFeed,
+		ProposerSlashingsFeed: n.proposerSlashingsFeed,
 	})
 
 	return n.services.RegisterService(rpcService)
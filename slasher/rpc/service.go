@@ -12,7 +12,9 @@ import (
 	recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
 	"github.com/prysmaticlabs/prysm/slasher/beaconclient"
 	"github.com/prysmaticlabs/prysm/slasher/db"
@@ -36,13 +38,15 @@ type Service struct {
 
 // Config options for the slasher node RPC server.
 type Config struct {
-	Host         string
-	Port         string
-	CertFlag     string
-	KeyFlag      string
-	Detector     *detection.Service
-	SlasherDB    db.Database
-	BeaconClient *beaconclient.Service
+	Host                  string
+	Port                  string
+	CertFlag              string
+	KeyFlag               string
+	Detector              *detection.Service
+	SlasherDB             db.Database
+	BeaconClient          *beaconclient.Service
+	AttesterSlashingsFeed *event.Feed
+	ProposerSlashingsFeed *event.Feed
 }
 
 // NewService instantiates a new RPC service instance that will
@@ -107,6 +111,16 @@ func (s *Service) Start() {
 		beaconClient: s.cfg.BeaconClient,
 	}
 	slashpb.RegisterSlasherServer(s.grpcServer, slasherServer)
+	slashingsServer := &SlasherServer{
+		ctx:                   s.ctx,
+		server:                slasherServer,
+		detector:              s.cfg.Detector,
+		slasherDB:             s.cfg.SlasherDB,
+		attesterSlashingsFeed: s.cfg.AttesterSlashingsFeed,
+		proposerSlashingsFeed: s.cfg.ProposerSlashingsFeed,
+	}
+	go slashingsServer.forwardSlashings()
+	pbrpc.RegisterSlasherServer(s.grpcServer, slashingsServer)
 
 	// Register reflection service on gRPC server.
 	reflection.Register(s.grpcServer)
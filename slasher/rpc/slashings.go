@@ -0,0 +1,321 @@
+package rpc
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+	"github.com/prysmaticlabs/prysm/slasher/db"
+	dbtypes "github.com/prysmaticlabs/prysm/slasher/db/types"
+	"github.com/prysmaticlabs/prysm/slasher/detection"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// slashingsStreamQueueSize is the number of detected slashings buffered for every stream,
+// after which a client too slow to receive them is disconnected.
+const slashingsStreamQueueSize = 256
+
+// SlasherServer defines a server implementation of the gRPC Slasher service of the
+// beacon node API, which also lets clients follow the slashings detected by slasher.
+type SlasherServer struct {
+	ctx                   context.Context
+	server                *Server
+	detector              *detection.Service
+	slasherDB             db.Database
+	attesterSlashingsFeed *event.Feed
+	proposerSlashingsFeed *event.Feed
+	streamsLock           sync.Mutex
+	streams               map[*slashingsStream]bool
+}
+
+// slashingsStream queues the detected slashings to send over a stream.
+type slashingsStream struct {
+	queue      chan *pbrpc.DetectedSlashing
+	overflowed chan struct{}
+}
+
+// IsSlashableAttestation returns an attester slashing if the attestation submitted
+// is a slashable vote.
+func (s *SlasherServer) IsSlashableAttestation(ctx context.Context, req *ethpb.IndexedAttestation) (*pbrpc.AttesterSlashingResponse, error) {
+	res, err := s.server.IsSlashableAttestation(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pbrpc.AttesterSlashingResponse{}
+	if len(res.AttesterSlashing) > 0 {
+		resp.AttesterSlashing = res.AttesterSlashing[0]
+	}
+	return resp, nil
+}
+
+// IsSlashableBlock returns a proposer slashing if the block submitted
+// is a double proposal.
+func (s *SlasherServer) IsSlashableBlock(ctx context.Context, req *ethpb.SignedBeaconBlockHeader) (*pbrpc.ProposerSlashingResponse, error) {
+	res, err := s.server.IsSlashableBlock(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pbrpc.ProposerSlashingResponse{}
+	if len(res.ProposerSlashing) > 0 {
+		resp.ProposerSlashing = res.ProposerSlashing[0]
+	}
+	return resp, nil
+}
+
+// HighestAttestations returns the highest observed attestation source and target epochs for the given validators.
+func (s *SlasherServer) HighestAttestations(ctx context.Context, req *pbrpc.HighestAttestationRequest) (*pbrpc.HighestAttestationResponse, error) {
+	res, err := s.server.HighestAttestations(ctx, &slashpb.HighestAttestationRequest{ValidatorIds: req.ValidatorIndices})
+	if err != nil {
+		return nil, err
+	}
+	atts := make([]*pbrpc.HighestAttestation, len(res.Attestations))
+	for i, att := range res.Attestations {
+		atts[i] = &pbrpc.HighestAttestation{
+			ValidatorIndex:     att.ValidatorId,
+			HighestSourceEpoch: att.HighestSourceEpoch,
+			HighestTargetEpoch: att.HighestTargetEpoch,
+		}
+	}
+	return &pbrpc.HighestAttestationResponse{Attestations: atts}, nil
+}
+
+// StreamSlashings streams the attester and proposer slashings detected by slasher,
+// as they are submitted to the beacon node.
+func (s *SlasherServer) StreamSlashings(req *pbrpc.StreamSlashingsRequest, stream pbrpc.Slasher_StreamSlashingsServer) error {
+	st := s.addStream()
+	defer s.removeStream(st)
+
+	for {
+		select {
+		case <-st.overflowed:
+			return status.Error(codes.ResourceExhausted, "Client too slow to receive detected slashings, closing stream")
+		default:
+		}
+		select {
+		case slashing := <-st.queue:
+			if !hasOffender(slashing, req.ValidatorIndices) {
+				continue
+			}
+			if err := stream.Send(slashing); err != nil {
+				return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+			}
+		case <-st.overflowed:
+			return status.Error(codes.ResourceExhausted, "Client too slow to receive detected slashings, closing stream")
+		case <-s.ctx.Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		}
+	}
+}
+
+// forwardSlashings queues the slashings sent by the detection service for every stream until
+// the context of the server is canceled. Queuing never blocks, so that slow clients cannot delay
+// the detection service, which sends the slashings synchronously.
+func (s *SlasherServer) forwardSlashings() {
+	attSlashingsChan := make(chan *ethpb.AttesterSlashing, 1)
+	attSub := s.attesterSlashingsFeed.Subscribe(attSlashingsChan)
+	defer attSub.Unsubscribe()
+	propSlashingsChan := make(chan *ethpb.ProposerSlashing, 1)
+	propSub := s.proposerSlashingsFeed.Subscribe(propSlashingsChan)
+	defer propSub.Unsubscribe()
+
+	for {
+		var slashing *pbrpc.DetectedSlashing
+		select {
+		case attSlashing := <-attSlashingsChan:
+			slashing = detectedAttesterSlashing(attSlashing, dbtypes.Active)
+		case propSlashing := <-propSlashingsChan:
+			slashing = detectedProposerSlashing(propSlashing, dbtypes.Active)
+		case err := <-attSub.Err():
+			log.WithError(err).Error("Attester slashings subscription failed")
+			return
+		case err := <-propSub.Err():
+			log.WithError(err).Error("Proposer slashings subscription failed")
+			return
+		case <-s.ctx.Done():
+			return
+		}
+		if slashing != nil {
+			s.queueSlashing(slashing)
+		}
+	}
+}
+
+func (s *SlasherServer) queueSlashing(slashing *pbrpc.DetectedSlashing) {
+	s.streamsLock.Lock()
+	defer s.streamsLock.Unlock()
+	for st := range s.streams {
+		select {
+		case st.queue <- slashing:
+		default:
+			// The stream is disconnected rather than missing slashings.
+			close(st.overflowed)
+			delete(s.streams, st)
+		}
+	}
+}
+
+func (s *SlasherServer) addStream() *slashingsStream {
+	st := &slashingsStream{
+		queue:      make(chan *pbrpc.DetectedSlashing, slashingsStreamQueueSize),
+		overflowed: make(chan struct{}),
+	}
+	s.streamsLock.Lock()
+	defer s.streamsLock.Unlock()
+	if s.streams == nil {
+		s.streams = make(map[*slashingsStream]bool)
+	}
+	s.streams[st] = true
+	return st
+}
+
+func (s *SlasherServer) removeStream(st *slashingsStream) {
+	s.streamsLock.Lock()
+	defer s.streamsLock.Unlock()
+	delete(s.streams, st)
+}
+
+// ListSlashings returns the slashings detected by slasher for the offences of an epoch
+// and of the given validators, ordered by epoch.
+func (s *SlasherServer) ListSlashings(ctx context.Context, req *pbrpc.ListSlashingsRequest) (*pbrpc.ListSlashingsResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "history.ListSlashings")
+	defer span.End()
+
+	slashings := make([]*pbrpc.DetectedSlashing, 0)
+	for _, st := range []dbtypes.SlashingStatus{dbtypes.Active, dbtypes.Included, dbtypes.Reverted} {
+		attSlashings, err := s.slasherDB.AttesterSlashings(ctx, st)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve attester slashings: %v", err)
+		}
+		for _, attSlashing := range attSlashings {
+			if slashing := detectedAttesterSlashing(attSlashing, st); slashing != nil {
+				slashings = append(slashings, slashing)
+			}
+		}
+		propSlashings, err := s.slasherDB.ProposalSlashingsByStatus(ctx, st)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve proposer slashings: %v", err)
+		}
+		for _, propSlashing := range propSlashings {
+			if slashing := detectedProposerSlashing(propSlashing, st); slashing != nil {
+				slashings = append(slashings, slashing)
+			}
+		}
+	}
+
+	filtered := make([]*pbrpc.DetectedSlashing, 0, len(slashings))
+	for _, slashing := range slashings {
+		if q, ok := req.QueryFilter.(*pbrpc.ListSlashingsRequest_Epoch); ok && slashing.Epoch != q.Epoch {
+			continue
+		}
+		if !hasOffender(slashing, req.ValidatorIndices) {
+			continue
+		}
+		filtered = append(filtered, slashing)
+	}
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return filtered[i].Epoch < filtered[j].Epoch
+	})
+	return &pbrpc.ListSlashingsResponse{Slashings: filtered}, nil
+}
+
+// HistoricalDetectionStatus returns the progress of the slashing detection on historical chain data.
+func (s *SlasherServer) HistoricalDetectionStatus(_ context.Context, _ *emptypb.Empty) (*pbrpc.HistoricalDetectionStatusResponse, error) {
+	progress := s.detector.HistoricalProgress()
+	resp := &pbrpc.HistoricalDetectionStatusResponse{
+		DetectionStatus:       s.detector.DetectionStatus().String(),
+		Enabled:               progress.Enabled,
+		Completed:             progress.Completed,
+		StartEpoch:            progress.StartEpoch,
+		NextEpoch:             progress.NextEpoch,
+		HeadEpoch:             progress.HeadEpoch,
+		AttestationsProcessed: progress.AttestationsProcessed,
+		SlashingsDetected:     progress.SlashingsDetected,
+	}
+	if progress.Err != nil {
+		resp.Error = progress.Err.Error()
+	}
+	return resp, nil
+}
+
+// detectedAttesterSlashing returns the offenders and epoch of an attester slashing, which are the
+// validators who signed both attestations and the highest target epoch of the attestations.
+func detectedAttesterSlashing(slashing *ethpb.AttesterSlashing, st dbtypes.SlashingStatus) *pbrpc.DetectedSlashing {
+	att1, att2 := slashing.Attestation_1, slashing.Attestation_2
+	if att1 == nil || att2 == nil || att1.Data == nil || att2.Data == nil ||
+		att1.Data.Target == nil || att2.Data.Target == nil {
+		return nil
+	}
+	indices := sliceutil.IntersectionUint64(att1.AttestingIndices, att2.AttestingIndices)
+	offenders := make([]types.ValidatorIndex, len(indices))
+	for i, index := range indices {
+		offenders[i] = types.ValidatorIndex(index)
+	}
+	sort.Slice(offenders, func(i, j int) bool {
+		return offenders[i] < offenders[j]
+	})
+	epoch := att1.Data.Target.Epoch
+	if att2.Data.Target.Epoch > epoch {
+		epoch = att2.Data.Target.Epoch
+	}
+	return &pbrpc.DetectedSlashing{
+		AttesterSlashing: slashing,
+		OffenderIndices:  offenders,
+		Epoch:            epoch,
+		Status:           detectedSlashingStatus(st),
+	}
+}
+
+// detectedProposerSlashing returns the offender and epoch of a proposer slashing.
+func detectedProposerSlashing(slashing *ethpb.ProposerSlashing, st dbtypes.SlashingStatus) *pbrpc.DetectedSlashing {
+	if slashing.Header_1 == nil || slashing.Header_1.Header == nil {
+		return nil
+	}
+	header := slashing.Header_1.Header
+	return &pbrpc.DetectedSlashing{
+		ProposerSlashing: slashing,
+		OffenderIndices:  []types.ValidatorIndex{header.ProposerIndex},
+		Epoch:            helpers.SlotToEpoch(header.Slot),
+		Status:           detectedSlashingStatus(st),
+	}
+}
+
+func detectedSlashingStatus(st dbtypes.SlashingStatus) pbrpc.DetectedSlashing_Status {
+	switch st {
+	case dbtypes.Active:
+		return pbrpc.DetectedSlashing_ACTIVE
+	case dbtypes.Included:
+		return pbrpc.DetectedSlashing_INCLUDED
+	case dbtypes.Reverted:
+		return pbrpc.DetectedSlashing_REVERTED
+	default:
+		return pbrpc.DetectedSlashing_UNKNOWN
+	}
+}
+
+// hasOffender returns true if one of the validators committed the offence of the slashing,
+// or if no validators are given.
+func hasOffender(slashing *pbrpc.DetectedSlashing, validators []types.ValidatorIndex) bool {
+	if len(validators) == 0 {
+		return true
+	}
+	for _, offender := range slashing.OffenderIndices {
+		for _, v := range validators {
+			if offender == v {
+				return true
+			}
+		}
+	}
+	return false
+}
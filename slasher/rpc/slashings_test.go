@@ -0,0 +1,202 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	testDB "github.com/prysmaticlabs/prysm/slasher/db/testing"
+	dbtypes "github.com/prysmaticlabs/prysm/slasher/db/types"
+	"github.com/prysmaticlabs/prysm/slasher/detection"
+	"google.golang.org/grpc"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+type mockSlashingsStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *pbrpc.DetectedSlashing
+}
+
+func (m *mockSlashingsStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockSlashingsStream) Send(slashing *pbrpc.DetectedSlashing) error {
+	m.sent <- slashing
+	return nil
+}
+
+func attesterSlashing(sourceEpoch, targetEpoch types.Epoch, indices1, indices2 []uint64) *ethpb.AttesterSlashing {
+	att := func(target types.Epoch, root byte, indices []uint64) *ethpb.IndexedAttestation {
+		return &ethpb.IndexedAttestation{
+			AttestingIndices: indices,
+			Data: &ethpb.AttestationData{
+				BeaconBlockRoot: make([]byte, 32),
+				Source:          &ethpb.Checkpoint{Epoch: sourceEpoch, Root: make([]byte, 32)},
+				Target:          &ethpb.Checkpoint{Epoch: target, Root: bytesutil.PadTo([]byte{root}, 32)},
+			},
+			Signature: make([]byte, 96),
+		}
+	}
+	return &ethpb.AttesterSlashing{
+		Attestation_1: att(targetEpoch, 'a', indices1),
+		Attestation_2: att(targetEpoch, 'b', indices2),
+	}
+}
+
+func proposerSlashing(slot types.Slot, proposer types.ValidatorIndex) *ethpb.ProposerSlashing {
+	header := func(root byte) *ethpb.SignedBeaconBlockHeader {
+		return &ethpb.SignedBeaconBlockHeader{
+			Header: &ethpb.BeaconBlockHeader{
+				Slot:          slot,
+				ProposerIndex: proposer,
+				ParentRoot:    make([]byte, 32),
+				StateRoot:     make([]byte, 32),
+				BodyRoot:      bytesutil.PadTo([]byte{root}, 32),
+			},
+			Signature: make([]byte, 96),
+		}
+	}
+	return &ethpb.ProposerSlashing{Header_1: header('a'), Header_2: header('b')}
+}
+
+func TestSlasherServer_ListSlashings(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupSlasherDB(t, false)
+	s := &SlasherServer{ctx: ctx, slasherDB: db}
+
+	active := attesterSlashing(1, 3, []uint64{1, 2, 3}, []uint64{2, 3, 4})
+	included := attesterSlashing(2, 4, []uint64{5}, []uint64{5})
+	require.NoError(t, db.SaveAttesterSlashing(ctx, dbtypes.Active, active))
+	require.NoError(t, db.SaveAttesterSlashing(ctx, dbtypes.Included, included))
+	proposal := proposerSlashing(params.BeaconConfig().SlotsPerEpoch*3+1, 2)
+	require.NoError(t, db.SaveProposerSlashing(ctx, dbtypes.Active, proposal))
+
+	res, err := s.ListSlashings(ctx, &pbrpc.ListSlashingsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(res.Slashings))
+	assert.Equal(t, types.Epoch(4), res.Slashings[2].Epoch)
+	assert.Equal(t, pbrpc.DetectedSlashing_INCLUDED, res.Slashings[2].Status)
+	assert.DeepEqual(t, []types.ValidatorIndex{5}, res.Slashings[2].OffenderIndices)
+
+	res, err = s.ListSlashings(ctx, &pbrpc.ListSlashingsRequest{
+		QueryFilter: &pbrpc.ListSlashingsRequest_Epoch{Epoch: 3},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Slashings))
+
+	res, err = s.ListSlashings(ctx, &pbrpc.ListSlashingsRequest{
+		QueryFilter:      &pbrpc.ListSlashingsRequest_Epoch{Epoch: 3},
+		ValidatorIndices: []types.ValidatorIndex{3},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Slashings))
+	assert.DeepEqual(t, []types.ValidatorIndex{2, 3}, res.Slashings[0].OffenderIndices)
+	assert.Equal(t, pbrpc.DetectedSlashing_ACTIVE, res.Slashings[0].Status)
+	assert.DeepEqual(t, active, res.Slashings[0].AttesterSlashing)
+
+	res, err = s.ListSlashings(ctx, &pbrpc.ListSlashingsRequest{
+		ValidatorIndices: []types.ValidatorIndex{1, 4},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(res.Slashings))
+}
+
+// startSlashingsServer returns a server forwarding the slashings sent on the returned feeds.
+func startSlashingsServer(t *testing.T) (*SlasherServer, *event.Feed, *event.Feed) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	attFeed, propFeed := new(event.Feed), new(event.Feed)
+	s := &SlasherServer{
+		ctx:                   ctx,
+		attesterSlashingsFeed: attFeed,
+		proposerSlashingsFeed: propFeed,
+	}
+	go s.forwardSlashings()
+	for attFeed.Send(attesterSlashing(0, 0, nil, nil)) == 0 || propFeed.Send(proposerSlashing(0, 0)) == 0 {
+	}
+	return s, attFeed, propFeed
+}
+
+// waitForStreams waits until the server has the number of streams.
+func waitForStreams(s *SlasherServer, n int) {
+	for {
+		s.streamsLock.Lock()
+		count := len(s.streams)
+		s.streamsLock.Unlock()
+		if count == n {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestSlasherServer_StreamSlashings(t *testing.T) {
+	s, attFeed, propFeed := startSlashingsServer(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &mockSlashingsStream{ctx: ctx, sent: make(chan *pbrpc.DetectedSlashing, 4)}
+	done := make(chan error)
+	go func() {
+		done <- s.StreamSlashings(&pbrpc.StreamSlashingsRequest{ValidatorIndices: []types.ValidatorIndex{2}}, stream)
+	}()
+	waitForStreams(s, 1)
+	// Slashings of other validators are not streamed.
+	attFeed.Send(attesterSlashing(1, 3, []uint64{1}, []uint64{1}))
+	propFeed.Send(proposerSlashing(1, 2))
+	slashing := <-stream.sent
+	assert.NotNil(t, slashing.ProposerSlashing)
+	assert.DeepEqual(t, []types.ValidatorIndex{2}, slashing.OffenderIndices)
+	assert.Equal(t, pbrpc.DetectedSlashing_ACTIVE, slashing.Status)
+
+	attFeed.Send(attesterSlashing(1, 3, []uint64{1, 2}, []uint64{2}))
+	slashing = <-stream.sent
+	assert.NotNil(t, slashing.AttesterSlashing)
+	assert.Equal(t, types.Epoch(3), slashing.Epoch)
+
+	cancel()
+	assert.ErrorContains(t, "Context canceled", <-done)
+	assert.Equal(t, 0, len(stream.sent))
+	waitForStreams(s, 0)
+}
+
+func TestSlasherServer_StreamSlashings_SlowClient(t *testing.T) {
+	s, _, propFeed := startSlashingsServer(t)
+	// The client never receives the slashings sent over the stream.
+	stream := &mockSlashingsStream{ctx: context.Background(), sent: make(chan *pbrpc.DetectedSlashing)}
+	done := make(chan error)
+	go func() {
+		done <- s.StreamSlashings(&pbrpc.StreamSlashingsRequest{}, stream)
+	}()
+	waitForStreams(s, 1)
+
+	// Sending the slashings never blocks, and the stream is closed once its queue is full.
+	for i := 0; i < slashingsStreamQueueSize+2; i++ {
+		propFeed.Send(proposerSlashing(types.Slot(i), 1))
+	}
+	waitForStreams(s, 0)
+	<-stream.sent
+	assert.ErrorContains(t, "Client too slow to receive detected slashings", <-done)
+}
+
+func TestSlasherServer_HistoricalDetectionStatus(t *testing.T) {
+	ctx := context.Background()
+	s := &SlasherServer{
+		ctx:      ctx,
+		detector: detection.NewService(ctx, &detection.Config{HistoricalDetection: true}),
+	}
+	res, err := s.HistoricalDetectionStatus(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, detection.None.String(), res.DetectionStatus)
+	assert.Equal(t, true, res.Enabled)
+	assert.Equal(t, false, res.Completed)
+	assert.Equal(t, "", res.Error)
+}
@@ -0,0 +1,270 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/interop"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gopkg.in/yaml.v2"
+)
+
+// Actions of a devnet script.
+const (
+	// depositAction deposits the next interop keys through the mock eth1 chain, and starts a validator
+	// client running them.
+	depositAction = "deposit"
+	// killNodeAction kills a beacon node, as if it crashed.
+	killNodeAction = "kill-node"
+	// startNodeAction starts a beacon node which was killed, with its database.
+	startNodeAction = "start-node"
+	// doubleVoteAction signs two attestations of a validator for the same target, and submits them as an
+	// attester slashing.
+	doubleVoteAction = "double-vote"
+	// doubleProposalAction signs two block headers of a validator for the same slot, and submits them as a
+	// proposer slashing.
+	doubleProposalAction = "double-proposal"
+)
+
+// action is a step of a devnet script, run at the start of its epoch.
+type action struct {
+	Epoch     types.Epoch          `yaml:"epoch"`
+	Action    string               `yaml:"action"`
+	Node      int                  `yaml:"node"`
+	Count     uint64               `yaml:"count"`
+	Validator types.ValidatorIndex `yaml:"validator"`
+}
+
+// loadScript reads a script, which is a YAML list of actions, and checks its actions against the options
+// of the devnet. The actions are returned in the order they run.
+func loadScript(path string, opts *options) ([]*action, error) {
+	enc, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read script")
+	}
+	var actions []*action
+	if err := yaml.UnmarshalStrict(enc, &actions); err != nil {
+		return nil, errors.Wrap(err, "could not parse script")
+	}
+	sort.SliceStable(actions, func(i, j int) bool {
+		return actions[i].Epoch < actions[j].Epoch
+	})
+	validators := opts.validators
+	validatorClients := opts.validatorClients
+	for i, a := range actions {
+		switch a.Action {
+		case depositAction:
+			if a.Count == 0 {
+				return nil, fmt.Errorf("action %d: deposit of no validators", i)
+			}
+			validators += a.Count
+			validatorClients++
+			if validatorClients > maxNodes {
+				return nil, fmt.Errorf("action %d: a devnet runs at most %d validator clients", i, maxNodes)
+			}
+		case killNodeAction, startNodeAction:
+			if a.Node < 0 || a.Node >= opts.beaconNodes {
+				return nil, fmt.Errorf("action %d: no beacon node %d", i, a.Node)
+			}
+		case doubleVoteAction, doubleProposalAction:
+			if uint64(a.Validator) >= validators {
+				return nil, fmt.Errorf("action %d: validator %d is not deposited before epoch %d", i, a.Validator, a.Epoch)
+			}
+		default:
+			return nil, fmt.Errorf("action %d: unknown action %q", i, a.Action)
+		}
+	}
+	return actions, nil
+}
+
+// runScript runs the actions at the start of their epoch, until the context is canceled. A failed action
+// is logged, and does not stop the script.
+func (d *devnet) runScript(ctx context.Context, actions []*action) {
+	for _, a := range actions {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Until(d.epochStart(a.Epoch))):
+		}
+		fields := logrus.Fields{
+			"epoch":  a.Epoch,
+			"action": a.Action,
+		}
+		if err := d.runAction(ctx, a); err != nil {
+			log.WithError(err).WithFields(fields).Error("Could not run action")
+			continue
+		}
+		log.WithFields(fields).Info("Ran action")
+	}
+}
+
+func (d *devnet) runAction(ctx context.Context, a *action) error {
+	switch a.Action {
+	case depositAction:
+		return d.deposit(a.Count)
+	case killNodeAction:
+		return d.beaconNodes[a.Node].stop(true /*kill*/)
+	case startNodeAction:
+		return d.beaconNodes[a.Node].start()
+	case doubleVoteAction, doubleProposalAction:
+		conn, err := d.dialBeaconNode(ctx)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := conn.Close(); err != nil {
+				log.WithError(err).Error("Could not close connection to beacon node")
+			}
+		}()
+		if a.Action == doubleVoteAction {
+			return doubleVote(ctx, conn, a.Validator)
+		}
+		return doubleProposal(ctx, conn, a.Validator)
+	default:
+		return fmt.Errorf("unknown action %q", a.Action)
+	}
+}
+
+// deposit deposits the next interop keys, and starts a validator client running them.
+func (d *devnet) deposit(count uint64) error {
+	d.lock.Lock()
+	startIndex := d.nextKeyIndex
+	d.nextKeyIndex += count
+	client := d.newValidatorClient(len(d.validatorClients), startIndex, count)
+	d.validatorClients = append(d.validatorClients, client)
+	d.lock.Unlock()
+
+	privKeys, pubKeys, err := interop.DeterministicallyGenerateKeys(startIndex, count)
+	if err != nil {
+		return errors.Wrap(err, "could not generate validator keys")
+	}
+	depositData, depositRoots, err := interop.DepositDataFromKeys(privKeys, pubKeys)
+	if err != nil {
+		return errors.Wrap(err, "could not generate deposit data")
+	}
+	if err := d.eth1.deposit(depositData, depositRoots); err != nil {
+		return err
+	}
+	return client.start()
+}
+
+// dialBeaconNode connects to the first running beacon node.
+func (d *devnet) dialBeaconNode(ctx context.Context) (*grpc.ClientConn, error) {
+	for i, node := range d.beaconNodes {
+		if node.running() {
+			return grpc.DialContext(ctx, fmt.Sprintf("127.0.0.1:%d", d.opts.port(beaconRPCPort, i)), grpc.WithInsecure())
+		}
+	}
+	return nil, errors.New("no beacon node is running")
+}
+
+// doubleVote submits an attester slashing of the validator, made of two attestations of the head epoch.
+func doubleVote(ctx context.Context, conn *grpc.ClientConn, index types.ValidatorIndex) error {
+	beaconClient := ethpb.NewBeaconChainClient(conn)
+	head, err := beaconClient.GetChainHead(ctx, &emptypb.Empty{})
+	if err != nil {
+		return errors.Wrap(err, "could not get chain head")
+	}
+	key, err := validatorKey(index)
+	if err != nil {
+		return err
+	}
+	domain, err := signatureDomain(ctx, conn, head.HeadEpoch, params.BeaconConfig().DomainBeaconAttester)
+	if err != nil {
+		return err
+	}
+	atts := make([]*ethpb.IndexedAttestation, 2)
+	for i := range atts {
+		data := &ethpb.AttestationData{
+			Slot:            head.HeadSlot,
+			BeaconBlockRoot: bytesutil.PadTo([]byte{byte(i + 1)}, 32),
+			Source:          &ethpb.Checkpoint{Epoch: head.JustifiedEpoch, Root: head.JustifiedBlockRoot},
+			Target:          &ethpb.Checkpoint{Epoch: head.HeadEpoch, Root: head.HeadBlockRoot},
+		}
+		root, err := helpers.ComputeSigningRoot(data, domain)
+		if err != nil {
+			return errors.Wrap(err, "could not compute signing root")
+		}
+		atts[i] = &ethpb.IndexedAttestation{
+			AttestingIndices: []uint64{uint64(index)},
+			Data:             data,
+			Signature:        key.Sign(root[:]).Marshal(),
+		}
+	}
+	_, err = beaconClient.SubmitAttesterSlashing(ctx, &ethpb.AttesterSlashing{
+		Attestation_1: atts[0],
+		Attestation_2: atts[1],
+	})
+	return err
+}
+
+// doubleProposal submits a proposer slashing of the validator, made of two block headers of the head slot.
+func doubleProposal(ctx context.Context, conn *grpc.ClientConn, index types.ValidatorIndex) error {
+	beaconClient := ethpb.NewBeaconChainClient(conn)
+	head, err := beaconClient.GetChainHead(ctx, &emptypb.Empty{})
+	if err != nil {
+		return errors.Wrap(err, "could not get chain head")
+	}
+	key, err := validatorKey(index)
+	if err != nil {
+		return err
+	}
+	domain, err := signatureDomain(ctx, conn, head.HeadEpoch, params.BeaconConfig().DomainBeaconProposer)
+	if err != nil {
+		return err
+	}
+	headers := make([]*ethpb.SignedBeaconBlockHeader, 2)
+	for i := range headers {
+		header := &ethpb.BeaconBlockHeader{
+			Slot:          head.HeadSlot,
+			ProposerIndex: index,
+			ParentRoot:    head.HeadBlockRoot,
+			StateRoot:     make([]byte, 32),
+			BodyRoot:      bytesutil.PadTo([]byte{byte(i + 1)}, 32),
+		}
+		root, err := helpers.ComputeSigningRoot(header, domain)
+		if err != nil {
+			return errors.Wrap(err, "could not compute signing root")
+		}
+		headers[i] = &ethpb.SignedBeaconBlockHeader{
+			Header:    header,
+			Signature: key.Sign(root[:]).Marshal(),
+		}
+	}
+	_, err = beaconClient.SubmitProposerSlashing(ctx, &ethpb.ProposerSlashing{
+		Header_1: headers[0],
+		Header_2: headers[1],
+	})
+	return err
+}
+
+func validatorKey(index types.ValidatorIndex) (bls.SecretKey, error) {
+	privKeys, _, err := interop.DeterministicallyGenerateKeys(uint64(index), 1)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not generate key of validator %d", index)
+	}
+	return privKeys[0], nil
+}
+
+func signatureDomain(ctx context.Context, conn *grpc.ClientConn, epoch types.Epoch, domainType [4]byte) ([]byte, error) {
+	resp, err := ethpb.NewBeaconNodeValidatorClient(conn).DomainData(ctx, &ethpb.DomainRequest{
+		Epoch:  epoch,
+		Domain: domainType[:],
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get domain data")
+	}
+	return resp.SignatureDomain, nil
+}
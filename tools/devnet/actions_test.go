@@ -0,0 +1,108 @@
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestLoadScript(t *testing.T) {
+	opts := &options{beaconNodes: 2, validators: 64, validatorClients: 2}
+	tests := []struct {
+		name    string
+		script  string
+		epochs  []types.Epoch
+		wantErr string
+	}{
+		{
+			name: "sorted by epoch",
+			script: `
+- epoch: 5
+  action: double-vote
+  validator: 70
+- epoch: 2
+  action: deposit
+  count: 8
+- epoch: 2
+  action: kill-node
+  node: 1
+- epoch: 3
+  action: start-node
+  node: 1
+- epoch: 4
+  action: double-proposal
+  validator: 3
+`,
+			epochs: []types.Epoch{2, 2, 3, 4, 5},
+		},
+		{
+			name: "validator deposited later",
+			script: `
+- epoch: 5
+  action: deposit
+  count: 8
+- epoch: 4
+  action: double-vote
+  validator: 70
+`,
+			wantErr: "validator 70 is not deposited before epoch 4",
+		},
+		{
+			name: "empty deposit",
+			script: `
+- epoch: 1
+  action: deposit
+`,
+			wantErr: "deposit of no validators",
+		},
+		{
+			name: "unknown node",
+			script: `
+- epoch: 1
+  action: kill-node
+  node: 2
+`,
+			wantErr: "no beacon node 2",
+		},
+		{
+			name: "unknown action",
+			script: `
+- epoch: 1
+  action: fork
+`,
+			wantErr: `unknown action "fork"`,
+		},
+		{
+			name: "unknown field",
+			script: `
+- epoch: 1
+  action: deposit
+  amount: 32
+`,
+			wantErr: "could not parse script",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "script.yaml")
+			require.NoError(t, ioutil.WriteFile(path, []byte(tt.script), params.BeaconIoConfig().ReadWritePermissions))
+			actions, err := loadScript(path, opts)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			epochs := make([]types.Epoch, len(actions))
+			for i, a := range actions {
+				epochs[i] = a.Epoch
+			}
+			assert.DeepEqual(t, tt.epochs, epochs)
+			assert.Equal(t, doubleVoteAction, actions[4].Action)
+		})
+	}
+}
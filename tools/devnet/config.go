@@ -0,0 +1,117 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/networkdir"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+var (
+	devnetGenesisForkVersion = []byte{0x00, 0x00, 0x0d, 0xe7}
+	devnetAltairForkVersion  = []byte{0x01, 0x00, 0x0d, 0xe7}
+)
+
+// devnetConfig returns the beacon chain config of a devnet, which is the config of the preset with shorter
+// timings, so that deposits and exits are processed within minutes, and the chain id of the mock eth1 chain.
+func devnetConfig(opts *options, genesisTime uint64) (*params.BeaconChainConfig, error) {
+	var cfg *params.BeaconChainConfig
+	switch opts.preset {
+	case "mainnet":
+		cfg = params.MainnetConfig().Copy()
+	case "minimal":
+		cfg = params.MinimalSpecConfig().Copy()
+	default:
+		return nil, fmt.Errorf("unknown preset %q, expected mainnet or minimal", opts.preset)
+	}
+	cfg.ConfigName = "devnet"
+	cfg.GenesisForkVersion = devnetGenesisForkVersion
+	cfg.AltairForkVersion = devnetAltairForkVersion
+	cfg.AltairForkEpoch = types.Epoch(opts.altairForkEpoch)
+	cfg.MinGenesisActiveValidatorCount = opts.validators
+	cfg.MinGenesisTime = genesisTime
+	cfg.GenesisDelay = opts.genesisDelay
+	cfg.SecondsPerSlot = opts.secondsPerSlot
+	cfg.SecondsPerETH1Block = 2
+	cfg.Eth1FollowDistance = 8
+	cfg.EpochsPerEth1VotingPeriod = 2
+	cfg.ShardCommitteePeriod = 4
+	cfg.DepositChainID = eth1ChainID
+	cfg.DepositNetworkID = eth1ChainID
+	return cfg, nil
+}
+
+// marshalConfig encodes the spec values of the config in the format of the config files of the
+// consensus specs, which the beacon node and validator client load with --network-dir.
+func marshalConfig(cfg *params.BeaconChainConfig) ([]byte, error) {
+	t := reflect.TypeOf(*cfg)
+	v := reflect.ValueOf(cfg).Elem()
+	lines := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		tField := t.Field(i)
+		if _, isSpecField := tField.Tag.Lookup("spec"); !isSpecField {
+			continue
+		}
+		key := strings.ToUpper(tField.Tag.Get("yaml"))
+		vField := v.Field(i)
+		var value string
+		switch vField.Kind() {
+		case reflect.Uint64:
+			value = strconv.FormatUint(vField.Uint(), 10)
+		case reflect.Slice:
+			value = hexutil.Encode(vField.Bytes())
+		case reflect.Array:
+			value = hexutil.Encode(vField.Slice(0, vField.Len()).Bytes())
+		case reflect.String:
+			value = strconv.Quote(vField.String())
+		case reflect.Uint8:
+			value = hexutil.Encode([]byte{uint8(vField.Uint())})
+		default:
+			return nil, fmt.Errorf("unsupported config field type: %s", vField.Kind().String())
+		}
+		lines = append(lines, fmt.Sprintf("%s: %s", key, value))
+	}
+	sort.Strings(lines)
+	return []byte(strings.Join(lines, "\n") + "\n"), nil
+}
+
+// writeNetworkDir writes the network directory of the devnet, and loads it back to check that the beacon
+// nodes and validator clients will accept it.
+func writeNetworkDir(dir string, cfg *params.BeaconChainConfig, genesis *pb.BeaconState, bootstrapNodes []string, deployBlock uint64) (*networkdir.Network, error) {
+	enc, err := marshalConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	genesisEnc, err := genesis.MarshalSSZ()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal genesis state")
+	}
+	var nodes bytes.Buffer
+	for _, node := range bootstrapNodes {
+		nodes.WriteString(node + "\n")
+	}
+	files := map[string][]byte{
+		networkdir.ConfigFileName:          enc,
+		networkdir.GenesisStateFileName:    genesisEnc,
+		networkdir.BootstrapNodesFileName:  nodes.Bytes(),
+		networkdir.DeployBlockFileName:     []byte(fmt.Sprintf("%d\n", deployBlock)),
+		networkdir.DepositContractFileName: []byte(cfg.DepositContractAddress + "\n"),
+	}
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), content, params.BeaconIoConfig().ReadWritePermissions); err != nil {
+			return nil, errors.Wrapf(err, "could not write %s", name)
+		}
+	}
+	return networkdir.Load(dir)
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestMarshalConfig_RoundTrip(t *testing.T) {
+	for _, preset := range []string{"mainnet", "minimal"} {
+		t.Run(preset, func(t *testing.T) {
+			cfg, err := devnetConfig(&options{preset: preset, validators: 64, secondsPerSlot: 4, altairForkEpoch: 10}, 1600000000)
+			require.NoError(t, err)
+			cfg.DepositContractAddress = "0x4242424242424242424242424242424242424242"
+			enc, err := marshalConfig(cfg)
+			require.NoError(t, err)
+			path := filepath.Join(t.TempDir(), "config.yaml")
+			require.NoError(t, ioutil.WriteFile(path, enc, params.BeaconIoConfig().ReadWritePermissions))
+
+			loaded, err := params.UnmarshalConfigFile(path)
+			require.NoError(t, err)
+			loadedEnc, err := marshalConfig(loaded)
+			require.NoError(t, err)
+			assert.Equal(t, string(enc), string(loadedEnc))
+			assert.Equal(t, cfg.SlotsPerEpoch, loaded.SlotsPerEpoch)
+			assert.Equal(t, uint64(4), loaded.SecondsPerSlot)
+			assert.DeepEqual(t, devnetGenesisForkVersion, loaded.GenesisForkVersion)
+		})
+	}
+	_, err := devnetConfig(&options{preset: "testnet"}, 0)
+	assert.ErrorContains(t, "unknown preset", err)
+}
+
+func TestWriteNetworkDir(t *testing.T) {
+	cfg, err := devnetConfig(&options{preset: "mainnet", validators: 1, secondsPerSlot: 4}, 1600000000)
+	require.NoError(t, err)
+	cfg.DepositContractAddress = "0x4242424242424242424242424242424242424242"
+	st, err := testutil.NewBeaconState(func(state *pb.BeaconState) error {
+		state.GenesisTime = 1600000000
+		state.Fork = &pb.Fork{
+			PreviousVersion: devnetGenesisForkVersion,
+			CurrentVersion:  devnetGenesisForkVersion,
+		}
+		state.Validators = []*ethpb.Validator{{
+			PublicKey:             make([]byte, 48),
+			WithdrawalCredentials: make([]byte, 32),
+			ExitEpoch:             params.BeaconConfig().FarFutureEpoch,
+			WithdrawableEpoch:     params.BeaconConfig().FarFutureEpoch,
+		}}
+		state.Balances = []uint64{params.BeaconConfig().MaxEffectiveBalance}
+		return nil
+	})
+	require.NoError(t, err)
+	genesis, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	genesisState := &pb.BeaconState{}
+	require.NoError(t, genesisState.UnmarshalSSZ(genesis))
+
+	dir := t.TempDir()
+	id, err := newP2PIdentity(filepath.Join(dir, "p2p-key"), 13000, 12000)
+	require.NoError(t, err)
+	network, err := writeNetworkDir(dir, cfg, genesisState, []string{id.enr}, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "devnet", network.BeaconConfig.ConfigName)
+	assert.Equal(t, uint64(eth1ChainID), network.BeaconConfig.DepositChainID)
+	assert.Equal(t, cfg.DepositContractAddress, network.BeaconConfig.DepositContractAddress)
+	assert.DeepEqual(t, []string{id.enr}, network.NetworkConfig.BootstrapNodes)
+	assert.Equal(t, uint64(1), network.NetworkConfig.ContractDeploymentBlock)
+	assert.Equal(t, filepath.Join(dir, "genesis.ssz"), network.GenesisStatePath)
+}
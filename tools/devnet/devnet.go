@@ -0,0 +1,341 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	beaconflags "github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	validatorflags "github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/interop"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+// maxNodes is the maximum number of beacon nodes and of validator clients of a devnet, ports being
+// allocated in ranges of that size from the base port.
+const maxNodes = 100
+
+// portKind identifies a range of ports of the devnet.
+type portKind int
+
+const (
+	beaconRPCPort portKind = iota
+	beaconGatewayPort
+	beaconEthAPIPort
+	beaconMonitoringPort
+	beaconP2PTCPPort
+	beaconP2PUDPPort
+	validatorMonitoringPort
+	eth1RPCPort
+)
+
+// options of a devnet.
+type options struct {
+	dataDir          string
+	forceClear       bool
+	beaconNodes      int
+	validators       uint64
+	validatorClients int
+	preset           string
+	secondsPerSlot   uint64
+	genesisDelay     uint64
+	altairForkEpoch  uint64
+	basePort         int
+	beaconBinary     string
+	validatorBinary  string
+	beaconFlags      []string
+	validatorFlags   []string
+	verbosity        string
+}
+
+// port returns the port of the given kind of the index-th beacon node or validator client.
+func (o *options) port(kind portKind, index int) int {
+	return o.basePort + int(kind)*maxNodes + index
+}
+
+func (o *options) validate() error {
+	if o.beaconNodes < 1 || o.beaconNodes > maxNodes {
+		return fmt.Errorf("the number of beacon nodes must be between 1 and %d", maxNodes)
+	}
+	if o.validatorClients < 1 || o.validatorClients > maxNodes {
+		return fmt.Errorf("the number of validator clients must be between 1 and %d", maxNodes)
+	}
+	if o.validators < uint64(o.validatorClients) {
+		return fmt.Errorf("%d validators cannot be split between %d validator clients", o.validators, o.validatorClients)
+	}
+	if o.secondsPerSlot == 0 {
+		return errors.New("seconds per slot must be at least 1")
+	}
+	return nil
+}
+
+// devnet is a local chain made of a mock eth1 chain, running in the devnet process, and of beacon nodes and
+// validator clients running as child processes. All of them run on localhost.
+type devnet struct {
+	opts            *options
+	eth1            *mockEth1Chain
+	eth1Endpoint    string
+	networkDir      string
+	genesisTime     time.Time
+	identities      []*p2pIdentity
+	beaconBinary    string
+	validatorBinary string
+	beaconNodes     []*process
+
+	lock             sync.Mutex
+	validatorClients []*process
+	// nextKeyIndex is the index of the first interop key which has not been deposited yet.
+	nextKeyIndex uint64
+}
+
+// newDevnet creates the data directory of a devnet, with the network directory loaded by its nodes, and
+// starts its mock eth1 chain, in which the deposits of the genesis validators are included. The config of
+// the devnet is applied to the devnet process.
+func newDevnet(ctx context.Context, opts *options) (*devnet, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	if err := prepareDataDir(opts.dataDir, opts.forceClear); err != nil {
+		return nil, err
+	}
+	d := &devnet{
+		opts:         opts,
+		networkDir:   filepath.Join(opts.dataDir, "network"),
+		nextKeyIndex: opts.validators,
+	}
+	var err error
+	if d.beaconBinary, err = findBinary(opts.beaconBinary, "cmd/beacon-chain", "beacon-chain"); err != nil {
+		return nil, err
+	}
+	if d.validatorBinary, err = findBinary(opts.validatorBinary, "cmd/validator", "validator"); err != nil {
+		return nil, err
+	}
+	for _, dir := range []string{d.networkDir, d.path("logs")} {
+		if err := fileutil.MkdirAll(dir); err != nil {
+			return nil, err
+		}
+	}
+
+	d.genesisTime = time.Unix(time.Now().Unix()+int64(opts.genesisDelay), 0)
+	cfg, err := devnetConfig(opts, uint64(d.genesisTime.Unix()))
+	if err != nil {
+		return nil, err
+	}
+	// The deposits and the genesis state are signed and built with the config of the devnet.
+	params.OverrideBeaconConfig(cfg)
+
+	d.eth1, err = newMockEth1Chain()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not start mock eth1 chain")
+	}
+	d.eth1Endpoint, err = d.eth1.serve(ctx, fmt.Sprintf("127.0.0.1:%d", opts.port(eth1RPCPort, 0)))
+	if err != nil {
+		return nil, err
+	}
+	privKeys, pubKeys, err := interop.DeterministicallyGenerateKeys(0 /*startIndex*/, opts.validators)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not generate validator keys")
+	}
+	depositData, depositRoots, err := interop.DepositDataFromKeys(privKeys, pubKeys)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not generate deposit data")
+	}
+	if err := d.eth1.deposit(depositData, depositRoots); err != nil {
+		return nil, err
+	}
+	depositBlock, err := d.eth1.mine(time.Now().Add(-eth1Lag))
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.DepositContractAddress = d.eth1.contractAddr.Hex()
+	params.OverrideBeaconConfig(cfg)
+	genesis, _, err := interop.GenerateGenesisStateFromDepositData(ctx, uint64(d.genesisTime.Unix()), depositData, depositRoots)
+	if err != nil {
+		return nil, err
+	}
+	genesis.Eth1Data.BlockHash = depositBlock.Hash().Bytes()
+
+	bootstrapNodes := make([]string, opts.beaconNodes)
+	for i := 0; i < opts.beaconNodes; i++ {
+		dir := d.path(fmt.Sprintf("beacon-node-%d", i))
+		if err := fileutil.MkdirAll(dir); err != nil {
+			return nil, err
+		}
+		id, err := newP2PIdentity(filepath.Join(dir, "p2p-key"), opts.port(beaconP2PTCPPort, i), opts.port(beaconP2PUDPPort, i))
+		if err != nil {
+			return nil, err
+		}
+		d.identities = append(d.identities, id)
+		bootstrapNodes[i] = id.enr
+	}
+	if _, err := writeNetworkDir(d.networkDir, cfg, genesis, bootstrapNodes, d.eth1.deployBlock); err != nil {
+		return nil, errors.Wrap(err, "could not write network directory")
+	}
+
+	for i := 0; i < opts.beaconNodes; i++ {
+		d.beaconNodes = append(d.beaconNodes, d.newBeaconNode(i))
+	}
+	for i := 0; i < opts.validatorClients; i++ {
+		start := uint64(i) * opts.validators / uint64(opts.validatorClients)
+		end := uint64(i+1) * opts.validators / uint64(opts.validatorClients)
+		d.validatorClients = append(d.validatorClients, d.newValidatorClient(i, start, end-start))
+	}
+	return d, nil
+}
+
+// start starts mining the eth1 chain, the beacon nodes and the validator clients, and runs the script.
+func (d *devnet) start(ctx context.Context, script []*action) error {
+	go d.eth1.run(ctx, time.Duration(params.BeaconConfig().SecondsPerETH1Block)*time.Second)
+	for _, node := range d.beaconNodes {
+		if err := node.start(); err != nil {
+			return err
+		}
+	}
+	d.lock.Lock()
+	clients := d.validatorClients
+	d.lock.Unlock()
+	for _, client := range clients {
+		if err := client.start(); err != nil {
+			return err
+		}
+	}
+	go d.runScript(ctx, script)
+
+	for i := range d.beaconNodes {
+		log.WithFields(logrus.Fields{
+			"node":    i,
+			"grpc":    fmt.Sprintf("127.0.0.1:%d", d.opts.port(beaconRPCPort, i)),
+			"gateway": fmt.Sprintf("http://127.0.0.1:%d", d.opts.port(beaconGatewayPort, i)),
+			"ethAPI":  fmt.Sprintf("http://127.0.0.1:%d", d.opts.port(beaconEthAPIPort, i)),
+		}).Info("Beacon node endpoints")
+	}
+	log.WithFields(logrus.Fields{
+		"genesisTime":     d.genesisTime,
+		"validators":      d.opts.validators,
+		"eth1":            d.eth1Endpoint,
+		"depositContract": d.eth1.contractAddr.Hex(),
+		"networkDir":      d.networkDir,
+	}).Info("Devnet started")
+	return nil
+}
+
+// stop stops the validator clients, then the beacon nodes.
+func (d *devnet) stop() error {
+	d.lock.Lock()
+	clients := d.validatorClients
+	d.lock.Unlock()
+	var err error
+	for _, client := range clients {
+		if stopErr := client.stop(false /*kill*/); stopErr != nil {
+			err = stopErr
+		}
+	}
+	for _, node := range d.beaconNodes {
+		if stopErr := node.stop(false /*kill*/); stopErr != nil {
+			err = stopErr
+		}
+	}
+	return err
+}
+
+func (d *devnet) newBeaconNode(i int) *process {
+	o := d.opts
+	minSyncPeers := o.beaconNodes - 1
+	if minSyncPeers > beaconflags.MinSyncPeers.Value {
+		minSyncPeers = beaconflags.MinSyncPeers.Value
+	}
+	args := []string{
+		fmt.Sprintf("--%s=%s", cmd.DataDirFlag.Name, d.path(fmt.Sprintf("beacon-node-%d", i))),
+		fmt.Sprintf("--%s=%s", cmd.NetworkDirFlag.Name, d.networkDir),
+		fmt.Sprintf("--%s=%s", beaconflags.HTTPWeb3ProviderFlag.Name, d.eth1Endpoint),
+		fmt.Sprintf("--%s=%d", beaconflags.RPCPort.Name, o.port(beaconRPCPort, i)),
+		fmt.Sprintf("--%s=%d", beaconflags.GRPCGatewayPort.Name, o.port(beaconGatewayPort, i)),
+		fmt.Sprintf("--%s=%d", beaconflags.EthApiPort.Name, o.port(beaconEthAPIPort, i)),
+		fmt.Sprintf("--%s=%d", beaconflags.MonitoringPortFlag.Name, o.port(beaconMonitoringPort, i)),
+		fmt.Sprintf("--%s=%d", cmd.P2PTCPPort.Name, o.port(beaconP2PTCPPort, i)),
+		fmt.Sprintf("--%s=%d", cmd.P2PUDPPort.Name, o.port(beaconP2PUDPPort, i)),
+		fmt.Sprintf("--%s=%s", cmd.P2PIP.Name, "127.0.0.1"),
+		fmt.Sprintf("--%s=%s", cmd.P2PHost.Name, "127.0.0.1"),
+		fmt.Sprintf("--%s=%s", cmd.P2PPrivKey.Name, d.identities[i].keyPath),
+		fmt.Sprintf("--%s=%d", beaconflags.MinSyncPeers.Name, minSyncPeers),
+		fmt.Sprintf("--%s=%s", cmd.VerbosityFlag.Name, o.verbosity),
+		// A devnet has too few nodes to find peers on each attestation subnet.
+		"--" + beaconflags.SubscribeToAllSubnets.Name,
+		"--" + cmd.AcceptTosFlag.Name,
+	}
+	for j, id := range d.identities {
+		if j != i {
+			args = append(args, fmt.Sprintf("--%s=%s", cmd.StaticPeers.Name, id.multiaddr))
+		}
+	}
+	name := fmt.Sprintf("beacon-node-%d", i)
+	return &process{
+		name:    name,
+		binary:  d.beaconBinary,
+		args:    append(args, o.beaconFlags...),
+		logPath: d.path("logs", name+".log"),
+	}
+}
+
+// newValidatorClient creates a validator client running the given range of interop keys, connected to
+// one of the beacon nodes.
+func (d *devnet) newValidatorClient(i int, startIndex, count uint64) *process {
+	o := d.opts
+	args := []string{
+		fmt.Sprintf("--%s=%s", cmd.DataDirFlag.Name, d.path(fmt.Sprintf("validator-client-%d", i))),
+		fmt.Sprintf("--%s=%s", cmd.NetworkDirFlag.Name, d.networkDir),
+		fmt.Sprintf("--%s=127.0.0.1:%d", validatorflags.BeaconRPCProviderFlag.Name, o.port(beaconRPCPort, i%o.beaconNodes)),
+		fmt.Sprintf("--%s=%d", validatorflags.InteropStartIndex.Name, startIndex),
+		fmt.Sprintf("--%s=%d", validatorflags.InteropNumValidators.Name, count),
+		fmt.Sprintf("--%s=%d", validatorflags.MonitoringPortFlag.Name, o.port(validatorMonitoringPort, i)),
+		fmt.Sprintf("--%s=%s", cmd.VerbosityFlag.Name, o.verbosity),
+		"--" + cmd.AcceptTosFlag.Name,
+	}
+	name := fmt.Sprintf("validator-client-%d", i)
+	return &process{
+		name:    name,
+		binary:  d.validatorBinary,
+		args:    append(args, o.validatorFlags...),
+		logPath: d.path("logs", name+".log"),
+	}
+}
+
+// epochStart returns the time at which the epoch starts.
+func (d *devnet) epochStart(epoch types.Epoch) time.Time {
+	secondsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch) * params.BeaconConfig().SecondsPerSlot
+	return d.genesisTime.Add(time.Duration(uint64(epoch)*secondsPerEpoch) * time.Second)
+}
+
+func (d *devnet) path(elems ...string) string {
+	return filepath.Join(append([]string{d.opts.dataDir}, elems...)...)
+}
+
+// prepareDataDir creates the data directory of the devnet, which must be empty unless it is cleared.
+func prepareDataDir(dir string, forceClear bool) error {
+	if forceClear {
+		if err := os.RemoveAll(dir); err != nil {
+			return errors.Wrap(err, "could not clear data directory")
+		}
+	}
+	if err := fileutil.MkdirAll(dir); err != nil {
+		return err
+	}
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	if len(entries) > 0 {
+		return fmt.Errorf("data directory %s is not empty, a devnet always starts from genesis", dir)
+	}
+	return nil
+}
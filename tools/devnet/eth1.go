@@ -0,0 +1,265 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	depositcontract "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+const (
+	// eth1ChainID is the chain id and network id of the simulated eth1 chain.
+	eth1ChainID = 1337
+	// The simulated backend offsets each block by 10 seconds from its parent.
+	simulatedBlockTime = 10 * time.Second
+	// eth1Lag is how far the chain trails the wall clock. The backend rejects blocks more than 15 seconds
+	// ahead of the wall clock, so that a block with transactions, which is mined 10 seconds after its
+	// parent, must follow a block in the past.
+	eth1Lag         = simulatedBlockTime
+	eth1GasLimit    = 210000000000
+	depositGasLimit = 4000000
+)
+
+// mockEth1Chain is a simulated eth1 chain with the deposit contract deployed, served over JSON-RPC to the
+// beacon nodes. Its blocks trail the wall clock, as the beacon nodes vote on eth1 blocks by timestamp.
+// Deposits are sent by the devnet process, which holds the only funded account.
+type mockEth1Chain struct {
+	backend      *backends.SimulatedBackend
+	contract     *depositcontract.DepositContract
+	contractAddr common.Address
+	deployBlock  uint64
+	txOpts       *bind.TransactOpts
+	lock         sync.Mutex
+	// pendingTxs is the number of transactions in the pending block.
+	pendingTxs int
+}
+
+// newMockEth1Chain starts a simulated eth1 chain and deploys the deposit contract in its first block.
+func newMockEth1Chain() (*mockEth1Chain, error) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+	txOpts, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(eth1ChainID))
+	if err != nil {
+		return nil, err
+	}
+	txOpts.GasLimit = depositGasLimit
+	balance, ok := new(big.Int).SetString("100000000000000000000000000000000000000", 10)
+	if !ok {
+		return nil, errors.New("could not set balance of the eth1 account")
+	}
+	c := &mockEth1Chain{
+		backend: backends.NewSimulatedBackend(core.GenesisAlloc{txOpts.From: {Balance: balance}}, eth1GasLimit),
+		txOpts:  txOpts,
+	}
+	// The simulated chain otherwise starts in 1970, the block of the deposit contract follows this one.
+	if _, err := c.mine(time.Now().Add(-eth1Lag - simulatedBlockTime)); err != nil {
+		return nil, err
+	}
+	c.contractAddr, _, c.contract, err = depositcontract.DeployDepositContract(txOpts, c.backend, txOpts.From)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not deploy deposit contract")
+	}
+	c.backend.Commit()
+	c.deployBlock = c.backend.Blockchain().CurrentBlock().NumberU64()
+	return c, nil
+}
+
+// deposit sends the deposits to the deposit contract. They are included in the next mined block.
+func (c *mockEth1Chain) deposit(data []*ethpb.Deposit_Data, roots [][]byte) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for i, d := range data {
+		opts := *c.txOpts
+		opts.Value = new(big.Int).Mul(new(big.Int).SetUint64(d.Amount), new(big.Int).SetUint64(params.BeaconConfig().GweiPerEth))
+		if _, err := c.contract.Deposit(&opts, d.PublicKey, d.WithdrawalCredentials, d.Signature, bytesutil.ToBytes32(roots[i])); err != nil {
+			return errors.Wrapf(err, "could not send deposit of %#x", d.PublicKey)
+		}
+		c.pendingTxs++
+	}
+	return nil
+}
+
+// mine mines the pending block at the given time. The simulated backend only sets the time of empty
+// blocks, a block with transactions is mined 10 seconds after its parent, and the blocks which follow
+// are mined one second after their parent until they catch up with the given times.
+func (c *mockEth1Chain) mine(t time.Time) (*gethTypes.Header, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.pendingTxs == 0 {
+		parentTime := int64(c.backend.Blockchain().CurrentHeader().Time)
+		offset := t.Unix() - parentTime
+		if offset < 1 {
+			offset = 1
+		}
+		if err := c.backend.AdjustTime(time.Duration(offset)*time.Second - simulatedBlockTime); err != nil {
+			return nil, err
+		}
+	}
+	c.backend.Commit()
+	c.pendingTxs = 0
+	return c.backend.Blockchain().CurrentHeader(), nil
+}
+
+// run mines a block every period until the context is canceled.
+func (c *mockEth1Chain) run(ctx context.Context, period time.Duration) {
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if _, err := c.mine(now.Add(-eth1Lag)); err != nil {
+				log.WithError(err).Error("Could not mine eth1 block")
+			}
+		}
+	}
+}
+
+// serve serves the JSON-RPC API used by the beacon nodes on the given address until the context is
+// canceled, and returns the endpoint of the API.
+func (c *mockEth1Chain) serve(ctx context.Context, addr string) (string, error) {
+	srv := rpc.NewServer()
+	if err := srv.RegisterName("eth", &eth1API{chain: c}); err != nil {
+		return "", err
+	}
+	if err := srv.RegisterName("net", &netAPI{}); err != nil {
+		return "", err
+	}
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return "", errors.Wrap(err, "could not listen for eth1 JSON-RPC requests")
+	}
+	httpServer := &http.Server{Handler: srv}
+	go func() {
+		<-ctx.Done()
+		if err := httpServer.Close(); err != nil {
+			log.WithError(err).Error("Could not close eth1 JSON-RPC server")
+		}
+		srv.Stop()
+	}()
+	go func() {
+		if err := httpServer.Serve(listener); err != nil && err != http.ErrServerClosed {
+			log.WithError(err).Error("Eth1 JSON-RPC server failed")
+		}
+	}()
+	return fmt.Sprintf("http://%s", listener.Addr()), nil
+}
+
+// eth1API implements the eth namespace methods which the beacon node uses.
+type eth1API struct {
+	chain *mockEth1Chain
+}
+
+// ChainId returns the chain id of the chain.
+func (api *eth1API) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(eth1ChainID))
+}
+
+// Syncing always returns false, the chain is never syncing.
+func (api *eth1API) Syncing() bool {
+	return false
+}
+
+// BlockNumber returns the number of the head block.
+func (api *eth1API) BlockNumber() hexutil.Uint64 {
+	return hexutil.Uint64(api.chain.backend.Blockchain().CurrentHeader().Number.Uint64())
+}
+
+// GetBlockByNumber returns the header of a block, transactions are never included.
+func (api *eth1API) GetBlockByNumber(ctx context.Context, number rpc.BlockNumber, _ bool) (*gethTypes.Header, error) {
+	head := api.chain.backend.Blockchain().CurrentHeader()
+	if number < 0 {
+		return head, nil
+	}
+	if uint64(number) > head.Number.Uint64() {
+		return nil, nil
+	}
+	return api.chain.backend.HeaderByNumber(ctx, big.NewInt(number.Int64()))
+}
+
+// GetBlockByHash returns the header of a block, transactions are never included.
+func (api *eth1API) GetBlockByHash(ctx context.Context, hash common.Hash, _ bool) (*gethTypes.Header, error) {
+	header, err := api.chain.backend.HeaderByHash(ctx, hash)
+	if err == ethereum.NotFound {
+		return nil, nil
+	}
+	return header, err
+}
+
+// GetLogs returns the logs matching the filter.
+func (api *eth1API) GetLogs(ctx context.Context, crit filters.FilterCriteria) ([]gethTypes.Log, error) {
+	logs, err := api.chain.backend.FilterLogs(ctx, ethereum.FilterQuery(crit))
+	if err != nil {
+		return nil, err
+	}
+	if logs == nil {
+		logs = []gethTypes.Log{}
+	}
+	return logs, nil
+}
+
+// callArgs are the arguments of a message call.
+type callArgs struct {
+	From  *common.Address `json:"from"`
+	To    *common.Address `json:"to"`
+	Gas   *hexutil.Uint64 `json:"gas"`
+	Value *hexutil.Big    `json:"value"`
+	Data  *hexutil.Bytes  `json:"data"`
+	Input *hexutil.Bytes  `json:"input"`
+}
+
+// Call executes a message call on the head state, the block number is ignored.
+func (api *eth1API) Call(ctx context.Context, args callArgs, _ rpc.BlockNumber) (hexutil.Bytes, error) {
+	msg := ethereum.CallMsg{To: args.To}
+	if args.From != nil {
+		msg.From = *args.From
+	}
+	if args.Gas != nil {
+		msg.Gas = uint64(*args.Gas)
+	}
+	if args.Value != nil {
+		msg.Value = args.Value.ToInt()
+	}
+	if args.Input != nil {
+		msg.Data = *args.Input
+	} else if args.Data != nil {
+		msg.Data = *args.Data
+	}
+	return api.chain.backend.CallContract(ctx, msg, nil)
+}
+
+// GetCode returns the code of a contract on the head state, the block number is ignored.
+func (api *eth1API) GetCode(ctx context.Context, address common.Address, _ rpc.BlockNumber) (hexutil.Bytes, error) {
+	return api.chain.backend.CodeAt(ctx, address, nil)
+}
+
+// netAPI implements the net namespace methods which the beacon node uses.
+type netAPI struct{}
+
+// Version returns the network id of the chain.
+func (api *netAPI) Version() string {
+	return strconv.Itoa(eth1ChainID)
+}
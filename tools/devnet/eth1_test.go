@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	depositcontract "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+func TestMockEth1Chain(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	chain, err := newMockEth1Chain()
+	require.NoError(t, err)
+	endpoint, err := chain.serve(ctx, "127.0.0.1:0")
+	require.NoError(t, err)
+	client, err := ethclient.DialContext(ctx, endpoint)
+	require.NoError(t, err)
+	defer client.Close()
+
+	chainID, err := client.ChainID(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(eth1ChainID), chainID.Uint64())
+	networkID, err := client.NetworkID(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(eth1ChainID), networkID.Uint64())
+	progress, err := client.SyncProgress(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, (*ethereum.SyncProgress)(nil), progress)
+
+	// The blocks of the chain trail the wall clock.
+	head, err := client.HeaderByNumber(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, chain.deployBlock, head.Number.Uint64())
+	assert.Equal(t, true, time.Since(time.Unix(int64(head.Time), 0)) >= eth1Lag)
+	assert.Equal(t, true, time.Since(time.Unix(int64(head.Time), 0)) < time.Minute)
+
+	data := make([]*ethpb.Deposit_Data, 2)
+	roots := make([][]byte, len(data))
+	for i := range data {
+		data[i] = &ethpb.Deposit_Data{
+			PublicKey:             bytesutil.PadTo([]byte{byte(i)}, 48),
+			WithdrawalCredentials: make([]byte, 32),
+			Amount:                params.BeaconConfig().MaxEffectiveBalance,
+			Signature:             make([]byte, 96),
+		}
+		root, err := data[i].HashTreeRoot()
+		require.NoError(t, err)
+		roots[i] = root[:]
+	}
+	require.NoError(t, chain.deposit(data, roots))
+	mined, err := chain.mine(time.Unix(int64(head.Time)+2, 0))
+	require.NoError(t, err)
+	// The block with the deposits is mined 10 seconds after its parent, the next block one second later.
+	assert.Equal(t, head.Time+10, mined.Time)
+	empty, err := chain.mine(time.Unix(int64(head.Time)+4, 0))
+	require.NoError(t, err)
+	assert.Equal(t, head.Time+11, empty.Time)
+	empty, err = chain.mine(time.Unix(int64(head.Time)+14, 0))
+	require.NoError(t, err)
+	assert.Equal(t, head.Time+14, empty.Time)
+
+	header, err := client.HeaderByNumber(ctx, mined.Number)
+	require.NoError(t, err)
+	assert.Equal(t, mined.Hash(), header.Hash())
+	header, err = client.HeaderByHash(ctx, mined.Hash())
+	require.NoError(t, err)
+	assert.Equal(t, mined.Number.Uint64(), header.Number.Uint64())
+	_, err = client.HeaderByNumber(ctx, new(big.Int).Add(empty.Number, big.NewInt(1)))
+	assert.ErrorContains(t, ethereum.NotFound.Error(), err)
+
+	logs, err := client.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(chain.deployBlock),
+		ToBlock:   mined.Number,
+		Addresses: []common.Address{chain.contractAddr},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(logs))
+
+	caller, err := depositcontract.NewDepositContractCaller(chain.contractAddr, client)
+	require.NoError(t, err)
+	count, err := caller.GetDepositCount(&bind.CallOpts{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, bytesutil.Bytes8(2), count)
+	trie, err := trieutil.GenerateTrieFromItems(roots, params.BeaconConfig().DepositContractTreeDepth)
+	require.NoError(t, err)
+	depositRoot, err := caller.GetDepositRoot(&bind.CallOpts{})
+	require.NoError(t, err)
+	assert.Equal(t, trie.HashTreeRoot(), depositRoot)
+}
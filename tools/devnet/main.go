@@ -0,0 +1,183 @@
+// This binary starts a local devnet: a chain of beacon nodes and validator clients running on localhost from
+// a generated genesis of interop validators, with a mock eth1 chain in place of an eth1 node. It is meant for
+// developing applications and clients against a chain which starts in seconds and can be scripted.
+package main
+
+import (
+	"context"
+	"math"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	_ "github.com/prysmaticlabs/prysm/shared/maxprocs"
+	"github.com/prysmaticlabs/prysm/shared/version"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+	prefixed "github.com/x-cray/logrus-prefixed-formatter"
+)
+
+var log = logrus.WithField("prefix", "devnet")
+
+var (
+	dataDirFlag = &cli.StringFlag{
+		Name:  "datadir",
+		Usage: "Directory holding the network directory, data directories and logs of the devnet, which must be empty",
+		Value: filepath.Join(os.TempDir(), "prysm-devnet"),
+	}
+	forceClearFlag = &cli.BoolFlag{
+		Name:  "force-clear-datadir",
+		Usage: "Delete the data directory of a previous devnet before starting",
+	}
+	beaconNodesFlag = &cli.IntFlag{
+		Name:  "beacon-nodes",
+		Usage: "Number of beacon nodes",
+		Value: 2,
+	}
+	validatorsFlag = &cli.Uint64Flag{
+		Name:  "validators",
+		Usage: "Number of genesis validators, which use the interop keys from index 0",
+		Value: 64,
+	}
+	validatorClientsFlag = &cli.IntFlag{
+		Name:  "validator-clients",
+		Usage: "Number of validator clients the genesis validators are split between, one per beacon node if not set",
+	}
+	presetFlag = &cli.StringFlag{
+		Name:  "preset",
+		Usage: "Preset of the config of the devnet, mainnet or minimal, which must match the preset the binaries were built with",
+		Value: "mainnet",
+	}
+	secondsPerSlotFlag = &cli.Uint64Flag{
+		Name:  "seconds-per-slot",
+		Usage: "Duration of a slot in seconds",
+		Value: 4,
+	}
+	genesisDelayFlag = &cli.Uint64Flag{
+		Name:  "genesis-delay",
+		Usage: "Seconds between the start of the devnet and its genesis",
+		Value: 30,
+	}
+	altairForkEpochFlag = &cli.Uint64Flag{
+		Name:  "altair-fork-epoch",
+		Usage: "Epoch of the Altair fork",
+		Value: math.MaxUint64,
+	}
+	basePortFlag = &cli.IntFlag{
+		Name: "base-port",
+		Usage: "First port used by the devnet. Each kind of port, such as the gRPC ports of the beacon nodes, " +
+			"uses a range of 100 ports from the base port",
+		Value: 20000,
+	}
+	scriptFlag = &cli.StringFlag{
+		Name: "script",
+		Usage: "YAML file listing actions to run at the start of an epoch: deposit, kill-node, start-node, " +
+			"double-vote and double-proposal",
+	}
+	beaconBinaryFlag = &cli.StringFlag{
+		Name:  "beacon-chain-binary",
+		Usage: "Path of the beacon-chain binary, found in the bazel runfiles or the PATH if not set",
+	}
+	validatorBinaryFlag = &cli.StringFlag{
+		Name:  "validator-binary",
+		Usage: "Path of the validator binary, found in the bazel runfiles or the PATH if not set",
+	}
+	beaconFlagsFlag = &cli.StringSliceFlag{
+		Name:  "beacon-flag",
+		Usage: "Additional flag passed to the beacon nodes, such as --beacon-flag=--enable-debug-rpc-endpoints",
+	}
+	validatorFlagsFlag = &cli.StringSliceFlag{
+		Name:  "validator-flag",
+		Usage: "Additional flag passed to the validator clients",
+	}
+	nodeVerbosityFlag = &cli.StringFlag{
+		Name:  "node-verbosity",
+		Usage: "Logging verbosity of the beacon nodes and validator clients",
+		Value: "info",
+	}
+)
+
+func main() {
+	customFormatter := new(prefixed.TextFormatter)
+	customFormatter.TimestampFormat = "2006-01-02 15:04:05"
+	customFormatter.FullTimestamp = true
+	logrus.SetFormatter(customFormatter)
+
+	app := cli.App{}
+	app.Name = "devnet"
+	app.Usage = "Starts a local devnet of beacon nodes and validator clients with interop validators"
+	app.Version = version.Version()
+	app.Flags = []cli.Flag{
+		dataDirFlag,
+		forceClearFlag,
+		beaconNodesFlag,
+		validatorsFlag,
+		validatorClientsFlag,
+		presetFlag,
+		secondsPerSlotFlag,
+		genesisDelayFlag,
+		altairForkEpochFlag,
+		basePortFlag,
+		scriptFlag,
+		beaconBinaryFlag,
+		validatorBinaryFlag,
+		beaconFlagsFlag,
+		validatorFlagsFlag,
+		nodeVerbosityFlag,
+	}
+	app.Action = run
+	if err := app.Run(os.Args); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(cliCtx *cli.Context) error {
+	opts := &options{
+		dataDir:          cliCtx.String(dataDirFlag.Name),
+		forceClear:       cliCtx.Bool(forceClearFlag.Name),
+		beaconNodes:      cliCtx.Int(beaconNodesFlag.Name),
+		validators:       cliCtx.Uint64(validatorsFlag.Name),
+		validatorClients: cliCtx.Int(validatorClientsFlag.Name),
+		preset:           cliCtx.String(presetFlag.Name),
+		secondsPerSlot:   cliCtx.Uint64(secondsPerSlotFlag.Name),
+		genesisDelay:     cliCtx.Uint64(genesisDelayFlag.Name),
+		altairForkEpoch:  cliCtx.Uint64(altairForkEpochFlag.Name),
+		basePort:         cliCtx.Int(basePortFlag.Name),
+		beaconBinary:     cliCtx.String(beaconBinaryFlag.Name),
+		validatorBinary:  cliCtx.String(validatorBinaryFlag.Name),
+		beaconFlags:      cliCtx.StringSlice(beaconFlagsFlag.Name),
+		validatorFlags:   cliCtx.StringSlice(validatorFlagsFlag.Name),
+		verbosity:        cliCtx.String(nodeVerbosityFlag.Name),
+	}
+	if !cliCtx.IsSet(validatorClientsFlag.Name) {
+		opts.validatorClients = opts.beaconNodes
+	}
+	var script []*action
+	if path := cliCtx.String(scriptFlag.Name); path != "" {
+		var err error
+		if script, err = loadScript(path, opts); err != nil {
+			return err
+		}
+	}
+
+	ctx, cancel := context.WithCancel(cliCtx.Context)
+	defer cancel()
+	d, err := newDevnet(ctx, opts)
+	if err != nil {
+		return err
+	}
+	if err := d.start(ctx, script); err != nil {
+		if stopErr := d.stop(); stopErr != nil {
+			log.WithError(stopErr).Error("Could not stop devnet")
+		}
+		return err
+	}
+
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+	<-sigc
+	log.Info("Stopping devnet")
+	cancel()
+	return d.stop()
+}
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/exec"
+	"sync"
+	"time"
+
+	"github.com/bazelbuild/rules_go/go/tools/bazel"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+// processStopTimeout is how long a process has to exit after being interrupted, before it is killed.
+const processStopTimeout = 30 * time.Second
+
+// process is a beacon node or validator client running as a child process of the devnet. It can be
+// stopped and started again with the same arguments, and so the same data directory.
+type process struct {
+	name    string
+	binary  string
+	args    []string
+	logPath string
+
+	lock     sync.Mutex
+	cmd      *exec.Cmd
+	exited   chan struct{}
+	stopping bool
+}
+
+// start starts the process, appending its output to its log file.
+func (p *process) start() error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.cmd != nil {
+		return fmt.Errorf("%s is already running", p.name)
+	}
+	logFile, err := os.OpenFile(p.logPath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, params.BeaconIoConfig().ReadWritePermissions)
+	if err != nil {
+		return errors.Wrapf(err, "could not open log file of %s", p.name)
+	}
+	cmd := exec.Command(p.binary, p.args...)
+	cmd.Stdout = logFile
+	cmd.Stderr = logFile
+	if err := cmd.Start(); err != nil {
+		if err := logFile.Close(); err != nil {
+			log.WithError(err).Error("Could not close log file")
+		}
+		return errors.Wrapf(err, "could not start %s", p.name)
+	}
+	exited := make(chan struct{})
+	p.cmd = cmd
+	p.exited = exited
+	go func() {
+		err := cmd.Wait()
+		if err := logFile.Close(); err != nil {
+			log.WithError(err).Error("Could not close log file")
+		}
+		p.lock.Lock()
+		expected := p.stopping
+		p.cmd = nil
+		p.stopping = false
+		p.lock.Unlock()
+		close(exited)
+		if !expected {
+			log.WithError(err).WithFields(logrus.Fields{
+				"process": p.name,
+				"log":     p.logPath,
+			}).Error("Process exited unexpectedly")
+		}
+	}()
+	log.WithFields(logrus.Fields{
+		"process": p.name,
+		"pid":     cmd.Process.Pid,
+		"log":     p.logPath,
+	}).Info("Started process")
+	return nil
+}
+
+// stop interrupts the process, or kills it right away to simulate a crash, and waits for it to exit.
+func (p *process) stop(kill bool) error {
+	p.lock.Lock()
+	cmd, exited := p.cmd, p.exited
+	if cmd == nil {
+		p.lock.Unlock()
+		return nil
+	}
+	p.stopping = true
+	p.lock.Unlock()
+
+	sig := os.Interrupt
+	if kill {
+		sig = os.Kill
+	}
+	// Signaling fails if the process already exited, in which case waiting for it returns right away.
+	if err := cmd.Process.Signal(sig); err != nil {
+		log.WithError(err).WithField("process", p.name).Debug("Could not signal process")
+	}
+	select {
+	case <-exited:
+	case <-time.After(processStopTimeout):
+		log.WithField("process", p.name).Warn("Process did not exit in time, killing it")
+		if err := cmd.Process.Kill(); err != nil {
+			return errors.Wrapf(err, "could not kill %s", p.name)
+		}
+		<-exited
+	}
+	log.WithField("process", p.name).Info("Stopped process")
+	return nil
+}
+
+// running returns true if the process is running.
+func (p *process) running() bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.cmd != nil
+}
+
+// findBinary returns the path of a binary, which is the binary of the given flag if set, else the binary
+// built by bazel when run with bazel, or the binary in the PATH.
+func findBinary(flagValue, pkg, name string) (string, error) {
+	if flagValue != "" {
+		return exec.LookPath(flagValue)
+	}
+	if path, found := bazel.FindBinary(pkg, name); found {
+		return path, nil
+	}
+	path, err := exec.LookPath(name)
+	if err != nil {
+		return "", errors.Wrapf(err, "could not find %s binary, set its path with a flag", name)
+	}
+	return path, nil
+}
+
+// p2pIdentity is the networking identity of a beacon node. It is generated before the node starts, so that
+// the nodes of the devnet are wired to each other from the start.
+type p2pIdentity struct {
+	keyPath   string
+	enr       string
+	multiaddr string
+}
+
+// newP2PIdentity generates a networking key, which is written to the key path in the format of
+// --p2p-priv-key, and returns the ENR and multiaddr of a node listening on localhost with it.
+func newP2PIdentity(keyPath string, tcpPort, udpPort int) (*p2pIdentity, error) {
+	priv, _, err := crypto.GenerateSecp256k1Key(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := priv.Raw()
+	if err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(keyPath, []byte(hex.EncodeToString(raw)), params.BeaconIoConfig().ReadWritePermissions); err != nil {
+		return nil, errors.Wrap(err, "could not write networking key")
+	}
+	id, err := peer.IDFromPrivateKey(priv)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := enode.OpenDB("")
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open node database")
+	}
+	defer db.Close()
+	localNode := enode.NewLocalNode(db, (*ecdsa.PrivateKey)(priv.(*crypto.Secp256k1PrivateKey)))
+	localNode.Set(enr.IP(net.IPv4(127, 0, 0, 1)))
+	localNode.Set(enr.TCP(tcpPort))
+	localNode.Set(enr.UDP(udpPort))
+	return &p2pIdentity{
+		keyPath:   keyPath,
+		enr:       localNode.Node().String(),
+		multiaddr: fmt.Sprintf("/ip4/127.0.0.1/tcp/%d/p2p/%s", tcpPort, id.String()),
+	}, nil
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+)
+
+// Kinds of alerts.
+const (
+	splitAlert             = "split"
+	splitResolvedAlert     = "split_resolved"
+	finalizedConflictAlert = "finalized_conflict"
+)
+
+// alert is the JSON body posted to the webhook. The text field is understood by chat webhooks
+// such as the ones of Slack and Mattermost.
+type alert struct {
+	Kind  string       `json:"kind"`
+	Text  string       `json:"text"`
+	Time  time.Time    `json:"time"`
+	Slot  types.Slot   `json:"slot"`
+	Heads []*alertHead `json:"heads"`
+}
+
+// alertHead is the head of a node when an alert was raised.
+type alertHead struct {
+	Node           string      `json:"node"`
+	HeadSlot       types.Slot  `json:"head_slot"`
+	HeadRoot       string      `json:"head_root"`
+	FinalizedEpoch types.Epoch `json:"finalized_epoch"`
+	FinalizedRoot  string      `json:"finalized_root"`
+}
+
+// alerter sends alerts.
+type alerter interface {
+	send(ctx context.Context, a *alert) error
+}
+
+// webhookAlerter posts alerts as JSON to a webhook.
+type webhookAlerter struct {
+	url    string
+	client *http.Client
+}
+
+func (w *webhookAlerter) send(ctx context.Context, a *alert) error {
+	body, err := json.Marshal(a)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	if err := resp.Body.Close(); err != nil {
+		log.WithError(err).Debug("Could not close webhook response body")
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %s", resp.Status)
+	}
+	return nil
+}
+
+// logAlerter only logs alerts, when no webhook is configured.
+type logAlerter struct{}
+
+func (logAlerter) send(_ context.Context, a *alert) error {
+	log.WithField("kind", a.Kind).Warn(a.Text)
+	return nil
+}
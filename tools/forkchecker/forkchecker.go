@@ -6,6 +6,12 @@
  * Example: 2 beacon nodes with 2 gRPC end points, 127.0.0.1:4000 and 127.0.0.1:4001
  * For logging heads: forkchecker --endpoint 127.0.0.1:4000 --endpoint 127.0.0.1:4001
  * For comparing heads: forkchecker --endpoint 127.0.0.1:4000 --endpoint 127.0.0.1:4001 --compare
+ *
+ * The monitor mode follows the heads, checkpoints and reorgs of nodes of any client, through the
+ * Prysm gRPC API or the standard REST API, exports them as Prometheus metrics and a timeline, and
+ * alerts on a webhook when nodes disagree on the head for too long.
+ * For monitoring: forkchecker --monitor --endpoint 127.0.0.1:4000 --rest-endpoint http://127.0.0.1:5052 \
+ *   --webhook-url https://hooks.example.com/... --timeline-file timeline.csv
  */
 package main
 
@@ -13,9 +19,13 @@ import (
 	"context"
 	"encoding/hex"
 	"flag"
+	"fmt"
+	"net/http"
+	"os"
 	"reflect"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	types "github.com/prysmaticlabs/eth2-types"
 	pb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -39,13 +49,32 @@ func (e *endpoint) Set(value string) error {
 }
 
 func main() {
-	var endpts endpoint
+	var endpts, restEndpts endpoint
 	clients := make(map[string]pb.BeaconChainClient)
 
 	flag.Var(&endpts, "endpoint", "Specify gRPC end points for beacon node")
 	compare := flag.Bool("compare", false, "Enable head comparisons between all end points")
+	monitorMode := flag.Bool("monitor", false, "Monitor the heads, checkpoints and reorgs of all end points")
+	flag.Var(&restEndpts, "rest-endpoint", "Specify standard REST API end points for beacon node, only used with --monitor")
+	pollInterval := flag.Duration("poll-interval", 2*time.Second, "How often the monitor requests the heads of the end points")
+	alertAfterSlots := flag.Uint64("alert-after-slots", 2, "How many slots end points must disagree on the head before an alert")
+	secondsPerSlot := flag.Uint64("seconds-per-slot", 0, "Seconds per slot of the monitored chain, read from the first end point which answers if 0")
+	webhookURL := flag.String("webhook-url", "", "URL alerts of the monitor are posted to as JSON, alerts are only logged if empty")
+	metricsAddr := flag.String("metrics-address", "127.0.0.1:8080", "Address the monitor serves Prometheus metrics on at /metrics")
+	timelineFile := flag.String("timeline-file", "", "File the monitor writes the view of every end point at every slot to")
+	timelineFormat := flag.String("timeline-format", "csv", "Format of the timeline file: csv or json")
 	flag.Parse()
 
+	if *monitorMode {
+		cfg := &monitorConfig{
+			pollInterval:    *pollInterval,
+			alertAfterSlots: *alertAfterSlots,
+			secondsPerSlot:  *secondsPerSlot,
+		}
+		runMonitor(cfg, endpts, restEndpts, *webhookURL, *metricsAddr, *timelineFile, *timelineFormat)
+		return
+	}
+
 	for _, endpt := range endpts {
 		conn, err := grpc.Dial(endpt, grpc.WithInsecure())
 		if err != nil {
@@ -54,7 +83,10 @@ func main() {
 		clients[endpt] = pb.NewBeaconChainClient(conn)
 	}
 
-	ticker := time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	if *secondsPerSlot == 0 {
+		*secondsPerSlot = params.BeaconConfig().SecondsPerSlot
+	}
+	ticker := time.NewTicker(time.Duration(*secondsPerSlot) * time.Second)
 	go func() {
 		for range ticker.C {
 			if *compare {
@@ -67,6 +99,63 @@ func main() {
 	select {}
 }
 
+// runMonitor monitors the gRPC and REST end points until the process is stopped.
+func runMonitor(cfg *monitorConfig, endpts, restEndpts []string, webhookURL, metricsAddr, timelineFile, timelineFormat string) {
+	m := &monitor{cfg: cfg, alerter: logAlerter{}}
+	for _, endpt := range endpts {
+		conn, err := grpc.Dial(endpt, grpc.WithInsecure())
+		if err != nil {
+			log.Fatalf("fail to dial: %v", err)
+		}
+		m.nodes = append(m.nodes, &monitoredNode{name: endpt, source: newGRPCSource(conn)})
+	}
+	for _, endpt := range restEndpts {
+		m.nodes = append(m.nodes, &monitoredNode{name: endpt, source: newRESTSource(endpt)})
+	}
+	if len(m.nodes) == 0 {
+		log.Fatal("No end point to monitor, specify them with --endpoint or --rest-endpoint")
+	}
+	if webhookURL != "" {
+		m.alerter = &webhookAlerter{url: webhookURL, client: &http.Client{Timeout: 10 * time.Second}}
+	}
+	if timelineFile != "" {
+		f, err := os.OpenFile(timelineFile, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+		if err != nil {
+			log.Fatalf("Could not create timeline file: %v", err)
+		}
+		m.timeline, err = newTimelineWriter(f, timelineFormat)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	go func() {
+		if err := http.ListenAndServe(metricsAddr, mux); err != nil {
+			log.Fatalf("Failed to start metrics server: %v", err)
+		}
+	}()
+
+	// The slot clock of the timeline comes from the first end point which answers.
+	ctx := context.Background()
+	for m.genesis.IsZero() {
+		for _, n := range m.nodes {
+			if err := m.readSlotClock(ctx, n.source); err != nil {
+				log.WithError(err).WithField("node", n.name).Warn("Could not get slot clock")
+				continue
+			}
+			break
+		}
+		if m.genesis.IsZero() {
+			time.Sleep(cfg.pollInterval)
+		}
+	}
+	log.WithField("secondsPerSlot", cfg.secondsPerSlot).Info("Read slot clock of the chain")
+	log.WithField("nodes", fmt.Sprint(append(endpts, restEndpts...))).Info("Monitoring beacon nodes")
+	m.run(ctx)
+}
+
 // log heads for all RPC end points
 func displayHeads(clients map[string]pb.BeaconChainClient) {
 	for endpt, client := range clients {
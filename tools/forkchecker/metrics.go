@@ -0,0 +1,51 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	nodeUp = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "forkchecker_node_up",
+		Help: "Whether the last request for the head of the node succeeded.",
+	}, []string{"node"})
+	nodeHeadSlot = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "forkchecker_head_slot",
+		Help: "The slot of the head of the node.",
+	}, []string{"node"})
+	nodeJustifiedEpoch = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "forkchecker_justified_epoch",
+		Help: "The epoch of the current justified checkpoint of the node.",
+	}, []string{"node"})
+	nodeFinalizedEpoch = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "forkchecker_finalized_epoch",
+		Help: "The epoch of the finalized checkpoint of the node.",
+	}, []string{"node"})
+	nodeInConsensus = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "forkchecker_in_consensus",
+		Help: "Whether the head of the node is the head of the most nodes.",
+	}, []string{"node"})
+	nodeReorgs = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "forkchecker_reorgs_total",
+		Help: "The number of chain reorganizations reported by the node.",
+	}, []string{"node"})
+	nodeReorgDepth = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "forkchecker_reorg_depth",
+		Help:    "The depth in slots of the chain reorganizations reported by the node.",
+		Buckets: []float64{1, 2, 3, 4, 8, 16, 32, 64},
+	}, []string{"node"})
+	nodeConvergenceSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "forkchecker_convergence_seconds",
+		Help:    "The time the node took to follow the head of the most nodes again after diverging.",
+		Buckets: []float64{1, 2, 4, 6, 12, 24, 48, 96, 192, 384},
+	}, []string{"node"})
+	distinctHeads = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "forkchecker_distinct_heads",
+		Help: "The number of distinct heads among the reachable nodes.",
+	})
+	alertsSent = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "forkchecker_alerts_total",
+		Help: "The number of alerts sent, by kind.",
+	}, []string{"kind"})
+)
@@ -0,0 +1,369 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+)
+
+// monitorConfig configures the monitor.
+type monitorConfig struct {
+	pollInterval    time.Duration // how often the heads of the nodes are requested.
+	alertAfterSlots uint64        // how many slots nodes must disagree on the head before an alert.
+	alertAfter      time.Duration // alertAfterSlots as a duration, set with the slot clock.
+	secondsPerSlot  uint64        // read from the nodes if zero.
+}
+
+// monitoredNode is a beacon node followed by the monitor.
+type monitoredNode struct {
+	name       string
+	source     headSource
+	head       *nodeHead // last head of the node, nil until it answered once.
+	err        error     // error of the last request for the head, if it failed.
+	divergedAt time.Time // when the node left the head of the most nodes, zero if it follows it.
+	reorgs     []*reorg  // reorgs reported since the last row of the timeline.
+}
+
+// nodeReorg is a reorg reported by a monitored node.
+type nodeReorg struct {
+	node  *monitoredNode
+	reorg *reorg
+}
+
+// monitor follows the heads and checkpoints of several beacon nodes. It measures how long nodes
+// which diverge from the head of the most nodes take to converge, alerts when nodes disagree on the
+// head for longer than configured or on a finalized checkpoint, and writes a timeline of the view of
+// every node at every slot.
+type monitor struct {
+	cfg      *monitorConfig
+	nodes    []*monitoredNode
+	alerter  alerter
+	timeline timelineWriter // nil if no timeline is written.
+	genesis  time.Time
+
+	splitSince        time.Time // when the reachable nodes started to disagree, zero if they agree.
+	splitAlerted      bool
+	finalizedConflict types.Epoch // epoch of the last finalized conflict alerted on, zero if none.
+	lastRowSlot       types.Slot
+}
+
+// readSlotClock reads the genesis time of the chain from a node, and the seconds per slot if they
+// were not configured, so the monitor does not assume the slot clock of mainnet.
+func (m *monitor) readSlotClock(ctx context.Context, source headSource) error {
+	genesis, err := source.genesisTime(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get genesis time")
+	}
+	secondsPerSlot := m.cfg.secondsPerSlot
+	if secondsPerSlot == 0 {
+		if secondsPerSlot, err = source.secondsPerSlot(ctx); err != nil {
+			return errors.Wrap(err, "could not get seconds per slot")
+		}
+		if secondsPerSlot == 0 {
+			return errors.New("node reported zero seconds per slot")
+		}
+	}
+	m.genesis = genesis
+	m.cfg.secondsPerSlot = secondsPerSlot
+	m.cfg.alertAfter = time.Duration(m.cfg.alertAfterSlots*secondsPerSlot) * time.Second
+	return nil
+}
+
+// run polls the nodes and follows their reorgs until the context is done.
+func (m *monitor) run(ctx context.Context) {
+	reorgs := make(chan *nodeReorg, len(m.nodes))
+	for _, n := range m.nodes {
+		go m.followReorgs(ctx, n, reorgs)
+	}
+	m.lastRowSlot = m.slotAt(time.Now())
+
+	ticker := time.NewTicker(m.cfg.pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case r := <-reorgs:
+			m.recordReorg(r.node, r.reorg)
+		case <-ticker.C:
+			m.poll(ctx)
+			m.update(ctx, time.Now())
+		}
+	}
+}
+
+// followReorgs streams the reorgs reported by a node, reconnecting every slot while the stream fails.
+func (m *monitor) followReorgs(ctx context.Context, n *monitoredNode, reorgs chan<- *nodeReorg) {
+	stream := make(chan *reorg)
+	go func() {
+		for {
+			select {
+			case r := <-stream:
+				select {
+				case reorgs <- &nodeReorg{node: n, reorg: r}:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	for {
+		err := n.source.streamReorgs(ctx, stream)
+		if ctx.Err() != nil {
+			return
+		}
+		log.WithError(err).WithField("node", n.name).Debug("Reorg stream failed, reconnecting")
+		select {
+		case <-time.After(time.Duration(m.cfg.secondsPerSlot) * time.Second):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// poll requests the head of every node concurrently.
+func (m *monitor) poll(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, m.cfg.pollInterval)
+	defer cancel()
+	var wg sync.WaitGroup
+	heads := make([]*nodeHead, len(m.nodes))
+	errs := make([]error, len(m.nodes))
+	for i, n := range m.nodes {
+		wg.Add(1)
+		go func(i int, n *monitoredNode) {
+			defer wg.Done()
+			heads[i], errs[i] = n.source.chainHead(ctx)
+		}(i, n)
+	}
+	wg.Wait()
+	for i, n := range m.nodes {
+		n.err = errs[i]
+		if errs[i] == nil {
+			n.head = heads[i]
+		}
+	}
+}
+
+// recordReorg logs a reorg reported by a node, and keeps it for the timeline.
+func (m *monitor) recordReorg(n *monitoredNode, r *reorg) {
+	log.WithFields(logrus.Fields{
+		"node":    n.name,
+		"slot":    r.slot,
+		"depth":   r.depth,
+		"oldHead": fmt.Sprintf("%#x", bytesutil.Trunc(r.oldHead)),
+		"newHead": fmt.Sprintf("%#x", bytesutil.Trunc(r.newHead)),
+	}).Warn("Node reported a chain reorg")
+	nodeReorgs.WithLabelValues(n.name).Inc()
+	nodeReorgDepth.WithLabelValues(n.name).Observe(float64(r.depth))
+	n.reorgs = append(n.reorgs, r)
+}
+
+// update compares the last heads of the nodes, and updates the metrics, the alerts and the timeline.
+func (m *monitor) update(ctx context.Context, now time.Time) {
+	consensusRoot, groups := m.consensusHead()
+	distinctHeads.Set(float64(len(groups)))
+
+	for _, n := range m.nodes {
+		up := n.err == nil && n.head != nil
+		nodeUp.WithLabelValues(n.name).Set(boolToFloat(up))
+		if !up {
+			continue
+		}
+		nodeHeadSlot.WithLabelValues(n.name).Set(float64(n.head.headSlot))
+		nodeJustifiedEpoch.WithLabelValues(n.name).Set(float64(n.head.justifiedEpoch))
+		nodeFinalizedEpoch.WithLabelValues(n.name).Set(float64(n.head.finalizedEpoch))
+
+		inConsensus := bytes.Equal(n.head.headRoot, consensusRoot)
+		nodeInConsensus.WithLabelValues(n.name).Set(boolToFloat(inConsensus))
+		switch {
+		case !inConsensus && n.divergedAt.IsZero():
+			n.divergedAt = now
+			log.WithFields(logrus.Fields{
+				"node":     n.name,
+				"headSlot": n.head.headSlot,
+				"headRoot": fmt.Sprintf("%#x", bytesutil.Trunc(n.head.headRoot)),
+			}).Info("Node diverged from the head of the most nodes")
+		case inConsensus && !n.divergedAt.IsZero():
+			took := now.Sub(n.divergedAt)
+			n.divergedAt = time.Time{}
+			nodeConvergenceSeconds.WithLabelValues(n.name).Observe(took.Seconds())
+			log.WithFields(logrus.Fields{
+				"node": n.name,
+				"took": took,
+			}).Info("Node converged to the head of the most nodes")
+		}
+	}
+
+	m.checkSplit(ctx, now, len(groups))
+	m.checkFinalizedConflict(ctx, now)
+	m.writeTimeline(now, consensusRoot)
+}
+
+// consensusHead returns the head root shared by the most reachable nodes, preferring the highest
+// head slot on ties, and the number of nodes at each head root.
+func (m *monitor) consensusHead() ([]byte, map[string]int) {
+	groups := make(map[string]int)
+	slots := make(map[string]types.Slot)
+	for _, n := range m.nodes {
+		if n.err != nil || n.head == nil {
+			continue
+		}
+		root := string(n.head.headRoot)
+		groups[root]++
+		slots[root] = n.head.headSlot
+	}
+	var best string
+	for root, count := range groups {
+		switch {
+		case best == "", count > groups[best]:
+			best = root
+		case count == groups[best] && (slots[root] > slots[best] || (slots[root] == slots[best] && root < best)):
+			best = root
+		}
+	}
+	if best == "" {
+		return nil, groups
+	}
+	return []byte(best), groups
+}
+
+// checkSplit alerts once when the reachable nodes disagree on the head for longer than configured,
+// and again when they agree after it.
+func (m *monitor) checkSplit(ctx context.Context, now time.Time, distinct int) {
+	if distinct <= 1 {
+		if m.splitAlerted {
+			m.sendAlert(ctx, splitResolvedAlert, now, fmt.Sprintf("Nodes agree on the head again after %s",
+				now.Sub(m.splitSince).Round(time.Second)))
+		}
+		m.splitSince = time.Time{}
+		m.splitAlerted = false
+		return
+	}
+	if m.splitSince.IsZero() {
+		m.splitSince = now
+	}
+	if !m.splitAlerted && now.Sub(m.splitSince) >= m.cfg.alertAfter {
+		m.splitAlerted = true
+		m.sendAlert(ctx, splitAlert, now, fmt.Sprintf("Nodes disagree on the head since %s: %d distinct heads",
+			now.Sub(m.splitSince).Round(time.Second), distinct))
+	}
+}
+
+// checkFinalizedConflict alerts once per epoch when reachable nodes finalized different roots at the
+// same epoch, which no fork choice can resolve.
+func (m *monitor) checkFinalizedConflict(ctx context.Context, now time.Time) {
+	finalized := make(map[types.Epoch][]byte)
+	for _, n := range m.nodes {
+		if n.err != nil || n.head == nil {
+			continue
+		}
+		epoch := n.head.finalizedEpoch
+		root, ok := finalized[epoch]
+		if !ok {
+			finalized[epoch] = n.head.finalizedRoot
+			continue
+		}
+		if !bytes.Equal(root, n.head.finalizedRoot) && epoch > m.finalizedConflict {
+			m.finalizedConflict = epoch
+			m.sendAlert(ctx, finalizedConflictAlert, now, fmt.Sprintf("Nodes finalized different roots at epoch %d", epoch))
+			return
+		}
+	}
+}
+
+// sendAlert sends an alert with the heads of the reachable nodes.
+func (m *monitor) sendAlert(ctx context.Context, kind string, now time.Time, text string) {
+	a := &alert{Kind: kind, Text: text, Time: now, Slot: m.slotAt(now)}
+	var lines []string
+	for _, n := range m.nodes {
+		if n.err != nil || n.head == nil {
+			continue
+		}
+		a.Heads = append(a.Heads, &alertHead{
+			Node:           n.name,
+			HeadSlot:       n.head.headSlot,
+			HeadRoot:       fmt.Sprintf("%#x", n.head.headRoot),
+			FinalizedEpoch: n.head.finalizedEpoch,
+			FinalizedRoot:  fmt.Sprintf("%#x", n.head.finalizedRoot),
+		})
+		lines = append(lines, fmt.Sprintf("%s: head %d %#x, finalized %d %#x", n.name, n.head.headSlot,
+			bytesutil.Trunc(n.head.headRoot), n.head.finalizedEpoch, bytesutil.Trunc(n.head.finalizedRoot)))
+	}
+	sort.Strings(lines)
+	if len(lines) > 0 {
+		a.Text += "\n" + strings.Join(lines, "\n")
+	}
+	alertsSent.WithLabelValues(kind).Inc()
+	if err := m.alerter.send(ctx, a); err != nil {
+		log.WithError(err).WithField("kind", kind).Error("Could not send alert")
+	}
+}
+
+// writeTimeline writes the view of every node once per slot, after the slot ends.
+func (m *monitor) writeTimeline(now time.Time, consensusRoot []byte) {
+	slot := m.slotAt(now)
+	if slot <= m.lastRowSlot {
+		return
+	}
+	rowSlot := m.lastRowSlot
+	m.lastRowSlot = slot
+	if m.timeline == nil {
+		return
+	}
+	rows := make([]*timelineRow, len(m.nodes))
+	for i, n := range m.nodes {
+		row := &timelineRow{Time: now, Slot: rowSlot, Node: n.name, Reorgs: len(n.reorgs)}
+		for _, r := range n.reorgs {
+			if r.depth > row.MaxReorgDepth {
+				row.MaxReorgDepth = r.depth
+			}
+		}
+		n.reorgs = nil
+		if n.err != nil {
+			row.Error = n.err.Error()
+		}
+		if n.err == nil && n.head != nil {
+			row.Up = true
+			row.HeadSlot = n.head.headSlot
+			row.HeadRoot = fmt.Sprintf("%#x", n.head.headRoot)
+			row.JustifiedEpoch = n.head.justifiedEpoch
+			row.JustifiedRoot = fmt.Sprintf("%#x", n.head.justifiedRoot)
+			row.FinalizedEpoch = n.head.finalizedEpoch
+			row.FinalizedRoot = fmt.Sprintf("%#x", n.head.finalizedRoot)
+			row.InConsensus = bytes.Equal(n.head.headRoot, consensusRoot)
+			if !n.divergedAt.IsZero() {
+				row.DivergedFor = now.Sub(n.divergedAt).Seconds()
+			}
+		}
+		rows[i] = row
+	}
+	if err := m.timeline.write(rows); err != nil {
+		log.WithError(err).Error("Could not write timeline")
+	}
+}
+
+// slotAt returns the slot of the chain at a time.
+func (m *monitor) slotAt(t time.Time) types.Slot {
+	if t.Before(m.genesis) || m.cfg.secondsPerSlot == 0 {
+		return 0
+	}
+	return types.Slot(uint64(t.Sub(m.genesis).Seconds()) / m.cfg.secondsPerSlot)
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
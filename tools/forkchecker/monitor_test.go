@@ -0,0 +1,223 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+type recordingAlerter struct {
+	alerts []*alert
+}
+
+func (r *recordingAlerter) send(_ context.Context, a *alert) error {
+	r.alerts = append(r.alerts, a)
+	return nil
+}
+
+type recordingTimeline struct {
+	rows []*timelineRow
+}
+
+func (r *recordingTimeline) write(rows []*timelineRow) error {
+	r.rows = append(r.rows, rows...)
+	return nil
+}
+
+func testMonitor(names ...string) (*monitor, *recordingAlerter, *recordingTimeline) {
+	alerts := &recordingAlerter{}
+	timeline := &recordingTimeline{}
+	m := &monitor{
+		cfg: &monitorConfig{
+			pollInterval:   time.Second,
+			alertAfter:     24 * time.Second,
+			secondsPerSlot: 12,
+		},
+		alerter:  alerts,
+		timeline: timeline,
+		genesis:  time.Unix(0, 0),
+	}
+	for _, name := range names {
+		m.nodes = append(m.nodes, &monitoredNode{name: name})
+	}
+	return m, alerts, timeline
+}
+
+func head(slot types.Slot, root byte, finalizedEpoch types.Epoch, finalizedRoot byte) *nodeHead {
+	return &nodeHead{
+		headSlot:       slot,
+		headRoot:       bytes.Repeat([]byte{root}, 32),
+		finalizedEpoch: finalizedEpoch,
+		finalizedRoot:  bytes.Repeat([]byte{finalizedRoot}, 32),
+	}
+}
+
+type slotClockSource struct {
+	headSource
+	genesis   time.Time
+	slotTime  uint64
+	requested bool
+}
+
+func (s *slotClockSource) genesisTime(_ context.Context) (time.Time, error) {
+	return s.genesis, nil
+}
+
+func (s *slotClockSource) secondsPerSlot(_ context.Context) (uint64, error) {
+	s.requested = true
+	return s.slotTime, nil
+}
+
+func TestMonitor_ReadSlotClock(t *testing.T) {
+	source := &slotClockSource{genesis: time.Unix(100, 0), slotTime: 6}
+	m := &monitor{cfg: &monitorConfig{alertAfterSlots: 2}}
+	require.NoError(t, m.readSlotClock(context.Background(), source))
+	assert.Equal(t, time.Unix(100, 0), m.genesis)
+	assert.Equal(t, uint64(6), m.cfg.secondsPerSlot)
+	assert.Equal(t, 12*time.Second, m.cfg.alertAfter)
+}
+
+func TestMonitor_ReadSlotClock_Configured(t *testing.T) {
+	source := &slotClockSource{genesis: time.Unix(100, 0), slotTime: 6}
+	m := &monitor{cfg: &monitorConfig{alertAfterSlots: 2, secondsPerSlot: 5}}
+	require.NoError(t, m.readSlotClock(context.Background(), source))
+	assert.Equal(t, false, source.requested)
+	assert.Equal(t, uint64(5), m.cfg.secondsPerSlot)
+	assert.Equal(t, 10*time.Second, m.cfg.alertAfter)
+}
+
+func TestMonitor_ReadSlotClock_ZeroSecondsPerSlot(t *testing.T) {
+	m := &monitor{cfg: &monitorConfig{alertAfterSlots: 2}}
+	err := m.readSlotClock(context.Background(), &slotClockSource{genesis: time.Unix(100, 0)})
+	assert.ErrorContains(t, "zero seconds per slot", err)
+	assert.Equal(t, true, m.genesis.IsZero())
+}
+
+func TestMonitor_ConsensusHead(t *testing.T) {
+	m, _, _ := testMonitor("a", "b", "c", "d")
+	m.nodes[0].head = head(10, 'x', 0, 0)
+	m.nodes[1].head = head(10, 'x', 0, 0)
+	m.nodes[2].head = head(11, 'y', 0, 0)
+	m.nodes[3].head = head(11, 'y', 0, 0)
+	m.nodes[3].err = errors.New("unreachable")
+
+	root, groups := m.consensusHead()
+	assert.DeepEqual(t, bytes.Repeat([]byte{'x'}, 32), root)
+	assert.Equal(t, 2, len(groups))
+
+	// Ties are broken by the highest head slot.
+	m.nodes[3].err = nil
+	m.nodes[1].head = head(9, 'z', 0, 0)
+	root, _ = m.consensusHead()
+	assert.DeepEqual(t, bytes.Repeat([]byte{'y'}, 32), root)
+}
+
+func TestMonitor_DivergenceAndSplitAlert(t *testing.T) {
+	ctx := context.Background()
+	m, alerts, _ := testMonitor("a", "b", "c")
+	start := time.Unix(1200, 0)
+
+	for _, n := range m.nodes {
+		n.head = head(100, 'x', 2, 'f')
+	}
+	m.update(ctx, start)
+	assert.Equal(t, 0, len(alerts.alerts))
+
+	// Node c follows another head.
+	m.nodes[2].head = head(101, 'y', 2, 'f')
+	m.update(ctx, start.Add(time.Second))
+	assert.Equal(t, start.Add(time.Second), m.nodes[2].divergedAt)
+	assert.Equal(t, true, m.nodes[0].divergedAt.IsZero())
+	assert.Equal(t, 0, len(alerts.alerts), "Alerted before the split persisted")
+
+	m.update(ctx, start.Add(25*time.Second))
+	require.Equal(t, 1, len(alerts.alerts))
+	assert.Equal(t, splitAlert, alerts.alerts[0].Kind)
+	assert.Equal(t, 3, len(alerts.alerts[0].Heads))
+	assert.Equal(t, true, strings.Contains(alerts.alerts[0].Text, "2 distinct heads"), alerts.alerts[0].Text)
+
+	// The split is only alerted once.
+	m.update(ctx, start.Add(30*time.Second))
+	assert.Equal(t, 1, len(alerts.alerts))
+
+	// Node c converges.
+	m.nodes[2].head = head(101, 'x', 2, 'f')
+	m.update(ctx, start.Add(31*time.Second))
+	assert.Equal(t, true, m.nodes[2].divergedAt.IsZero())
+	require.Equal(t, 2, len(alerts.alerts))
+	assert.Equal(t, splitResolvedAlert, alerts.alerts[1].Kind)
+	assert.Equal(t, true, m.splitSince.IsZero())
+}
+
+func TestMonitor_UnreachableNodeKeepsDivergence(t *testing.T) {
+	ctx := context.Background()
+	m, alerts, _ := testMonitor("a", "b", "c")
+	now := time.Unix(1200, 0)
+	m.nodes[0].head = head(100, 'x', 2, 'f')
+	m.nodes[1].head = head(100, 'x', 2, 'f')
+	m.nodes[2].head = head(100, 'y', 2, 'f')
+	m.update(ctx, now)
+	divergedAt := m.nodes[2].divergedAt
+	require.Equal(t, false, divergedAt.IsZero())
+
+	// An unreachable node does not count as a distinct head.
+	m.nodes[2].err = errors.New("connection refused")
+	m.update(ctx, now.Add(time.Minute))
+	assert.Equal(t, divergedAt, m.nodes[2].divergedAt)
+	assert.Equal(t, true, m.splitSince.IsZero())
+	assert.Equal(t, 0, len(alerts.alerts))
+}
+
+func TestMonitor_FinalizedConflictAlert(t *testing.T) {
+	ctx := context.Background()
+	m, alerts, _ := testMonitor("a", "b", "c")
+	now := time.Unix(1200, 0)
+	m.nodes[0].head = head(100, 'x', 2, 'f')
+	m.nodes[1].head = head(100, 'x', 1, 'e')
+	m.nodes[2].head = head(100, 'x', 2, 'g')
+
+	m.update(ctx, now)
+	require.Equal(t, 1, len(alerts.alerts))
+	assert.Equal(t, finalizedConflictAlert, alerts.alerts[0].Kind)
+
+	// The conflict is only alerted once per epoch.
+	m.update(ctx, now.Add(time.Second))
+	assert.Equal(t, 1, len(alerts.alerts))
+}
+
+func TestMonitor_Timeline(t *testing.T) {
+	ctx := context.Background()
+	m, _, timeline := testMonitor("a", "b")
+	m.lastRowSlot = 100
+	m.nodes[0].head = head(100, 'x', 2, 'f')
+	m.nodes[1].head = head(99, 'y', 2, 'f')
+	m.recordReorg(m.nodes[0], &reorg{slot: 100, depth: 2})
+	m.recordReorg(m.nodes[0], &reorg{slot: 100, depth: 1})
+
+	// Nothing is written before the slot ends.
+	m.update(ctx, time.Unix(100*12+6, 0))
+	assert.Equal(t, 0, len(timeline.rows))
+
+	m.nodes[1].err = errors.New("timeout")
+	m.update(ctx, time.Unix(101*12, 0))
+	require.Equal(t, 2, len(timeline.rows))
+	a, b := timeline.rows[0], timeline.rows[1]
+	assert.Equal(t, types.Slot(100), a.Slot)
+	assert.Equal(t, true, a.Up)
+	assert.Equal(t, true, a.InConsensus)
+	assert.Equal(t, 2, a.Reorgs)
+	assert.Equal(t, uint64(2), a.MaxReorgDepth)
+	assert.Equal(t, false, b.Up)
+	assert.Equal(t, "timeout", b.Error)
+	assert.Equal(t, 0, len(m.nodes[0].reorgs), "Reorgs were not reset")
+
+	m.update(ctx, time.Unix(101*12+1, 0))
+	assert.Equal(t, 2, len(timeline.rows), "Slot written twice")
+}
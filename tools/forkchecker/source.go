@@ -0,0 +1,334 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// chainReorgTopic is the topic of chain reorganization events in the standard events API.
+const chainReorgTopic = "chain_reorg"
+
+// nodeHead is the view of the chain of a beacon node.
+type nodeHead struct {
+	headSlot       types.Slot
+	headRoot       []byte
+	justifiedEpoch types.Epoch
+	justifiedRoot  []byte
+	finalizedEpoch types.Epoch
+	finalizedRoot  []byte
+}
+
+// reorg is a chain reorganization reported by a beacon node.
+type reorg struct {
+	slot    types.Slot
+	depth   uint64
+	oldHead []byte
+	newHead []byte
+}
+
+// headSource fetches the view of the chain of a beacon node, through the Prysm gRPC API or the
+// standard REST API.
+type headSource interface {
+	// genesisTime returns the genesis time of the chain of the node.
+	genesisTime(ctx context.Context) (time.Time, error)
+	// secondsPerSlot returns the seconds per slot of the chain of the node.
+	secondsPerSlot(ctx context.Context) (uint64, error)
+	// chainHead returns the head and the checkpoints of the node.
+	chainHead(ctx context.Context) (*nodeHead, error)
+	// streamReorgs sends the reorgs reported by the node until the context is done or the stream
+	// fails.
+	streamReorgs(ctx context.Context, reorgs chan<- *reorg) error
+}
+
+// grpcSource reads a beacon node through the Prysm gRPC API.
+type grpcSource struct {
+	beaconChain pb.BeaconChainClient
+	node        pb.NodeClient
+	events      ethpbv1.EventsClient
+}
+
+func newGRPCSource(conn *grpc.ClientConn) *grpcSource {
+	return &grpcSource{
+		beaconChain: pb.NewBeaconChainClient(conn),
+		node:        pb.NewNodeClient(conn),
+		events:      ethpbv1.NewEventsClient(conn),
+	}
+}
+
+func (s *grpcSource) genesisTime(ctx context.Context) (time.Time, error) {
+	genesis, err := s.node.GetGenesis(ctx, &emptypb.Empty{})
+	if err != nil {
+		return time.Time{}, err
+	}
+	return genesis.GenesisTime.AsTime(), nil
+}
+
+func (s *grpcSource) secondsPerSlot(ctx context.Context) (uint64, error) {
+	cfg, err := s.beaconChain.GetBeaconConfig(ctx, &emptypb.Empty{})
+	if err != nil {
+		return 0, err
+	}
+	secondsPerSlot, err := strconv.ParseUint(cfg.Config["SecondsPerSlot"], 10, 64)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not parse seconds per slot")
+	}
+	return secondsPerSlot, nil
+}
+
+func (s *grpcSource) chainHead(ctx context.Context) (*nodeHead, error) {
+	head, err := s.beaconChain.GetChainHead(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	return &nodeHead{
+		headSlot:       head.HeadSlot,
+		headRoot:       head.HeadBlockRoot,
+		justifiedEpoch: head.JustifiedEpoch,
+		justifiedRoot:  head.JustifiedBlockRoot,
+		finalizedEpoch: head.FinalizedEpoch,
+		finalizedRoot:  head.FinalizedBlockRoot,
+	}, nil
+}
+
+func (s *grpcSource) streamReorgs(ctx context.Context, reorgs chan<- *reorg) error {
+	stream, err := s.events.StreamEvents(ctx, &ethpbv1.StreamEventsRequest{Topics: []string{chainReorgTopic}})
+	if err != nil {
+		return err
+	}
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		if event.Event != chainReorgTopic {
+			continue
+		}
+		data := &ethpbv1.EventChainReorg{}
+		if err := event.Data.UnmarshalTo(data); err != nil {
+			return errors.Wrap(err, "could not decode chain reorg event")
+		}
+		select {
+		case reorgs <- &reorg{slot: data.Slot, depth: data.Depth, oldHead: data.OldHeadBlock, newHead: data.NewHeadBlock}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// restSource reads a beacon node through the standard REST API.
+type restSource struct {
+	url    string
+	client *http.Client
+}
+
+func newRESTSource(url string) *restSource {
+	return &restSource{url: strings.TrimSuffix(url, "/"), client: &http.Client{}}
+}
+
+func (s *restSource) genesisTime(ctx context.Context) (time.Time, error) {
+	var resp struct {
+		Data struct {
+			GenesisTime string `json:"genesis_time"`
+		} `json:"data"`
+	}
+	if err := s.get(ctx, "/eth/v1/beacon/genesis", &resp); err != nil {
+		return time.Time{}, err
+	}
+	genesis, err := strconv.ParseInt(resp.Data.GenesisTime, 10, 64)
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "could not parse genesis time")
+	}
+	return time.Unix(genesis, 0), nil
+}
+
+func (s *restSource) secondsPerSlot(ctx context.Context) (uint64, error) {
+	var resp struct {
+		Data struct {
+			SecondsPerSlot string `json:"SECONDS_PER_SLOT"`
+		} `json:"data"`
+	}
+	if err := s.get(ctx, "/eth/v1/config/spec", &resp); err != nil {
+		return 0, err
+	}
+	secondsPerSlot, err := strconv.ParseUint(resp.Data.SecondsPerSlot, 10, 64)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not parse seconds per slot")
+	}
+	return secondsPerSlot, nil
+}
+
+func (s *restSource) chainHead(ctx context.Context) (*nodeHead, error) {
+	var header struct {
+		Data struct {
+			Root   string `json:"root"`
+			Header struct {
+				Message struct {
+					Slot string `json:"slot"`
+				} `json:"message"`
+			} `json:"header"`
+		} `json:"data"`
+	}
+	if err := s.get(ctx, "/eth/v1/beacon/headers/head", &header); err != nil {
+		return nil, err
+	}
+	type checkpoint struct {
+		Epoch string `json:"epoch"`
+		Root  string `json:"root"`
+	}
+	var checkpoints struct {
+		Data struct {
+			CurrentJustified checkpoint `json:"current_justified"`
+			Finalized        checkpoint `json:"finalized"`
+		} `json:"data"`
+	}
+	if err := s.get(ctx, "/eth/v1/beacon/states/head/finality_checkpoints", &checkpoints); err != nil {
+		return nil, err
+	}
+
+	head := &nodeHead{}
+	slot, err := strconv.ParseUint(header.Data.Header.Message.Slot, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse head slot")
+	}
+	head.headSlot = types.Slot(slot)
+	if head.headRoot, err = hexutil.Decode(header.Data.Root); err != nil {
+		return nil, errors.Wrap(err, "could not parse head root")
+	}
+	justified, finalized := checkpoints.Data.CurrentJustified, checkpoints.Data.Finalized
+	epoch, err := strconv.ParseUint(justified.Epoch, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse justified epoch")
+	}
+	head.justifiedEpoch = types.Epoch(epoch)
+	if head.justifiedRoot, err = hexutil.Decode(justified.Root); err != nil {
+		return nil, errors.Wrap(err, "could not parse justified root")
+	}
+	epoch, err = strconv.ParseUint(finalized.Epoch, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse finalized epoch")
+	}
+	head.finalizedEpoch = types.Epoch(epoch)
+	if head.finalizedRoot, err = hexutil.Decode(finalized.Root); err != nil {
+		return nil, errors.Wrap(err, "could not parse finalized root")
+	}
+	return head, nil
+}
+
+// streamReorgs reads the server-sent events of the events API.
+func (s *restSource) streamReorgs(ctx context.Context, reorgs chan<- *reorg) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url+"/eth/v1/events?topics="+chainReorgTopic, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close event stream")
+		}
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("event stream returned status %s", resp.Status)
+	}
+
+	var event string
+	var data bytes.Buffer
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "event:"):
+			event = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			data.WriteString(strings.TrimSpace(strings.TrimPrefix(line, "data:")))
+		case line == "":
+			// An empty line ends an event.
+			if event == chainReorgTopic && data.Len() > 0 {
+				r, err := decodeReorgEvent(data.Bytes())
+				if err != nil {
+					return err
+				}
+				select {
+				case reorgs <- r:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+			event = ""
+			data.Reset()
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	return errors.New("event stream ended")
+}
+
+// decodeReorgEvent decodes the data of a chain reorganization event of the events API.
+func decodeReorgEvent(data []byte) (*reorg, error) {
+	var event struct {
+		Slot         string `json:"slot"`
+		Depth        string `json:"depth"`
+		OldHeadBlock string `json:"old_head_block"`
+		NewHeadBlock string `json:"new_head_block"`
+	}
+	if err := json.Unmarshal(data, &event); err != nil {
+		return nil, errors.Wrap(err, "could not decode chain reorg event")
+	}
+	slot, err := strconv.ParseUint(event.Slot, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse reorg slot")
+	}
+	depth, err := strconv.ParseUint(event.Depth, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse reorg depth")
+	}
+	r := &reorg{slot: types.Slot(slot), depth: depth}
+	if r.oldHead, err = hexutil.Decode(event.OldHeadBlock); err != nil {
+		return nil, errors.Wrap(err, "could not parse old head of reorg")
+	}
+	if r.newHead, err = hexutil.Decode(event.NewHeadBlock); err != nil {
+		return nil, errors.Wrap(err, "could not parse new head of reorg")
+	}
+	return r, nil
+}
+
+// get requests a path of the REST API and decodes the JSON response.
+func (s *restSource) get(ctx context.Context, path string, resp interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := res.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close response body")
+		}
+	}()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned status %s", path, res.Status)
+	}
+	return json.NewDecoder(res.Body).Decode(resp)
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestRESTSource_ChainHead(t *testing.T) {
+	headRoot := fmt.Sprintf("%#x", bytes.Repeat([]byte{'h'}, 32))
+	justifiedRoot := fmt.Sprintf("%#x", bytes.Repeat([]byte{'j'}, 32))
+	finalizedRoot := fmt.Sprintf("%#x", bytes.Repeat([]byte{'f'}, 32))
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/beacon/genesis", func(w http.ResponseWriter, _ *http.Request) {
+		_, err := fmt.Fprint(w, `{"data":{"genesis_time":"1606824023"}}`)
+		require.NoError(t, err)
+	})
+	mux.HandleFunc("/eth/v1/beacon/headers/head", func(w http.ResponseWriter, _ *http.Request) {
+		_, err := fmt.Fprintf(w, `{"data":{"root":"%s","canonical":true,"header":{"message":{"slot":"123"}}}}`, headRoot)
+		require.NoError(t, err)
+	})
+	mux.HandleFunc("/eth/v1/beacon/states/head/finality_checkpoints", func(w http.ResponseWriter, _ *http.Request) {
+		_, err := fmt.Fprintf(w, `{"data":{"current_justified":{"epoch":"3","root":"%s"},"finalized":{"epoch":"2","root":"%s"}}}`,
+			justifiedRoot, finalizedRoot)
+		require.NoError(t, err)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	s := newRESTSource(srv.URL + "/")
+	genesis, err := s.genesisTime(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, time.Unix(1606824023, 0), genesis)
+
+	head, err := s.chainHead(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(123), head.headSlot)
+	assert.Equal(t, headRoot, fmt.Sprintf("%#x", head.headRoot))
+	assert.Equal(t, types.Epoch(3), head.justifiedEpoch)
+	assert.Equal(t, justifiedRoot, fmt.Sprintf("%#x", head.justifiedRoot))
+	assert.Equal(t, types.Epoch(2), head.finalizedEpoch)
+	assert.Equal(t, finalizedRoot, fmt.Sprintf("%#x", head.finalizedRoot))
+}
+
+func TestRESTSource_ChainHead_Error(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	defer srv.Close()
+	_, err := newRESTSource(srv.URL).chainHead(context.Background())
+	assert.ErrorContains(t, "/eth/v1/beacon/headers/head returned status 404", err)
+}
+
+func TestRESTSource_SecondsPerSlot(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/config/spec", func(w http.ResponseWriter, _ *http.Request) {
+		_, err := fmt.Fprint(w, `{"data":{"SECONDS_PER_SLOT":"6","SLOTS_PER_EPOCH":"8"}}`)
+		require.NoError(t, err)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	secondsPerSlot, err := newRESTSource(srv.URL).secondsPerSlot(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(6), secondsPerSlot)
+}
+
+func TestRESTSource_StreamReorgs(t *testing.T) {
+	oldHead := fmt.Sprintf("%#x", bytes.Repeat([]byte{'o'}, 32))
+	newHead := fmt.Sprintf("%#x", bytes.Repeat([]byte{'n'}, 32))
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/eth/v1/events", r.URL.Path)
+		assert.Equal(t, chainReorgTopic, r.URL.Query().Get("topics"))
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, err := fmt.Fprintf(w, "event: head\ndata: {\"slot\":\"10\"}\n\n"+
+			"event: chain_reorg\ndata: {\"slot\":\"10\",\"depth\":\"2\",\"old_head_block\":\"%s\",\"new_head_block\":\"%s\",\"epoch\":\"0\"}\n\n",
+			oldHead, newHead)
+		require.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	reorgs := make(chan *reorg, 2)
+	err := newRESTSource(srv.URL).streamReorgs(context.Background(), reorgs)
+	assert.ErrorContains(t, "event stream ended", err)
+	require.Equal(t, 1, len(reorgs))
+	r := <-reorgs
+	assert.Equal(t, types.Slot(10), r.slot)
+	assert.Equal(t, uint64(2), r.depth)
+	assert.Equal(t, oldHead, fmt.Sprintf("%#x", r.oldHead))
+	assert.Equal(t, newHead, fmt.Sprintf("%#x", r.newHead))
+}
+
+func TestTimelineWriter(t *testing.T) {
+	row := &timelineRow{
+		Time:     time.Unix(1200, 0),
+		Slot:     100,
+		Node:     "127.0.0.1:4000",
+		Up:       true,
+		HeadSlot: 100,
+		HeadRoot: "0xaa",
+		Reorgs:   1,
+	}
+	var buf bytes.Buffer
+	w, err := newTimelineWriter(&buf, "csv")
+	require.NoError(t, err)
+	require.NoError(t, w.write([]*timelineRow{row}))
+	assert.Equal(t, "time,slot,node,up,head_slot,head_root,justified_epoch,justified_root,finalized_epoch,"+
+		"finalized_root,in_consensus,diverged_for_seconds,reorgs,max_reorg_depth,error\n"+
+		"1970-01-01T00:20:00Z,100,127.0.0.1:4000,true,100,0xaa,0,,0,,false,0.0,1,0,\n", buf.String())
+
+	buf.Reset()
+	w, err = newTimelineWriter(&buf, "json")
+	require.NoError(t, err)
+	require.NoError(t, w.write([]*timelineRow{row}))
+	assert.Equal(t, true, bytes.Contains(buf.Bytes(), []byte(`"node":"127.0.0.1:4000","up":true,"head_slot":100`)), buf.String())
+
+	_, err = newTimelineWriter(&buf, "xml")
+	assert.ErrorContains(t, "unknown timeline format", err)
+}
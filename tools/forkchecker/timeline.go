@@ -0,0 +1,102 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+)
+
+// timelineRow is the view of a node at a slot.
+type timelineRow struct {
+	Time           time.Time   `json:"time"`
+	Slot           types.Slot  `json:"slot"`
+	Node           string      `json:"node"`
+	Up             bool        `json:"up"`
+	HeadSlot       types.Slot  `json:"head_slot"`
+	HeadRoot       string      `json:"head_root"`
+	JustifiedEpoch types.Epoch `json:"justified_epoch"`
+	JustifiedRoot  string      `json:"justified_root"`
+	FinalizedEpoch types.Epoch `json:"finalized_epoch"`
+	FinalizedRoot  string      `json:"finalized_root"`
+	InConsensus    bool        `json:"in_consensus"`
+	DivergedFor    float64     `json:"diverged_for_seconds"` // zero when the node is in consensus.
+	Reorgs         int         `json:"reorgs"`               // reorgs reported during the slot.
+	MaxReorgDepth  uint64      `json:"max_reorg_depth"`
+	Error          string      `json:"error,omitempty"`
+}
+
+var timelineHeader = []string{
+	"time", "slot", "node", "up", "head_slot", "head_root", "justified_epoch", "justified_root",
+	"finalized_epoch", "finalized_root", "in_consensus", "diverged_for_seconds", "reorgs", "max_reorg_depth", "error",
+}
+
+// timelineWriter writes the view of every node at every slot.
+type timelineWriter interface {
+	write(rows []*timelineRow) error
+}
+
+// newTimelineWriter returns a writer of the timeline as CSV, or as JSON with an object per line.
+func newTimelineWriter(w io.Writer, format string) (timelineWriter, error) {
+	switch format {
+	case "csv":
+		cw := csv.NewWriter(w)
+		if err := cw.Write(timelineHeader); err != nil {
+			return nil, err
+		}
+		cw.Flush()
+		return &csvTimeline{w: cw}, cw.Error()
+	case "json":
+		return &jsonTimeline{enc: json.NewEncoder(w)}, nil
+	default:
+		return nil, fmt.Errorf("unknown timeline format %q, expected csv or json", format)
+	}
+}
+
+type csvTimeline struct {
+	w *csv.Writer
+}
+
+func (t *csvTimeline) write(rows []*timelineRow) error {
+	for _, r := range rows {
+		record := []string{
+			r.Time.UTC().Format(time.RFC3339),
+			strconv.FormatUint(uint64(r.Slot), 10),
+			r.Node,
+			strconv.FormatBool(r.Up),
+			strconv.FormatUint(uint64(r.HeadSlot), 10),
+			r.HeadRoot,
+			strconv.FormatUint(uint64(r.JustifiedEpoch), 10),
+			r.JustifiedRoot,
+			strconv.FormatUint(uint64(r.FinalizedEpoch), 10),
+			r.FinalizedRoot,
+			strconv.FormatBool(r.InConsensus),
+			strconv.FormatFloat(r.DivergedFor, 'f', 1, 64),
+			strconv.Itoa(r.Reorgs),
+			strconv.FormatUint(r.MaxReorgDepth, 10),
+			r.Error,
+		}
+		if err := t.w.Write(record); err != nil {
+			return err
+		}
+	}
+	t.w.Flush()
+	return t.w.Error()
+}
+
+type jsonTimeline struct {
+	enc *json.Encoder
+}
+
+func (t *jsonTimeline) write(rows []*timelineRow) error {
+	for _, r := range rows {
+		if err := t.enc.Encode(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
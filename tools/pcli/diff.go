@@ -0,0 +1,113 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+)
+
+// fieldDiff lists the changes of a field between two states. Lists are compared element by
+// element, validators field by field.
+type fieldDiff struct {
+	name    string
+	changes []string
+}
+
+// diffStates compares two states of the same type field by field. Fields with the same hash tree
+// root are skipped.
+func diffStates(ctx context.Context, a, b sszObject) ([]*fieldDiff, error) {
+	if reflect.TypeOf(a) != reflect.TypeOf(b) {
+		return nil, errors.New("states are not of the same type")
+	}
+	aRoots, err := stateFieldRoots(ctx, a)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute field roots of first state")
+	}
+	bRoots, err := stateFieldRoots(ctx, b)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute field roots of second state")
+	}
+	aValue, bValue := reflect.ValueOf(a).Elem(), reflect.ValueOf(b).Elem()
+	var diffs []*fieldDiff
+	// The field roots are in the order of the fields of the states.
+	rootIndex := -1
+	for i, t := 0, aValue.Type(); i < t.NumField(); i++ {
+		name, ok := fieldName(t.Field(i))
+		if !ok {
+			continue
+		}
+		rootIndex++
+		if bytes.Equal(aRoots[rootIndex].root, bRoots[rootIndex].root) {
+			continue
+		}
+		diffs = append(diffs, &fieldDiff{
+			name:    name,
+			changes: diffValues(aValue.Field(i), bValue.Field(i)),
+		})
+	}
+	return diffs, nil
+}
+
+// diffValues describes the changes between two values of a field. Byte strings longer than a root,
+// such as participation flags, are compared byte by byte.
+func diffValues(a, b reflect.Value) []string {
+	isBytes := a.Kind() == reflect.Slice && a.Type().Elem().Kind() == reflect.Uint8
+	if a.Kind() != reflect.Slice || (isBytes && a.Len() <= 32 && b.Len() <= 32) {
+		return []string{fmt.Sprintf("%s -> %s", compactValue(a), compactValue(b))}
+	}
+	var changes []string
+	for i := 0; i < a.Len() || i < b.Len(); i++ {
+		switch {
+		case i >= b.Len():
+			changes = append(changes, fmt.Sprintf("[%d] removed %s", i, compactValue(a.Index(i))))
+		case i >= a.Len():
+			changes = append(changes, fmt.Sprintf("[%d] added %s", i, compactValue(b.Index(i))))
+		default:
+			if change := diffElement(a.Index(i), b.Index(i)); change != "" {
+				changes = append(changes, fmt.Sprintf("[%d] %s", i, change))
+			}
+		}
+	}
+	return changes
+}
+
+// diffElement describes the change of an element of a list, or returns an empty string if it did
+// not change. Validators list their changed fields, and numbers their delta.
+func diffElement(a, b reflect.Value) string {
+	if reflect.DeepEqual(a.Interface(), b.Interface()) {
+		return ""
+	}
+	if aVal, ok := a.Interface().(*ethpb.Validator); ok {
+		bVal := b.Interface().(*ethpb.Validator)
+		aFields, bFields := reflect.ValueOf(aVal).Elem(), reflect.ValueOf(bVal).Elem()
+		var changes []string
+		for i, t := 0, aFields.Type(); i < t.NumField(); i++ {
+			name, ok := fieldName(t.Field(i))
+			if !ok || reflect.DeepEqual(aFields.Field(i).Interface(), bFields.Field(i).Interface()) {
+				continue
+			}
+			changes = append(changes, fmt.Sprintf("%s: %s -> %s", name, compactValue(aFields.Field(i)), compactValue(bFields.Field(i))))
+		}
+		return strings.Join(changes, ", ")
+	}
+	if a.Kind() == reflect.Uint64 {
+		delta := int64(b.Uint() - a.Uint())
+		return fmt.Sprintf("%d -> %d (%+d)", a.Uint(), b.Uint(), delta)
+	}
+	return fmt.Sprintf("%s -> %s", compactValue(a), compactValue(b))
+}
+
+// compactValue writes a value as single line JSON.
+func compactValue(v reflect.Value) string {
+	enc, err := json.Marshal(plainValue(v))
+	if err != nil {
+		return fmt.Sprint(v.Interface())
+	}
+	return string(enc)
+}
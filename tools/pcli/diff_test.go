@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestDiffStates(t *testing.T) {
+	newState := func() *pb.BeaconState {
+		st, err := testutil.NewBeaconState(func(state *pb.BeaconState) error {
+			state.Validators = make([]*ethpb.Validator, 3)
+			for i := range state.Validators {
+				state.Validators[i] = &ethpb.Validator{
+					PublicKey:             bytesutil.PadTo([]byte{byte(i)}, 48),
+					WithdrawalCredentials: make([]byte, 32),
+					EffectiveBalance:      params.BeaconConfig().MaxEffectiveBalance,
+					ExitEpoch:             params.BeaconConfig().FarFutureEpoch,
+				}
+			}
+			state.Balances = []uint64{32e9, 32e9, 32e9}
+			return nil
+		})
+		require.NoError(t, err)
+		inner, ok := st.InnerStateUnsafe().(*pb.BeaconState)
+		require.Equal(t, true, ok)
+		return inner
+	}
+	a, b := newState(), newState()
+	diffs, err := diffStates(context.Background(), a, b)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(diffs))
+
+	b.Slot = 7
+	b.Validators[1].Slashed = true
+	b.Validators[1].ExitEpoch = 10
+	b.Balances[2] = 31e9
+	b.Balances = append(b.Balances, 1e9)
+	b.Validators = append(b.Validators, &ethpb.Validator{
+		PublicKey:             bytesutil.PadTo([]byte{3}, 48),
+		WithdrawalCredentials: make([]byte, 32),
+	})
+	diffs, err = diffStates(context.Background(), a, b)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(diffs))
+	assert.Equal(t, "slot", diffs[0].name)
+	assert.DeepEqual(t, []string{"0 -> 7"}, diffs[0].changes)
+	assert.Equal(t, "validators", diffs[1].name)
+	require.Equal(t, 2, len(diffs[1].changes))
+	assert.Equal(t, "[1] slashed: false -> true, exit_epoch: 18446744073709551615 -> 10", diffs[1].changes[0])
+	assert.Equal(t, true, len(diffs[1].changes[1]) > 0 && diffs[1].changes[1][:10] == "[3] added ")
+	assert.Equal(t, "balances", diffs[2].name)
+	assert.DeepEqual(t, []string{"[2] 32000000000 -> 31000000000 (-1000000000)", "[3] added 1000000000"}, diffs[2].changes)
+
+	_, err = diffStates(context.Background(), a, &pb.BeaconStateAltair{})
+	assert.ErrorContains(t, "not of the same type", err)
+}
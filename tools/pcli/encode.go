@@ -0,0 +1,130 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"gopkg.in/yaml.v2"
+)
+
+// orderedObject is a decoded container, which keeps its fields in the order of the SSZ type
+// when marshaled to JSON or YAML.
+type orderedObject []orderedField
+
+type orderedField struct {
+	name  string
+	value interface{}
+}
+
+// MarshalJSON writes the fields in order.
+func (o orderedObject) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, f := range o {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, err := json.Marshal(f.name)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(f.value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// MarshalYAML writes the fields in order.
+func (o orderedObject) MarshalYAML() (interface{}, error) {
+	m := make(yaml.MapSlice, len(o))
+	for i, f := range o {
+		m[i] = yaml.MapItem{Key: f.name, Value: f.value}
+	}
+	return m, nil
+}
+
+// marshalObject marshals a decoded object to JSON or YAML. Byte strings, including bitfields,
+// are written in hex and fields use the names of the specification.
+func marshalObject(obj interface{}, format string) ([]byte, error) {
+	value := plainValue(reflect.ValueOf(obj))
+	switch format {
+	case "json":
+		enc, err := json.MarshalIndent(value, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return append(enc, '\n'), nil
+	case "yaml":
+		return yaml.Marshal(value)
+	default:
+		return nil, fmt.Errorf("unknown format %q, expected json or yaml", format)
+	}
+}
+
+// plainValue converts a protobuf message, or a field of one, to values which marshal the same way
+// in JSON and YAML.
+func plainValue(v reflect.Value) interface{} {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return plainValue(v.Elem())
+	case reflect.Struct:
+		t := v.Type()
+		obj := make(orderedObject, 0, t.NumField())
+		for i := 0; i < t.NumField(); i++ {
+			name, ok := fieldName(t.Field(i))
+			if !ok {
+				continue
+			}
+			obj = append(obj, orderedField{name: name, value: plainValue(v.Field(i))})
+		}
+		return obj
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(b), v)
+			return hexutil.Encode(b)
+		}
+		list := make([]interface{}, v.Len())
+		for i := range list {
+			list[i] = plainValue(v.Index(i))
+		}
+		return list
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int()
+	case reflect.Bool:
+		return v.Bool()
+	case reflect.String:
+		return v.String()
+	default:
+		return fmt.Sprint(v.Interface())
+	}
+}
+
+// fieldName returns the name of a field of a protobuf message as in the specification, which is
+// the name of the protobuf field. The internal fields of messages are skipped.
+func fieldName(f reflect.StructField) (string, bool) {
+	if f.PkgPath != "" {
+		return "", false
+	}
+	for _, part := range strings.Split(f.Tag.Get("protobuf"), ",") {
+		if strings.HasPrefix(part, "name=") {
+			return strings.TrimPrefix(part, "name="), true
+		}
+	}
+	return "", false
+}
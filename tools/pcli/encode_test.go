@@ -0,0 +1,53 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestMarshalObject(t *testing.T) {
+	att := &ethpb.Attestation{
+		AggregationBits: bitfield.Bitlist{0x0b},
+		Data: &ethpb.AttestationData{
+			Slot:            3,
+			CommitteeIndex:  1,
+			BeaconBlockRoot: bytesutil.PadTo([]byte{0xaa}, 32),
+			Source:          &ethpb.Checkpoint{Epoch: 0, Root: make([]byte, 32)},
+			Target:          &ethpb.Checkpoint{Epoch: 1, Root: bytesutil.PadTo([]byte{0xbb}, 32)},
+		},
+		Signature: []byte{0x01, 0x02},
+	}
+	enc, err := marshalObject(att, "json")
+	require.NoError(t, err)
+	want := `{
+  "aggregation_bits": "0x0b",
+  "data": {
+    "slot": 3,
+    "committee_index": 1,
+    "beacon_block_root": "0xaa00000000000000000000000000000000000000000000000000000000000000",
+    "source": {
+      "epoch": 0,
+      "root": "0x0000000000000000000000000000000000000000000000000000000000000000"
+    },
+    "target": {
+      "epoch": 1,
+      "root": "0xbb00000000000000000000000000000000000000000000000000000000000000"
+    }
+  },
+  "signature": "0x0102"
+}
+`
+	assert.Equal(t, want, string(enc))
+
+	enc, err = marshalObject(att.Data.Target, "yaml")
+	require.NoError(t, err)
+	assert.Equal(t, "epoch: 1\nroot: 0xbb00000000000000000000000000000000000000000000000000000000000000\n", string(enc))
+
+	_, err = marshalObject(att, "xml")
+	assert.ErrorContains(t, "unknown format", err)
+}
@@ -4,7 +4,6 @@ import (
 	"bufio"
 	"context"
 	"fmt"
-	"io/ioutil"
 	"os"
 	"regexp"
 	"strings"
@@ -12,6 +11,7 @@ import (
 	fssz "github.com/ferranbt/fastssz"
 	"github.com/kr/pretty"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
@@ -30,6 +30,12 @@ func main() {
 	var expectedPostStatePath string
 	var sszPath string
 	var sszType string
+	var format string
+	var fieldRoots bool
+	var statePath string
+	var otherStatePath string
+	var maxChanges int
+	var traceSteps bool
 
 	customFormatter := new(prefixed.TextFormatter)
 	customFormatter.TimestampFormat = "2006-01-02 15:04:05"
@@ -52,54 +58,165 @@ func main() {
 					Destination: &sszPath,
 				},
 				&cli.StringFlag{
-					Name: "data-type",
-					Usage: "ssz file data type: " +
-						"block|" +
-						"signed_block|" +
-						"attestation|" +
-						"block_header|" +
-						"deposit|" +
-						"proposer_slashing|" +
-						"signed_block_header|" +
-						"signed_voluntary_exit|" +
-						"voluntary_exit|" +
-						"state",
+					Name:        "data-type",
+					Usage:       sszTypeUsage(),
 					Required:    true,
 					Destination: &sszType,
 				},
 			},
 			Action: func(c *cli.Context) error {
-				var data fssz.Unmarshaler
-				switch sszType {
-				case "block":
-					data = &ethpb.BeaconBlock{}
-				case "signed_block":
-					data = &ethpb.SignedBeaconBlock{}
-				case "attestation":
-					data = &ethpb.Attestation{}
-				case "block_header":
-					data = &ethpb.BeaconBlockHeader{}
-				case "deposit":
-					data = &ethpb.Deposit{}
-				case "deposit_message":
-					data = &pb.DepositMessage{}
-				case "proposer_slashing":
-					data = &ethpb.ProposerSlashing{}
-				case "signed_block_header":
-					data = &ethpb.SignedBeaconBlockHeader{}
-				case "signed_voluntary_exit":
-					data = &ethpb.SignedVoluntaryExit{}
-				case "voluntary_exit":
-					data = &ethpb.VoluntaryExit{}
-				case "state":
-					data = &pb.BeaconState{}
-				default:
-					log.Fatal("Invalid type")
+				data, err := newSSZObject(sszType)
+				if err != nil {
+					log.Fatal(err)
 				}
 				prettyPrint(sszPath, data)
 				return nil
 			},
 		},
+		{
+			Name:  "decode",
+			Usage: "Decode SSZ data to JSON or YAML",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:        "ssz-path",
+					Usage:       "Path to file(ssz)",
+					Required:    true,
+					Destination: &sszPath,
+				},
+				&cli.StringFlag{
+					Name:        "data-type",
+					Usage:       sszTypeUsage(),
+					Required:    true,
+					Destination: &sszType,
+				},
+				&cli.StringFlag{
+					Name:        "format",
+					Usage:       "Output format: json|yaml",
+					Value:       "json",
+					Destination: &format,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				obj, err := decodeSSZFile(sszPath, sszType)
+				if err != nil {
+					return err
+				}
+				enc, err := marshalObject(obj, format)
+				if err != nil {
+					return err
+				}
+				fmt.Print(string(enc))
+				return nil
+			},
+		},
+		{
+			Name:    "hash-tree-root",
+			Aliases: []string{"htr"},
+			Usage:   "Compute the hash tree root of SSZ data, and of each field of a state",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:        "ssz-path",
+					Usage:       "Path to file(ssz)",
+					Required:    true,
+					Destination: &sszPath,
+				},
+				&cli.StringFlag{
+					Name:        "data-type",
+					Usage:       sszTypeUsage(),
+					Required:    true,
+					Destination: &sszType,
+				},
+				&cli.BoolFlag{
+					Name:        "field-roots",
+					Usage:       "Print the hash tree root of each field of a state",
+					Destination: &fieldRoots,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				obj, err := decodeSSZFile(sszPath, sszType)
+				if err != nil {
+					return err
+				}
+				root, err := hashTreeRoot(c.Context, obj)
+				if err != nil {
+					return err
+				}
+				fmt.Printf("%#x\n", root)
+				if !fieldRoots {
+					return nil
+				}
+				roots, err := stateFieldRoots(c.Context, obj)
+				if err != nil {
+					return err
+				}
+				for _, r := range roots {
+					fmt.Printf("%s: %#x\n", r.name, r.root)
+				}
+				return nil
+			},
+		},
+		{
+			Name:  "state-diff",
+			Usage: "List the fields which differ between two states, with the changed validators and balances",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:        "state-path",
+					Usage:       "Path to the first state file(ssz)",
+					Required:    true,
+					Destination: &statePath,
+				},
+				&cli.StringFlag{
+					Name:        "other-state-path",
+					Usage:       "Path to the second state file(ssz)",
+					Required:    true,
+					Destination: &otherStatePath,
+				},
+				&cli.StringFlag{
+					Name:        "data-type",
+					Usage:       "ssz file data type: state|state_altair",
+					Value:       "state",
+					Destination: &sszType,
+				},
+				&cli.IntFlag{
+					Name:        "max-changes",
+					Usage:       "Maximum number of changed list elements printed per field, 0 to print all",
+					Value:       100,
+					Destination: &maxChanges,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				if sszType != "state" && sszType != "state_altair" {
+					return fmt.Errorf("cannot diff data type %q, expected state or state_altair", sszType)
+				}
+				a, err := decodeSSZFile(statePath, sszType)
+				if err != nil {
+					return err
+				}
+				b, err := decodeSSZFile(otherStatePath, sszType)
+				if err != nil {
+					return err
+				}
+				diffs, err := diffStates(c.Context, a, b)
+				if err != nil {
+					return err
+				}
+				if len(diffs) == 0 {
+					fmt.Println("States are equal")
+					return nil
+				}
+				for _, d := range diffs {
+					fmt.Printf("%s: %d changes\n", d.name, len(d.changes))
+					for i, change := range d.changes {
+						if maxChanges > 0 && i == maxChanges {
+							fmt.Printf("  ... and %d more\n", len(d.changes)-maxChanges)
+							break
+						}
+						fmt.Printf("  %s\n", change)
+					}
+				}
+				return nil
+			},
+		},
 		{
 			Name:     "state-transition",
 			Category: "state-transition",
@@ -120,6 +237,11 @@ func main() {
 					Usage:       "Path to expected post state file(ssz)",
 					Destination: &expectedPostStatePath,
 				},
+				&cli.BoolFlag{
+					Name:        "trace",
+					Usage:       "Log the result of every slot, epoch processing step and block operation",
+					Destination: &traceSteps,
+				},
 			},
 			Action: func(c *cli.Context) error {
 				if blockPath == "" {
@@ -176,7 +298,12 @@ func main() {
 					blkRoot,
 					preStateRoot,
 				)
-				postState, err := state.ExecuteStateTransition(context.Background(), stateObj, wrapper.WrappedPhase0SignedBeaconBlock(block))
+				var postState iface.BeaconState
+				if traceSteps {
+					postState, err = traceStateTransition(context.Background(), stateObj, wrapper.WrappedPhase0SignedBeaconBlock(block))
+				} else {
+					postState, err = state.ExecuteStateTransition(context.Background(), stateObj, wrapper.WrappedPhase0SignedBeaconBlock(block))
+				}
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -207,15 +334,6 @@ func main() {
 	}
 }
 
-// dataFetcher fetches and unmarshals data from file to provided data structure.
-func dataFetcher(fPath string, data fssz.Unmarshaler) error {
-	rawFile, err := ioutil.ReadFile(fPath)
-	if err != nil {
-		return err
-	}
-	return data.UnmarshalSSZ(rawFile)
-}
-
 func prettyPrint(sszPath string, data fssz.Unmarshaler) {
 	if err := dataFetcher(sszPath, data); err != nil {
 		log.Fatal(err)
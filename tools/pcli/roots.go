@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/htrutils"
+)
+
+// fieldRoot is the hash tree root of a field of a container.
+type fieldRoot struct {
+	name string
+	root []byte
+}
+
+// hashTreeRoot returns the hash tree root of an object. The root of a state is computed from the roots
+// of its fields, which follow the specification where the generated hashing of Altair states does not.
+func hashTreeRoot(ctx context.Context, obj sszObject) ([32]byte, error) {
+	switch obj.(type) {
+	case *pb.BeaconState, *pb.BeaconStateAltair:
+		roots, err := stateFieldRoots(ctx, obj)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		chunks := make([][]byte, len(roots))
+		for i, r := range roots {
+			chunks[i] = r.root
+		}
+		return htrutils.BitwiseMerkleize(hashutil.CustomSHA256Hasher(), chunks, uint64(len(chunks)), uint64(len(chunks)))
+	default:
+		return obj.HashTreeRoot()
+	}
+}
+
+// stateFieldRoots returns the hash tree root of every field of a phase 0 or Altair state.
+func stateFieldRoots(ctx context.Context, obj sszObject) ([]*fieldRoot, error) {
+	var roots [][]byte
+	var err error
+	switch st := obj.(type) {
+	case *pb.BeaconState:
+		roots, err = stateutil.ComputeFieldRootsPhase0(ctx, st)
+	case *pb.BeaconStateAltair:
+		roots, err = stateutil.ComputeFieldRootsAltair(ctx, st)
+	default:
+		return nil, errors.New("field roots can only be computed for states")
+	}
+	if err != nil {
+		return nil, err
+	}
+	names := fieldNames(obj)
+	if len(names) != len(roots) {
+		return nil, fmt.Errorf("state has %d fields but %d field roots were computed", len(names), len(roots))
+	}
+	fieldRoots := make([]*fieldRoot, len(roots))
+	for i, root := range roots {
+		fieldRoots[i] = &fieldRoot{name: names[i], root: root}
+	}
+	return fieldRoots, nil
+}
+
+// fieldNames returns the names of the fields of a protobuf message, in order.
+func fieldNames(obj interface{}) []string {
+	t := reflect.TypeOf(obj).Elem()
+	names := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		if name, ok := fieldName(t.Field(i)); ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
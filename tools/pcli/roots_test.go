@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestHashTreeRoot_State(t *testing.T) {
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(10))
+	inner, ok := st.InnerStateUnsafe().(*pb.BeaconState)
+	require.Equal(t, true, ok)
+
+	root, err := hashTreeRoot(context.Background(), inner)
+	require.NoError(t, err)
+	want, err := inner.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, want, root)
+
+	roots, err := stateFieldRoots(context.Background(), inner)
+	require.NoError(t, err)
+	require.Equal(t, params.BeaconConfig().BeaconStateFieldCount, len(roots))
+	assert.Equal(t, "genesis_time", roots[0].name)
+	assert.Equal(t, "slot", roots[2].name)
+	assert.Equal(t, "finalized_checkpoint", roots[len(roots)-1].name)
+}
+
+func TestHashTreeRoot_NotState(t *testing.T) {
+	checkpoint := &ethpb.Checkpoint{Epoch: 5, Root: make([]byte, 32)}
+	root, err := hashTreeRoot(context.Background(), checkpoint)
+	require.NoError(t, err)
+	want, err := checkpoint.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, want, root)
+
+	_, err = stateFieldRoots(context.Background(), checkpoint)
+	assert.ErrorContains(t, "field roots can only be computed for states", err)
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	fssz "github.com/ferranbt/fastssz"
+	"github.com/pkg/errors"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+)
+
+// sszObject is a type pcli can decode from SSZ and hash.
+type sszObject interface {
+	fssz.Unmarshaler
+	fssz.HashRoot
+}
+
+// sszTypes are the types pcli can decode, by the name given to --data-type. Altair types are
+// suffixed with _altair where phase 0 has a type of the same name.
+var sszTypes = map[string]func() sszObject{
+	"aggregate_attestation_and_proof":        func() sszObject { return &ethpb.AggregateAttestationAndProof{} },
+	"attestation":                            func() sszObject { return &ethpb.Attestation{} },
+	"attestation_data":                       func() sszObject { return &ethpb.AttestationData{} },
+	"attester_slashing":                      func() sszObject { return &ethpb.AttesterSlashing{} },
+	"block":                                  func() sszObject { return &ethpb.BeaconBlock{} },
+	"block_altair":                           func() sszObject { return &prysmv2.BeaconBlockAltair{} },
+	"block_body":                             func() sszObject { return &ethpb.BeaconBlockBody{} },
+	"block_body_altair":                      func() sszObject { return &prysmv2.BeaconBlockBodyAltair{} },
+	"block_header":                           func() sszObject { return &ethpb.BeaconBlockHeader{} },
+	"checkpoint":                             func() sszObject { return &ethpb.Checkpoint{} },
+	"contribution_and_proof":                 func() sszObject { return &prysmv2.ContributionAndProof{} },
+	"deposit":                                func() sszObject { return &ethpb.Deposit{} },
+	"deposit_data":                           func() sszObject { return &ethpb.Deposit_Data{} },
+	"deposit_message":                        func() sszObject { return &pb.DepositMessage{} },
+	"eth1_data":                              func() sszObject { return &ethpb.Eth1Data{} },
+	"fork":                                   func() sszObject { return &pb.Fork{} },
+	"historical_batch":                       func() sszObject { return &pb.HistoricalBatch{} },
+	"indexed_attestation":                    func() sszObject { return &ethpb.IndexedAttestation{} },
+	"pending_attestation":                    func() sszObject { return &pb.PendingAttestation{} },
+	"proposer_slashing":                      func() sszObject { return &ethpb.ProposerSlashing{} },
+	"signed_aggregate_attestation_and_proof": func() sszObject { return &ethpb.SignedAggregateAttestationAndProof{} },
+	"signed_block":                           func() sszObject { return &ethpb.SignedBeaconBlock{} },
+	"signed_block_altair":                    func() sszObject { return &prysmv2.SignedBeaconBlockAltair{} },
+	"signed_block_header":                    func() sszObject { return &ethpb.SignedBeaconBlockHeader{} },
+	"signed_contribution_and_proof":          func() sszObject { return &prysmv2.SignedContributionAndProof{} },
+	"signed_voluntary_exit":                  func() sszObject { return &ethpb.SignedVoluntaryExit{} },
+	"state":                                  func() sszObject { return &pb.BeaconState{} },
+	"state_altair":                           func() sszObject { return &pb.BeaconStateAltair{} },
+	"sync_aggregate":                         func() sszObject { return &prysmv2.SyncAggregate{} },
+	"sync_committee":                         func() sszObject { return &pb.SyncCommittee{} },
+	"sync_committee_contribution":            func() sszObject { return &prysmv2.SyncCommitteeContribution{} },
+	"sync_committee_message":                 func() sszObject { return &prysmv2.SyncCommitteeMessage{} },
+	"validator":                              func() sszObject { return &ethpb.Validator{} },
+	"voluntary_exit":                         func() sszObject { return &ethpb.VoluntaryExit{} },
+}
+
+// sszTypeUsage lists the names of the SSZ types for the usage of a flag.
+func sszTypeUsage() string {
+	names := make([]string, 0, len(sszTypes))
+	for name := range sszTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return "ssz file data type: " + strings.Join(names, "|")
+}
+
+// newSSZObject returns an empty object of the named SSZ type.
+func newSSZObject(typeName string) (sszObject, error) {
+	newObject, ok := sszTypes[typeName]
+	if !ok {
+		return nil, fmt.Errorf("unknown data type %q", typeName)
+	}
+	return newObject(), nil
+}
+
+// decodeSSZFile reads an SSZ file as an object of the named SSZ type.
+func decodeSSZFile(path, typeName string) (sszObject, error) {
+	obj, err := newSSZObject(typeName)
+	if err != nil {
+		return nil, err
+	}
+	if err := dataFetcher(path, obj); err != nil {
+		return nil, errors.Wrapf(err, "could not decode %s as %s", path, typeName)
+	}
+	return obj, nil
+}
+
+// dataFetcher fetches and unmarshals data from file to provided data structure.
+func dataFetcher(fPath string, data fssz.Unmarshaler) error {
+	rawFile, err := ioutil.ReadFile(fPath)
+	if err != nil {
+		return err
+	}
+	return data.UnmarshalSSZ(rawFile)
+}
@@ -0,0 +1,239 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	e "github.com/prysmaticlabs/prysm/beacon-chain/core/epoch"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	v "github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/interfaces"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	log "github.com/sirupsen/logrus"
+)
+
+// stepFunc is a step of the state transition. It may add the results of the step to the fields
+// which are logged.
+type stepFunc func(st iface.BeaconState, fields log.Fields) (iface.BeaconState, error)
+
+// tracer runs the state transition one step at a time, logging the result of every step.
+type tracer struct {
+	ctx   context.Context
+	state iface.BeaconState
+}
+
+// traceStateTransition runs the state transition of a block as the spec defines it, one step at a
+// time, and logs each slot, each step of the epoch processing and each operation of the block with
+// the change of balances it made and the state root after it. Unlike state.ExecuteStateTransition,
+// it returns the post state when its root differs from the one of the block.
+func traceStateTransition(ctx context.Context, st iface.BeaconState, signed interfaces.SignedBeaconBlock) (iface.BeaconState, error) {
+	if err := helpers.VerifyNilBeaconBlock(signed); err != nil {
+		return nil, err
+	}
+	blk := signed.Block()
+	if st.Slot() >= blk.Slot() {
+		return nil, fmt.Errorf("expected state.slot %d < slot %d", st.Slot(), blk.Slot())
+	}
+	t := &tracer{ctx: ctx, state: st}
+	for t.state.Slot() < blk.Slot() {
+		slot := t.state.Slot()
+		if err := t.step("process_slot", log.Fields{"slot": slot}, func(st iface.BeaconState, _ log.Fields) (iface.BeaconState, error) {
+			return state.ProcessSlot(ctx, st)
+		}); err != nil {
+			return nil, err
+		}
+		if state.CanProcessEpoch(t.state) {
+			if err := t.epoch(); err != nil {
+				return nil, err
+			}
+		}
+		if err := t.state.SetSlot(slot + 1); err != nil {
+			return nil, err
+		}
+	}
+	if err := t.block(signed); err != nil {
+		return nil, err
+	}
+
+	postRoot, err := t.state.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(postRoot[:], blk.StateRoot()) {
+		log.Errorf("Post state root %#x differs from the state root of the block %#x", postRoot, blk.StateRoot())
+	}
+	return t.state, nil
+}
+
+// epoch traces the steps of the epoch processing, as state.ProcessEpochPrecompute runs them.
+func (t *tracer) epoch() error {
+	var vp []*precompute.Validator
+	var bp *precompute.Balance
+	steps := []struct {
+		name string
+		fn   stepFunc
+	}{
+		{"process_attestations", func(st iface.BeaconState, fields log.Fields) (iface.BeaconState, error) {
+			var err error
+			vp, bp, err = precompute.New(t.ctx, st)
+			if err != nil {
+				return nil, err
+			}
+			vp, bp, err = precompute.ProcessAttestations(t.ctx, st, vp, bp)
+			if err != nil {
+				return nil, err
+			}
+			fields["activeBalance"] = bp.ActiveCurrentEpoch
+			fields["prevEpochAttested"] = bp.PrevEpochAttested
+			fields["prevEpochTargetAttested"] = bp.PrevEpochTargetAttested
+			fields["prevEpochHeadAttested"] = bp.PrevEpochHeadAttested
+			fields["currentEpochTargetAttested"] = bp.CurrentEpochTargetAttested
+			return st, nil
+		}},
+		{"process_justification_and_finalization", func(st iface.BeaconState, fields log.Fields) (iface.BeaconState, error) {
+			st, err := precompute.ProcessJustificationAndFinalizationPreCompute(st, bp)
+			if err != nil {
+				return nil, err
+			}
+			fields["justifiedEpoch"] = st.CurrentJustifiedCheckpoint().Epoch
+			fields["finalizedEpoch"] = st.FinalizedCheckpoint().Epoch
+			return st, nil
+		}},
+		{"process_rewards_and_penalties", func(st iface.BeaconState, _ log.Fields) (iface.BeaconState, error) {
+			return precompute.ProcessRewardsAndPenaltiesPrecompute(st, bp, vp, precompute.AttestationsDelta, precompute.ProposersDelta)
+		}},
+		{"process_registry_updates", func(st iface.BeaconState, _ log.Fields) (iface.BeaconState, error) {
+			return e.ProcessRegistryUpdates(st)
+		}},
+		{"process_slashings", func(st iface.BeaconState, _ log.Fields) (iface.BeaconState, error) {
+			return st, precompute.ProcessSlashingsPrecompute(st, bp)
+		}},
+		{"process_final_updates", func(st iface.BeaconState, _ log.Fields) (iface.BeaconState, error) {
+			return e.ProcessFinalUpdates(st)
+		}},
+	}
+	epoch := helpers.CurrentEpoch(t.state)
+	for _, s := range steps {
+		if err := t.step(s.name, log.Fields{"epoch": epoch}, s.fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// block traces the steps of the block processing, and each of its operations.
+func (t *tracer) block(signed interfaces.SignedBeaconBlock) error {
+	blk := signed.Block()
+	body := blk.Body()
+	if err := t.step("process_block_header", log.Fields{"proposerIndex": blk.ProposerIndex()}, func(st iface.BeaconState, _ log.Fields) (iface.BeaconState, error) {
+		return b.ProcessBlockHeader(t.ctx, st, signed)
+	}); err != nil {
+		return err
+	}
+	if err := t.step("process_randao", log.Fields{}, func(st iface.BeaconState, _ log.Fields) (iface.BeaconState, error) {
+		return b.ProcessRandao(t.ctx, st, signed)
+	}); err != nil {
+		return err
+	}
+	if err := t.step("process_eth1_data", log.Fields{"depositCount": body.Eth1Data().DepositCount}, func(st iface.BeaconState, _ log.Fields) (iface.BeaconState, error) {
+		return b.ProcessEth1DataInBlock(t.ctx, st, body.Eth1Data())
+	}); err != nil {
+		return err
+	}
+	if err := t.step("verify_operation_lengths", log.Fields{}, func(st iface.BeaconState, _ log.Fields) (iface.BeaconState, error) {
+		return state.VerifyOperationLengths(t.ctx, st, signed)
+	}); err != nil {
+		return err
+	}
+	for i, slashing := range body.ProposerSlashings() {
+		fields := log.Fields{"index": i, "proposerIndex": slashing.Header_1.Header.ProposerIndex}
+		if err := t.step("process_proposer_slashing", fields, func(st iface.BeaconState, _ log.Fields) (iface.BeaconState, error) {
+			return b.ProcessProposerSlashings(t.ctx, st, []*ethpb.ProposerSlashing{slashing}, v.SlashValidator)
+		}); err != nil {
+			return err
+		}
+	}
+	for i, slashing := range body.AttesterSlashings() {
+		fields := log.Fields{"index": i, "targetEpoch": slashing.Attestation_1.Data.Target.Epoch}
+		if err := t.step("process_attester_slashing", fields, func(st iface.BeaconState, _ log.Fields) (iface.BeaconState, error) {
+			return b.ProcessAttesterSlashings(t.ctx, st, []*ethpb.AttesterSlashing{slashing}, v.SlashValidator)
+		}); err != nil {
+			return err
+		}
+	}
+	for i, att := range body.Attestations() {
+		fields := log.Fields{
+			"index":          i,
+			"slot":           att.Data.Slot,
+			"committeeIndex": att.Data.CommitteeIndex,
+			"bitsSet":        att.AggregationBits.Count(),
+		}
+		if err := t.step("process_attestation", fields, func(st iface.BeaconState, _ log.Fields) (iface.BeaconState, error) {
+			return b.ProcessAttestation(t.ctx, st, att)
+		}); err != nil {
+			return err
+		}
+	}
+	for i, deposit := range body.Deposits() {
+		fields := log.Fields{"index": i, "pubkey": fmt.Sprintf("%#x", bytesutil.Trunc(deposit.Data.PublicKey))}
+		if err := t.step("process_deposit", fields, func(st iface.BeaconState, _ log.Fields) (iface.BeaconState, error) {
+			return b.ProcessDeposits(t.ctx, st, []*ethpb.Deposit{deposit})
+		}); err != nil {
+			return err
+		}
+	}
+	for i, exit := range body.VoluntaryExits() {
+		fields := log.Fields{"index": i, "validatorIndex": exit.Exit.ValidatorIndex}
+		if err := t.step("process_voluntary_exit", fields, func(st iface.BeaconState, _ log.Fields) (iface.BeaconState, error) {
+			return b.ProcessVoluntaryExits(t.ctx, st, []*ethpb.SignedVoluntaryExit{exit})
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// step runs a step on the state, and logs the balances it changed and the state root after it.
+func (t *tracer) step(name string, fields log.Fields, fn stepFunc) error {
+	before := t.state.Balances()
+	start := time.Now()
+	st, err := fn(t.state, fields)
+	if err != nil {
+		return errors.Wrapf(err, "could not run %s", name)
+	}
+	duration := time.Since(start)
+	t.state = st
+
+	after := t.state.Balances()
+	var changed int
+	var delta int64
+	for i := range after {
+		var prev uint64
+		if i < len(before) {
+			prev = before[i]
+		}
+		if after[i] != prev {
+			changed++
+			delta += int64(after[i] - prev)
+		}
+	}
+	root, err := t.state.HashTreeRoot(t.ctx)
+	if err != nil {
+		return errors.Wrapf(err, "could not compute state root after %s", name)
+	}
+	fields["step"] = name
+	fields["balancesChanged"] = changed
+	fields["balanceDelta"] = delta
+	fields["stateRoot"] = fmt.Sprintf("%#x", bytesutil.Trunc(root[:]))
+	fields["duration"] = duration
+	log.WithFields(fields).Info("Ran state transition step")
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
@@ -39,7 +40,7 @@ const exitPassphrase = "Exit my validator"
 
 // ExitAccountsCli performs a voluntary exit on one or more accounts.
 func ExitAccountsCli(cliCtx *cli.Context, r io.Reader) error {
-	validatingPublicKeys, kManager, err := prepareWallet(cliCtx)
+	w, validatingPublicKeys, kManager, err := prepareWallet(cliCtx)
 	if err != nil {
 		return err
 	}
@@ -52,6 +53,9 @@ func ExitAccountsCli(cliCtx *cli.Context, r io.Reader) error {
 	if rawPubKeys == nil && trimmedPubKeys == nil {
 		return nil
 	}
+	if cliCtx.IsSet(flags.ExitEpochFlag.Name) {
+		return scheduleVoluntaryExits(cliCtx, w, kManager, rawPubKeys, types.Epoch(cliCtx.Uint64(flags.ExitEpochFlag.Name)))
+	}
 
 	validatorClient, nodeClient, err := prepareClients(cliCtx)
 	if err != nil {
@@ -112,27 +116,27 @@ func PerformVoluntaryExit(
 	return rawExitedKeys, formattedExitedKeys, nil
 }
 
-func prepareWallet(cliCtx *cli.Context) (validatingPublicKeys [][48]byte, km keymanager.IKeymanager, err error) {
-	w, err := wallet.OpenWalletOrElseCli(cliCtx, func(cliCtx *cli.Context) (*wallet.Wallet, error) {
+func prepareWallet(cliCtx *cli.Context) (w *wallet.Wallet, validatingPublicKeys [][48]byte, km keymanager.IKeymanager, err error) {
+	w, err = wallet.OpenWalletOrElseCli(cliCtx, func(cliCtx *cli.Context) (*wallet.Wallet, error) {
 		return nil, wallet.ErrNoWalletFound
 	})
 	if err != nil {
-		return nil, nil, errors.Wrap(err, "could not open wallet")
+		return nil, nil, nil, errors.Wrap(err, "could not open wallet")
 	}
 
 	km, err = w.InitializeKeymanager(cliCtx.Context, iface.InitKeymanagerConfig{ListenForChanges: false})
 	if err != nil {
-		return nil, nil, errors.Wrap(err, ErrCouldNotInitializeKeymanager)
+		return nil, nil, nil, errors.Wrap(err, ErrCouldNotInitializeKeymanager)
 	}
 	validatingPublicKeys, err = km.FetchValidatingPublicKeys(cliCtx.Context)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 	if len(validatingPublicKeys) == 0 {
-		return nil, nil, errors.New("wallet is empty, no accounts to perform voluntary exit")
+		return nil, nil, nil, errors.New("wallet is empty, no accounts to perform voluntary exit")
 	}
 
-	return validatingPublicKeys, km, nil
+	return w, validatingPublicKeys, km, nil
 }
 
 func interact(
@@ -228,14 +232,25 @@ func prepareAllKeys(validatingKeys [][48]byte) (raw [][]byte, formatted []string
 }
 
 func prepareClients(cliCtx *cli.Context) (*ethpb.BeaconNodeValidatorClient, *ethpb.NodeClient, error) {
+	conn, err := dialBeaconNode(cliCtx)
+	if err != nil {
+		return nil, nil, err
+	}
+	validatorClient := ethpb.NewBeaconNodeValidatorClient(conn)
+	nodeClient := ethpb.NewNodeClient(conn)
+	return &validatorClient, &nodeClient, nil
+}
+
+func dialBeaconNode(cliCtx *cli.Context) (*grpc.ClientConn, error) {
 	dialOpts := client.ConstructDialOptions(
 		cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name),
 		cliCtx.String(flags.CertFlag.Name),
 		cliCtx.Uint(flags.GrpcRetriesFlag.Name),
 		cliCtx.Duration(flags.GrpcRetryDelayFlag.Name),
+		client.WithMultipleEndpoints(),
 	)
 	if dialOpts == nil {
-		return nil, nil, errors.New("failed to construct dial options")
+		return nil, errors.New("failed to construct dial options")
 	}
 
 	grpcHeaders := strings.Split(cliCtx.String(flags.GrpcHeadersFlag.Name), ",")
@@ -243,11 +258,9 @@ func prepareClients(cliCtx *cli.Context) (*ethpb.BeaconNodeValidatorClient, *eth
 
 	conn, err := grpc.DialContext(cliCtx.Context, cliCtx.String(flags.BeaconRPCProviderFlag.Name), dialOpts...)
 	if err != nil {
-		return nil, nil, errors.Wrapf(err, "could not dial endpoint %s", flags.BeaconRPCProviderFlag.Name)
+		return nil, errors.Wrapf(err, "could not dial endpoint %s", flags.BeaconRPCProviderFlag.Name)
 	}
-	validatorClient := ethpb.NewBeaconNodeValidatorClient(conn)
-	nodeClient := ethpb.NewNodeClient(conn)
-	return &validatorClient, &nodeClient, nil
+	return conn, nil
 }
 
 func displayExitInfo(rawExitedKeys [][]byte, trimmedExitedKeys []string) {
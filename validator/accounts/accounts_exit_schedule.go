@@ -0,0 +1,60 @@
+package accounts
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/exits"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/urfave/cli/v2"
+)
+
+// scheduleVoluntaryExits signs voluntary exits of the keys for the epoch and saves them in
+// the wallet, for a validator client running with the wallet to submit once the epoch is reached.
+func scheduleVoluntaryExits(
+	cliCtx *cli.Context, w *wallet.Wallet, km keymanager.IKeymanager, rawPubKeys [][]byte, epoch types.Epoch,
+) error {
+	if kind := w.KeymanagerKind(); kind != keymanager.Imported && kind != keymanager.Derived {
+		return fmt.Errorf("voluntary exits can only be scheduled with %s or %s wallets", keymanager.Imported, keymanager.Derived)
+	}
+	store, err := exits.NewStore(filepath.Join(w.AccountsDir(), exits.StoreFileName), w.Password())
+	if err != nil {
+		return errors.Wrap(err, "could not open scheduled exits")
+	}
+	conn, err := dialBeaconNode(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.WithError(err).Error("Could not close connection to beacon node")
+		}
+	}()
+	manager := exits.NewManager(store, ethpb.NewBeaconNodeValidatorClient(conn), ethpb.NewBeaconChainClient(conn))
+
+	pubKeys := make([][48]byte, len(rawPubKeys))
+	for i, key := range rawPubKeys {
+		pubKeys[i] = bytesutil.ToBytes48(key)
+	}
+	scheduled, failed, err := manager.Schedule(cliCtx.Context, km.Sign, pubKeys, epoch)
+	if err != nil {
+		return errors.Wrap(err, "could not schedule voluntary exits")
+	}
+	for _, pubKey := range pubKeys {
+		if err, ok := failed[pubKey]; ok {
+			log.WithError(err).Errorf("Could not schedule voluntary exit for account %#x", bytesutil.Trunc(pubKey[:]))
+		}
+	}
+	if len(scheduled) > 0 {
+		fmt.Printf(
+			"Scheduled the voluntary exit of %d accounts at epoch %d, a validator client running with this wallet "+
+				"submits them once the epoch is reached\n", len(scheduled), epoch,
+		)
+	}
+	return nil
+}
@@ -80,7 +80,7 @@ func TestExitAccountsCli_OK(t *testing.T) {
 	require.NoError(t, err)
 	require.NoError(t, ImportAccountsCli(cliCtx))
 
-	validatingPublicKeys, keymanager, err := prepareWallet(cliCtx)
+	_, validatingPublicKeys, keymanager, err := prepareWallet(cliCtx)
 	require.NoError(t, err)
 	require.NotNil(t, validatingPublicKeys)
 	require.NotNil(t, keymanager)
@@ -175,7 +175,7 @@ func TestExitAccountsCli_OK_AllPublicKeys(t *testing.T) {
 	require.NoError(t, err)
 	require.NoError(t, ImportAccountsCli(cliCtx))
 
-	validatingPublicKeys, keymanager, err := prepareWallet(cliCtx)
+	_, validatingPublicKeys, keymanager, err := prepareWallet(cliCtx)
 	require.NoError(t, err)
 	require.NotNil(t, validatingPublicKeys)
 	require.NotNil(t, keymanager)
@@ -226,7 +226,7 @@ func TestPrepareWallet_EmptyWalletReturnsError(t *testing.T) {
 		},
 	})
 	require.NoError(t, err)
-	_, _, err = prepareWallet(cliCtx)
+	_, _, _, err = prepareWallet(cliCtx)
 	assert.ErrorContains(t, "wallet is empty", err)
 }
 
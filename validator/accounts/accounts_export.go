@@ -0,0 +1,308 @@
+package accounts
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/promptutil"
+	"github.com/prysmaticlabs/prysm/validator/accounts/iface"
+	"github.com/prysmaticlabs/prysm/validator/accounts/prompt"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/validator/keyconfig"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
+	protectionFormat "github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	exportPromptText                 = "Enter the directory where your exported keystores will be written to"
+	exportSlashingProtectionFileName = "slashing_protection.json"
+	// Accounts of derived wallets are created at consecutive indices, the search for
+	// exported public keys goes that far past the number of accounts in the wallet to
+	// account for deleted ones.
+	exportSearchMargin = 256
+)
+
+// ExportAccountsConfig specifies the accounts of a derived wallet to export and where
+// to export them.
+type ExportAccountsConfig struct {
+	Keymanager       keymanager.IKeymanager
+	Mnemonic         string
+	Mnemonic25thWord string
+	// Indices and PublicKeys select the accounts to export, by derivation index or by public key.
+	Indices        []uint64
+	PublicKeys     [][48]byte
+	ExportPassword string
+	ExportDir      string
+	// ValidatorDB holds the slashing protection history exported along with the keystores.
+	ValidatorDB db.Database
+	// KeyConfig, if set, is where the exported accounts are disabled.
+	KeyConfig *keyconfig.Store
+}
+
+// ExportAccountsCli derives the keys of selected accounts of a derived wallet from its
+// mnemonic and writes them as EIP-2335 keystores, along with their EIP-3076 slashing
+// protection history, so they can be moved to another machine or a remote signer.
+// This function uses the CLI to extract necessary values.
+func ExportAccountsCli(cliCtx *cli.Context) error {
+	w, err := wallet.OpenWalletOrElseCli(cliCtx, func(cliCtx *cli.Context) (*wallet.Wallet, error) {
+		return nil, wallet.ErrNoWalletFound
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not open wallet")
+	}
+	if w.KeymanagerKind() != keymanager.Derived {
+		return fmt.Errorf("only %s wallets can export accounts, use `accounts backup` instead", keymanager.Derived)
+	}
+	km, err := w.InitializeKeymanager(cliCtx.Context, iface.InitKeymanagerConfig{ListenForChanges: false})
+	if err != nil {
+		return errors.Wrap(err, ErrCouldNotInitializeKeymanager)
+	}
+	cfg := &ExportAccountsConfig{Keymanager: km}
+	if cliCtx.IsSet(flags.ExportIndicesFlag.Name) {
+		cfg.Indices, err = parseAccountIndices(cliCtx.String(flags.ExportIndicesFlag.Name))
+		if err != nil {
+			return err
+		}
+	} else {
+		pubKeys, err := km.FetchValidatingPublicKeys(cliCtx.Context)
+		if err != nil {
+			return errors.Wrap(err, "could not fetch validating public keys")
+		}
+		filteredPubKeys, err := filterPublicKeysFromUserInput(
+			cliCtx,
+			flags.ExportPublicKeysFlag,
+			pubKeys,
+			prompt.SelectAccountsExportPromptText,
+		)
+		if err != nil {
+			return errors.Wrap(err, "could not filter public keys for export")
+		}
+		for _, pk := range filteredPubKeys {
+			cfg.PublicKeys = append(cfg.PublicKeys, bytesutil.ToBytes48(pk.Marshal()))
+		}
+	}
+	if cliCtx.Bool(flags.DisableExportedKeysFlag.Name) {
+		if !cliCtx.IsSet(flags.KeyConfigFileFlag.Name) {
+			return fmt.Errorf("--%s requires --%s", flags.DisableExportedKeysFlag.Name, flags.KeyConfigFileFlag.Name)
+		}
+		cfg.KeyConfig, err = keyconfig.NewStore(cliCtx.String(flags.KeyConfigFileFlag.Name))
+		if err != nil {
+			return errors.Wrap(err, "could not load key configuration")
+		}
+	}
+
+	cfg.Mnemonic, err = inputMnemonic(cliCtx)
+	if err != nil {
+		return errors.Wrap(err, "could not get mnemonic phrase")
+	}
+	cfg.Mnemonic25thWord, err = inputMnemonicPassphrase(cliCtx)
+	if err != nil {
+		return err
+	}
+	cfg.ExportDir, err = prompt.InputDirectory(cliCtx, exportPromptText, flags.ExportDirFlag)
+	if err != nil {
+		return errors.Wrap(err, "could not parse export directory")
+	}
+	cfg.ExportPassword, err = promptutil.InputPassword(
+		cliCtx,
+		flags.ExportPasswordFileFlag,
+		"Enter a new password for your exported accounts",
+		"Confirm new password",
+		true,
+		promptutil.ValidatePasswordInput,
+	)
+	if err != nil {
+		return errors.Wrap(err, "could not determine password for exported accounts")
+	}
+
+	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
+	if !cliCtx.IsSet(cmd.DataDirFlag.Name) {
+		dataDir, err = prompt.InputDirectory(cliCtx, prompt.DataDirDirPromptText, cmd.DataDirFlag)
+		if err != nil {
+			return err
+		}
+	}
+	found, _, err := fileutil.RecursiveFileFind(kv.ProtectionDbFileName, dataDir)
+	if err != nil {
+		return errors.Wrapf(err, "error finding validator database at path %s", dataDir)
+	}
+	if !found {
+		return fmt.Errorf("validator database not found at path %s", dataDir)
+	}
+	cfg.ValidatorDB, err = kv.NewKVStore(cliCtx.Context, dataDir, &kv.Config{})
+	if err != nil {
+		return errors.Wrapf(err, "could not access validator database at path %s", dataDir)
+	}
+	defer func() {
+		if err := cfg.ValidatorDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close validator database")
+		}
+	}()
+	return ExportAccounts(cliCtx.Context, cfg)
+}
+
+// ExportAccounts derives the selected accounts from the mnemonic, checks they belong to the
+// wallet, and writes their keystores and slashing protection history to the export directory.
+// Keystores are named after the derivation path of their key, as the deposit CLI does.
+func ExportAccounts(ctx context.Context, cfg *ExportAccountsConfig) error {
+	if len(cfg.Indices) == 0 && len(cfg.PublicKeys) == 0 {
+		return errors.New("no accounts selected to export")
+	}
+	walletPubKeys, err := cfg.Keymanager.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not fetch validating public keys")
+	}
+	var accounts []*derived.Account
+	if len(cfg.Indices) > 0 {
+		accounts, err = derived.DeriveAccounts(cfg.Mnemonic, cfg.Mnemonic25thWord, cfg.Indices)
+	} else {
+		searchLimit := uint64(len(walletPubKeys) + exportSearchMargin)
+		accounts, err = derived.FindAccounts(cfg.Mnemonic, cfg.Mnemonic25thWord, cfg.PublicKeys, searchLimit)
+	}
+	if err != nil {
+		return err
+	}
+	inWallet := make(map[[48]byte]bool, len(walletPubKeys))
+	for _, pubKey := range walletPubKeys {
+		inWallet[pubKey] = true
+	}
+	pubKeys := make([][48]byte, len(accounts))
+	for i, account := range accounts {
+		if !inWallet[account.PublicKey] {
+			return fmt.Errorf(
+				"account %d with public key %#x is not in the wallet, the mnemonic may not be the one of this wallet",
+				account.Index, bytesutil.Trunc(account.PublicKey[:]),
+			)
+		}
+		pubKeys[i] = account.PublicKey
+	}
+
+	if err := fileutil.MkdirAll(cfg.ExportDir); err != nil {
+		return errors.Wrapf(err, "could not create directory at path: %s", cfg.ExportDir)
+	}
+	// Nothing is written if any of the files exists, so an export is never partially
+	// written over another one.
+	keystorePaths := make([]string, len(accounts))
+	for i, account := range accounts {
+		keystorePaths[i] = filepath.Join(
+			cfg.ExportDir, fmt.Sprintf("keystore-%s.json", strings.ReplaceAll(account.Path, "/", "_")),
+		)
+	}
+	protectionPath := filepath.Join(cfg.ExportDir, exportSlashingProtectionFileName)
+	for _, path := range append(keystorePaths, protectionPath) {
+		if fileutil.FileExists(path) {
+			return fmt.Errorf("file already exists: %s", path)
+		}
+	}
+
+	interchange, err := protectionFormat.ExportStandardProtectionJSON(ctx, cfg.ValidatorDB)
+	if err != nil {
+		return errors.Wrap(err, "could not export slashing protection history")
+	}
+	interchange, err = protectionFormat.FilterInterchange(interchange, pubKeys)
+	if err != nil {
+		return errors.Wrap(err, "could not filter slashing protection history")
+	}
+	encodedInterchange, err := json.MarshalIndent(interchange, "", "\t")
+	if err != nil {
+		return errors.Wrap(err, "could not JSON marshal slashing protection history")
+	}
+	for i, account := range accounts {
+		keystore, err := account.Keystore(cfg.ExportPassword)
+		if err != nil {
+			return err
+		}
+		encodedKeystore, err := json.MarshalIndent(keystore, "", "\t")
+		if err != nil {
+			return errors.Wrap(err, "could not marshal keystore to JSON file")
+		}
+		if err := fileutil.WriteFile(keystorePaths[i], encodedKeystore); err != nil {
+			return errors.Wrap(err, "could not write keystore file")
+		}
+	}
+	if err := fileutil.WriteFile(protectionPath, encodedInterchange); err != nil {
+		return errors.Wrap(err, "could not write slashing protection history")
+	}
+	log.WithField("export-path", cfg.ExportDir).Infof(
+		"Successfully exported %d accounts with their slashing protection history", len(accounts),
+	)
+
+	if cfg.KeyConfig != nil {
+		if err := cfg.KeyConfig.DisableKeys(pubKeys); err != nil {
+			return errors.Wrap(err, "could not disable exported accounts")
+		}
+		log.WithField("key-config-file", cfg.KeyConfig.Path()).Infof(
+			"Disabled %d exported accounts, a validator client running with the wallet stops using them",
+			len(pubKeys),
+		)
+	}
+	return nil
+}
+
+// parseAccountIndices parses a comma-separated list of account indices.
+func parseAccountIndices(input string) ([]uint64, error) {
+	var indices []uint64
+	seen := make(map[uint64]bool)
+	for _, str := range strings.Split(input, ",") {
+		str = strings.TrimSpace(str)
+		if str == "" {
+			continue
+		}
+		index, err := strconv.ParseUint(str, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse account index %s", str)
+		}
+		if !seen[index] {
+			seen[index] = true
+			indices = append(indices, index)
+		}
+	}
+	if len(indices) == 0 {
+		return nil, fmt.Errorf("could not parse %s. It must be a comma-separated list of account indices", input)
+	}
+	return indices, nil
+}
+
+// inputMnemonicPassphrase reads the optional '25th word' passphrase of a mnemonic from
+// a file, or asks the user for it unless the check is skipped.
+func inputMnemonicPassphrase(cliCtx *cli.Context) (string, error) {
+	if !cliCtx.IsSet(flags.Mnemonic25thWordFileFlag.Name) {
+		if cliCtx.IsSet(flags.SkipMnemonic25thWordCheckFlag.Name) {
+			return "", nil
+		}
+		resp, err := promptutil.ValidatePrompt(os.Stdin, mnemonicPassphraseYesNoText, promptutil.ValidateYesOrNo)
+		if err != nil {
+			return "", errors.Wrap(err, "could not validate choice")
+		}
+		if !strings.EqualFold(resp, "y") {
+			return "", nil
+		}
+	}
+	return promptutil.InputPassword(
+		cliCtx,
+		flags.Mnemonic25thWordFileFlag,
+		mnemonicPassphrasePromptText,
+		"Confirm mnemonic passphrase",
+		false, /* Should confirm password */
+		func(input string) error {
+			if strings.TrimSpace(input) == "" {
+				return errors.New("input cannot be empty")
+			}
+			return nil
+		},
+	)
+}
@@ -0,0 +1,93 @@
+package accounts
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+	"github.com/prysmaticlabs/prysm/validator/keyconfig"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
+	"github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format/format"
+	constant "github.com/prysmaticlabs/prysm/validator/testing"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+func TestExportAccounts(t *testing.T) {
+	ctx := context.Background()
+	accounts, err := derived.DeriveAccounts(constant.TestMnemonic, "", []uint64{0, 1, 2})
+	require.NoError(t, err)
+	pubKeys := make([][48]byte, len(accounts))
+	for i, account := range accounts {
+		pubKeys[i] = account.PublicKey
+	}
+	validatorDB := dbtest.SetupDB(t, pubKeys)
+	for _, pubKey := range pubKeys {
+		require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubKey, 10, []byte{1}))
+	}
+	keyConfig, err := keyconfig.NewStore(filepath.Join(t.TempDir(), "keys.yaml"))
+	require.NoError(t, err)
+
+	exportDir := filepath.Join(t.TempDir(), "export")
+	cfg := &ExportAccountsConfig{
+		Keymanager:     &mockRemoteKeymanager{publicKeys: pubKeys},
+		Mnemonic:       constant.TestMnemonic,
+		PublicKeys:     [][48]byte{pubKeys[2]},
+		ExportPassword: "Passw0rdz4938%%",
+		ExportDir:      exportDir,
+		ValidatorDB:    validatorDB,
+		KeyConfig:      keyConfig,
+	}
+	require.NoError(t, ExportAccounts(ctx, cfg))
+
+	enc, err := ioutil.ReadFile(filepath.Join(exportDir, "keystore-m_12381_3600_2_0_0.json"))
+	require.NoError(t, err)
+	keystore := &keymanager.Keystore{}
+	require.NoError(t, json.Unmarshal(enc, keystore))
+	assert.Equal(t, "m/12381/3600/2/0/0", keystore.Path)
+	secretKey, err := keystorev4.New().Decrypt(keystore.Crypto, cfg.ExportPassword)
+	require.NoError(t, err)
+	assert.DeepEqual(t, accounts[2].SecretKey, secretKey)
+
+	enc, err = ioutil.ReadFile(filepath.Join(exportDir, exportSlashingProtectionFileName))
+	require.NoError(t, err)
+	interchange := &format.EIPSlashingProtectionFormat{}
+	require.NoError(t, json.Unmarshal(enc, interchange))
+	require.Equal(t, 1, len(interchange.Data))
+	assert.Equal(t, "0x"+keystore.Pubkey, interchange.Data[0].Pubkey)
+
+	assert.Equal(t, false, keyConfig.Settings(pubKeys[2]).Enabled)
+	assert.Equal(t, true, keyConfig.Settings(pubKeys[0]).Enabled)
+
+	// Exporting again does not overwrite the keystores.
+	assert.ErrorContains(t, "file already exists", ExportAccounts(ctx, cfg))
+
+	// By index, accounts which are not in the wallet are refused.
+	cfg = &ExportAccountsConfig{
+		Keymanager:     &mockRemoteKeymanager{publicKeys: pubKeys[:1]},
+		Mnemonic:       constant.TestMnemonic,
+		Indices:        []uint64{0, 1},
+		ExportPassword: "Passw0rdz4938%%",
+		ExportDir:      filepath.Join(t.TempDir(), "export"),
+		ValidatorDB:    validatorDB,
+	}
+	assert.ErrorContains(t, "account 1 with public key", ExportAccounts(ctx, cfg))
+	assert.Equal(t, false, fileutil.FileExists(filepath.Join(cfg.ExportDir, "keystore-m_12381_3600_0_0_0.json")))
+}
+
+func TestParseAccountIndices(t *testing.T) {
+	indices, err := parseAccountIndices("3, 1,3,")
+	require.NoError(t, err)
+	assert.DeepEqual(t, []uint64{3, 1}, indices)
+
+	_, err = parseAccountIndices("a")
+	assert.ErrorContains(t, "could not parse account index", err)
+	_, err = parseAccountIndices(",")
+	assert.ErrorContains(t, "comma-separated list of account indices", err)
+}
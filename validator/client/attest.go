@@ -35,6 +35,11 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubK
 	defer span.End()
 	span.AddAttributes(trace.StringAttribute("validator", fmt.Sprintf("%#x", pubKey)))
 
+	if v.observer != nil {
+		v.observeDuty(observedAttestation, slot, pubKey)
+		return
+	}
+
 	v.waitOneThirdOrValidBlock(ctx, slot)
 
 	var b strings.Builder
@@ -9,11 +9,13 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/slashutil"
+	vdb "github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/db/kv"
 	"go.opencensus.io/trace"
 )
 
 var failedAttLocalProtectionErr = "attempted to make slashable attestation, rejected by local slashing protection"
+var failedAttRemoteProtectionErr = "attempted to make slashable attestation, rejected by remote slashing protection"
 var failedPostAttSignExternalErr = "attempted to make slashable attestation, rejected by external slasher service"
 
 // Checks if an attestation is slashable by comparing it with the attesting
@@ -28,6 +30,21 @@ func (v *validator) slashableAttestationCheck(
 	ctx, span := trace.StartSpan(ctx, "validator.postAttSignUpdate")
 	defer span.End()
 
+	fmtKey := "0x" + hex.EncodeToString(pubKey[:])
+	if protector, ok := v.db.(vdb.AtomicSlashingProtector); ok {
+		// The slashing protection history is kept by a service shared with other validator
+		// clients, which checks and records the attestation in a single step.
+		slashingKind, err := protector.CheckAndSaveAttestation(ctx, pubKey, signingRoot, indexedAtt)
+		if err != nil {
+			if v.emitAccountMetrics {
+				ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
+			}
+			logSlashingKind(slashingKind)
+			return errors.Wrap(err, failedAttRemoteProtectionErr)
+		}
+		return v.commitAttestationExternally(ctx, indexedAtt, fmtKey)
+	}
+
 	// Based on EIP3076, validator should refuse to sign any attestation with source epoch less
 	// than the minimum source epoch present in that signer’s attestations.
 	lowestSourceEpoch, exists, err := v.db.LowestSignedSourceEpoch(ctx, pubKey)
@@ -60,27 +77,23 @@ func (v *validator) slashableAttestationCheck(
 			lowestTargetEpoch,
 		)
 	}
-	fmtKey := "0x" + hex.EncodeToString(pubKey[:])
 	slashingKind, err := v.db.CheckSlashableAttestation(ctx, pubKey, signingRoot, indexedAtt)
 	if err != nil {
 		if v.emitAccountMetrics {
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
 		}
-		switch slashingKind {
-		case kv.DoubleVote:
-			log.Warn("Attestation is slashable as it is a double vote")
-		case kv.SurroundingVote:
-			log.Warn("Attestation is slashable as it is surrounding a previous attestation")
-		case kv.SurroundedVote:
-			log.Warn("Attestation is slashable as it is surrounded by a previous attestation")
-		}
+		logSlashingKind(slashingKind)
 		return errors.Wrap(err, failedAttLocalProtectionErr)
 	}
 
 	if err := v.db.SaveAttestationForPubKey(ctx, pubKey, signingRoot, indexedAtt); err != nil {
 		return errors.Wrap(err, "could not save attestation history for validator public key")
 	}
+	return v.commitAttestationExternally(ctx, indexedAtt, fmtKey)
+}
 
+// Commits the attestation to the external slasher service if it is enabled.
+func (v *validator) commitAttestationExternally(ctx context.Context, indexedAtt *ethpb.IndexedAttestation, fmtKey string) error {
 	if featureconfig.Get().SlasherProtection && v.protector != nil {
 		if !v.protector.CommitAttestation(ctx, indexedAtt) {
 			if v.emitAccountMetrics {
@@ -91,3 +104,14 @@ func (v *validator) slashableAttestationCheck(
 	}
 	return nil
 }
+
+func logSlashingKind(slashingKind kv.SlashingKind) {
+	switch slashingKind {
+	case kv.DoubleVote:
+		log.Warn("Attestation is slashable as it is a double vote")
+	case kv.SurroundingVote:
+		log.Warn("Attestation is slashable as it is surrounding a previous attestation")
+	case kv.SurroundedVote:
+		log.Warn("Attestation is slashable as it is surrounded by a previous attestation")
+	}
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -9,7 +10,10 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	vdb "github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
 	mockSlasher "github.com/prysmaticlabs/prysm/validator/testing"
 )
 
@@ -168,3 +172,54 @@ func Test_slashableAttestationCheck_GenesisEpoch(t *testing.T) {
 	require.Equal(t, true, exists)
 	require.Equal(t, types.Epoch(0), e)
 }
+
+// atomicProtectorDB records the signing requests checked in a single step by a remote
+// slashing protection service.
+type atomicProtectorDB struct {
+	vdb.Database
+	err          error
+	attestations int
+	proposals    int
+}
+
+func (d *atomicProtectorDB) CheckAndSaveAttestation(
+	_ context.Context, _ [48]byte, _ [32]byte, _ *ethpb.IndexedAttestation,
+) (kv.SlashingKind, error) {
+	d.attestations++
+	if d.err != nil {
+		return kv.DoubleVote, d.err
+	}
+	return kv.NotSlashable, nil
+}
+
+func (d *atomicProtectorDB) CheckAndSaveProposal(_ context.Context, _ [48]byte, _ types.Slot, _ [32]byte) error {
+	d.proposals++
+	return d.err
+}
+
+func Test_slashableAttestationCheck_AtomicProtector(t *testing.T) {
+	validator, _, validatorKey, finish := setup(t)
+	defer finish()
+	pubKey := [48]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+	att := &ethpb.IndexedAttestation{
+		Data: &ethpb.AttestationData{
+			BeaconBlockRoot: make([]byte, 32),
+			Source:          &ethpb.Checkpoint{Epoch: 1, Root: make([]byte, 32)},
+			Target:          &ethpb.Checkpoint{Epoch: 2, Root: make([]byte, 32)},
+		},
+	}
+	db := &atomicProtectorDB{Database: validator.db}
+	validator.db = db
+	require.NoError(t, validator.slashableAttestationCheck(context.Background(), att, pubKey, [32]byte{1}))
+	assert.Equal(t, 1, db.attestations)
+
+	// The local history is not used.
+	_, exists, err := db.Database.LowestSignedTargetEpoch(context.Background(), pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, false, exists)
+
+	db.err = errors.New("slashing protection server unavailable")
+	err = validator.slashableAttestationCheck(context.Background(), att, pubKey, [32]byte{1})
+	require.ErrorContains(t, failedAttRemoteProtectionErr, err)
+}
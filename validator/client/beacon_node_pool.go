@@ -0,0 +1,434 @@
+package client
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// maxHeadSlotLag is the number of slots a beacon node may be behind the best head
+// of the configured beacon nodes and still be considered healthy.
+const maxHeadSlotLag = types.Slot(2)
+
+// broadcastMethods are the gRPC methods submitting signed objects, which are sent to
+// every healthy beacon node when broadcasting is enabled.
+var broadcastMethods = map[string]bool{
+	"/ethereum.eth.v1alpha1.BeaconNodeValidator/ProposeBlock":                        true,
+	"/ethereum.eth.v1alpha1.BeaconNodeValidator/ProposeAttestation":                  true,
+	"/ethereum.eth.v1alpha1.BeaconNodeValidator/SubmitSignedAggregateSelectionProof": true,
+}
+
+// healthTier orders beacon nodes by health. Calls are made to nodes of a lower tier first.
+type healthTier int
+
+const (
+	tierHealthy healthTier = iota
+	tierDegraded
+	tierSyncing
+	tierUnreachable
+)
+
+func (t healthTier) String() string {
+	switch t {
+	case tierHealthy:
+		return "healthy"
+	case tierDegraded:
+		return "degraded"
+	case tierSyncing:
+		return "syncing"
+	default:
+		return "unreachable"
+	}
+}
+
+// beaconNodeHealth is the result of the last health check of a beacon node.
+type beaconNodeHealth struct {
+	checked       bool
+	reachable     bool
+	syncing       bool
+	eth1Connected bool
+	headSlot      types.Slot
+	peers         int
+}
+
+// beaconNode is a connection to one of the beacon nodes configured for the validator client.
+type beaconNode struct {
+	endpoint string
+	conn     *grpc.ClientConn
+	health   beaconNodeHealth
+	tier     healthTier
+}
+
+// beaconNodePool makes the gRPC calls of the validator client to the healthiest of the configured
+// beacon nodes, failing over to the next one when a node is unavailable. Signed blocks, attestations
+// and aggregates can optionally be broadcast to every healthy node.
+type beaconNodePool struct {
+	nodes     []*beaconNode
+	broadcast bool
+	lock      sync.RWMutex
+	ranked    []*beaconNode
+}
+
+var _ grpc.ClientConnInterface = (*beaconNodePool)(nil)
+
+// newBeaconNodePool dials each of the comma separated beacon node endpoints.
+func newBeaconNodePool(ctx context.Context, endpoints string, broadcast bool, dialOpts ...grpc.DialOption) (*beaconNodePool, error) {
+	p := &beaconNodePool{broadcast: broadcast}
+	for _, endpoint := range strings.Split(endpoints, ",") {
+		endpoint = strings.TrimSpace(endpoint)
+		if endpoint == "" {
+			continue
+		}
+		conn, err := grpc.DialContext(ctx, endpoint, dialOpts...)
+		if err != nil {
+			if closeErr := p.Close(); closeErr != nil {
+				log.WithError(closeErr).Error("Could not close beacon node connections")
+			}
+			return nil, errors.Wrapf(err, "could not dial endpoint %s", endpoint)
+		}
+		p.nodes = append(p.nodes, &beaconNode{endpoint: endpoint, conn: conn})
+	}
+	if len(p.nodes) == 0 {
+		return nil, errors.New("no beacon node endpoint configured")
+	}
+	p.ranked = p.nodes
+	SyncEth2FallbackConfiguredGauge.Set(boolToFloat(len(p.nodes) > 1))
+	return p, nil
+}
+
+// Invoke makes a unary call to the healthiest beacon node, failing over to the other nodes
+// in order of health when a node is unavailable.
+func (p *beaconNodePool) Invoke(ctx context.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error {
+	nodes := p.rankedNodes()
+	if p.broadcast && broadcastMethods[method] {
+		if healthy := p.healthyNodes(); len(healthy) > 1 {
+			err := p.broadcastInvoke(ctx, healthy, method, args, reply, opts...)
+			if err == nil || !shouldFailover(ctx, err) {
+				return err
+			}
+			// None of the healthy nodes could be reached, the call is made to the other nodes in order of health.
+			nodes = excludeNodes(p.rankedNodes(), healthy)
+			if len(nodes) == 0 {
+				return err
+			}
+		}
+	}
+	var err error
+	for i, n := range nodes {
+		callOpts := opts
+		if i < len(nodes)-1 {
+			// Fail over right away instead of retrying a node which is unavailable.
+			callOpts = append(append([]grpc.CallOption{}, opts...), grpc_retry.Disable())
+		}
+		err = n.conn.Invoke(ctx, method, args, reply, callOpts...)
+		if err == nil || !shouldFailover(ctx, err) {
+			return err
+		}
+		p.markUnreachable(n, err)
+	}
+	return err
+}
+
+// NewStream opens a stream to the healthiest beacon node, failing over to the other nodes
+// in order of health when a node is unavailable.
+func (p *beaconNodePool) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	var err error
+	for _, n := range p.rankedNodes() {
+		var stream grpc.ClientStream
+		stream, err = n.conn.NewStream(ctx, desc, method, opts...)
+		if err == nil {
+			return &failoverStream{ClientStream: stream, pool: p, node: n, ctx: ctx}, nil
+		}
+		if !shouldFailover(ctx, err) {
+			return nil, err
+		}
+		p.markUnreachable(n, err)
+	}
+	return nil, err
+}
+
+// Close closes the connections to all beacon nodes.
+func (p *beaconNodePool) Close() error {
+	var err error
+	for _, n := range p.nodes {
+		if closeErr := n.conn.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+// status returns an error when none of the beacon nodes is reachable.
+func (p *beaconNodePool) status() error {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	if p.ranked[0].tier == tierUnreachable {
+		return errors.New("no beacon node is reachable")
+	}
+	return nil
+}
+
+// run checks the health of the beacon nodes twice per slot until the context is canceled.
+func (p *beaconNodePool) run(ctx context.Context) {
+	interval := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second / 2
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		p.checkHealth(ctx, interval)
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// checkHealth queries the sync status, head slot, peer count and eth1 connectivity of every
+// beacon node, and ranks the nodes by health.
+func (p *beaconNodePool) checkHealth(ctx context.Context, timeout time.Duration) {
+	results := make([]beaconNodeHealth, len(p.nodes))
+	var wg sync.WaitGroup
+	for i, n := range p.nodes {
+		wg.Add(1)
+		go func(i int, n *beaconNode) {
+			defer wg.Done()
+			checkCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			results[i] = queryHealth(checkCtx, n.conn)
+		}(i, n)
+	}
+	wg.Wait()
+	if ctx.Err() != nil {
+		return
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	for i, n := range p.nodes {
+		n.health = results[i]
+	}
+	p.rank()
+}
+
+func queryHealth(ctx context.Context, conn grpc.ClientConnInterface) beaconNodeHealth {
+	h := beaconNodeHealth{checked: true}
+	nodeClient := ethpb.NewNodeClient(conn)
+	syncStatus, err := nodeClient.GetSyncStatus(ctx, &emptypb.Empty{}, grpc_retry.Disable())
+	if err != nil {
+		return h
+	}
+	h.reachable = true
+	h.syncing = syncStatus.Syncing
+	head, err := ethpb.NewBeaconChainClient(conn).GetChainHead(ctx, &emptypb.Empty{}, grpc_retry.Disable())
+	if err == nil {
+		h.headSlot = head.HeadSlot
+	}
+	peers, err := nodeClient.ListPeers(ctx, &emptypb.Empty{}, grpc_retry.Disable())
+	if err == nil {
+		h.peers = len(peers.Peers)
+	}
+	eth1, err := nodeClient.GetETH1ConnectionStatus(ctx, &emptypb.Empty{}, grpc_retry.Disable())
+	switch {
+	case err == nil:
+		h.eth1Connected = eth1.Connected
+	case status.Code(err) == codes.Unimplemented:
+		// Beacon nodes which do not report their eth1 connection are given the benefit of the doubt.
+		h.eth1Connected = true
+	}
+	return h
+}
+
+// rank sorts the beacon nodes by health tier, keeping the configured order within a tier, and
+// updates the metrics. The caller must hold the write lock.
+func (p *beaconNodePool) rank() {
+	var bestHead types.Slot
+	for _, n := range p.nodes {
+		if n.health.reachable && !n.health.syncing && n.health.headSlot > bestHead {
+			bestHead = n.health.headSlot
+		}
+	}
+	for _, n := range p.nodes {
+		n.tier = n.health.tier(bestHead)
+		BeaconNodeHealthyGaugeVec.WithLabelValues(n.endpoint).Set(boolToFloat(n.tier == tierHealthy))
+	}
+	ranked := make([]*beaconNode, len(p.nodes))
+	copy(ranked, p.nodes)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].tier < ranked[j].tier
+	})
+	if ranked[0] != p.ranked[0] {
+		log.WithFields(logrus.Fields{
+			"endpoint": ranked[0].endpoint,
+			"previous": p.ranked[0].endpoint,
+			"health":   ranked[0].tier,
+		}).Warn("Switched beacon node")
+	}
+	p.ranked = ranked
+	SyncEth2FallbackConnectedGauge.Set(boolToFloat(ranked[0] != p.nodes[0]))
+}
+
+func (h beaconNodeHealth) tier(bestHead types.Slot) healthTier {
+	switch {
+	case !h.checked:
+		// Nodes are used in the configured order until their health is known.
+		return tierHealthy
+	case !h.reachable:
+		return tierUnreachable
+	case h.syncing:
+		return tierSyncing
+	case !h.eth1Connected || h.peers == 0 || h.headSlot+maxHeadSlotLag < bestHead:
+		return tierDegraded
+	default:
+		return tierHealthy
+	}
+}
+
+// markUnreachable demotes a beacon node which failed a call, until its next health check.
+func (p *beaconNodePool) markUnreachable(n *beaconNode, err error) {
+	log.WithError(err).WithField("endpoint", n.endpoint).Warn("Beacon node is unavailable, failing over")
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	n.health = beaconNodeHealth{checked: true}
+	p.rank()
+}
+
+func (p *beaconNodePool) rankedNodes() []*beaconNode {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return p.ranked
+}
+
+func (p *beaconNodePool) healthyNodes() []*beaconNode {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	healthy := make([]*beaconNode, 0, len(p.ranked))
+	for _, n := range p.ranked {
+		if n.tier == tierHealthy {
+			healthy = append(healthy, n)
+		}
+	}
+	return healthy
+}
+
+// excludeNodes returns the nodes which are not excluded, keeping their order.
+func excludeNodes(nodes, excluded []*beaconNode) []*beaconNode {
+	skip := make(map[*beaconNode]bool, len(excluded))
+	for _, n := range excluded {
+		skip[n] = true
+	}
+	remaining := make([]*beaconNode, 0, len(nodes))
+	for _, n := range nodes {
+		if !skip[n] {
+			remaining = append(remaining, n)
+		}
+	}
+	return remaining
+}
+
+// broadcastInvoke makes the call to all given beacon nodes, and succeeds if any of them succeeds.
+// The reply is the one of the healthiest node which succeeded. When all of them fail, the error
+// is one which does not call for a failover if there is any, so that a rejection is not retried.
+func (p *beaconNodePool) broadcastInvoke(
+	ctx context.Context,
+	nodes []*beaconNode,
+	method string,
+	args, reply interface{},
+	opts ...grpc.CallOption,
+) error {
+	msg, ok := reply.(proto.Message)
+	if !ok {
+		return errors.Errorf("reply of %s is not a protobuf message", method)
+	}
+	callOpts := append(append([]grpc.CallOption{}, opts...), grpc_retry.Disable())
+	replies := make([]proto.Message, len(nodes))
+	errs := make([]error, len(nodes))
+	var wg sync.WaitGroup
+	for i, n := range nodes {
+		replies[i] = proto.Clone(msg)
+		wg.Add(1)
+		go func(i int, n *beaconNode) {
+			defer wg.Done()
+			errs[i] = n.conn.Invoke(ctx, method, args, replies[i], callOpts...)
+		}(i, n)
+	}
+	wg.Wait()
+
+	succeeded := -1
+	for i, n := range nodes {
+		if errs[i] != nil {
+			log.WithError(errs[i]).WithFields(logrus.Fields{
+				"endpoint": n.endpoint,
+				"method":   method,
+			}).Debug("Could not broadcast to beacon node")
+			if shouldFailover(ctx, errs[i]) {
+				p.markUnreachable(n, errs[i])
+			}
+			continue
+		}
+		if succeeded < 0 {
+			succeeded = i
+		}
+	}
+	if succeeded < 0 {
+		for _, err := range errs {
+			if !shouldFailover(ctx, err) {
+				return err
+			}
+		}
+		return errs[0]
+	}
+	proto.Merge(msg, replies[succeeded])
+	return nil
+}
+
+// failoverStream demotes the beacon node of a stream which fails because the node is unavailable,
+// so that the stream is opened on another node when it is reestablished.
+type failoverStream struct {
+	grpc.ClientStream
+	pool *beaconNodePool
+	node *beaconNode
+	ctx  context.Context
+}
+
+// RecvMsg --
+func (s *failoverStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil && shouldFailover(s.ctx, err) {
+		s.pool.markUnreachable(s.node, err)
+	}
+	return err
+}
+
+// shouldFailover returns whether a call which failed with the error should be made to another beacon node.
+func shouldFailover(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
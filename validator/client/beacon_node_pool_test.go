@@ -0,0 +1,226 @@
+package client
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type fakeBeaconNode struct {
+	ethpb.UnimplementedNodeServer
+	ethpb.UnimplementedBeaconChainServer
+	ethpb.UnimplementedBeaconNodeValidatorServer
+	unavailable             bool
+	attestationsUnavailable bool
+	syncing                 bool
+	headSlot                types.Slot
+	attestations            int32
+}
+
+func (n *fakeBeaconNode) GetSyncStatus(_ context.Context, _ *empty.Empty) (*ethpb.SyncStatus, error) {
+	if n.unavailable {
+		return nil, status.Error(codes.Unavailable, "unavailable")
+	}
+	return &ethpb.SyncStatus{Syncing: n.syncing}, nil
+}
+
+func (n *fakeBeaconNode) GetGenesis(_ context.Context, _ *empty.Empty) (*ethpb.Genesis, error) {
+	if n.unavailable {
+		return nil, status.Error(codes.Unavailable, "unavailable")
+	}
+	return &ethpb.Genesis{DepositContractAddress: []byte(n.label())}, nil
+}
+
+func (n *fakeBeaconNode) ListPeers(_ context.Context, _ *empty.Empty) (*ethpb.Peers, error) {
+	return &ethpb.Peers{Peers: []*ethpb.Peer{{}}}, nil
+}
+
+func (n *fakeBeaconNode) GetChainHead(_ context.Context, _ *empty.Empty) (*ethpb.ChainHead, error) {
+	return &ethpb.ChainHead{HeadSlot: n.headSlot}, nil
+}
+
+func (n *fakeBeaconNode) ProposeAttestation(_ context.Context, _ *ethpb.Attestation) (*ethpb.AttestResponse, error) {
+	if n.attestationsUnavailable {
+		return nil, status.Error(codes.Unavailable, "unavailable")
+	}
+	atomic.AddInt32(&n.attestations, 1)
+	return &ethpb.AttestResponse{AttestationDataRoot: []byte(n.label())}, nil
+}
+
+// label identifies the fake node in its replies.
+func (n *fakeBeaconNode) label() string {
+	if n.syncing {
+		return "syncing"
+	}
+	return "synced"
+}
+
+func startFakeBeaconNode(t *testing.T, n *fakeBeaconNode) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := grpc.NewServer()
+	ethpb.RegisterNodeServer(s, n)
+	ethpb.RegisterBeaconChainServer(s, n)
+	ethpb.RegisterBeaconNodeValidatorServer(s, n)
+	go func() {
+		if err := s.Serve(lis); err != nil {
+			t.Log(err)
+		}
+	}()
+	t.Cleanup(s.Stop)
+	return lis.Addr().String()
+}
+
+func newTestBeaconNodePool(t *testing.T, broadcast bool, nodes ...*fakeBeaconNode) *beaconNodePool {
+	endpoints := ""
+	for i, n := range nodes {
+		if i > 0 {
+			endpoints += ","
+		}
+		endpoints += startFakeBeaconNode(t, n)
+	}
+	p, err := newBeaconNodePool(context.Background(), endpoints, broadcast, grpc.WithInsecure())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, p.Close())
+	})
+	return p
+}
+
+func TestBeaconNodePool_NoEndpoint(t *testing.T) {
+	_, err := newBeaconNodePool(context.Background(), " , ", false, grpc.WithInsecure())
+	assert.ErrorContains(t, "no beacon node endpoint configured", err)
+}
+
+func TestBeaconNodePool_Failover(t *testing.T) {
+	primary := &fakeBeaconNode{unavailable: true}
+	fallback := &fakeBeaconNode{}
+	p := newTestBeaconNodePool(t, false, primary, fallback)
+
+	genesis, err := ethpb.NewNodeClient(p).GetGenesis(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte("synced"), genesis.DepositContractAddress)
+	// The unavailable node is demoted until its next health check.
+	assert.Equal(t, p.nodes[1], p.rankedNodes()[0])
+	assert.Equal(t, tierUnreachable, p.nodes[0].tier)
+}
+
+func TestBeaconNodePool_CheckHealth(t *testing.T) {
+	syncing := &fakeBeaconNode{syncing: true, headSlot: 10}
+	behind := &fakeBeaconNode{headSlot: 97}
+	synced := &fakeBeaconNode{headSlot: 100}
+	p := newTestBeaconNodePool(t, false, syncing, behind, synced)
+
+	p.checkHealth(context.Background(), 5*time.Second)
+	ranked := p.rankedNodes()
+	assert.Equal(t, p.nodes[2], ranked[0])
+	assert.Equal(t, tierHealthy, ranked[0].tier)
+	assert.Equal(t, p.nodes[1], ranked[1])
+	assert.Equal(t, tierDegraded, ranked[1].tier)
+	assert.Equal(t, p.nodes[0], ranked[2])
+	assert.Equal(t, tierSyncing, ranked[2].tier)
+	require.NoError(t, p.status())
+
+	genesis, err := ethpb.NewNodeClient(p).GetGenesis(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte("synced"), genesis.DepositContractAddress)
+}
+
+func TestBeaconNodePool_Broadcast(t *testing.T) {
+	first := &fakeBeaconNode{headSlot: 100}
+	second := &fakeBeaconNode{headSlot: 100}
+	p := newTestBeaconNodePool(t, true, first, second)
+	p.checkHealth(context.Background(), 5*time.Second)
+
+	client := ethpb.NewBeaconNodeValidatorClient(p)
+	res, err := client.ProposeAttestation(context.Background(), &ethpb.Attestation{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte("synced"), res.AttestationDataRoot)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&first.attestations))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&second.attestations))
+
+	// Without broadcasting, only the healthiest node receives the attestation.
+	p.broadcast = false
+	_, err = client.ProposeAttestation(context.Background(), &ethpb.Attestation{})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&first.attestations))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&second.attestations))
+}
+
+func TestBeaconNodePool_BroadcastFallback(t *testing.T) {
+	first := &fakeBeaconNode{headSlot: 100, attestationsUnavailable: true}
+	second := &fakeBeaconNode{headSlot: 100, attestationsUnavailable: true}
+	behind := &fakeBeaconNode{headSlot: 90}
+	p := newTestBeaconNodePool(t, true, first, second, behind)
+	p.checkHealth(context.Background(), 5*time.Second)
+	require.Equal(t, 2, len(p.healthyNodes()))
+
+	// Every healthy node fails, so the attestation is sent to the degraded node.
+	client := ethpb.NewBeaconNodeValidatorClient(p)
+	_, err := client.ProposeAttestation(context.Background(), &ethpb.Attestation{})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&behind.attestations))
+	assert.Equal(t, tierUnreachable, p.nodes[0].tier)
+	assert.Equal(t, tierUnreachable, p.nodes[1].tier)
+}
+
+func TestBeaconNodePool_StatusRace(t *testing.T) {
+	p := newTestBeaconNodePool(t, false, &fakeBeaconNode{headSlot: 100})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		p.checkHealth(context.Background(), 5*time.Second)
+	}()
+	assert.NoError(t, p.status())
+	<-done
+	require.NoError(t, p.status())
+}
+
+func TestBeaconNodeHealth_Tier(t *testing.T) {
+	tests := []struct {
+		name   string
+		health beaconNodeHealth
+		want   healthTier
+	}{
+		{name: "unchecked", health: beaconNodeHealth{}, want: tierHealthy},
+		{name: "unreachable", health: beaconNodeHealth{checked: true}, want: tierUnreachable},
+		{name: "syncing", health: beaconNodeHealth{checked: true, reachable: true, syncing: true}, want: tierSyncing},
+		{
+			name:   "no eth1",
+			health: beaconNodeHealth{checked: true, reachable: true, peers: 1, headSlot: 100},
+			want:   tierDegraded,
+		},
+		{
+			name:   "no peers",
+			health: beaconNodeHealth{checked: true, reachable: true, eth1Connected: true, headSlot: 100},
+			want:   tierDegraded,
+		},
+		{
+			name:   "behind",
+			health: beaconNodeHealth{checked: true, reachable: true, eth1Connected: true, peers: 1, headSlot: 97},
+			want:   tierDegraded,
+		},
+		{
+			name:   "healthy",
+			health: beaconNodeHealth{checked: true, reachable: true, eth1Connected: true, peers: 1, headSlot: 98},
+			want:   tierHealthy,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.health.tier(100))
+		})
+	}
+}
@@ -0,0 +1,219 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+)
+
+// ErrDoppelGangerDetected is returned when another instance of one of our validating keys
+// was seen on the network and the client is configured to shut down.
+var ErrDoppelGangerDetected = errors.New("doppelganger detected")
+
+// DoppelGangerStatus is the doppelganger protection state of a validating key.
+type DoppelGangerStatus int
+
+const (
+	// DoppelGangerPending means the liveness of the key is being tracked and its duties are withheld.
+	DoppelGangerPending DoppelGangerStatus = iota
+	// DoppelGangerCleared means no other instance of the key was seen and it performs its duties.
+	DoppelGangerCleared
+	// DoppelGangerDetected means another instance of the key was seen and the key is disabled.
+	DoppelGangerDetected
+)
+
+// String returns the name of the doppelganger status.
+func (s DoppelGangerStatus) String() string {
+	switch s {
+	case DoppelGangerPending:
+		return "PENDING"
+	case DoppelGangerCleared:
+		return "CLEARED"
+	case DoppelGangerDetected:
+		return "DETECTED"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// DoppelGangerKeyState describes the doppelganger protection state of a single validating key.
+type DoppelGangerKeyState struct {
+	PublicKey      [48]byte
+	ValidatorIndex types.ValidatorIndex
+	Status         DoppelGangerStatus
+	// StartEpoch is the first epoch whose liveness is checked.
+	StartEpoch types.Epoch
+	// RemainingEpochs is the number of epochs left to check before the key is cleared.
+	RemainingEpochs uint64
+	// DetectedEpoch is the epoch in which another instance of the key was seen.
+	DetectedEpoch types.Epoch
+	// nextEpoch is the next epoch whose liveness has to be checked.
+	nextEpoch types.Epoch
+}
+
+// doppelGangerTracker holds back the duties of newly activated keys until the beacon node has
+// not seen them attesting or proposing for a number of epochs. As the keys do not perform any
+// duty while they are tracked, any activity observed by the beacon node comes from another
+// instance of the same key.
+type doppelGangerTracker struct {
+	lock       sync.RWMutex
+	epochs     uint64
+	disableKey bool
+	keys       map[[48]byte]*DoppelGangerKeyState
+}
+
+func newDoppelGangerTracker(epochs uint64, disableKey bool) *doppelGangerTracker {
+	return &doppelGangerTracker{
+		epochs:     epochs,
+		disableKey: disableKey,
+		keys:       make(map[[48]byte]*DoppelGangerKeyState),
+	}
+}
+
+// track starts tracking the active keys of the duties which are not known to the tracker yet.
+// Keys are tracked from the epoch after the current one, as an earlier run of this client
+// may still have performed duties during the current epoch.
+func (d *doppelGangerTracker) track(duties []*ethpb.DutiesResponse_Duty, epoch types.Epoch) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	for _, duty := range duties {
+		if duty == nil || (duty.Status != ethpb.ValidatorStatus_ACTIVE && duty.Status != ethpb.ValidatorStatus_EXITING) {
+			continue
+		}
+		pubKey := bytesutil.ToBytes48(duty.PublicKey)
+		if _, ok := d.keys[pubKey]; ok {
+			continue
+		}
+		state := &DoppelGangerKeyState{
+			PublicKey:       pubKey,
+			ValidatorIndex:  duty.ValidatorIndex,
+			StartEpoch:      epoch + 1,
+			RemainingEpochs: d.epochs,
+			nextEpoch:       epoch + 1,
+		}
+		if d.epochs == 0 {
+			state.Status = DoppelGangerCleared
+		}
+		d.keys[pubKey] = state
+		log.WithFields(logrus.Fields{
+			"publicKey":  fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])),
+			"startEpoch": state.StartEpoch,
+			"epochs":     d.epochs,
+		}).Info("Holding back duties until doppelganger check completes")
+	}
+}
+
+// canPerformDuties returns whether the key may perform its duties.
+func (d *doppelGangerTracker) canPerformDuties(pubKey [48]byte) bool {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	state, ok := d.keys[pubKey]
+	return !ok || state.Status == DoppelGangerCleared
+}
+
+// pending returns the keys which are due a liveness check for the epoch, sorted by validator index.
+func (d *doppelGangerTracker) pending(epoch types.Epoch) []*DoppelGangerKeyState {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	keys := make([]*DoppelGangerKeyState, 0)
+	for _, state := range d.keys {
+		if state.Status == DoppelGangerPending && state.nextEpoch <= epoch {
+			keys = append(keys, state)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].ValidatorIndex < keys[j].ValidatorIndex
+	})
+	return keys
+}
+
+// update records the liveness of the keys in the epoch and returns the keys which were seen live.
+func (d *doppelGangerTracker) update(epoch types.Epoch, live map[types.ValidatorIndex]bool, keys []*DoppelGangerKeyState) [][48]byte {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	detected := make([][48]byte, 0)
+	for _, state := range keys {
+		if state.Status != DoppelGangerPending {
+			continue
+		}
+		pubKey := fmt.Sprintf("%#x", bytesutil.Trunc(state.PublicKey[:]))
+		if live[state.ValidatorIndex] {
+			state.Status = DoppelGangerDetected
+			state.DetectedEpoch = epoch
+			detected = append(detected, state.PublicKey)
+			log.WithFields(logrus.Fields{
+				"publicKey": pubKey,
+				"epoch":     epoch,
+			}).Error("Another instance of the validator key was seen on the network")
+			continue
+		}
+		state.nextEpoch = epoch + 1
+		state.RemainingEpochs--
+		if state.RemainingEpochs == 0 {
+			state.Status = DoppelGangerCleared
+			log.WithField("publicKey", pubKey).Info("Doppelganger check completed, performing duties")
+		}
+	}
+	return detected
+}
+
+// states returns a copy of the state of every tracked key, sorted by validator index.
+func (d *doppelGangerTracker) states() []*DoppelGangerKeyState {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	states := make([]*DoppelGangerKeyState, 0, len(d.keys))
+	for _, state := range d.keys {
+		s := *state
+		states = append(states, &s)
+	}
+	sort.Slice(states, func(i, j int) bool {
+		return states[i].ValidatorIndex < states[j].ValidatorIndex
+	})
+	return states
+}
+
+// CheckDoppelGangerLiveness asks the beacon node whether the keys whose duties are held back were
+// seen attesting or proposing during the previous epoch. Keys seen live are disabled, and unless
+// the client is configured to only disable them, ErrDoppelGangerDetected is returned.
+func (v *validator) CheckDoppelGangerLiveness(ctx context.Context, slot types.Slot) error {
+	if v.doppelGanger == nil {
+		return nil
+	}
+	currentEpoch := helpers.SlotToEpoch(slot)
+	if currentEpoch == 0 {
+		return nil
+	}
+	epoch := currentEpoch - 1
+	keys := v.doppelGanger.pending(epoch)
+	if len(keys) == 0 {
+		return nil
+	}
+	indices := make([]types.ValidatorIndex, len(keys))
+	for i, state := range keys {
+		indices[i] = state.ValidatorIndex
+	}
+	res, err := v.validatorClient.GetValidatorLiveness(ctx, &ethpb.ValidatorLivenessRequest{
+		Epoch:   epoch,
+		Indices: indices,
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not get validator liveness")
+	}
+	live := make(map[types.ValidatorIndex]bool, len(res.Liveness))
+	for _, l := range res.Liveness {
+		live[l.Index] = l.IsLive
+	}
+	detected := v.doppelGanger.update(epoch, live, keys)
+	if len(detected) == 0 || v.doppelGanger.disableKey {
+		return nil
+	}
+	return errors.Wrapf(ErrDoppelGangerDetected, "validator keys %#x", detected)
+}
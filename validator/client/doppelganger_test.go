@@ -0,0 +1,149 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func doppelGangerDuties(statuses ...ethpb.ValidatorStatus) []*ethpb.DutiesResponse_Duty {
+	duties := make([]*ethpb.DutiesResponse_Duty, len(statuses))
+	for i, s := range statuses {
+		duties[i] = &ethpb.DutiesResponse_Duty{
+			PublicKey:      bytesutil.PadTo([]byte{byte(i + 1)}, 48),
+			ValidatorIndex: types.ValidatorIndex(i),
+			Status:         s,
+		}
+	}
+	return duties
+}
+
+func TestDoppelGangerTracker_Track(t *testing.T) {
+	d := newDoppelGangerTracker(2, false)
+	duties := doppelGangerDuties(ethpb.ValidatorStatus_ACTIVE, ethpb.ValidatorStatus_PENDING)
+	d.track(duties, 5)
+
+	states := d.states()
+	require.Equal(t, 1, len(states))
+	assert.Equal(t, DoppelGangerPending, states[0].Status)
+	assert.Equal(t, types.Epoch(6), states[0].StartEpoch)
+	assert.Equal(t, uint64(2), states[0].RemainingEpochs)
+	assert.Equal(t, false, d.canPerformDuties(bytesutil.ToBytes48(duties[0].PublicKey)))
+	// Keys which are not active yet are not tracked.
+	assert.Equal(t, true, d.canPerformDuties(bytesutil.ToBytes48(duties[1].PublicKey)))
+
+	// Tracking a key again does not restart its check.
+	d.track(duties, 7)
+	assert.Equal(t, types.Epoch(6), d.states()[0].StartEpoch)
+
+	// Without any epochs to check, keys are cleared right away.
+	d = newDoppelGangerTracker(0, false)
+	d.track(duties, 5)
+	assert.Equal(t, true, d.canPerformDuties(bytesutil.ToBytes48(duties[0].PublicKey)))
+}
+
+func TestValidator_CheckDoppelGangerLiveness(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	duties := doppelGangerDuties(ethpb.ValidatorStatus_ACTIVE, ethpb.ValidatorStatus_ACTIVE)
+	v := &validator{
+		validatorClient: client,
+		doppelGanger:    newDoppelGangerTracker(2, false),
+	}
+	v.doppelGanger.track(duties, 5)
+	epochStart := func(epoch types.Epoch) types.Slot {
+		return params.BeaconConfig().SlotsPerEpoch.Mul(uint64(epoch))
+	}
+
+	// Nothing is checked before the tracking starts.
+	require.NoError(t, v.CheckDoppelGangerLiveness(context.Background(), epochStart(6)))
+
+	for _, epoch := range []types.Epoch{6, 7} {
+		client.EXPECT().GetValidatorLiveness(gomock.Any(), &ethpb.ValidatorLivenessRequest{
+			Epoch:   epoch,
+			Indices: []types.ValidatorIndex{0, 1},
+		}).Return(&ethpb.ValidatorLivenessResponse{
+			Epoch: epoch,
+			Liveness: []*ethpb.ValidatorLivenessResponse_Liveness{
+				{Index: 0, IsLive: false},
+				{Index: 1, IsLive: false},
+			},
+		}, nil)
+		require.NoError(t, v.CheckDoppelGangerLiveness(context.Background(), epochStart(epoch+1)))
+	}
+	for _, st := range v.doppelGanger.states() {
+		assert.Equal(t, DoppelGangerCleared, st.Status)
+		assert.Equal(t, uint64(0), st.RemainingEpochs)
+	}
+
+	// Cleared keys are not checked anymore.
+	require.NoError(t, v.CheckDoppelGangerLiveness(context.Background(), epochStart(9)))
+}
+
+func TestValidator_CheckDoppelGangerLiveness_Detected(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	duties := doppelGangerDuties(ethpb.ValidatorStatus_ACTIVE, ethpb.ValidatorStatus_ACTIVE)
+	liveness := &ethpb.ValidatorLivenessResponse{
+		Epoch: 1,
+		Liveness: []*ethpb.ValidatorLivenessResponse_Liveness{
+			{Index: 0, IsLive: false},
+			{Index: 1, IsLive: true},
+		},
+	}
+	slot := params.BeaconConfig().SlotsPerEpoch.Mul(2)
+
+	v := &validator{
+		validatorClient: client,
+		doppelGanger:    newDoppelGangerTracker(2, false),
+	}
+	v.doppelGanger.track(duties, 0)
+	client.EXPECT().GetValidatorLiveness(gomock.Any(), gomock.Any()).Return(liveness, nil)
+	err := v.CheckDoppelGangerLiveness(context.Background(), slot)
+	assert.Equal(t, true, errors.Is(err, ErrDoppelGangerDetected))
+
+	// When only the key is disabled, the other keys keep being checked.
+	v = &validator{
+		validatorClient: client,
+		doppelGanger:    newDoppelGangerTracker(2, true),
+	}
+	v.doppelGanger.track(duties, 0)
+	client.EXPECT().GetValidatorLiveness(gomock.Any(), gomock.Any()).Return(liveness, nil)
+	require.NoError(t, v.CheckDoppelGangerLiveness(context.Background(), slot))
+	states := v.doppelGanger.states()
+	assert.Equal(t, DoppelGangerPending, states[0].Status)
+	assert.Equal(t, uint64(1), states[0].RemainingEpochs)
+	assert.Equal(t, DoppelGangerDetected, states[1].Status)
+	assert.Equal(t, types.Epoch(1), states[1].DetectedEpoch)
+	assert.Equal(t, false, v.doppelGanger.canPerformDuties(bytesutil.ToBytes48(duties[1].PublicKey)))
+}
+
+func TestRolesAt_DoppelGangerPending(t *testing.T) {
+	v, _, validatorKey, finish := setup(t)
+	defer finish()
+
+	duty := &ethpb.DutiesResponse_Duty{
+		CommitteeIndex: 1,
+		AttesterSlot:   1,
+		PublicKey:      validatorKey.PublicKey().Marshal(),
+		Status:         ethpb.ValidatorStatus_ACTIVE,
+	}
+	v.duties = &ethpb.DutiesResponse{Duties: []*ethpb.DutiesResponse_Duty{duty}}
+	v.doppelGanger = newDoppelGangerTracker(2, false)
+	v.doppelGanger.track(v.duties.Duties, 0)
+
+	roleMap, err := v.RolesAt(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(roleMap))
+}
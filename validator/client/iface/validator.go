@@ -51,4 +51,6 @@ type Validator interface {
 	ReceiveBlocks(ctx context.Context, connectionErrorChannel chan<- error)
 	HandleKeyReload(ctx context.Context, newKeys [][48]byte) (bool, error)
 	CheckDoppelGanger(ctx context.Context) error
+	CheckDoppelGangerLiveness(ctx context.Context, slot types.Slot) error
+	CheckObservedDuties(ctx context.Context, slot types.Slot) error
 }
@@ -76,6 +76,18 @@ var (
 			"pubkey",
 		},
 	)
+	// ValidatorObservedDutiesVec used to count the duties of observed keys by whether the beacon chain includes them.
+	ValidatorObservedDutiesVec = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "validator_observed_duties_total",
+			Help: "Count the duties of keys in observer mode, by duty and whether the beacon chain includes them.",
+		},
+		[]string{
+			"pubkey",
+			"duty",
+			"included",
+		},
+	)
 	// ValidatorBalancesGaugeVec used to keep track of validator balances by public key.
 	ValidatorBalancesGaugeVec = promauto.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -194,6 +206,33 @@ var (
 			"pubkey",
 		},
 	)
+	// BeaconNodeHealthyGaugeVec used to track the health of the configured beacon nodes by endpoint.
+	BeaconNodeHealthyGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_healthy",
+			Help:      "Boolean indicating whether a beacon node is healthy: 0=false, 1=true.",
+		},
+		[]string{
+			"endpoint",
+		},
+	)
+	// SyncEth2FallbackConfiguredGauge used to track whether more than one beacon node is configured.
+	SyncEth2FallbackConfiguredGauge = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "sync_eth2_fallback_configured",
+			Help:      "Boolean recording whether a fallback beacon node was configured: 0=false, 1=true.",
+		},
+	)
+	// SyncEth2FallbackConnectedGauge used to track whether the validator client is using a fallback beacon node.
+	SyncEth2FallbackConnectedGauge = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "sync_eth2_fallback_connected",
+			Help:      "Boolean indicating whether a fallback beacon node is currently used: 0=false, 1=true.",
+		},
+	)
 )
 
 // LogValidatorGainsAndLosses logs important metrics related to this validator client's
@@ -3,9 +3,17 @@ package client
 import (
 	"strings"
 
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/resolver"
 )
 
+// WithMultipleEndpoints returns a dial option which lets a single connection be dialed to comma
+// separated endpoints. The validator client itself dials each endpoint separately instead, so that
+// it can fail over by beacon node health.
+func WithMultipleEndpoints() grpc.DialOption {
+	return grpc.WithResolvers(&multipleEndpointsGrpcResolverBuilder{})
+}
+
 // Modification of a default grpc passthrough resolver (google.golang.org/grpc/resolver/passthrough) allowing to use multiple addresses
 // in grpc endpoint. Example:
 // conn, err := grpc.DialContext(ctx, "127.0.0.1:4000,127.0.0.1:4001", grpc.WithInsecure(), grpc.WithResolvers(&multipleEndpointsGrpcResolverBuilder{}))
@@ -0,0 +1,229 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// observedDutyKind is a duty whose inclusion in the beacon chain is checked in observer mode.
+type observedDutyKind string
+
+const (
+	observedAttestation observedDutyKind = "attestation"
+	observedProposal    observedDutyKind = "proposal"
+)
+
+// proposalInclusionDelay is the number of slots after a proposal duty at which the beacon
+// chain is checked for the block, leaving time for the block to be imported.
+const proposalInclusionDelay = 2
+
+// errUncheckableDuty is returned for duties whose inclusion the beacon node cannot tell,
+// which are dropped rather than retried or counted as missing.
+var errUncheckableDuty = errors.New("could not determine if the duty was included")
+
+type observedDuty struct {
+	kind   observedDutyKind
+	pubKey [48]byte
+	index  types.ValidatorIndex
+	slot   types.Slot
+}
+
+// checkSlot is the slot from which the inclusion of the duty is checked. Attestations can be
+// included up to an epoch after their slot.
+func (d *observedDuty) checkSlot() types.Slot {
+	if d.kind == observedAttestation {
+		return d.slot + params.BeaconConfig().SlotsPerEpoch
+	}
+	return d.slot + proposalInclusionDelay
+}
+
+// dutyObserver records the duties of keys the validator client follows without signing for
+// them, so that it can later report whether the beacon chain includes an attestation or a
+// block from the key for each duty.
+type dutyObserver struct {
+	lock    sync.Mutex
+	pending []*observedDuty
+}
+
+func newDutyObserver() *dutyObserver {
+	return &dutyObserver{}
+}
+
+func (o *dutyObserver) add(duty *observedDuty) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	o.pending = append(o.pending, duty)
+}
+
+// due removes and returns the duties whose inclusion can be checked at the slot.
+func (o *dutyObserver) due(slot types.Slot) []*observedDuty {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	var due []*observedDuty
+	remaining := o.pending[:0]
+	for _, duty := range o.pending {
+		if duty.checkSlot() <= slot {
+			due = append(due, duty)
+		} else {
+			remaining = append(remaining, duty)
+		}
+	}
+	o.pending = remaining
+	sort.Slice(due, func(i, j int) bool {
+		if due[i].slot != due[j].slot {
+			return due[i].slot < due[j].slot
+		}
+		return due[i].index < due[j].index
+	})
+	return due
+}
+
+// observeDuty records a duty of an observed key instead of performing it.
+func (v *validator) observeDuty(kind observedDutyKind, slot types.Slot, pubKey [48]byte) {
+	fmtKey := fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:]))
+	duty, err := v.duty(pubKey)
+	if err != nil {
+		log.WithError(err).WithField("pubKey", fmtKey).Error("Could not find duty of observed key")
+		return
+	}
+	v.observer.add(&observedDuty{
+		kind:   kind,
+		pubKey: pubKey,
+		index:  duty.ValidatorIndex,
+		slot:   slot,
+	})
+	log.WithFields(logrus.Fields{
+		"pubKey":         fmtKey,
+		"validatorIndex": duty.ValidatorIndex,
+		"slot":           slot,
+		"duty":           kind,
+	}).Info("Observed duty, not signing in observer mode")
+}
+
+// CheckObservedDuties reports, for the duties of observed keys whose inclusion window has
+// passed, whether the beacon chain includes an attestation or a block from the key. Duties
+// which cannot be checked because of an error are checked again at the next slot.
+func (v *validator) CheckObservedDuties(ctx context.Context, slot types.Slot) error {
+	if v.observer == nil {
+		return nil
+	}
+	var firstErr error
+	for _, duty := range v.observer.due(slot) {
+		var included bool
+		var err error
+		switch duty.kind {
+		case observedAttestation:
+			included, err = v.attestationIncluded(ctx, duty, slot)
+		case observedProposal:
+			included, err = v.proposalIncluded(ctx, duty)
+		}
+		if errors.Is(err, errUncheckableDuty) {
+			log.WithError(err).WithFields(logrus.Fields{
+				"pubKey":         fmt.Sprintf("%#x", bytesutil.Trunc(duty.pubKey[:])),
+				"validatorIndex": duty.index,
+				"slot":           duty.slot,
+				"duty":           duty.kind,
+			}).Error("Could not check observed duty")
+			continue
+		}
+		if err != nil {
+			v.observer.add(duty)
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		fmtKey := fmt.Sprintf("%#x", duty.pubKey)
+		if v.emitAccountMetrics {
+			ValidatorObservedDutiesVec.WithLabelValues(fmtKey, string(duty.kind), strconv.FormatBool(included)).Inc()
+		}
+		l := log.WithFields(logrus.Fields{
+			"pubKey":         fmt.Sprintf("%#x", bytesutil.Trunc(duty.pubKey[:])),
+			"validatorIndex": duty.index,
+			"slot":           duty.slot,
+			"duty":           duty.kind,
+		})
+		if included {
+			l.Info("Observed duty was included in the beacon chain")
+		} else {
+			l.Warn("Observed duty is missing from the beacon chain")
+		}
+	}
+	return firstErr
+}
+
+// attestationIncluded looks the attestation of the duty up in the attestation inclusion indices of
+// the beacon node. Beacon nodes which do not index inclusions only report whether the attestations
+// of the previous epoch were included.
+func (v *validator) attestationIncluded(ctx context.Context, duty *observedDuty, slot types.Slot) (bool, error) {
+	res, err := v.beaconClient.ListAttestationInclusions(ctx, &ethpb.ListAttestationInclusionsRequest{
+		QueryFilter: &ethpb.ListAttestationInclusionsRequest_Validator{
+			Validator: &ethpb.ListAttestationInclusionsRequest_ValidatorEpochFilter{
+				ValidatorIndex: duty.index,
+				Epoch:          helpers.SlotToEpoch(duty.slot),
+			},
+		},
+	})
+	if status.Code(err) == codes.FailedPrecondition {
+		return v.attestationPerformance(ctx, duty, slot)
+	}
+	if err != nil {
+		return false, err
+	}
+	for _, inclusion := range res.Inclusions {
+		if inclusion.Canonical && inclusion.AttestationSlot == duty.slot {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (v *validator) attestationPerformance(ctx context.Context, duty *observedDuty, slot types.Slot) (bool, error) {
+	if helpers.SlotToEpoch(slot) != helpers.SlotToEpoch(duty.slot)+1 {
+		return false, errors.Wrap(errUncheckableDuty, "the beacon node does not index attestation inclusions "+
+			"and only reports the performance of the previous epoch")
+	}
+	res, err := v.beaconClient.GetValidatorPerformance(ctx, &ethpb.ValidatorPerformanceRequest{
+		Indices: []types.ValidatorIndex{duty.index},
+	})
+	if err != nil {
+		return false, err
+	}
+	for i, pubKey := range res.PublicKeys {
+		if bytesutil.ToBytes48(pubKey) == duty.pubKey && i < len(res.CorrectlyVotedSource) {
+			return res.CorrectlyVotedSource[i], nil
+		}
+	}
+	return false, errors.Wrap(errUncheckableDuty, "the beacon node did not report the performance of the validator")
+}
+
+func (v *validator) proposalIncluded(ctx context.Context, duty *observedDuty) (bool, error) {
+	res, err := v.beaconClient.ListBlocks(ctx, &ethpb.ListBlocksRequest{
+		QueryFilter: &ethpb.ListBlocksRequest_Slot{Slot: duty.slot},
+	})
+	if err != nil {
+		return false, err
+	}
+	for _, container := range res.BlockContainers {
+		if !container.Canonical || container.Block == nil || container.Block.Block == nil {
+			continue
+		}
+		if container.Block.Block.ProposerIndex == duty.index {
+			return true, nil
+		}
+	}
+	return false, nil
+}
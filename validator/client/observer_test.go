@@ -0,0 +1,112 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestValidator_ObserverMode(t *testing.T) {
+	hook := logTest.NewGlobal()
+	v, _, validatorKey, finish := setup(t)
+	defer finish()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+	v.beaconClient = beaconClient
+	v.observer = newDutyObserver()
+	// Signing is never attempted, any call to the keymanager fails the test.
+	v.keyManager = nil
+
+	var pubKey [48]byte
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+	v.duties = &ethpb.DutiesResponse{
+		Duties: []*ethpb.DutiesResponse_Duty{
+			{PublicKey: pubKey[:], ValidatorIndex: 7, AttesterSlot: 10, ProposerSlots: []types.Slot{11}},
+		},
+	}
+	aggregator, err := v.isAggregator(context.Background(), []types.ValidatorIndex{7}, 10, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, false, aggregator)
+
+	// No request is made to the beacon node to produce or submit the attestation and block.
+	v.SubmitAttestation(context.Background(), 10, pubKey)
+	v.ProposeBlock(context.Background(), 11, pubKey)
+	assert.LogsContain(t, hook, "Observed duty, not signing in observer mode")
+
+	// Nothing is due before the inclusion windows have passed.
+	require.NoError(t, v.CheckObservedDuties(context.Background(), 12))
+
+	beaconClient.EXPECT().ListBlocks(gomock.Any(), &ethpb.ListBlocksRequest{
+		QueryFilter: &ethpb.ListBlocksRequest_Slot{Slot: 11},
+	}).Return(&ethpb.ListBlocksResponse{
+		BlockContainers: []*ethpb.BeaconBlockContainer{
+			{Canonical: true, Block: testutil.NewBeaconBlock()},
+		},
+	}, nil)
+	require.NoError(t, v.CheckObservedDuties(context.Background(), 13))
+	assert.LogsContain(t, hook, "Observed duty is missing from the beacon chain")
+
+	attestationSlot := 10 + params.BeaconConfig().SlotsPerEpoch
+	req := &ethpb.ListAttestationInclusionsRequest{
+		QueryFilter: &ethpb.ListAttestationInclusionsRequest_Validator{
+			Validator: &ethpb.ListAttestationInclusionsRequest_ValidatorEpochFilter{ValidatorIndex: 7, Epoch: 0},
+		},
+	}
+	// Duties which cannot be checked are retried.
+	beaconClient.EXPECT().ListAttestationInclusions(gomock.Any(), req).Return(nil, errors.New("unavailable"))
+	assert.ErrorContains(t, "unavailable", v.CheckObservedDuties(context.Background(), attestationSlot))
+	beaconClient.EXPECT().ListAttestationInclusions(gomock.Any(), req).Return(&ethpb.AttestationInclusions{
+		Inclusions: []*ethpb.AttestationInclusions_Inclusion{
+			{AttestationSlot: 10, Canonical: false},
+			{AttestationSlot: 10, Canonical: true},
+		},
+	}, nil)
+	require.NoError(t, v.CheckObservedDuties(context.Background(), attestationSlot+1))
+	assert.LogsContain(t, hook, "Observed duty was included in the beacon chain")
+	assert.Equal(t, 0, len(v.observer.pending))
+}
+
+func TestValidator_ObserverMode_NoInclusionIndices(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+	v := &validator{beaconClient: beaconClient, observer: newDutyObserver()}
+	pubKey := [48]byte{1}
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	indicesDisabled := status.Error(codes.FailedPrecondition, "Attestation inclusion indices are disabled")
+
+	// The performance of the previous epoch tells whether the attestation was included.
+	v.observer.add(&observedDuty{kind: observedAttestation, pubKey: pubKey, index: 7, slot: 10})
+	beaconClient.EXPECT().ListAttestationInclusions(gomock.Any(), gomock.Any()).Return(nil, indicesDisabled)
+	beaconClient.EXPECT().GetValidatorPerformance(gomock.Any(), &ethpb.ValidatorPerformanceRequest{
+		Indices: []types.ValidatorIndex{7},
+	}).Return(&ethpb.ValidatorPerformanceResponse{
+		PublicKeys:           [][]byte{pubKey[:]},
+		CorrectlyVotedSource: []bool{true},
+	}, nil)
+	require.NoError(t, v.CheckObservedDuties(context.Background(), 10+slotsPerEpoch))
+	assert.LogsContain(t, hook, "Observed duty was included in the beacon chain")
+
+	// Attestations older than the previous epoch are dropped rather than counted as missing.
+	hook.Reset()
+	v.observer.add(&observedDuty{kind: observedAttestation, pubKey: pubKey, index: 7, slot: 10})
+	beaconClient.EXPECT().ListAttestationInclusions(gomock.Any(), gomock.Any()).Return(nil, indicesDisabled)
+	require.NoError(t, v.CheckObservedDuties(context.Background(), 10+2*slotsPerEpoch))
+	assert.LogsContain(t, hook, "Could not check observed duty")
+	assert.LogsDoNotContain(t, hook, "Observed duty is missing from the beacon chain")
+	assert.Equal(t, 0, len(v.observer.pending))
+}
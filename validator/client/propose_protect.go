@@ -9,11 +9,13 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/blockutil"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	vdb "github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/sirupsen/logrus"
 )
 
 var failedPreBlockSignLocalErr = "attempted to sign a double proposal, block rejected by local protection"
 var failedPreBlockSignExternalErr = "attempted a double proposal, block rejected by remote slashing protection"
+var failedPreBlockSignRemoteErr = "attempted to sign a slashable proposal, block rejected by remote slashing protection service"
 var failedPostBlockSignErr = "made a double proposal, considered slashable by remote slashing protection"
 
 func (v *validator) preBlockSignValidations(
@@ -21,6 +23,18 @@ func (v *validator) preBlockSignValidations(
 ) error {
 	fmtKey := fmt.Sprintf("%#x", pubKey[:])
 
+	if protector, ok := v.db.(vdb.AtomicSlashingProtector); ok {
+		// The slashing protection history is kept by a service shared with other validator
+		// clients, which checks and records the proposal before it is signed.
+		if err := protector.CheckAndSaveProposal(ctx, pubKey, block.Slot(), signingRoot); err != nil {
+			if v.emitAccountMetrics {
+				ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
+			}
+			return errors.Wrap(err, failedPreBlockSignRemoteErr)
+		}
+		return v.checkBlockExternally(ctx, block, fmtKey)
+	}
+
 	prevSigningRoot, proposalAtSlotExists, err := v.db.ProposalHistoryForSlot(ctx, pubKey, block.Slot())
 	if err != nil {
 		if v.emitAccountMetrics {
@@ -57,7 +71,11 @@ func (v *validator) preBlockSignValidations(
 			block.Slot(),
 		)
 	}
+	return v.checkBlockExternally(ctx, block, fmtKey)
+}
 
+// Checks the block with the external slasher service if it is enabled.
+func (v *validator) checkBlockExternally(ctx context.Context, block interfaces.BeaconBlock, fmtKey string) error {
 	if featureconfig.Get().SlasherProtection && v.protector != nil {
 		blockHdr, err := blockutil.BeaconBlockHeaderFromBlockInterface(block)
 		if err != nil {
@@ -70,7 +88,6 @@ func (v *validator) preBlockSignValidations(
 			return errors.New(failedPreBlockSignExternalErr)
 		}
 	}
-
 	return nil
 }
 
@@ -97,6 +114,10 @@ func (v *validator) postBlockSignUpdate(
 			return fmt.Errorf(failedPostBlockSignErr)
 		}
 	}
+	if _, ok := v.db.(vdb.AtomicSlashingProtector); ok {
+		// The proposal was already recorded before signing.
+		return nil
+	}
 	if err := v.db.SaveProposalHistoryForSlot(ctx, pubKey, block.Block().Slot(), signingRoot[:]); err != nil {
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	types "github.com/prysmaticlabs/eth2-types"
@@ -9,6 +10,7 @@ import (
 	"github.com/prysmaticlabs/prysm/proto/eth/v1alpha1/wrapper"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	mockSlasher "github.com/prysmaticlabs/prysm/validator/testing"
 )
@@ -159,3 +161,28 @@ func TestPostBlockSignUpdate(t *testing.T) {
 	err = validator.postBlockSignUpdate(context.Background(), pubKey, wrapper.WrappedPhase0SignedBeaconBlock(emptyBlock), [32]byte{})
 	require.NoError(t, err, "Expected allowed block not to throw error")
 }
+
+func TestPreBlockSignValidation_AtomicProtector(t *testing.T) {
+	validator, _, validatorKey, finish := setup(t)
+	defer finish()
+	pubKey := [48]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+	block := testutil.NewBeaconBlock()
+	block.Block.Slot = 10
+	db := &atomicProtectorDB{Database: validator.db}
+	validator.db = db
+
+	err := validator.preBlockSignValidations(context.Background(), pubKey, wrapper.WrappedPhase0BeaconBlock(block.Block), [32]byte{2})
+	require.NoError(t, err)
+	assert.Equal(t, 1, db.proposals)
+	// The proposal was recorded before signing, so it is not saved to the local history afterwards.
+	err = validator.postBlockSignUpdate(context.Background(), pubKey, wrapper.WrappedPhase0SignedBeaconBlock(block), [32]byte{2})
+	require.NoError(t, err)
+	_, exists, err := db.Database.ProposalHistoryForSlot(context.Background(), pubKey, 10)
+	require.NoError(t, err)
+	assert.Equal(t, false, exists)
+
+	db.err = errors.New("slashing protection server unavailable")
+	err = validator.preBlockSignValidations(context.Background(), pubKey, wrapper.WrappedPhase0BeaconBlock(block.Block), [32]byte{2})
+	require.ErrorContains(t, failedPreBlockSignRemoteErr, err)
+}
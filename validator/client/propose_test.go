@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	"errors"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -22,6 +23,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	testing2 "github.com/prysmaticlabs/prysm/validator/db/testing"
 	"github.com/prysmaticlabs/prysm/validator/graffiti"
+	"github.com/prysmaticlabs/prysm/validator/keyconfig"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 	grpc "google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -733,11 +735,25 @@ func TestGetGraffiti_Ok(t *testing.T) {
 			},
 			want: []byte{},
 		},
+		{name: "use default cli graffiti, key not in key configuration",
+			v: &validator{
+				graffiti:  []byte{'b'},
+				keyConfig: keyConfigWithGraffiti(t, [48]byte{'z'}, "h"),
+			},
+			want: []byte{'b'},
+		},
+		{name: "use key configuration graffiti",
+			v: &validator{
+				graffiti:  []byte{'b'},
+				keyConfig: keyConfigWithGraffiti(t, pubKey, "h"),
+			},
+			want: []byte{'h'},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if !strings.Contains(tt.name, "use default cli graffiti") {
+			if !strings.Contains(tt.name, "use default cli graffiti") && !strings.Contains(tt.name, "key configuration") {
 				m.validatorClient.EXPECT().
 					ValidatorIndex(gomock.Any(), &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]}).
 					Return(&ethpb.ValidatorIndexResponse{Index: 2}, nil)
@@ -749,6 +765,13 @@ func TestGetGraffiti_Ok(t *testing.T) {
 	}
 }
 
+func keyConfigWithGraffiti(t *testing.T, pubKey [48]byte, g string) *keyconfig.Store {
+	store, err := keyconfig.NewStore(filepath.Join(t.TempDir(), "keys.yaml"))
+	require.NoError(t, err)
+	require.NoError(t, store.SetKey(pubKey, &keyconfig.KeyConfig{Graffiti: &g}))
+	return store
+}
+
 func TestGetGraffitiOrdered_Ok(t *testing.T) {
 	pubKey := [48]byte{'a'}
 	valDB := testing2.SetupDB(t, [][48]byte{pubKey})
@@ -175,6 +175,14 @@ func run(ctx context.Context, v iface.Validator) {
 				continue
 			}
 
+			if err := v.CheckDoppelGangerLiveness(ctx, slot); err != nil {
+				if errors.Is(err, ErrDoppelGangerDetected) {
+					cleanup()
+					log.Fatalf("Stopping validator: %v", err)
+				}
+				log.WithError(err).Error("Could not check doppelganger liveness")
+			}
+
 			// Start fetching domain data for the next epoch.
 			if helpers.IsEpochEnd(slot) {
 				go v.UpdateDomainDataCaches(ctx, slot+1)
@@ -217,6 +225,9 @@ func run(ctx context.Context, v iface.Validator) {
 				if err := v.LogValidatorGainsAndLosses(slotCtx, slot); err != nil {
 					log.WithError(err).Error("Could not report validator's rewards/penalties")
 				}
+				if err := v.CheckObservedDuties(ctx, slot); err != nil {
+					log.WithError(err).Error("Could not check inclusion of observed duties")
+				}
 				if err := v.LogNextDutyTimeLeft(slot); err != nil {
 					log.WithError(err).Error("Could not report next count down")
 				}
@@ -17,13 +17,16 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/grpcutils"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	accountsiface "github.com/prysmaticlabs/prysm/validator/accounts/iface"
 	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
 	"github.com/prysmaticlabs/prysm/validator/client/iface"
 	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/exits"
 	"github.com/prysmaticlabs/prysm/validator/graffiti"
+	"github.com/prysmaticlabs/prysm/validator/keyconfig"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
 	slashingiface "github.com/prysmaticlabs/prysm/validator/slashing-protection/iface"
@@ -52,7 +55,8 @@ type ValidatorService struct {
 	emitAccountMetrics    bool
 	logValidatorBalances  bool
 	logDutyCountDown      bool
-	conn                  *grpc.ClientConn
+	conn                  *beaconNodePool
+	broadcast             bool
 	grpcRetryDelay        time.Duration
 	grpcRetries           uint
 	maxCallRecvMsgSize    int
@@ -69,6 +73,11 @@ type ValidatorService struct {
 	grpcHeaders           []string
 	graffiti              []byte
 	graffitiStruct        *graffiti.Graffiti
+	doppelGanger          *doppelGangerTracker
+	keyConfig             *keyconfig.Store
+	observer              *dutyObserver
+	exitsStore            *exits.Store
+	exits                 *exits.Manager
 }
 
 // Config for the validator service.
@@ -91,12 +100,26 @@ type Config struct {
 	DataDir                    string
 	GrpcHeadersFlag            string
 	GraffitiStruct             *graffiti.Graffiti
+	BroadcastToBeaconNodes     bool
+	DoppelGangerEpochs         uint64
+	DoppelGangerDisableKey     bool
+	KeyConfig                  *keyconfig.Store
+	ObserverMode               bool
+	ScheduledExits             *exits.Store
 }
 
 // NewValidatorService creates a new validator service for the service
 // registry.
 func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, error) {
 	ctx, cancel := context.WithCancel(ctx)
+	var doppelGanger *doppelGangerTracker
+	if featureconfig.Get().EnableDoppelGanger {
+		doppelGanger = newDoppelGangerTracker(cfg.DoppelGangerEpochs, cfg.DoppelGangerDisableKey)
+	}
+	var observer *dutyObserver
+	if cfg.ObserverMode {
+		observer = newDutyObserver()
+	}
 	return &ValidatorService{
 		ctx:                   ctx,
 		cancel:                cancel,
@@ -118,6 +141,11 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		useWeb:                cfg.UseWeb,
 		graffitiStruct:        cfg.GraffitiStruct,
 		logDutyCountDown:      cfg.LogDutyCountDown,
+		broadcast:             cfg.BroadcastToBeaconNodes,
+		doppelGanger:          doppelGanger,
+		keyConfig:             cfg.KeyConfig,
+		observer:              observer,
+		exitsStore:            cfg.ScheduledExits,
 	}, nil
 }
 
@@ -136,7 +164,7 @@ func (v *ValidatorService) Start() {
 
 	v.ctx = grpcutils.AppendHeaders(v.ctx, v.grpcHeaders)
 
-	conn, err := grpc.DialContext(v.ctx, v.endpoint, dialOpts...)
+	conn, err := newBeaconNodePool(v.ctx, v.endpoint, v.broadcast, dialOpts...)
 	if err != nil {
 		log.Errorf("Could not dial endpoint: %s, %v", v.endpoint, err)
 		return
@@ -146,6 +174,7 @@ func (v *ValidatorService) Start() {
 	}
 
 	v.conn = conn
+	go v.conn.run(v.ctx)
 	cache, err := ristretto.NewCache(&ristretto.Config{
 		NumCounters: 1920, // number of keys to track.
 		MaxCost:     192,  // maximum cost of cache, 1 item = 1 cost.
@@ -200,6 +229,16 @@ func (v *ValidatorService) Start() {
 		graffitiOrderedIndex:           graffitiOrderedIndex,
 		eipImportBlacklistedPublicKeys: slashablePublicKeys,
 		logDutyCountDown:               v.logDutyCountDown,
+		doppelGanger:                   v.doppelGanger,
+		keyConfig:                      v.keyConfig,
+		observer:                       v.observer,
+	}
+	if v.keyConfig != nil {
+		go v.keyConfig.Watch(v.ctx)
+	}
+	if v.exitsStore != nil {
+		v.exits = exits.NewManager(v.exitsStore, ethpb.NewBeaconNodeValidatorClient(v.conn), ethpb.NewBeaconChainClient(v.conn))
+		go v.exits.Run(v.ctx)
 	}
 	go run(v.ctx, v.validator)
 	go v.recheckKeys(v.ctx)
@@ -220,7 +259,28 @@ func (v *ValidatorService) Status() error {
 	if v.conn == nil {
 		return errors.New("no connection to beacon RPC")
 	}
-	return nil
+	return v.conn.status()
+}
+
+// KeyConfig returns the per key configuration of the validator client, or nil if no key
+// configuration file is used.
+func (v *ValidatorService) KeyConfig() *keyconfig.Store {
+	return v.keyConfig
+}
+
+// ScheduledExits returns the manager of scheduled voluntary exits, or nil if exits cannot be
+// scheduled, as the wallet does not keep signing keys or the service has not started.
+func (v *ValidatorService) ScheduledExits() *exits.Manager {
+	return v.exits
+}
+
+// DoppelGangerStatuses returns the doppelganger protection state of the validating keys, or nil
+// if doppelganger protection is disabled.
+func (v *ValidatorService) DoppelGangerStatuses() []*DoppelGangerKeyState {
+	if v.doppelGanger == nil {
+		return nil
+	}
+	return v.doppelGanger.states()
 }
 
 func (v *ValidatorService) recheckKeys(ctx context.Context) {
@@ -302,7 +362,6 @@ func ConstructDialOptions(
 			grpc_prometheus.StreamClientInterceptor,
 			grpc_retry.StreamClientInterceptor(),
 		),
-		grpc.WithResolvers(&multipleEndpointsGrpcResolverBuilder{}),
 	}
 
 	dialOpts = append(dialOpts, extraOpts...)
@@ -218,6 +218,16 @@ func (fv *FakeValidator) CheckDoppelGanger(ctx context.Context) error {
 	return nil
 }
 
+// CheckDoppelGangerLiveness for mocking
+func (fv *FakeValidator) CheckDoppelGangerLiveness(_ context.Context, _ types.Slot) error {
+	return nil
+}
+
+// CheckObservedDuties for mocking
+func (fv *FakeValidator) CheckObservedDuties(_ context.Context, _ types.Slot) error {
+	return nil
+}
+
 // ReceiveBlocks for mocking
 func (fv *FakeValidator) ReceiveBlocks(ctx context.Context, connectionErrorChannel chan<- error) {
 	fv.ReceiveBlocksCalled++
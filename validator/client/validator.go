@@ -32,8 +32,10 @@ import (
 	vdb "github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/db/kv"
 	"github.com/prysmaticlabs/prysm/validator/graffiti"
+	"github.com/prysmaticlabs/prysm/validator/keyconfig"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	slashingiface "github.com/prysmaticlabs/prysm/validator/slashing-protection/iface"
+	"github.com/prysmaticlabs/prysm/validator/slashing-protection/remote"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 	"google.golang.org/protobuf/proto"
@@ -85,6 +87,9 @@ type validator struct {
 	graffitiStruct                     *graffiti.Graffiti
 	graffitiOrderedIndex               uint64
 	eipImportBlacklistedPublicKeys     map[[48]byte]bool
+	doppelGanger                       *doppelGangerTracker
+	keyConfig                          *keyconfig.Store
+	observer                           *dutyObserver
 }
 
 type validatorStatus struct {
@@ -388,7 +393,9 @@ func (v *validator) CheckDoppelGanger(ctx context.Context) error {
 	req := &ethpb.DoppelGangerRequest{ValidatorRequests: []*ethpb.DoppelGangerRequest_ValidatorRequest{}}
 	for _, pkey := range pubkeys {
 		attRec, err := v.db.AttestationHistoryForPubKey(ctx, pkey)
-		if err != nil {
+		// A remote slashing protection server keeps the history out of reach of the client,
+		// so the key is checked as one without history.
+		if err != nil && !errors.Is(err, remote.ErrRemoteHistory) {
 			return err
 		}
 		if len(attRec) == 0 {
@@ -514,6 +521,9 @@ func (v *validator) UpdateDuties(ctx context.Context, slot types.Slot) error {
 
 	v.duties = resp
 	v.logDuties(slot, v.duties.CurrentEpochDuties)
+	if v.doppelGanger != nil {
+		v.doppelGanger.track(v.doppelGangerCheckedDuties(resp.CurrentEpochDuties), helpers.SlotToEpoch(slot))
+	}
 
 	// Non-blocking call for beacon node to start subscriptions for aggregators.
 	go func() {
@@ -594,6 +604,7 @@ func (v *validator) subscribeToSubnets(ctx context.Context, res *ethpb.DutiesRes
 // RolesAt slot returns the validator roles at the given slot. Returns nil if the
 // validator is known to not have a roles at the slot. Returns UNKNOWN if the
 // validator assignments are unknown. Otherwise returns a valid ValidatorRole map.
+// Keys held back by doppelganger protection are left out.
 func (v *validator) RolesAt(ctx context.Context, slot types.Slot) (map[[48]byte][]iface.ValidatorRole, error) {
 	rolesAt := make(map[[48]byte][]iface.ValidatorRole)
 	for _, duty := range v.duties.Duties {
@@ -602,7 +613,15 @@ func (v *validator) RolesAt(ctx context.Context, slot types.Slot) (map[[48]byte]
 		if duty == nil {
 			continue
 		}
-		if len(duty.ProposerSlots) > 0 {
+		pubKey := bytesutil.ToBytes48(duty.PublicKey)
+		settings := v.keySettings(pubKey)
+		if !settings.Enabled {
+			continue
+		}
+		if settings.DoppelGangerCheck && v.doppelGanger != nil && !v.doppelGanger.canPerformDuties(pubKey) {
+			continue
+		}
+		if len(duty.ProposerSlots) > 0 && settings.Propose {
 			for _, proposerSlot := range duty.ProposerSlots {
 				if proposerSlot != 0 && proposerSlot == slot {
 					roles = append(roles, iface.RoleProposer)
@@ -610,10 +629,10 @@ func (v *validator) RolesAt(ctx context.Context, slot types.Slot) (map[[48]byte]
 				}
 			}
 		}
-		if duty.AttesterSlot == slot {
+		if duty.AttesterSlot == slot && settings.Attest {
 			roles = append(roles, iface.RoleAttester)
 
-			aggregator, err := v.isAggregator(ctx, duty.Committee, slot, bytesutil.ToBytes48(duty.PublicKey))
+			aggregator, err := v.isAggregator(ctx, duty.Committee, slot, pubKey)
 			if err != nil {
 				return nil, errors.Wrap(err, "could not check if a validator is an aggregator")
 			}
@@ -626,13 +645,35 @@ func (v *validator) RolesAt(ctx context.Context, slot types.Slot) (map[[48]byte]
 			roles = append(roles, iface.RoleUnknown)
 		}
 
-		var pubKey [48]byte
-		copy(pubKey[:], duty.PublicKey)
 		rolesAt[pubKey] = roles
 	}
 	return rolesAt, nil
 }
 
+// keySettings returns the settings of a validating key from the key configuration file,
+// or the default settings if no file is used.
+func (v *validator) keySettings(pubKey [48]byte) *keyconfig.Settings {
+	if v.keyConfig == nil {
+		return keyconfig.DefaultSettings()
+	}
+	return v.keyConfig.Settings(pubKey)
+}
+
+// doppelGangerCheckedDuties filters out the duties of keys which are exempted from the
+// doppelganger check by the key configuration file.
+func (v *validator) doppelGangerCheckedDuties(duties []*ethpb.DutiesResponse_Duty) []*ethpb.DutiesResponse_Duty {
+	if v.keyConfig == nil {
+		return duties
+	}
+	checked := make([]*ethpb.DutiesResponse_Duty, 0, len(duties))
+	for _, duty := range duties {
+		if duty != nil && v.keyConfig.Settings(bytesutil.ToBytes48(duty.PublicKey)).DoppelGangerCheck {
+			checked = append(checked, duty)
+		}
+	}
+	return checked
+}
+
 // GetKeymanager returns the underlying validator's keymanager.
 func (v *validator) GetKeymanager() keymanager.IKeymanager {
 	return v.keyManager
@@ -641,6 +682,10 @@ func (v *validator) GetKeymanager() keymanager.IKeymanager {
 // isAggregator checks if a validator is an aggregator of a given slot and committee,
 // it uses a modulo calculated by validator count in committee and samples randomness around it.
 func (v *validator) isAggregator(ctx context.Context, committee []types.ValidatorIndex, slot types.Slot, pubKey [48]byte) (bool, error) {
+	// Selection proofs are signatures, which keys in observer mode never make.
+	if v.observer != nil {
+		return false, nil
+	}
 	modulo := uint64(1)
 	if len(committee)/int(params.BeaconConfig().TargetAggregatorsPerCommittee) > 1 {
 		modulo = uint64(len(committee)) / params.BeaconConfig().TargetAggregatorsPerCommittee
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"path/filepath"
 	"sync"
 	"testing"
 	"time"
@@ -24,6 +25,8 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	"github.com/prysmaticlabs/prysm/validator/client/iface"
 	dbTest "github.com/prysmaticlabs/prysm/validator/db/testing"
+	"github.com/prysmaticlabs/prysm/validator/keyconfig"
+	"github.com/prysmaticlabs/prysm/validator/slashing-protection/remote"
 	"github.com/sirupsen/logrus"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 	grpc "google.golang.org/grpc"
@@ -682,6 +685,52 @@ func TestRolesAt_DoesNotAssignProposer_Slot0(t *testing.T) {
 	assert.Equal(t, iface.RoleAttester, roleMap[bytesutil.ToBytes48(validatorKey.PublicKey().Marshal())][0])
 }
 
+func TestRolesAt_KeyConfig(t *testing.T) {
+	v, m, validatorKey, finish := setup(t)
+	defer finish()
+	pubKey := bytesutil.ToBytes48(validatorKey.PublicKey().Marshal())
+	disabledKey := [48]byte{1}
+	pendingKey := [48]byte{2}
+
+	store, err := keyconfig.NewStore(filepath.Join(t.TempDir(), "keys.yaml"))
+	require.NoError(t, err)
+	no := false
+	require.NoError(t, store.SetKey(pubKey, &keyconfig.KeyConfig{Propose: &no}))
+	require.NoError(t, store.SetKey(disabledKey, &keyconfig.KeyConfig{Enabled: &no}))
+	require.NoError(t, store.SetKey(pendingKey, &keyconfig.KeyConfig{DoppelGangerCheck: &no, Attest: &no}))
+	v.keyConfig = store
+	v.doppelGanger = newDoppelGangerTracker(2, false)
+	v.doppelGanger.track([]*ethpb.DutiesResponse_Duty{
+		{PublicKey: pendingKey[:], Status: ethpb.ValidatorStatus_ACTIVE},
+	}, 0)
+
+	v.duties = &ethpb.DutiesResponse{
+		Duties: []*ethpb.DutiesResponse_Duty{
+			{AttesterSlot: 1, ProposerSlots: []types.Slot{1}, PublicKey: pubKey[:]},
+			{AttesterSlot: 1, ProposerSlots: []types.Slot{1}, PublicKey: disabledKey[:]},
+			{AttesterSlot: 1, ProposerSlots: []types.Slot{1}, PublicKey: pendingKey[:]},
+		},
+	}
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), // epoch
+	).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/)
+
+	roleMap, err := v.RolesAt(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(roleMap))
+	assert.Equal(t, iface.RoleAttester, roleMap[pubKey][0])
+	for _, role := range roleMap[pubKey] {
+		assert.NotEqual(t, iface.RoleProposer, role)
+	}
+	// The key exempted from the doppelganger check performs its duties while the check is pending.
+	assert.DeepEqual(t, []iface.ValidatorRole{iface.RoleProposer}, roleMap[pendingKey])
+
+	// Keys exempted from the doppelganger check are not tracked.
+	duties := []*ethpb.DutiesResponse_Duty{{PublicKey: pubKey[:]}, {PublicKey: pendingKey[:]}}
+	assert.DeepEqual(t, duties[:1], v.doppelGangerCheckedDuties(duties))
+}
+
 func TestCheckAndLogValidatorStatus_OK(t *testing.T) {
 	nonexistentIndex := types.ValidatorIndex(^uint64(0))
 	type statusTest struct {
@@ -1181,6 +1230,41 @@ func TestValidator_CheckDoppelGanger(t *testing.T) {
 	}
 }
 
+func TestValidator_CheckDoppelGanger_RemoteSlashingProtection(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	flgs := featureconfig.Get()
+	flgs.EnableDoppelGanger = true
+	reset := featureconfig.InitWithReset(flgs)
+	defer reset()
+
+	pubKey := [48]byte{1}
+	km := &mockKeymanager{keysMap: map[[48]byte]bls.SecretKey{pubKey: nil}}
+	// The history is kept by the server, which the doppelganger check never reaches.
+	db, err := remote.NewDB(context.Background(), dbTest.SetupDB(t, [][48]byte{pubKey}), &remote.DBConfig{
+		Endpoint: "127.0.0.1:0",
+	})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	client.EXPECT().CheckDoppelGanger(
+		gomock.Any(), // ctx
+		&ethpb.DoppelGangerRequest{ValidatorRequests: []*ethpb.DoppelGangerRequest_ValidatorRequest{
+			{PublicKey: pubKey[:], SignedRoot: make([]byte, 32), Epoch: 0},
+		}},
+	).Return(&ethpb.DoppelGangerResponse{Responses: []*ethpb.DoppelGangerResponse_ValidatorResponse{
+		{PublicKey: pubKey[:], DuplicateExists: false},
+	}}, nil /*err*/)
+	v := &validator{
+		validatorClient: client,
+		keyManager:      km,
+		db:              db,
+	}
+	require.NoError(t, v.CheckDoppelGanger(context.Background()))
+}
+
 func TestValidatorAttestationsAreOrdered(t *testing.T) {
 	km := genMockKeymanager(10)
 	keys, err := km.FetchValidatingPublicKeys(context.Background())
@@ -6,3 +6,7 @@ import "github.com/prysmaticlabs/prysm/validator/db/iface"
 // key-value or relational database in practice. This is the full database interface which should
 // not be used often. Prefer a more restrictive interface in this package.
 type Database = iface.ValidatorDB
+
+// AtomicSlashingProtector is implemented by databases which check and record signing requests
+// in a single step, such as a slashing protection service shared by several validator clients.
+type AtomicSlashingProtector = iface.AtomicSlashingProtector
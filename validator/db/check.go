@@ -0,0 +1,156 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// Check the integrity of a validator database, reporting inconsistencies per public key.
+// With the repair flag, the lowest and highest signed buckets are raised to the recorded
+// history before checking again. An error is returned if inconsistencies remain.
+func Check(cliCtx *cli.Context) error {
+	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
+	if !fileutil.FileExists(path.Join(dataDir, kv.ProtectionDbFileName)) {
+		return errors.New("No validator db found at path, nothing to check")
+	}
+
+	ctx := context.Background()
+	validatorDB, err := kv.NewKVStore(ctx, dataDir, &kv.Config{})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := validatorDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close database")
+		}
+	}()
+
+	issues, err := validatorDB.CheckIntegrity(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not check database")
+	}
+	inconsistencies := logIssues(issues)
+	if inconsistencies > 0 && cliCtx.Bool(cmd.RepairDBFlag.Name) {
+		repaired, err := validatorDB.RepairDerivedBuckets(ctx)
+		if err != nil {
+			return errors.Wrap(err, "could not repair database")
+		}
+		log.WithField("keys", repaired).Info("Raised lowest and highest signed buckets")
+		issues, err = validatorDB.CheckIntegrity(ctx)
+		if err != nil {
+			return errors.Wrap(err, "could not check database")
+		}
+		inconsistencies = logIssues(issues)
+	}
+	if inconsistencies > 0 {
+		return fmt.Errorf("found %d inconsistencies in the database", inconsistencies)
+	}
+	log.Info("No inconsistencies found in the database")
+	return nil
+}
+
+// Compact a validator database: attestation history older than the slashing protection
+// pruning horizon is deleted, the lowest signed buckets are raised to the remaining history
+// so pruned attestations can never be signed again, and the file is rewritten to reclaim
+// the freed space. The original file is first copied next to it with a .bak extension, and
+// compaction is refused while a previous backup is there.
+func Compact(cliCtx *cli.Context) error {
+	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
+	dbPath := path.Join(dataDir, kv.ProtectionDbFileName)
+	if !fileutil.FileExists(dbPath) {
+		return errors.New("No validator db found at path, nothing to compact")
+	}
+	backupPath := dbPath + ".bak"
+	if fileutil.FileExists(backupPath) {
+		return fmt.Errorf("backup %s of a previous compaction exists, move it elsewhere to compact again", backupPath)
+	}
+	before, err := os.Stat(dbPath)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	validatorDB, err := kv.NewKVStore(ctx, dataDir, &kv.Config{})
+	if err != nil {
+		return err
+	}
+	compactedPath := dbPath + ".compact"
+	if err := compact(ctx, validatorDB, backupPath, compactedPath); err != nil {
+		if closeErr := validatorDB.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close database")
+		}
+		if removeErr := os.RemoveAll(compactedPath); removeErr != nil {
+			log.WithError(removeErr).Error("Could not remove compacted database")
+		}
+		return err
+	}
+	if err := validatorDB.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(compactedPath, dbPath); err != nil {
+		return errors.Wrap(err, "could not replace database with compacted database")
+	}
+	after, err := os.Stat(dbPath)
+	if err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"sizeBefore": before.Size(),
+		"sizeAfter":  after.Size(),
+	}).Info("Compacted database")
+	return nil
+}
+
+func compact(ctx context.Context, validatorDB *kv.Store, backupPath, compactedPath string) error {
+	issues, err := validatorDB.CheckIntegrity(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not check database")
+	}
+	for _, issue := range issues {
+		if !issue.Repairable && !issue.Informational {
+			logIssues(issues)
+			return errors.New("database has inconsistencies which cannot be repaired, refusing to compact")
+		}
+	}
+	if err := os.RemoveAll(compactedPath); err != nil {
+		return err
+	}
+	// The history is pruned in the original file, so it is backed up first.
+	if err := validatorDB.CopyTo(ctx, backupPath); err != nil {
+		return errors.Wrap(err, "could not back up database")
+	}
+	log.WithField("path", backupPath).Info("Backed up database")
+	log.Info("Pruning attestation history")
+	if err := validatorDB.PruneAttestations(ctx); err != nil {
+		return errors.Wrap(err, "could not prune attestation history")
+	}
+	if _, err := validatorDB.RepairDerivedBuckets(ctx); err != nil {
+		return errors.Wrap(err, "could not update lowest signed buckets")
+	}
+	log.Info("Rewriting database file")
+	return validatorDB.CompactTo(ctx, compactedPath)
+}
+
+// logIssues logs the issues and returns how many of them are inconsistencies.
+func logIssues(issues []*kv.IntegrityIssue) int {
+	inconsistencies := 0
+	for _, issue := range issues {
+		entry := log.WithField("publicKey", fmt.Sprintf("%#x", issue.PubKey))
+		if issue.Informational {
+			entry.Info(issue.Description)
+			continue
+		}
+		inconsistencies++
+		entry.WithField("repairable", issue.Repairable).Warn(issue.Description)
+	}
+	return inconsistencies
+}
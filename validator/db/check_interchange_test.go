@@ -0,0 +1,92 @@
+package db_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	interchangeformat "github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format"
+	"github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format/format"
+	"github.com/urfave/cli/v2"
+)
+
+func cliContext(t *testing.T, dataDir string, repair bool) *cli.Context {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(cmd.DataDirFlag.Name, dataDir, "")
+	set.Bool(cmd.RepairDBFlag.Name, repair, "")
+	require.NoError(t, set.Set(cmd.DataDirFlag.Name, dataDir))
+	return cli.NewContext(&app, set, nil)
+}
+
+// The lowest signed epochs and slot raised by a minimal import above an older history are
+// kept by checking, repairing and compacting the database.
+func TestCheckAndCompact_KeepMinimalImportFloors(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	dataDir := t.TempDir()
+	validatorDB, err := kv.NewKVStore(ctx, dataDir, &kv.Config{PubKeys: [][48]byte{pubKey}})
+	require.NoError(t, err)
+	require.NoError(t, validatorDB.SaveAttestationForPubKey(ctx, pubKey, [32]byte{1}, &ethpb.IndexedAttestation{
+		Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: 1},
+			Target: &ethpb.Checkpoint{Epoch: 2},
+		},
+	}))
+	require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubKey, 2, []byte{31: 1}))
+
+	interchange := &format.EIPSlashingProtectionFormat{Data: []*format.ProtectionData{{
+		Pubkey:       fmt.Sprintf("%#x", pubKey),
+		SignedBlocks: []*format.SignedBlock{{Slot: "50"}, {Slot: "90"}},
+		SignedAttestations: []*format.SignedAttestation{
+			{SourceEpoch: "11", TargetEpoch: "12"},
+			{SourceEpoch: "99", TargetEpoch: "100"},
+		},
+	}}}
+	interchange.Metadata.InterchangeFormatVersion = format.InterchangeFormatVersion
+	interchange.Metadata.GenesisValidatorsRoot = fmt.Sprintf("%#x", [32]byte{1})
+	enc, err := json.Marshal(interchange)
+	require.NoError(t, err)
+	require.NoError(t, interchangeformat.ImportMinimalStandardProtectionJSON(ctx, validatorDB, bytes.NewReader(enc)))
+
+	issues, err := validatorDB.CheckIntegrity(ctx)
+	require.NoError(t, err)
+	for _, issue := range issues {
+		assert.Equal(t, false, issue.Repairable, issue.Description)
+		assert.Equal(t, true, issue.Informational, issue.Description)
+	}
+	require.NoError(t, validatorDB.Close())
+
+	requireFloors := func() {
+		validatorDB, err := kv.NewKVStore(ctx, dataDir, &kv.Config{})
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, validatorDB.Close())
+		}()
+		source, _, err := validatorDB.LowestSignedSourceEpoch(ctx, pubKey)
+		require.NoError(t, err)
+		assert.Equal(t, types.Epoch(99), source)
+		target, _, err := validatorDB.LowestSignedTargetEpoch(ctx, pubKey)
+		require.NoError(t, err)
+		assert.Equal(t, types.Epoch(100), target)
+		slot, _, err := validatorDB.LowestSignedProposal(ctx, pubKey)
+		require.NoError(t, err)
+		assert.Equal(t, types.Slot(90), slot)
+	}
+	require.NoError(t, db.Check(cliContext(t, dataDir, false)))
+	require.NoError(t, db.Check(cliContext(t, dataDir, true)))
+	requireFloors()
+	require.NoError(t, db.Compact(cliContext(t, dataDir, false)))
+	requireFloors()
+	require.NoError(t, db.Check(cliContext(t, dataDir, false)))
+}
@@ -0,0 +1,115 @@
+package db
+
+import (
+	"context"
+	"flag"
+	"path"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/urfave/cli/v2"
+	bolt "go.etcd.io/bbolt"
+)
+
+func checkCliContext(t *testing.T, dataDir string, repair bool) *cli.Context {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(cmd.DataDirFlag.Name, dataDir, "")
+	set.Bool(cmd.RepairDBFlag.Name, repair, "")
+	require.NoError(t, set.Set(cmd.DataDirFlag.Name, dataDir))
+	return cli.NewContext(&app, set, nil)
+}
+
+// setupCorruptedDB saves an attestation and deletes its lowest signed target epoch.
+func setupCorruptedDB(t *testing.T) string {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	dataDir := t.TempDir()
+	validatorDB, err := kv.NewKVStore(ctx, dataDir, &kv.Config{PubKeys: [][48]byte{pubKey}})
+	require.NoError(t, err)
+	require.NoError(t, validatorDB.SaveAttestationForPubKey(ctx, pubKey, [32]byte{1}, &ethpb.IndexedAttestation{
+		Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: 1},
+			Target: &ethpb.Checkpoint{Epoch: 2},
+		},
+	}))
+	require.NoError(t, validatorDB.Close())
+
+	boltDB, err := bolt.Open(path.Join(dataDir, kv.ProtectionDbFileName), 0600, nil)
+	require.NoError(t, err)
+	require.NoError(t, boltDB.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte("lowest-signed-target-bucket")).Delete(pubKey[:])
+	}))
+	require.NoError(t, boltDB.Close())
+	return dataDir
+}
+
+func TestCheck_NoDBFound(t *testing.T) {
+	err := Check(checkCliContext(t, t.TempDir(), false))
+	assert.ErrorContains(t, "No validator db found at path", err)
+}
+
+func TestCheck_Repair(t *testing.T) {
+	dataDir := setupCorruptedDB(t)
+	err := Check(checkCliContext(t, dataDir, false))
+	assert.ErrorContains(t, "found 1 inconsistencies", err)
+
+	require.NoError(t, Check(checkCliContext(t, dataDir, true)))
+	require.NoError(t, Check(checkCliContext(t, dataDir, false)))
+
+	validatorDB, err := kv.NewKVStore(context.Background(), dataDir, &kv.Config{})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, validatorDB.Close())
+	}()
+	target, _, err := validatorDB.LowestSignedTargetEpoch(context.Background(), [48]byte{1})
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(2), target)
+}
+
+func TestCompact(t *testing.T) {
+	dataDir := setupCorruptedDB(t)
+	require.NoError(t, Compact(checkCliContext(t, dataDir, false)))
+	require.NoError(t, Check(checkCliContext(t, dataDir, false)))
+
+	// The backup keeps the database from before the compaction, which restored the lowest
+	// signed target epoch.
+	backupDir := t.TempDir()
+	require.NoError(t, fileutil.CopyFile(
+		path.Join(dataDir, kv.ProtectionDbFileName+".bak"),
+		path.Join(backupDir, kv.ProtectionDbFileName),
+	))
+	backupDB, err := kv.NewKVStore(context.Background(), backupDir, &kv.Config{})
+	require.NoError(t, err)
+	_, exists, err := backupDB.LowestSignedTargetEpoch(context.Background(), [48]byte{1})
+	require.NoError(t, err)
+	assert.Equal(t, false, exists)
+	require.NoError(t, backupDB.Close())
+
+	validatorDB, err := kv.NewKVStore(context.Background(), dataDir, &kv.Config{})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, validatorDB.Close())
+	}()
+	history, err := validatorDB.AttestationHistoryForPubKey(context.Background(), [48]byte{1})
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(history))
+}
+
+func TestCompact_BackupExists(t *testing.T) {
+	dataDir := setupCorruptedDB(t)
+	backupPath := path.Join(dataDir, kv.ProtectionDbFileName+".bak")
+	require.NoError(t, fileutil.WriteFile(backupPath, []byte("previous backup")))
+
+	err := Compact(checkCliContext(t, dataDir, false))
+	assert.ErrorContains(t, "of a previous compaction exists", err)
+	backup, err := fileutil.ReadFileAsBytes(backupPath)
+	require.NoError(t, err)
+	assert.Equal(t, "previous backup", string(backup))
+}
@@ -31,6 +31,7 @@ type ValidatorDB interface {
 	// Proposer protection related methods.
 	HighestSignedProposal(ctx context.Context, publicKey [48]byte) (types.Slot, bool, error)
 	LowestSignedProposal(ctx context.Context, publicKey [48]byte) (types.Slot, bool, error)
+	RaiseLowestSignedProposal(ctx context.Context, publicKey [48]byte, slot types.Slot) error
 	ProposalHistoryForPubKey(ctx context.Context, publicKey [48]byte) ([]*kv.Proposal, error)
 	ProposalHistoryForSlot(ctx context.Context, publicKey [48]byte, slot types.Slot) ([32]byte, bool, error)
 	SaveProposalHistoryForSlot(ctx context.Context, pubKey [48]byte, slot types.Slot, signingRoot []byte) error
@@ -44,6 +45,7 @@ type ValidatorDB interface {
 	SigningRootAtTargetEpoch(ctx context.Context, publicKey [48]byte, target types.Epoch) ([32]byte, error)
 	LowestSignedTargetEpoch(ctx context.Context, publicKey [48]byte) (types.Epoch, bool, error)
 	LowestSignedSourceEpoch(ctx context.Context, publicKey [48]byte) (types.Epoch, bool, error)
+	RaiseLowestSignedEpochs(ctx context.Context, publicKey [48]byte, source, target types.Epoch) error
 	AttestedPublicKeys(ctx context.Context) ([][48]byte, error)
 	CheckSlashableAttestation(
 		ctx context.Context, pubKey [48]byte, signingRoot [32]byte, att *ethpb.IndexedAttestation,
@@ -62,3 +64,13 @@ type ValidatorDB interface {
 	SaveGraffitiOrderedIndex(ctx context.Context, index uint64) error
 	GraffitiOrderedIndex(ctx context.Context, fileHash [32]byte) (uint64, error)
 }
+
+// AtomicSlashingProtector is implemented by validator databases which check a signing
+// request against the slashing protection history and record it in a single step, such
+// as a slashing protection service shared by several validator clients.
+type AtomicSlashingProtector interface {
+	CheckAndSaveAttestation(
+		ctx context.Context, pubKey [48]byte, signingRoot [32]byte, att *ethpb.IndexedAttestation,
+	) (kv.SlashingKind, error)
+	CheckAndSaveProposal(ctx context.Context, pubKey [48]byte, slot types.Slot, signingRoot [32]byte) error
+}
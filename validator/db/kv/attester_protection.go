@@ -576,3 +576,29 @@ func (s *Store) LowestSignedTargetEpoch(ctx context.Context, publicKey [48]byte)
 	})
 	return lowestSignedTargetEpoch, exists, err
 }
+
+// RaiseLowestSignedEpochs sets the lowest signed source and target epochs for a validator public
+// key to the given epochs, unless they are already higher. This is used when a minimal slashing
+// protection history is imported, so that nothing below the imported epochs is signed anymore.
+func (s *Store) RaiseLowestSignedEpochs(ctx context.Context, publicKey [48]byte, source, target types.Epoch) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.RaiseLowestSignedEpochs")
+	defer span.End()
+
+	return s.update(func(tx *bolt.Tx) error {
+		if err := raiseEpoch(tx.Bucket(lowestSignedSourceBucket), publicKey, source); err != nil {
+			return errors.Wrapf(err, "could not save lowest signed source epoch %d", source)
+		}
+		if err := raiseEpoch(tx.Bucket(lowestSignedTargetBucket), publicKey, target); err != nil {
+			return errors.Wrapf(err, "could not save lowest signed target epoch %d", target)
+		}
+		return nil
+	})
+}
+
+func raiseEpoch(bucket *bolt.Bucket, publicKey [48]byte, epoch types.Epoch) error {
+	existing := bucket.Get(publicKey[:])
+	if len(existing) >= 8 && bytesutil.BytesToEpochBigEndian(existing) >= epoch {
+		return nil
+	}
+	return bucket.Put(publicKey[:], bytesutil.EpochToBytesBigEndian(epoch))
+}
@@ -270,6 +270,41 @@ func TestLowestSignedSourceEpoch_SaveRetrieve(t *testing.T) {
 	require.Equal(t, types.Epoch(199), got)
 }
 
+func TestStore_RaiseLowestSignedEpochs(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	validatorDB := setupDB(t, [][48]byte{pubKey})
+
+	// Can save when there is no history.
+	require.NoError(t, validatorDB.RaiseLowestSignedEpochs(ctx, pubKey, 10, 11))
+	source, exists, err := validatorDB.LowestSignedSourceEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	require.Equal(t, types.Epoch(10), source)
+	target, exists, err := validatorDB.LowestSignedTargetEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	require.Equal(t, types.Epoch(11), target)
+
+	// Can raise.
+	require.NoError(t, validatorDB.RaiseLowestSignedEpochs(ctx, pubKey, 20, 21))
+	source, _, err = validatorDB.LowestSignedSourceEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, types.Epoch(20), source)
+	target, _, err = validatorDB.LowestSignedTargetEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, types.Epoch(21), target)
+
+	// Can not lower.
+	require.NoError(t, validatorDB.RaiseLowestSignedEpochs(ctx, pubKey, 5, 6))
+	source, _, err = validatorDB.LowestSignedSourceEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, types.Epoch(20), source)
+	target, _, err = validatorDB.LowestSignedTargetEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, types.Epoch(21), target)
+}
+
 func TestLowestSignedTargetEpoch_SaveRetrieveReplace(t *testing.T) {
 	ctx := context.Background()
 	validatorDB, err := NewKVStore(ctx, t.TempDir(), &Config{})
@@ -0,0 +1,84 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// CompactTo writes a copy of the database to the given file, which must not exist. Bolt never
+// shrinks its file when data is deleted, so the copy is the way to reclaim the space freed
+// by pruning. Nested buckets are copied along with their contents.
+func (s *Store) CompactTo(ctx context.Context, outputPath string) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.CompactTo")
+	defer span.End()
+
+	if fileutil.FileExists(outputPath) {
+		return fmt.Errorf("file %s already exists", outputPath)
+	}
+	copyDB, err := bolt.Open(
+		outputPath,
+		params.BeaconIoConfig().ReadWritePermissions,
+		&bolt.Options{Timeout: params.BeaconIoConfig().BoltTimeout},
+	)
+	if err != nil {
+		return err
+	}
+	err = s.db.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			log.Debugf("Copying bucket %s", name)
+			return copyDB.Update(func(tx2 *bolt.Tx) error {
+				b2, err := tx2.CreateBucketIfNotExists(name)
+				if err != nil {
+					return err
+				}
+				return copyBucket(b, b2)
+			})
+		})
+	})
+	if err != nil {
+		if closeErr := copyDB.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Failed to close compacted database")
+		}
+		return errors.Wrap(err, "could not copy database")
+	}
+	return copyDB.Close()
+}
+
+// CopyTo writes a snapshot of the database file to the given file, which must not exist. The
+// snapshot is taken in a read transaction, so it is consistent while the database is in use.
+func (s *Store) CopyTo(ctx context.Context, outputPath string) error {
+	_, span := trace.StartSpan(ctx, "Validator.CopyTo")
+	defer span.End()
+
+	if fileutil.FileExists(outputPath) {
+		return fmt.Errorf("file %s already exists", outputPath)
+	}
+	return s.db.View(func(tx *bolt.Tx) error {
+		return tx.CopyFile(outputPath, params.BeaconIoConfig().ReadWritePermissions)
+	})
+}
+
+func copyBucket(src, dst *bolt.Bucket) error {
+	// Bolt fills pages to the configured ratio on sequential inserts, a full fill
+	// gives the smallest file for data written in key order.
+	dst.FillPercent = 1.0
+	return src.ForEach(func(k, v []byte) error {
+		if v != nil {
+			return dst.Put(k, v)
+		}
+		nested, err := dst.CreateBucketIfNotExists(k)
+		if err != nil {
+			return err
+		}
+		return copyBucket(src.Bucket(k), nested)
+	})
+}
@@ -0,0 +1,40 @@
+package kv
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestStore_CompactTo(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	validatorDB := setupDB(t, [][48]byte{pubKey})
+	setupHistory(t, validatorDB, pubKey, []types.Epoch{1, 2, 5}, []types.Slot{10, 12})
+
+	outputDir := t.TempDir()
+	require.NoError(t, validatorDB.CompactTo(ctx, filepath.Join(outputDir, ProtectionDbFileName)))
+
+	// The compacted file is opened without registering the metrics of a second store.
+	boltDB, err := bolt.Open(filepath.Join(outputDir, ProtectionDbFileName), 0600, nil)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, boltDB.Close())
+	}()
+	compacted := &Store{db: boltDB}
+	requireIssues(t, compacted)
+	history, err := compacted.AttestationHistoryForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(history))
+	proposals, err := compacted.ProposalHistoryForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(proposals))
+
+	err = validatorDB.CompactTo(ctx, filepath.Join(outputDir, ProtectionDbFileName))
+	assert.ErrorContains(t, "already exists", err)
+}
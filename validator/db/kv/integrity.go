@@ -0,0 +1,326 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// IntegrityIssue is an inconsistency found in the slashing protection history of a public key.
+type IntegrityIssue struct {
+	PubKey      [48]byte
+	Description string
+	// Repairable issues concern the lowest and highest signed buckets, which are derived
+	// from the recorded history and can be raised to it.
+	Repairable bool
+	// Informational issues are not inconsistencies, such as lowest signed values raised
+	// above the recorded history on purpose by a minimal import.
+	Informational bool
+}
+
+// historyBounds are the lowest and highest epochs and slots in the recorded history of a key.
+type historyBounds struct {
+	hasSources   bool
+	minSource    types.Epoch
+	hasTargets   bool
+	minTarget    types.Epoch
+	hasProposals bool
+	minSlot      types.Slot
+	maxSlot      types.Slot
+}
+
+// CheckIntegrity verifies that the slashing protection history of every public key in the
+// database is internally consistent: the source and target epoch indices of attestations
+// reference each other, every attested target epoch has a signing root, every proposal has a
+// signing root, and the lowest and highest signed buckets match the recorded history.
+// Lowest signed epochs and slots below the recorded history are expected once history is
+// pruned, and are not reported. Lowest signed epochs and slots above it are reported as
+// informational, as they refuse more than the history does.
+func (s *Store) CheckIntegrity(ctx context.Context) ([]*IntegrityIssue, error) {
+	_, span := trace.StartSpan(ctx, "Validator.CheckIntegrity")
+	defer span.End()
+
+	var issues []*IntegrityIssue
+	err := s.view(func(tx *bolt.Tx) error {
+		for _, pubKey := range historyPublicKeys(tx) {
+			keyIssues, bounds := checkAttestingHistory(tx, pubKey)
+			issues = append(issues, keyIssues...)
+			keyIssues = checkProposalHistory(tx, pubKey, bounds)
+			issues = append(issues, keyIssues...)
+			issues = append(issues, checkDerivedBuckets(tx, pubKey, bounds)...)
+		}
+		return nil
+	})
+	return issues, err
+}
+
+// RepairDerivedBuckets raises the lowest signed source and target epochs and the lowest and
+// highest signed proposal slots of every public key to its recorded history, returning the
+// number of keys whose buckets changed. The buckets are never lowered, so values above the
+// recorded history remain in force, and keys without recorded history are left untouched.
+func (s *Store) RepairDerivedBuckets(ctx context.Context) (int, error) {
+	_, span := trace.StartSpan(ctx, "Validator.RepairDerivedBuckets")
+	defer span.End()
+
+	repaired := 0
+	err := s.update(func(tx *bolt.Tx) error {
+		for _, pubKey := range historyPublicKeys(tx) {
+			_, bounds := checkAttestingHistory(tx, pubKey)
+			checkProposalHistory(tx, pubKey, bounds)
+			changed := false
+			raise := func(bucketName []byte, value uint64) error {
+				bkt := tx.Bucket(bucketName)
+				existing := bkt.Get(pubKey[:])
+				if len(existing) >= 8 && bytesutil.BytesToUint64BigEndian(existing) >= value {
+					return nil
+				}
+				changed = true
+				return bkt.Put(pubKey[:], bytesutil.Uint64ToBytesBigEndian(value))
+			}
+			if bounds.hasSources {
+				if err := raise(lowestSignedSourceBucket, uint64(bounds.minSource)); err != nil {
+					return err
+				}
+			}
+			if bounds.hasTargets {
+				if err := raise(lowestSignedTargetBucket, uint64(bounds.minTarget)); err != nil {
+					return err
+				}
+			}
+			if bounds.hasProposals {
+				if err := raise(lowestSignedProposalsBucket, uint64(bounds.minSlot)); err != nil {
+					return err
+				}
+				if err := raise(highestSignedProposalsBucket, uint64(bounds.maxSlot)); err != nil {
+					return err
+				}
+			}
+			if changed {
+				repaired++
+			}
+		}
+		return nil
+	})
+	return repaired, err
+}
+
+// historyPublicKeys returns every public key with slashing protection data, sorted.
+func historyPublicKeys(tx *bolt.Tx) [][48]byte {
+	seen := make(map[[48]byte]bool)
+	collect := func(bucketName []byte) {
+		bkt := tx.Bucket(bucketName)
+		if bkt == nil {
+			return
+		}
+		_ = bkt.ForEach(func(k, _ []byte) error {
+			if len(k) == 48 {
+				seen[bytesutil.ToBytes48(k)] = true
+			}
+			return nil
+		})
+	}
+	collect(pubKeysBucket)
+	collect(historicProposalsBucket)
+	collect(lowestSignedSourceBucket)
+	collect(lowestSignedTargetBucket)
+	collect(lowestSignedProposalsBucket)
+	collect(highestSignedProposalsBucket)
+	keys := make([][48]byte, 0, len(seen))
+	for k := range seen {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i][:], keys[j][:]) < 0
+	})
+	return keys
+}
+
+func checkAttestingHistory(tx *bolt.Tx, pubKey [48]byte) ([]*IntegrityIssue, *historyBounds) {
+	bounds := &historyBounds{}
+	var issues []*IntegrityIssue
+	report := func(format string, args ...interface{}) {
+		issues = append(issues, &IntegrityIssue{PubKey: pubKey, Description: fmt.Sprintf(format, args...)})
+	}
+	pkBucket := tx.Bucket(pubKeysBucket).Bucket(pubKey[:])
+	if pkBucket == nil {
+		return nil, bounds
+	}
+	signingRootsBucket := pkBucket.Bucket(attestationSigningRootsBucket)
+	sourceEpochsBucket := pkBucket.Bucket(attestationSourceEpochsBucket)
+	targetEpochsBucket := pkBucket.Bucket(attestationTargetEpochsBucket)
+
+	// The buckets are pruned independently, so references to epochs below the retained
+	// range of the other bucket are expected to be missing.
+	sourceFloor, targetFloor := firstEpoch(sourceEpochsBucket), firstEpoch(targetEpochsBucket)
+	signingRootFloor := firstEpoch(signingRootsBucket)
+
+	if sourceEpochsBucket != nil {
+		_ = sourceEpochsBucket.ForEach(func(k, v []byte) error {
+			if len(k) != 8 || len(v)%8 != 0 {
+				report("malformed source epoch entry %#x", k)
+				return nil
+			}
+			source := bytesutil.BytesToEpochBigEndian(k)
+			if !bounds.hasSources || source < bounds.minSource {
+				bounds.minSource = source
+			}
+			bounds.hasSources = true
+			for i := 0; i < len(v); i += 8 {
+				target := bytesutil.BytesToEpochBigEndian(v[i : i+8])
+				if target < source {
+					report("attestation with source epoch %d has lower target epoch %d", source, target)
+				}
+				if target >= targetFloor && !containsEpoch(bucketGet(targetEpochsBucket, v[i:i+8]), source) {
+					report("attestation with source epoch %d and target epoch %d is missing from the target epochs", source, target)
+				}
+			}
+			return nil
+		})
+	}
+	if targetEpochsBucket != nil {
+		_ = targetEpochsBucket.ForEach(func(k, v []byte) error {
+			if len(k) != 8 || len(v)%8 != 0 {
+				report("malformed target epoch entry %#x", k)
+				return nil
+			}
+			target := bytesutil.BytesToEpochBigEndian(k)
+			if !bounds.hasTargets || target < bounds.minTarget {
+				bounds.minTarget = target
+			}
+			bounds.hasTargets = true
+			for i := 0; i < len(v); i += 8 {
+				source := bytesutil.BytesToEpochBigEndian(v[i : i+8])
+				if source >= sourceFloor && !containsEpoch(bucketGet(sourceEpochsBucket, v[i:i+8]), target) {
+					report("attestation with source epoch %d and target epoch %d is missing from the source epochs", source, target)
+				}
+			}
+			if target >= signingRootFloor && len(bucketGet(signingRootsBucket, k)) == 0 {
+				report("no signing root recorded for attestation with target epoch %d", target)
+			}
+			return nil
+		})
+	}
+	if signingRootsBucket != nil {
+		_ = signingRootsBucket.ForEach(func(k, v []byte) error {
+			if len(k) != 8 {
+				report("malformed signing root entry %#x", k)
+				return nil
+			}
+			target := bytesutil.BytesToEpochBigEndian(k)
+			if len(v) != 0 && len(v) != 32 {
+				report("malformed signing root for attestation with target epoch %d", target)
+			}
+			if target >= targetFloor && len(bucketGet(targetEpochsBucket, k)) == 0 {
+				report("signing root recorded for target epoch %d without a matching attestation", target)
+			}
+			return nil
+		})
+	}
+	return issues, bounds
+}
+
+func checkProposalHistory(tx *bolt.Tx, pubKey [48]byte, bounds *historyBounds) []*IntegrityIssue {
+	var issues []*IntegrityIssue
+	valBucket := tx.Bucket(historicProposalsBucket).Bucket(pubKey[:])
+	if valBucket == nil {
+		return nil
+	}
+	_ = valBucket.ForEach(func(k, v []byte) error {
+		if len(k) != 8 {
+			issues = append(issues, &IntegrityIssue{
+				PubKey:      pubKey,
+				Description: fmt.Sprintf("malformed proposal entry %#x", k),
+			})
+			return nil
+		}
+		slot := bytesutil.BytesToSlotBigEndian(k)
+		if !bounds.hasProposals || slot < bounds.minSlot {
+			bounds.minSlot = slot
+		}
+		if !bounds.hasProposals || slot > bounds.maxSlot {
+			bounds.maxSlot = slot
+		}
+		bounds.hasProposals = true
+		switch len(v) {
+		case 32:
+		case 0:
+			issues = append(issues, &IntegrityIssue{
+				PubKey:      pubKey,
+				Description: fmt.Sprintf("no signing root recorded for proposal at slot %d", slot),
+			})
+		default:
+			issues = append(issues, &IntegrityIssue{
+				PubKey:      pubKey,
+				Description: fmt.Sprintf("malformed signing root for proposal at slot %d", slot),
+			})
+		}
+		return nil
+	})
+	return issues
+}
+
+func checkDerivedBuckets(tx *bolt.Tx, pubKey [48]byte, bounds *historyBounds) []*IntegrityIssue {
+	var issues []*IntegrityIssue
+	report := func(format string, args ...interface{}) {
+		issues = append(issues, &IntegrityIssue{PubKey: pubKey, Description: fmt.Sprintf(format, args...), Repairable: true})
+	}
+	check := func(bucketName []byte, name string, recorded uint64, above bool) {
+		value := tx.Bucket(bucketName).Get(pubKey[:])
+		if len(value) < 8 {
+			report("no %s although history is recorded", name)
+			return
+		}
+		stored := bytesutil.BytesToUint64BigEndian(value)
+		if above && stored > recorded {
+			issues = append(issues, &IntegrityIssue{
+				PubKey:        pubKey,
+				Description:   fmt.Sprintf("%s %d is above the recorded history, which starts at %d", name, stored, recorded),
+				Informational: true,
+			})
+		}
+		if !above && stored < recorded {
+			report("%s %d is below the recorded history, which ends at %d", name, stored, recorded)
+		}
+	}
+	if bounds.hasSources {
+		check(lowestSignedSourceBucket, "lowest signed source epoch", uint64(bounds.minSource), true)
+	}
+	if bounds.hasTargets {
+		check(lowestSignedTargetBucket, "lowest signed target epoch", uint64(bounds.minTarget), true)
+	}
+	if bounds.hasProposals {
+		check(lowestSignedProposalsBucket, "lowest signed proposal slot", uint64(bounds.minSlot), true)
+		check(highestSignedProposalsBucket, "highest signed proposal slot", uint64(bounds.maxSlot), false)
+	}
+	return issues
+}
+
+func firstEpoch(bkt *bolt.Bucket) types.Epoch {
+	if bkt == nil {
+		return 0
+	}
+	k, _ := bkt.Cursor().First()
+	return bytesutil.BytesToEpochBigEndian(k)
+}
+
+func bucketGet(bkt *bolt.Bucket, key []byte) []byte {
+	if bkt == nil {
+		return nil
+	}
+	return bkt.Get(key)
+}
+
+func containsEpoch(encoded []byte, epoch types.Epoch) bool {
+	for i := 0; i+8 <= len(encoded); i += 8 {
+		if bytesutil.BytesToEpochBigEndian(encoded[i:i+8]) == epoch {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,191 @@
+package kv
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+// setupHistory saves attestations with source epoch i and target epoch i+1 for every
+// given epoch, and proposals at the given slots.
+func setupHistory(t *testing.T, validatorDB *Store, pubKey [48]byte, epochs []types.Epoch, slots []types.Slot) {
+	ctx := context.Background()
+	for _, epoch := range epochs {
+		require.NoError(t, validatorDB.SaveAttestationForPubKey(ctx, pubKey, [32]byte{1}, createAttestation(epoch, epoch+1)))
+	}
+	for _, slot := range slots {
+		require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubKey, slot, []byte{31: 1}))
+	}
+}
+
+func requireIssues(t *testing.T, validatorDB *Store, want ...string) []*IntegrityIssue {
+	issues, err := validatorDB.CheckIntegrity(context.Background())
+	require.NoError(t, err)
+	descriptions := make([]string, len(issues))
+	for i, issue := range issues {
+		descriptions[i] = issue.Description
+	}
+	require.Equal(t, len(want), len(issues), strings.Join(descriptions, "\n"))
+	for i, w := range want {
+		assert.Equal(t, true, strings.Contains(descriptions[i], w), "issue %q does not contain %q", descriptions[i], w)
+	}
+	return issues
+}
+
+func TestStore_CheckIntegrity_Consistent(t *testing.T) {
+	pubKey := [48]byte{1}
+	validatorDB := setupDB(t, [][48]byte{pubKey})
+	setupHistory(t, validatorDB, pubKey, []types.Epoch{1, 2, 5}, []types.Slot{10, 12})
+	requireIssues(t, validatorDB)
+
+	// Pruned history leaves the lowest signed values below the recorded history.
+	require.NoError(t, validatorDB.update(func(tx *bolt.Tx) error {
+		pkBucket := tx.Bucket(pubKeysBucket).Bucket(pubKey[:])
+		epochOne := bytesutil.EpochToBytesBigEndian(1)
+		epochTwo := bytesutil.EpochToBytesBigEndian(2)
+		if err := pkBucket.Bucket(attestationSourceEpochsBucket).Delete(epochOne); err != nil {
+			return err
+		}
+		if err := pkBucket.Bucket(attestationTargetEpochsBucket).Delete(epochTwo); err != nil {
+			return err
+		}
+		return pkBucket.Bucket(attestationSigningRootsBucket).Delete(epochTwo)
+	}))
+	requireIssues(t, validatorDB)
+}
+
+func TestStore_CheckIntegrity_CorruptedAttestations(t *testing.T) {
+	pubKey := [48]byte{1}
+	validatorDB := setupDB(t, [][48]byte{pubKey})
+	setupHistory(t, validatorDB, pubKey, []types.Epoch{1, 2, 5}, nil)
+
+	require.NoError(t, validatorDB.update(func(tx *bolt.Tx) error {
+		pkBucket := tx.Bucket(pubKeysBucket).Bucket(pubKey[:])
+		// The attestation with source 2 and target 3 is only in the target epochs.
+		if err := pkBucket.Bucket(attestationSourceEpochsBucket).Delete(bytesutil.EpochToBytesBigEndian(2)); err != nil {
+			return err
+		}
+		// The attestation with target 6 has no signing root.
+		return pkBucket.Bucket(attestationSigningRootsBucket).Delete(bytesutil.EpochToBytesBigEndian(6))
+	}))
+	issues := requireIssues(t, validatorDB,
+		"source epoch 2 and target epoch 3 is missing from the source epochs",
+		"no signing root recorded for attestation with target epoch 6",
+	)
+	assert.Equal(t, false, issues[0].Repairable)
+
+	// Repairing the derived buckets does not fix the recorded history.
+	_, err := validatorDB.RepairDerivedBuckets(context.Background())
+	require.NoError(t, err)
+	requireIssues(t, validatorDB,
+		"source epoch 2 and target epoch 3 is missing from the source epochs",
+		"no signing root recorded for attestation with target epoch 6",
+	)
+}
+
+func TestStore_CheckIntegrity_CorruptedProposals(t *testing.T) {
+	pubKey := [48]byte{1}
+	validatorDB := setupDB(t, [][48]byte{pubKey})
+	setupHistory(t, validatorDB, pubKey, nil, []types.Slot{10, 12})
+
+	require.NoError(t, validatorDB.update(func(tx *bolt.Tx) error {
+		valBucket := tx.Bucket(historicProposalsBucket).Bucket(pubKey[:])
+		if err := valBucket.Put(bytesutil.SlotToBytesBigEndian(11), []byte{}); err != nil {
+			return err
+		}
+		return valBucket.Put(bytesutil.SlotToBytesBigEndian(12), []byte{1, 2})
+	}))
+	requireIssues(t, validatorDB,
+		"no signing root recorded for proposal at slot 11",
+		"malformed signing root for proposal at slot 12",
+	)
+}
+
+func TestStore_RepairDerivedBuckets(t *testing.T) {
+	pubKey, otherKey, floorOnlyKey := [48]byte{1}, [48]byte{2}, [48]byte{3}
+	validatorDB := setupDB(t, [][48]byte{pubKey, otherKey})
+	setupHistory(t, validatorDB, pubKey, []types.Epoch{3, 4}, []types.Slot{10, 12})
+	setupHistory(t, validatorDB, otherKey, []types.Epoch{7}, nil)
+
+	require.NoError(t, validatorDB.update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(lowestSignedSourceBucket).Delete(pubKey[:]); err != nil {
+			return err
+		}
+		if err := tx.Bucket(highestSignedProposalsBucket).Put(pubKey[:], bytesutil.SlotToBytesBigEndian(11)); err != nil {
+			return err
+		}
+		// Pruned history leaves a lowest signed epoch below the recorded history.
+		if err := tx.Bucket(lowestSignedTargetBucket).Put(otherKey[:], bytesutil.EpochToBytesBigEndian(2)); err != nil {
+			return err
+		}
+		// A key without history keeps its lowest signed epochs.
+		return tx.Bucket(lowestSignedSourceBucket).Put(floorOnlyKey[:], bytesutil.EpochToBytesBigEndian(100))
+	}))
+	issues := requireIssues(t, validatorDB,
+		"no lowest signed source epoch",
+		"highest signed proposal slot 11 is below the recorded history, which ends at 12",
+	)
+	for _, issue := range issues {
+		assert.Equal(t, pubKey, issue.PubKey)
+		assert.Equal(t, true, issue.Repairable)
+	}
+
+	ctx := context.Background()
+	repaired, err := validatorDB.RepairDerivedBuckets(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, repaired)
+	requireIssues(t, validatorDB)
+
+	source, exists, err := validatorDB.LowestSignedSourceEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	assert.Equal(t, types.Epoch(3), source)
+	highest, _, err := validatorDB.HighestSignedProposal(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(12), highest)
+	target, _, err := validatorDB.LowestSignedTargetEpoch(ctx, otherKey)
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(8), target)
+	source, exists, err = validatorDB.LowestSignedSourceEpoch(ctx, floorOnlyKey)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	assert.Equal(t, types.Epoch(100), source)
+}
+
+func TestStore_RepairDerivedBuckets_KeepsRaisedFloors(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	validatorDB := setupDB(t, [][48]byte{pubKey})
+	setupHistory(t, validatorDB, pubKey, []types.Epoch{3, 4}, []types.Slot{10, 12})
+	require.NoError(t, validatorDB.RaiseLowestSignedEpochs(ctx, pubKey, 20, 21))
+	require.NoError(t, validatorDB.RaiseLowestSignedProposal(ctx, pubKey, 30))
+
+	issues := requireIssues(t, validatorDB,
+		"lowest signed source epoch 20 is above the recorded history, which starts at 3",
+		"lowest signed target epoch 21 is above the recorded history, which starts at 4",
+		"lowest signed proposal slot 30 is above the recorded history, which starts at 10",
+	)
+	for _, issue := range issues {
+		assert.Equal(t, false, issue.Repairable)
+		assert.Equal(t, true, issue.Informational)
+	}
+
+	repaired, err := validatorDB.RepairDerivedBuckets(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, repaired)
+	source, _, err := validatorDB.LowestSignedSourceEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(20), source)
+	target, _, err := validatorDB.LowestSignedTargetEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(21), target)
+	slot, _, err := validatorDB.LowestSignedProposal(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(30), slot)
+}
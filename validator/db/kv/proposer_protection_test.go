@@ -241,6 +241,30 @@ func TestStore_LowestSignedProposal(t *testing.T) {
 	assert.Equal(t, types.Slot(1), slot)
 }
 
+func TestStore_RaiseLowestSignedProposal(t *testing.T) {
+	ctx := context.Background()
+	pubkey := [48]byte{3}
+	dummySigningRoot := [32]byte{}
+	validatorDB := setupDB(t, [][48]byte{pubkey})
+
+	err := validatorDB.SaveProposalHistoryForSlot(ctx, pubkey, 2 /* slot */, dummySigningRoot[:])
+	require.NoError(t, err)
+
+	// We expect the lowest signed slot is raised.
+	require.NoError(t, validatorDB.RaiseLowestSignedProposal(ctx, pubkey, 10))
+	slot, exists, err := validatorDB.LowestSignedProposal(ctx, pubkey)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	assert.Equal(t, types.Slot(10), slot)
+
+	// We expect the lowest signed slot is never lowered.
+	require.NoError(t, validatorDB.RaiseLowestSignedProposal(ctx, pubkey, 5))
+	slot, exists, err = validatorDB.LowestSignedProposal(ctx, pubkey)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	assert.Equal(t, types.Slot(10), slot)
+}
+
 func TestStore_HighestSignedProposal(t *testing.T) {
 	ctx := context.Background()
 	pubkey := [48]byte{3}
@@ -0,0 +1,5 @@
+package exits
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "exits")
@@ -0,0 +1,293 @@
+package exits
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// SigningFunc signs a request with a validating key, as the Sign method of a keymanager does.
+type SigningFunc func(context.Context, *validatorpb.SignRequest) (bls.Signature, error)
+
+// ErrAlreadySubmitted is returned when scheduling an exit for a key whose exit was submitted.
+var ErrAlreadySubmitted = errors.New("the exit of this key was already submitted")
+
+// Manager schedules voluntary exits and follows their progress.
+type Manager struct {
+	store           *Store
+	validatorClient ethpb.BeaconNodeValidatorClient
+	beaconClient    ethpb.BeaconChainClient
+}
+
+// NewManager creates a manager of the exits kept in the store, using the given beacon node clients.
+func NewManager(
+	store *Store, validatorClient ethpb.BeaconNodeValidatorClient, beaconClient ethpb.BeaconChainClient,
+) *Manager {
+	return &Manager{
+		store:           store,
+		validatorClient: validatorClient,
+		beaconClient:    beaconClient,
+	}
+}
+
+// Store returns the store of scheduled exits.
+func (m *Manager) Store() *Store {
+	return m.store
+}
+
+// Schedule signs voluntary exits of the given keys for the epoch and saves them, to be
+// submitted once the epoch is reached. Keys which could not be scheduled, for instance
+// because they are unknown to the beacon node, are returned along with the reason, the
+// other keys are scheduled regardless. Scheduling a key again replaces its exit, unless
+// it was already submitted.
+func (m *Manager) Schedule(
+	ctx context.Context, signer SigningFunc, pubKeys [][48]byte, epoch types.Epoch,
+) ([]*ScheduledExit, map[[48]byte]error, error) {
+	ctx, span := trace.StartSpan(ctx, "exits.Schedule")
+	defer span.End()
+
+	existing, err := m.store.Exits()
+	if err != nil {
+		return nil, nil, err
+	}
+	submitted := make(map[[48]byte]bool)
+	for _, exit := range existing {
+		if exit.Status != Scheduled {
+			submitted[exit.PubKey] = true
+		}
+	}
+	failed := make(map[[48]byte]error)
+	scheduled := make([]*ScheduledExit, 0, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		if submitted[pubKey] {
+			failed[pubKey] = ErrAlreadySubmitted
+			continue
+		}
+		signedExit, err := m.signExit(ctx, signer, pubKey, epoch)
+		if err != nil {
+			failed[pubKey] = err
+			continue
+		}
+		scheduled = append(scheduled, &ScheduledExit{
+			PubKey:     pubKey,
+			SignedExit: signedExit,
+			Status:     Scheduled,
+		})
+	}
+	err = m.store.Update(func(exits map[[48]byte]*ScheduledExit) error {
+		for _, exit := range scheduled {
+			exits[exit.PubKey] = exit
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, exit := range scheduled {
+		log.WithFields(logrus.Fields{
+			"publicKey":      fmt.Sprintf("%#x", bytesutil.Trunc(exit.PubKey[:])),
+			"validatorIndex": exit.SignedExit.Exit.ValidatorIndex,
+			"epoch":          epoch,
+		}).Info("Scheduled voluntary exit")
+	}
+	return scheduled, failed, nil
+}
+
+// Cancel removes the scheduled exits of the given keys. Exits already submitted cannot be
+// canceled and are kept.
+func (m *Manager) Cancel(pubKeys [][48]byte) error {
+	return m.store.Update(func(exits map[[48]byte]*ScheduledExit) error {
+		for _, pubKey := range pubKeys {
+			if exit, ok := exits[pubKey]; ok && exit.Status == Scheduled {
+				delete(exits, pubKey)
+			}
+		}
+		return nil
+	})
+}
+
+// Process submits the scheduled exits which are due at the current head epoch, and updates
+// the status of the submitted exits from the validators in the beacon state.
+func (m *Manager) Process(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "exits.Process")
+	defer span.End()
+
+	exits, err := m.store.Exits()
+	if err != nil {
+		return err
+	}
+	if len(exits) == 0 {
+		return nil
+	}
+	head, err := m.beaconClient.GetChainHead(ctx, &emptypb.Empty{})
+	if err != nil {
+		return errors.Wrap(err, "could not get chain head")
+	}
+	updated := make(map[[48]byte]*ScheduledExit)
+	for _, exit := range exits {
+		before := *exit
+		switch exit.Status {
+		case Scheduled:
+			if exit.SignedExit.Exit.Epoch > head.HeadEpoch {
+				continue
+			}
+			m.submit(ctx, exit)
+		case Submitted, Exiting:
+			if err := m.updateFromState(ctx, exit, head.HeadEpoch); err != nil {
+				log.WithError(err).WithField(
+					"publicKey", fmt.Sprintf("%#x", bytesutil.Trunc(exit.PubKey[:])),
+				).Debug("Could not get validator to follow its exit")
+				continue
+			}
+		default:
+			continue
+		}
+		if *exit != before {
+			updated[exit.PubKey] = exit
+		}
+	}
+	if len(updated) > 0 {
+		err = m.store.Update(func(stored map[[48]byte]*ScheduledExit) error {
+			for pubKey, exit := range updated {
+				// The exit may have been canceled or scheduled again meanwhile.
+				if current, ok := stored[pubKey]; ok && bytes.Equal(current.SignedExit.Signature, exit.SignedExit.Signature) {
+					stored[pubKey] = exit
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	logProgress(exits)
+	return nil
+}
+
+// Run processes the scheduled exits once per epoch until the context is canceled.
+func (m *Manager) Run(ctx context.Context) {
+	interval := time.Duration(params.BeaconConfig().SlotsPerEpoch.Mul(params.BeaconConfig().SecondsPerSlot)) * time.Second
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := m.Process(ctx); err != nil {
+			log.WithError(err).Error("Could not process scheduled voluntary exits")
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (m *Manager) submit(ctx context.Context, exit *ScheduledExit) {
+	fields := logrus.Fields{
+		"publicKey":      fmt.Sprintf("%#x", bytesutil.Trunc(exit.PubKey[:])),
+		"validatorIndex": exit.SignedExit.Exit.ValidatorIndex,
+	}
+	_, err := m.validatorClient.ProposeExit(ctx, exit.SignedExit)
+	switch {
+	case err == nil:
+		exit.Status = Submitted
+		exit.Error = ""
+		log.WithFields(fields).Info("Submitted scheduled voluntary exit")
+	case strings.Contains(err.Error(), blocks.ValidatorAlreadyExitedMsg):
+		exit.Status = Submitted
+		exit.Error = ""
+		log.WithFields(fields).Info("Validator is already exiting")
+	default:
+		// The exit stays scheduled and is submitted again at the next epoch.
+		exit.Error = err.Error()
+		log.WithError(err).WithFields(fields).Warn("Could not submit scheduled voluntary exit")
+	}
+}
+
+func (m *Manager) updateFromState(ctx context.Context, exit *ScheduledExit, headEpoch types.Epoch) error {
+	validator, err := m.beaconClient.GetValidator(ctx, &ethpb.GetValidatorRequest{
+		QueryFilter: &ethpb.GetValidatorRequest_Index{Index: exit.SignedExit.Exit.ValidatorIndex},
+	})
+	if err != nil {
+		return err
+	}
+	if validator.ExitEpoch == params.BeaconConfig().FarFutureEpoch {
+		return nil
+	}
+	exit.ExitEpoch = validator.ExitEpoch
+	exit.WithdrawableEpoch = validator.WithdrawableEpoch
+	status := Exiting
+	if validator.WithdrawableEpoch <= headEpoch {
+		status = Withdrawable
+	}
+	if status != exit.Status {
+		log.WithFields(logrus.Fields{
+			"publicKey":         fmt.Sprintf("%#x", bytesutil.Trunc(exit.PubKey[:])),
+			"exitEpoch":         validator.ExitEpoch,
+			"withdrawableEpoch": validator.WithdrawableEpoch,
+		}).Infof("Validator is %s", status)
+	}
+	exit.Status = status
+	return nil
+}
+
+func (m *Manager) signExit(
+	ctx context.Context, signer SigningFunc, pubKey [48]byte, epoch types.Epoch,
+) (*ethpb.SignedVoluntaryExit, error) {
+	indexResponse, err := m.validatorClient.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get validator index")
+	}
+	exit := &ethpb.VoluntaryExit{Epoch: epoch, ValidatorIndex: indexResponse.Index}
+	domain, err := m.validatorClient.DomainData(ctx, &ethpb.DomainRequest{
+		Epoch:  epoch,
+		Domain: params.BeaconConfig().DomainVoluntaryExit[:],
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get domain data")
+	}
+	exitRoot, err := helpers.ComputeSigningRoot(exit, domain.SignatureDomain)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute signing root")
+	}
+	sig, err := signer(ctx, &validatorpb.SignRequest{
+		PublicKey:       pubKey[:],
+		SigningRoot:     exitRoot[:],
+		SignatureDomain: domain.SignatureDomain,
+		Object:          &validatorpb.SignRequest_Exit{Exit: exit},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not sign voluntary exit")
+	}
+	return &ethpb.SignedVoluntaryExit{Exit: exit, Signature: sig.Marshal()}, nil
+}
+
+func logProgress(exits []*ScheduledExit) {
+	counts := make(map[Status]int)
+	for _, exit := range exits {
+		counts[exit.Status]++
+	}
+	if counts[Withdrawable] == len(exits) {
+		return
+	}
+	log.WithFields(logrus.Fields{
+		string(Scheduled):    counts[Scheduled],
+		string(Submitted):    counts[Submitted],
+		string(Exiting):      counts[Exiting],
+		string(Withdrawable): counts[Withdrawable],
+	}).Info("Progress of scheduled voluntary exits")
+}
@@ -0,0 +1,166 @@
+package exits
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+type testSetup struct {
+	manager         *Manager
+	validatorClient *mock.MockBeaconNodeValidatorClient
+	beaconClient    *mock.MockBeaconChainClient
+	keys            map[[48]byte]bls.SecretKey
+	pubKeys         [][48]byte
+}
+
+func setup(t *testing.T, numKeys int) *testSetup {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	store, err := NewStore(filepath.Join(t.TempDir(), StoreFileName), "password")
+	require.NoError(t, err)
+	s := &testSetup{
+		validatorClient: mock.NewMockBeaconNodeValidatorClient(ctrl),
+		beaconClient:    mock.NewMockBeaconChainClient(ctrl),
+		keys:            make(map[[48]byte]bls.SecretKey),
+	}
+	s.manager = NewManager(store, s.validatorClient, s.beaconClient)
+	for i := 0; i < numKeys; i++ {
+		key, err := bls.RandKey()
+		require.NoError(t, err)
+		pubKey := bytesutil.ToBytes48(key.PublicKey().Marshal())
+		s.keys[pubKey] = key
+		s.pubKeys = append(s.pubKeys, pubKey)
+	}
+	return s
+}
+
+func (s *testSetup) sign(_ context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	key, ok := s.keys[bytesutil.ToBytes48(req.PublicKey)]
+	if !ok {
+		return nil, errors.New("no such key")
+	}
+	return key.Sign(req.SigningRoot), nil
+}
+
+func (s *testSetup) expectSigning(index types.ValidatorIndex, pubKey [48]byte) {
+	s.validatorClient.EXPECT().ValidatorIndex(gomock.Any(), &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]}).
+		Return(&ethpb.ValidatorIndexResponse{Index: index}, nil)
+	s.validatorClient.EXPECT().DomainData(gomock.Any(), gomock.Any()).
+		Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil)
+}
+
+func TestManager_Schedule(t *testing.T) {
+	ctx := context.Background()
+	s := setup(t, 2)
+	s.expectSigning(1, s.pubKeys[0])
+	s.validatorClient.EXPECT().ValidatorIndex(gomock.Any(), &ethpb.ValidatorIndexRequest{PublicKey: s.pubKeys[1][:]}).
+		Return(nil, errors.New("unknown key"))
+
+	scheduled, failed, err := s.manager.Schedule(ctx, s.sign, s.pubKeys, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(scheduled))
+	assert.Equal(t, types.ValidatorIndex(1), scheduled[0].SignedExit.Exit.ValidatorIndex)
+	assert.Equal(t, types.Epoch(10), scheduled[0].SignedExit.Exit.Epoch)
+	assert.Equal(t, Scheduled, scheduled[0].Status)
+	require.Equal(t, 1, len(failed))
+	assert.ErrorContains(t, "unknown key", failed[s.pubKeys[1]])
+
+	exits, err := s.manager.Store().Exits()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(exits))
+
+	require.NoError(t, s.manager.Cancel(s.pubKeys[:1]))
+	exits, err = s.manager.Store().Exits()
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(exits))
+}
+
+func TestManager_Process(t *testing.T) {
+	ctx := context.Background()
+	s := setup(t, 2)
+	s.expectSigning(1, s.pubKeys[0])
+	s.expectSigning(2, s.pubKeys[1])
+	_, failed, err := s.manager.Schedule(ctx, s.sign, s.pubKeys, 10)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(failed))
+
+	statuses := func() map[types.ValidatorIndex]*ScheduledExit {
+		exits, err := s.manager.Store().Exits()
+		require.NoError(t, err)
+		m := make(map[types.ValidatorIndex]*ScheduledExit)
+		for _, exit := range exits {
+			m[exit.SignedExit.Exit.ValidatorIndex] = exit
+		}
+		return m
+	}
+
+	// Nothing is submitted before the epoch.
+	s.beaconClient.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{HeadEpoch: 9}, nil)
+	require.NoError(t, s.manager.Process(ctx))
+	for _, exit := range statuses() {
+		assert.Equal(t, Scheduled, exit.Status)
+	}
+
+	// At the epoch, one exit is accepted and the other fails and is retried.
+	s.beaconClient.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{HeadEpoch: 10}, nil)
+	s.validatorClient.EXPECT().ProposeExit(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, exit *ethpb.SignedVoluntaryExit, _ ...interface{}) (*ethpb.ProposeExitResponse, error) {
+			if exit.Exit.ValidatorIndex == 2 {
+				return nil, errors.New("connection refused")
+			}
+			return &ethpb.ProposeExitResponse{}, nil
+		}).Times(2)
+	require.NoError(t, s.manager.Process(ctx))
+	exits := statuses()
+	assert.Equal(t, Submitted, exits[1].Status)
+	assert.Equal(t, Scheduled, exits[2].Status)
+	assert.Equal(t, "connection refused", exits[2].Error)
+
+	// A submitted exit can no longer be canceled nor scheduled again.
+	require.NoError(t, s.manager.Cancel(s.pubKeys[:1]))
+	_, failed, err = s.manager.Schedule(ctx, s.sign, s.pubKeys[:1], 20)
+	require.NoError(t, err)
+	assert.Equal(t, ErrAlreadySubmitted, failed[s.pubKeys[0]])
+
+	// The retried exit was already known to the beacon node, the other is in the state.
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	s.beaconClient.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{HeadEpoch: 11}, nil)
+	s.validatorClient.EXPECT().ProposeExit(gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("validator "+blocks.ValidatorAlreadyExitedMsg+" 15"))
+	s.beaconClient.EXPECT().GetValidator(gomock.Any(), &ethpb.GetValidatorRequest{
+		QueryFilter: &ethpb.GetValidatorRequest_Index{Index: 1},
+	}).Return(&ethpb.Validator{ExitEpoch: 15, WithdrawableEpoch: 271}, nil)
+	require.NoError(t, s.manager.Process(ctx))
+	exits = statuses()
+	assert.Equal(t, Exiting, exits[1].Status)
+	assert.Equal(t, types.Epoch(15), exits[1].ExitEpoch)
+	assert.Equal(t, Submitted, exits[2].Status)
+	assert.Equal(t, "", exits[2].Error)
+
+	// Once the withdrawable epoch is reached, the exits are complete.
+	s.beaconClient.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{HeadEpoch: 271}, nil)
+	s.beaconClient.EXPECT().GetValidator(gomock.Any(), &ethpb.GetValidatorRequest{
+		QueryFilter: &ethpb.GetValidatorRequest_Index{Index: 1},
+	}).Return(&ethpb.Validator{ExitEpoch: 15, WithdrawableEpoch: 271}, nil)
+	s.beaconClient.EXPECT().GetValidator(gomock.Any(), &ethpb.GetValidatorRequest{
+		QueryFilter: &ethpb.GetValidatorRequest_Index{Index: 2},
+	}).Return(&ethpb.Validator{ExitEpoch: farFuture, WithdrawableEpoch: farFuture}, nil)
+	require.NoError(t, s.manager.Process(ctx))
+	exits = statuses()
+	assert.Equal(t, Withdrawable, exits[1].Status)
+	assert.Equal(t, Submitted, exits[2].Status)
+}
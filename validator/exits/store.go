@@ -0,0 +1,220 @@
+// Package exits schedules voluntary exits for many validators at once. Exits are signed
+// ahead of time for a chosen epoch, kept encrypted at rest with the wallet password, and
+// submitted to the beacon node once the epoch is reached. Their progress is then followed
+// until the validators are withdrawable.
+package exits
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gofrs/flock"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+// StoreFileName is the name of the file holding scheduled exits in the wallet accounts directory.
+const StoreFileName = "scheduled-exits.json"
+
+// Status of a scheduled exit.
+type Status string
+
+const (
+	// Scheduled exits are signed and wait for their epoch.
+	Scheduled Status = "scheduled"
+	// Submitted exits were accepted by the beacon node, but are not yet included in the chain.
+	Submitted Status = "submitted"
+	// Exiting validators have an exit epoch in the beacon state.
+	Exiting Status = "exiting"
+	// Withdrawable validators have reached their withdrawable epoch, their exit is complete.
+	Withdrawable Status = "withdrawable"
+)
+
+// ScheduledExit is a signed voluntary exit and the progress of the validator exiting.
+type ScheduledExit struct {
+	PubKey            [48]byte                   `json:"pubkey"`
+	SignedExit        *ethpb.SignedVoluntaryExit `json:"signed_exit"`
+	Status            Status                     `json:"status"`
+	ExitEpoch         types.Epoch                `json:"exit_epoch,omitempty"`
+	WithdrawableEpoch types.Epoch                `json:"withdrawable_epoch,omitempty"`
+	// Error is the last error met when submitting the exit, which is retried.
+	Error string `json:"error,omitempty"`
+}
+
+// encryptedFile is the content of the store file, an EIP-2335 style crypto section
+// encrypting the JSON encoded scheduled exits.
+type encryptedFile struct {
+	Crypto  map[string]interface{} `json:"crypto"`
+	Version uint                   `json:"version"`
+	Name    string                 `json:"name"`
+}
+
+// Store keeps scheduled exits in an encrypted file. The file is read again whenever it
+// changes on disk, so exits scheduled from the command line are picked up by a running
+// validator client. Updates hold a lock on a file next to it, so processes sharing the
+// file do not overwrite the changes of each other.
+type Store struct {
+	path     string
+	password string
+	lock     sync.Mutex
+	modTime  time.Time
+	exits    map[[48]byte]*ScheduledExit
+}
+
+// NewStore opens the scheduled exits file at the given path, which is encrypted with the
+// password. A missing file is treated as an empty store.
+func NewStore(path, password string) (*Store, error) {
+	if password == "" {
+		return nil, errors.New("a password is required to encrypt scheduled exits")
+	}
+	s := &Store{
+		path:     filepath.Clean(path),
+		password: password,
+		exits:    make(map[[48]byte]*ScheduledExit),
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Exits returns a copy of the scheduled exits, sorted by public key.
+func (s *Store) Exits() ([]*ScheduledExit, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	exits := make([]*ScheduledExit, 0, len(s.exits))
+	for _, exit := range s.exits {
+		cpy := *exit
+		exits = append(exits, &cpy)
+	}
+	sort.Slice(exits, func(i, j int) bool {
+		return string(exits[i].PubKey[:]) < string(exits[j].PubKey[:])
+	})
+	return exits, nil
+}
+
+// Update applies the function to the scheduled exits and saves the result. The function
+// may add, change or delete entries of the map.
+func (s *Store) Update(fn func(exits map[[48]byte]*ScheduledExit) error) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	fileLock := flock.New(s.path + ".lock")
+	if err := fileLock.Lock(); err != nil {
+		return errors.Wrap(err, "could not lock scheduled exits")
+	}
+	defer func() {
+		if err := fileLock.Unlock(); err != nil {
+			log.WithError(err).Error("Could not unlock scheduled exits")
+		}
+	}()
+	// Another process may have saved the file within the resolution of its modification
+	// time, so it is always read again once locked.
+	s.modTime = time.Time{}
+	if err := s.load(); err != nil {
+		return err
+	}
+	exits := make(map[[48]byte]*ScheduledExit, len(s.exits))
+	for k, exit := range s.exits {
+		cpy := *exit
+		exits[k] = &cpy
+	}
+	if err := fn(exits); err != nil {
+		return err
+	}
+	if err := s.save(exits); err != nil {
+		return err
+	}
+	s.exits = exits
+	return nil
+}
+
+// load reads the file if it changed since it was last read.
+func (s *Store) load() error {
+	info, err := os.Stat(s.path)
+	if os.IsNotExist(err) {
+		s.exits = make(map[[48]byte]*ScheduledExit)
+		s.modTime = time.Time{}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if info.ModTime().Equal(s.modTime) {
+		return nil
+	}
+	enc, err := fileutil.ReadFileAsBytes(s.path)
+	if err != nil {
+		return errors.Wrap(err, "could not read scheduled exits")
+	}
+	file := &encryptedFile{}
+	if err := json.Unmarshal(enc, file); err != nil {
+		return errors.Wrap(err, "could not unmarshal scheduled exits")
+	}
+	decrypted, err := keystorev4.New().Decrypt(file.Crypto, s.password)
+	if err != nil {
+		return errors.Wrap(err, "could not decrypt scheduled exits, the wallet password may be wrong")
+	}
+	var exits []*ScheduledExit
+	if err := json.Unmarshal(decrypted, &exits); err != nil {
+		return errors.Wrap(err, "could not unmarshal scheduled exits")
+	}
+	s.exits = make(map[[48]byte]*ScheduledExit, len(exits))
+	for _, exit := range exits {
+		s.exits[exit.PubKey] = exit
+	}
+	s.modTime = info.ModTime()
+	return nil
+}
+
+func (s *Store) save(exits map[[48]byte]*ScheduledExit) error {
+	list := make([]*ScheduledExit, 0, len(exits))
+	for _, exit := range exits {
+		list = append(list, exit)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return string(list[i].PubKey[:]) < string(list[j].PubKey[:])
+	})
+	enc, err := json.Marshal(list)
+	if err != nil {
+		return err
+	}
+	encryptor := keystorev4.New()
+	cryptoFields, err := encryptor.Encrypt(enc, s.password)
+	if err != nil {
+		return errors.Wrap(err, "could not encrypt scheduled exits")
+	}
+	encFile, err := json.MarshalIndent(&encryptedFile{
+		Crypto:  cryptoFields,
+		Version: encryptor.Version(),
+		Name:    encryptor.Name(),
+	}, "", "\t")
+	if err != nil {
+		return err
+	}
+	// The file is replaced at once, so a reader never sees a partial write.
+	tmpPath := s.path + ".tmp"
+	if err := fileutil.WriteFile(tmpPath, encFile); err != nil {
+		return errors.Wrap(err, "could not write scheduled exits")
+	}
+	if err := os.Rename(tmpPath, s.path); err != nil {
+		return errors.Wrap(err, "could not write scheduled exits")
+	}
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return err
+	}
+	s.modTime = info.ModTime()
+	return nil
+}
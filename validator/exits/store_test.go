@@ -0,0 +1,132 @@
+package exits
+
+import (
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gofrs/flock"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_RoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), StoreFileName)
+	s, err := NewStore(path, "password")
+	require.NoError(t, err)
+	exits, err := s.Exits()
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(exits))
+
+	exit := &ScheduledExit{
+		PubKey: [48]byte{1},
+		SignedExit: &ethpb.SignedVoluntaryExit{
+			Exit:      &ethpb.VoluntaryExit{Epoch: 10, ValidatorIndex: 3},
+			Signature: make([]byte, 96),
+		},
+		Status: Scheduled,
+	}
+	require.NoError(t, s.Update(func(exits map[[48]byte]*ScheduledExit) error {
+		exits[exit.PubKey] = exit
+		return nil
+	}))
+
+	// The file is encrypted, the signed exit does not appear in clear.
+	enc, err := fileutil.ReadFileAsBytes(path)
+	require.NoError(t, err)
+	assert.Equal(t, false, strings.Contains(string(enc), "signed_exit"))
+
+	reopened, err := NewStore(path, "password")
+	require.NoError(t, err)
+	exits, err = reopened.Exits()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(exits))
+	assert.DeepEqual(t, exit.PubKey, exits[0].PubKey)
+	assert.Equal(t, Scheduled, exits[0].Status)
+	assert.Equal(t, exit.SignedExit.Exit.ValidatorIndex, exits[0].SignedExit.Exit.ValidatorIndex)
+	assert.Equal(t, exit.SignedExit.Exit.Epoch, exits[0].SignedExit.Exit.Epoch)
+
+	_, err = NewStore(path, "wrong")
+	assert.ErrorContains(t, "could not decrypt scheduled exits", err)
+}
+
+func TestStore_ReloadsChanges(t *testing.T) {
+	path := filepath.Join(t.TempDir(), StoreFileName)
+	s1, err := NewStore(path, "password")
+	require.NoError(t, err)
+	s2, err := NewStore(path, "password")
+	require.NoError(t, err)
+
+	require.NoError(t, s2.Update(func(exits map[[48]byte]*ScheduledExit) error {
+		exits[[48]byte{2}] = &ScheduledExit{
+			PubKey:     [48]byte{2},
+			SignedExit: &ethpb.SignedVoluntaryExit{Exit: &ethpb.VoluntaryExit{}},
+			Status:     Scheduled,
+		}
+		return nil
+	}))
+	exits, err := s1.Exits()
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(exits))
+}
+
+func TestStore_UpdateLocksFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), StoreFileName)
+	s, err := NewStore(path, "password")
+	require.NoError(t, err)
+
+	other := flock.New(path + ".lock")
+	require.NoError(t, s.Update(func(_ map[[48]byte]*ScheduledExit) error {
+		locked, err := other.TryLock()
+		require.NoError(t, err)
+		assert.Equal(t, false, locked, "Another process could lock the file during an update")
+		return nil
+	}))
+	locked, err := other.TryLock()
+	require.NoError(t, err)
+	assert.Equal(t, true, locked, "The file is still locked after the update")
+	require.NoError(t, other.Unlock())
+}
+
+func TestStore_ConcurrentUpdatesOfStores(t *testing.T) {
+	path := filepath.Join(t.TempDir(), StoreFileName)
+	stores := make([]*Store, 2)
+	for i := range stores {
+		s, err := NewStore(path, "password")
+		require.NoError(t, err)
+		stores[i] = s
+	}
+
+	const updates = 3
+	var wg sync.WaitGroup
+	for i, s := range stores {
+		wg.Add(1)
+		go func(i int, s *Store) {
+			defer wg.Done()
+			for j := 0; j < updates; j++ {
+				pubKey := [48]byte{byte(i), byte(j)}
+				assert.NoError(t, s.Update(func(exits map[[48]byte]*ScheduledExit) error {
+					exits[pubKey] = &ScheduledExit{
+						PubKey:     pubKey,
+						SignedExit: &ethpb.SignedVoluntaryExit{Exit: &ethpb.VoluntaryExit{}},
+						Status:     Scheduled,
+					}
+					return nil
+				}))
+			}
+		}(i, s)
+	}
+	wg.Wait()
+
+	exits, err := stores[0].Exits()
+	require.NoError(t, err)
+	assert.Equal(t, len(stores)*updates, len(exits))
+}
+
+func TestNewStore_RequiresPassword(t *testing.T) {
+	_, err := NewStore(filepath.Join(t.TempDir(), StoreFileName), "")
+	assert.ErrorContains(t, "a password is required", err)
+}
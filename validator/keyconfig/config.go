@@ -0,0 +1,160 @@
+// Package keyconfig defines a configuration file holding settings for individual
+// validating keys, such as their graffiti or whether they perform their duties, which
+// can be changed while the validator client is running.
+//
+// An example file, in YAML (JSON is accepted as well):
+//
+//	default:
+//	  graffiti: "prysm"
+//	keys:
+//	  0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c:
+//	    graffiti: "hex:0x1234"
+//	    propose: false
+//	  0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b:
+//	    enabled: false
+package keyconfig
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/validator/graffiti"
+	"gopkg.in/yaml.v2"
+)
+
+// KeyConfig holds the settings of a validating key. Fields which are not set fall back
+// to the default section of the file, then to the behaviour of the validator client
+// without a key configuration file.
+type KeyConfig struct {
+	Graffiti          *string `json:"graffiti,omitempty" yaml:"graffiti,omitempty"`
+	Enabled           *bool   `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	DoppelGangerCheck *bool   `json:"doppelganger_check,omitempty" yaml:"doppelganger_check,omitempty"`
+	Attest            *bool   `json:"attest,omitempty" yaml:"attest,omitempty"`
+	Propose           *bool   `json:"propose,omitempty" yaml:"propose,omitempty"`
+}
+
+// File is the content of a key configuration file. Keys are hex encoded, 0x prefixed
+// validating public keys.
+type File struct {
+	Default *KeyConfig            `json:"default,omitempty" yaml:"default,omitempty"`
+	Keys    map[string]*KeyConfig `json:"keys,omitempty" yaml:"keys,omitempty"`
+}
+
+// Settings are the resolved settings of a validating key.
+type Settings struct {
+	// Graffiti is empty if no graffiti is configured for the key, in which case the
+	// graffiti flags of the validator client apply.
+	Graffiti          string
+	Enabled           bool
+	DoppelGangerCheck bool
+	Attest            bool
+	Propose           bool
+}
+
+// DefaultSettings are the settings of keys which are not configured.
+func DefaultSettings() *Settings {
+	return &Settings{
+		Enabled:           true,
+		DoppelGangerCheck: true,
+		Attest:            true,
+		Propose:           true,
+	}
+}
+
+// Parse decodes a key configuration file, in YAML or JSON.
+func Parse(enc []byte) (*File, error) {
+	f := &File{}
+	if err := yaml.UnmarshalStrict(enc, f); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal key configuration")
+	}
+	if err := f.Default.validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid default key configuration")
+	}
+	for key, cfg := range f.Keys {
+		if _, err := PubKeyFromHex(key); err != nil {
+			return nil, err
+		}
+		if err := cfg.validate(); err != nil {
+			return nil, errors.Wrapf(err, "invalid configuration for key %s", key)
+		}
+	}
+	return f, nil
+}
+
+// PubKeyFromHex decodes a hex encoded validating public key, with or without 0x prefix.
+func PubKeyFromHex(key string) ([48]byte, error) {
+	var pubKey [48]byte
+	b, err := hex.DecodeString(strings.TrimPrefix(key, "0x"))
+	if err != nil {
+		return pubKey, errors.Wrapf(err, "could not decode public key %s", key)
+	}
+	if len(b) != len(pubKey) {
+		return pubKey, fmt.Errorf("public key %s has length %d, expected %d", key, len(b), len(pubKey))
+	}
+	copy(pubKey[:], b)
+	return pubKey, nil
+}
+
+// PubKeyToHex encodes a validating public key as it appears in a key configuration file.
+func PubKeyToHex(pubKey [48]byte) string {
+	return "0x" + hex.EncodeToString(pubKey[:])
+}
+
+func (c *KeyConfig) validate() error {
+	if c == nil || c.Graffiti == nil {
+		return nil
+	}
+	if length := len(graffiti.ParseHexGraffiti(*c.Graffiti)); length > 32 {
+		return fmt.Errorf("graffiti is %d bytes long, at most 32 bytes are allowed", length)
+	}
+	return nil
+}
+
+// apply overrides the settings with the fields set in the configuration.
+func (c *KeyConfig) apply(s *Settings) {
+	if c == nil {
+		return
+	}
+	if c.Graffiti != nil {
+		s.Graffiti = graffiti.ParseHexGraffiti(*c.Graffiti)
+	}
+	if c.Enabled != nil {
+		s.Enabled = *c.Enabled
+	}
+	if c.DoppelGangerCheck != nil {
+		s.DoppelGangerCheck = *c.DoppelGangerCheck
+	}
+	if c.Attest != nil {
+		s.Attest = *c.Attest
+	}
+	if c.Propose != nil {
+		s.Propose = *c.Propose
+	}
+}
+
+// copy returns a deep copy of the configuration.
+func (c *KeyConfig) copy() *KeyConfig {
+	if c == nil {
+		return nil
+	}
+	cpy := &KeyConfig{}
+	if c.Graffiti != nil {
+		g := *c.Graffiti
+		cpy.Graffiti = &g
+	}
+	cpy.Enabled = copyBool(c.Enabled)
+	cpy.DoppelGangerCheck = copyBool(c.DoppelGangerCheck)
+	cpy.Attest = copyBool(c.Attest)
+	cpy.Propose = copyBool(c.Propose)
+	return cpy
+}
+
+func copyBool(b *bool) *bool {
+	if b == nil {
+		return nil
+	}
+	v := *b
+	return &v
+}
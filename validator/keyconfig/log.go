@@ -0,0 +1,5 @@
+package keyconfig
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "keyconfig")
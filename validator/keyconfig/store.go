@@ -0,0 +1,214 @@
+package keyconfig
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"gopkg.in/yaml.v2"
+)
+
+// Store holds the key configuration loaded from a file, and writes changes made
+// through it back to the file.
+type Store struct {
+	path string
+	// updateLock serializes changes to the file, lock guards the loaded configuration.
+	updateLock sync.Mutex
+	lock       sync.RWMutex
+	file       *File
+	keys       map[[48]byte]*KeyConfig
+}
+
+// NewStore loads the key configuration file at the given path. A missing file is
+// treated as an empty configuration, and is created once the configuration is changed.
+func NewStore(path string) (*Store, error) {
+	expanded, err := fileutil.ExpandPath(path)
+	if err != nil {
+		return nil, err
+	}
+	s := &Store{path: expanded}
+	if !fileutil.FileExists(expanded) {
+		s.set(&File{})
+		return s, nil
+	}
+	if err := s.Reload(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Path of the key configuration file.
+func (s *Store) Path() string {
+	return s.path
+}
+
+// Reload reads the key configuration file again. The current configuration is kept
+// if the file cannot be parsed.
+func (s *Store) Reload() error {
+	enc, err := ioutil.ReadFile(s.path)
+	if err != nil {
+		return errors.Wrapf(err, "could not read key configuration file %s", s.path)
+	}
+	f, err := Parse(enc)
+	if err != nil {
+		return errors.Wrapf(err, "could not parse key configuration file %s", s.path)
+	}
+	s.set(f)
+	return nil
+}
+
+// Settings returns the settings of a validating key, resolved against the default
+// section of the configuration.
+func (s *Store) Settings(pubKey [48]byte) *Settings {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	settings := DefaultSettings()
+	s.file.Default.apply(settings)
+	s.keys[pubKey].apply(settings)
+	return settings
+}
+
+// File returns a copy of the current configuration.
+func (s *Store) File() *File {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	f := &File{
+		Default: s.file.Default.copy(),
+		Keys:    make(map[string]*KeyConfig, len(s.file.Keys)),
+	}
+	for key, cfg := range s.file.Keys {
+		f.Keys[key] = cfg.copy()
+	}
+	return f
+}
+
+// SetDefault replaces the default section of the configuration and saves it to the file.
+func (s *Store) SetDefault(cfg *KeyConfig) error {
+	if err := cfg.validate(); err != nil {
+		return err
+	}
+	return s.update(func(f *File) {
+		f.Default = cfg.copy()
+	})
+}
+
+// SetKey replaces the configuration of a validating key and saves it to the file.
+func (s *Store) SetKey(pubKey [48]byte, cfg *KeyConfig) error {
+	if err := cfg.validate(); err != nil {
+		return err
+	}
+	return s.update(func(f *File) {
+		deleteKey(f, pubKey)
+		f.Keys[PubKeyToHex(pubKey)] = cfg.copy()
+	})
+}
+
+// DeleteKey removes the configuration of a validating key and saves the file, after
+// which the key uses the default section of the configuration.
+func (s *Store) DeleteKey(pubKey [48]byte) error {
+	return s.update(func(f *File) {
+		deleteKey(f, pubKey)
+	})
+}
+
+// DisableKeys sets the validating keys as disabled and saves the file, keeping their other
+// settings.
+func (s *Store) DisableKeys(pubKeys [][48]byte) error {
+	return s.update(func(f *File) {
+		for _, pubKey := range pubKeys {
+			cfg := &KeyConfig{}
+			for key, existing := range f.Keys {
+				if decoded, err := PubKeyFromHex(key); err == nil && decoded == pubKey && existing != nil {
+					cfg = existing
+				}
+			}
+			enabled := false
+			cfg.Enabled = &enabled
+			deleteKey(f, pubKey)
+			f.Keys[PubKeyToHex(pubKey)] = cfg
+		}
+	})
+}
+
+// Keys returns the validating keys with a configuration, in the order of their hex encoding.
+func (s *Store) Keys() [][48]byte {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	keys := make([][48]byte, 0, len(s.keys))
+	for pubKey := range s.keys {
+		keys = append(keys, pubKey)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return PubKeyToHex(keys[i]) < PubKeyToHex(keys[j])
+	})
+	return keys
+}
+
+func (s *Store) update(change func(f *File)) error {
+	s.updateLock.Lock()
+	defer s.updateLock.Unlock()
+	f := s.File()
+	change(f)
+	var enc []byte
+	var err error
+	if strings.EqualFold(filepath.Ext(s.path), ".json") {
+		enc, err = json.MarshalIndent(f, "", "  ")
+	} else {
+		enc, err = yaml.Marshal(f)
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not marshal key configuration")
+	}
+	if err := writeFile(s.path, enc); err != nil {
+		return errors.Wrapf(err, "could not write key configuration file %s", s.path)
+	}
+	s.set(f)
+	return nil
+}
+
+func (s *Store) set(f *File) {
+	if f.Keys == nil {
+		f.Keys = make(map[string]*KeyConfig)
+	}
+	keys := make(map[[48]byte]*KeyConfig, len(f.Keys))
+	for key, cfg := range f.Keys {
+		// Keys are validated when the file is parsed.
+		pubKey, err := PubKeyFromHex(key)
+		if err != nil {
+			continue
+		}
+		keys[pubKey] = cfg
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.file = f
+	s.keys = keys
+}
+
+// deleteKey removes a key from the file, whichever way its hex encoding is written.
+func deleteKey(f *File, pubKey [48]byte) {
+	for key := range f.Keys {
+		if decoded, err := PubKeyFromHex(key); err == nil && decoded == pubKey {
+			delete(f.Keys, key)
+		}
+	}
+}
+
+// writeFile keeps the permissions of an existing file, as it is typically written by the
+// user rather than by the validator client.
+func writeFile(path string, enc []byte) error {
+	perm := params.BeaconIoConfig().ReadWritePermissions
+	if info, err := os.Stat(path); err == nil {
+		perm = info.Mode().Perm()
+	} else if err := os.MkdirAll(filepath.Dir(path), params.BeaconIoConfig().ReadWriteExecutePermissions); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, enc, perm)
+}
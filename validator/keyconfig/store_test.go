@@ -0,0 +1,180 @@
+package keyconfig
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+var (
+	firstKey  = [48]byte{1}
+	secondKey = [48]byte{2}
+)
+
+func writeConfig(t *testing.T, path, content string) {
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0644))
+}
+
+func TestNewStore_YAML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys.yaml")
+	writeConfig(t, path, `default:
+  graffiti: "prysm"
+  doppelganger_check: false
+keys:
+  `+PubKeyToHex(firstKey)+`:
+    graffiti: "hex:0x6869"
+    propose: false
+  `+PubKeyToHex(secondKey)[2:]+`:
+    enabled: false
+`)
+	s, err := NewStore(path)
+	require.NoError(t, err)
+
+	assert.DeepEqual(t, &Settings{
+		Graffiti: "hi",
+		Enabled:  true,
+		Attest:   true,
+	}, s.Settings(firstKey))
+	assert.DeepEqual(t, &Settings{
+		Graffiti: "prysm",
+		Attest:   true,
+		Propose:  true,
+	}, s.Settings(secondKey))
+	assert.DeepEqual(t, &Settings{
+		Graffiti: "prysm",
+		Enabled:  true,
+		Attest:   true,
+		Propose:  true,
+	}, s.Settings([48]byte{3}))
+	assert.DeepEqual(t, [][48]byte{firstKey, secondKey}, s.Keys())
+}
+
+func TestNewStore_JSON(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys.json")
+	writeConfig(t, path, `{"keys": {"`+PubKeyToHex(firstKey)+`": {"attest": false}}}`)
+	s, err := NewStore(path)
+	require.NoError(t, err)
+	settings := DefaultSettings()
+	settings.Attest = false
+	assert.DeepEqual(t, settings, s.Settings(firstKey))
+	assert.DeepEqual(t, DefaultSettings(), s.Settings(secondKey))
+}
+
+func TestNewStore_MissingFile(t *testing.T) {
+	s, err := NewStore(filepath.Join(t.TempDir(), "keys.yaml"))
+	require.NoError(t, err)
+	assert.DeepEqual(t, DefaultSettings(), s.Settings(firstKey))
+	assert.Equal(t, 0, len(s.Keys()))
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{name: "unknown field", content: `default: {graffitti: "x"}`, wantErr: "could not unmarshal"},
+		{name: "short key", content: `keys: {"0x0102": {enabled: false}}`, wantErr: "has length 2"},
+		{name: "bad hex", content: `keys: {"0xzz": {enabled: false}}`, wantErr: "could not decode public key"},
+		{name: "long graffiti", content: `default: {graffiti: "` + strings.Repeat("a", 33) + `"}`, wantErr: "at most 32 bytes"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(tt.content))
+			assert.ErrorContains(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestStore_SetAndDelete(t *testing.T) {
+	for _, name := range []string{"keys.yaml", "keys.json"} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), name)
+			s, err := NewStore(path)
+			require.NoError(t, err)
+
+			graffiti := "hello"
+			disabled := false
+			require.NoError(t, s.SetDefault(&KeyConfig{Graffiti: &graffiti}))
+			require.NoError(t, s.SetKey(firstKey, &KeyConfig{Enabled: &disabled}))
+			assert.Equal(t, false, s.Settings(firstKey).Enabled)
+			assert.Equal(t, "hello", s.Settings(firstKey).Graffiti)
+
+			// Changes are persisted in the file.
+			reloaded, err := NewStore(path)
+			require.NoError(t, err)
+			assert.DeepEqual(t, s.File(), reloaded.File())
+
+			require.NoError(t, s.DeleteKey(firstKey))
+			assert.Equal(t, true, s.Settings(firstKey).Enabled)
+			require.NoError(t, reloaded.Reload())
+			assert.Equal(t, 0, len(reloaded.Keys()))
+
+			long := strings.Repeat("a", 33)
+			assert.ErrorContains(t, "at most 32 bytes", s.SetKey(secondKey, &KeyConfig{Graffiti: &long}))
+		})
+	}
+}
+
+func TestStore_SetKey_ReplacesUnprefixedKey(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys.yaml")
+	writeConfig(t, path, `keys: {"`+PubKeyToHex(firstKey)[2:]+`": {enabled: false}}`)
+	s, err := NewStore(path)
+	require.NoError(t, err)
+	enabled := true
+	require.NoError(t, s.SetKey(firstKey, &KeyConfig{Enabled: &enabled}))
+	assert.Equal(t, 1, len(s.File().Keys))
+	assert.Equal(t, true, s.Settings(firstKey).Enabled)
+}
+
+func TestStore_Watch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys.yaml")
+	writeConfig(t, path, `default: {enabled: true}`)
+	s, err := NewStore(path)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.Watch(ctx)
+	// Give the watcher time to start.
+	time.Sleep(100 * time.Millisecond)
+
+	// An invalid file does not replace the configuration.
+	writeConfig(t, path, `default: {enabled: maybe}`)
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, true, s.Settings(firstKey).Enabled)
+
+	writeConfig(t, path, `default: {enabled: false}`)
+	require.NoError(t, waitFor(func() bool {
+		return !s.Settings(firstKey).Enabled
+	}))
+}
+
+func waitFor(condition func() bool) error {
+	for i := 0; i < 50; i++ {
+		if condition() {
+			return nil
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	return context.DeadlineExceeded
+}
+
+func TestStore_DisableKeys(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys.yaml")
+	writeConfig(t, path, `keys: {"`+PubKeyToHex(firstKey)[2:]+`": {graffiti: "hello"}}`)
+	s, err := NewStore(path)
+	require.NoError(t, err)
+	require.NoError(t, s.DisableKeys([][48]byte{firstKey, secondKey}))
+	assert.Equal(t, 2, len(s.File().Keys))
+	assert.Equal(t, false, s.Settings(firstKey).Enabled)
+	assert.Equal(t, "hello", s.Settings(firstKey).Graffiti)
+	assert.Equal(t, false, s.Settings(secondKey).Enabled)
+	assert.Equal(t, true, s.Settings([48]byte{3}).Enabled)
+}
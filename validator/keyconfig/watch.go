@@ -0,0 +1,57 @@
+package keyconfig
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/prysmaticlabs/prysm/shared/asyncutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+)
+
+// Watch reloads the key configuration whenever the file changes, until the context is
+// canceled. The directory of the file is watched rather than the file itself, as editors
+// commonly replace files instead of writing them in place. Events are debounced in the
+// same way as keystore changes, so a burst of writes results in a single reload.
+func (s *Store) Watch(ctx context.Context) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		log.WithError(err).Error("Could not initialize file watcher")
+		return
+	}
+	defer func() {
+		if err := watcher.Close(); err != nil {
+			log.WithError(err).Error("Could not close file watcher")
+		}
+	}()
+	dir := filepath.Dir(s.path)
+	if err := watcher.Add(dir); err != nil {
+		log.WithError(err).Errorf("Could not add directory %s to file watcher", dir)
+		return
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	fileChangesChan := make(chan interface{}, 100)
+	defer close(fileChangesChan)
+
+	go asyncutil.Debounce(ctx, featureconfig.Get().KeystoreImportDebounceInterval, fileChangesChan, func(interface{}) {
+		if err := s.Reload(); err != nil {
+			log.WithError(err).Error("Could not reload key configuration, keeping the previous configuration")
+			return
+		}
+		log.WithField("path", s.path).Info("Reloaded key configuration")
+	})
+	for {
+		select {
+		case event := <-watcher.Events:
+			if filepath.Clean(event.Name) != s.path || event.Op&(fsnotify.Write|fsnotify.Create) == 0 {
+				continue
+			}
+			fileChangesChan <- event
+		case err := <-watcher.Errors:
+			log.WithError(err).Errorf("Could not watch for file changes for: %s", s.path)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package derived
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	util "github.com/wealdtech/go-eth2-util"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+// Account is a validating key derived from a mnemonic, along with the account
+// index and the EIP-2334 path it was derived at.
+type Account struct {
+	Index     uint64
+	Path      string
+	PublicKey [48]byte
+	SecretKey []byte
+}
+
+// DeriveAccounts derives the validating keys of the accounts at the given indices from
+// the mnemonic, along the EIP-2334 path used by derived wallets.
+func DeriveAccounts(mnemonic, mnemonicPassphrase string, indices []uint64) ([]*Account, error) {
+	seed, err := seedFromMnemonic(mnemonic, mnemonicPassphrase)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not derive seed from mnemonic")
+	}
+	accounts := make([]*Account, len(indices))
+	for i, index := range indices {
+		path := fmt.Sprintf(ValidatingKeyDerivationPathTemplate, index)
+		privKey, err := util.PrivateKeyFromSeedAndPath(seed, path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not derive validating key at path %s", path)
+		}
+		accounts[i] = &Account{
+			Index:     index,
+			Path:      path,
+			PublicKey: bytesutil.ToBytes48(privKey.PublicKey().Marshal()),
+			SecretKey: privKey.Marshal(),
+		}
+	}
+	return accounts, nil
+}
+
+// FindAccounts derives the accounts of the mnemonic at indices below the search limit until
+// all the given public keys are found. It fails if a public key is not derived from the
+// mnemonic within the limit.
+func FindAccounts(
+	mnemonic, mnemonicPassphrase string, pubKeys [][48]byte, searchLimit uint64,
+) ([]*Account, error) {
+	seed, err := seedFromMnemonic(mnemonic, mnemonicPassphrase)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not derive seed from mnemonic")
+	}
+	wanted := make(map[[48]byte]*Account, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		wanted[pubKey] = nil
+	}
+	found := 0
+	for index := uint64(0); index < searchLimit && found < len(wanted); index++ {
+		path := fmt.Sprintf(ValidatingKeyDerivationPathTemplate, index)
+		privKey, err := util.PrivateKeyFromSeedAndPath(seed, path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not derive validating key at path %s", path)
+		}
+		pubKey := bytesutil.ToBytes48(privKey.PublicKey().Marshal())
+		if account, ok := wanted[pubKey]; !ok || account != nil {
+			continue
+		}
+		wanted[pubKey] = &Account{
+			Index:     index,
+			Path:      path,
+			PublicKey: pubKey,
+			SecretKey: privKey.Marshal(),
+		}
+		found++
+	}
+	accounts := make([]*Account, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		account := wanted[pubKey]
+		if account == nil {
+			return nil, fmt.Errorf(
+				"public key %#x is not derived from the mnemonic within the first %d accounts",
+				bytesutil.Trunc(pubKey[:]), searchLimit,
+			)
+		}
+		accounts[i] = account
+	}
+	return accounts, nil
+}
+
+// Keystore encrypts the secret key of the account with the password into an EIP-2335
+// keystore, which records the derivation path of the key.
+func (a *Account) Keystore(password string) (*keymanager.Keystore, error) {
+	encryptor := keystorev4.New()
+	cryptoFields, err := encryptor.Encrypt(a.SecretKey, password)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not encrypt secret key for public key %#x", a.PublicKey)
+	}
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+	return &keymanager.Keystore{
+		Crypto:  cryptoFields,
+		ID:      id.String(),
+		Pubkey:  fmt.Sprintf("%x", a.PublicKey),
+		Path:    a.Path,
+		Version: encryptor.Version(),
+		Name:    encryptor.Name(),
+	}, nil
+}
@@ -0,0 +1,60 @@
+package derived
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	constant "github.com/prysmaticlabs/prysm/validator/testing"
+	util "github.com/wealdtech/go-eth2-util"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+func TestDeriveAccounts(t *testing.T) {
+	accounts, err := DeriveAccounts(constant.TestMnemonic, "", []uint64{0, 3})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(accounts))
+
+	seed, err := seedFromMnemonic(constant.TestMnemonic, "")
+	require.NoError(t, err)
+	for i, index := range []uint64{0, 3} {
+		path := fmt.Sprintf(ValidatingKeyDerivationPathTemplate, index)
+		privKey, err := util.PrivateKeyFromSeedAndPath(seed, path)
+		require.NoError(t, err)
+		assert.Equal(t, index, accounts[i].Index)
+		assert.Equal(t, path, accounts[i].Path)
+		assert.DeepEqual(t, privKey.Marshal(), accounts[i].SecretKey)
+		assert.DeepEqual(t, privKey.PublicKey().Marshal(), accounts[i].PublicKey[:])
+	}
+
+	_, err = DeriveAccounts("not a mnemonic", "", []uint64{0})
+	assert.ErrorContains(t, "could not derive seed", err)
+}
+
+func TestFindAccounts(t *testing.T) {
+	derived, err := DeriveAccounts(constant.TestMnemonic, "", []uint64{0, 1, 2})
+	require.NoError(t, err)
+
+	accounts, err := FindAccounts(constant.TestMnemonic, "", [][48]byte{derived[2].PublicKey, derived[0].PublicKey}, 3)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(accounts))
+	assert.Equal(t, uint64(2), accounts[0].Index)
+	assert.Equal(t, uint64(0), accounts[1].Index)
+
+	_, err = FindAccounts(constant.TestMnemonic, "", [][48]byte{derived[2].PublicKey}, 2)
+	assert.ErrorContains(t, "not derived from the mnemonic within the first 2 accounts", err)
+}
+
+func TestAccount_Keystore(t *testing.T) {
+	accounts, err := DeriveAccounts(constant.TestMnemonic, "", []uint64{5})
+	require.NoError(t, err)
+	keystore, err := accounts[0].Keystore("password")
+	require.NoError(t, err)
+	assert.Equal(t, "m/12381/3600/5/0/0", keystore.Path)
+	assert.Equal(t, fmt.Sprintf("%x", accounts[0].PublicKey), keystore.Pubkey)
+
+	decrypted, err := keystorev4.New().Decrypt(keystore.Crypto, "password")
+	require.NoError(t, err)
+	assert.DeepEqual(t, accounts[0].SecretKey, decrypted)
+}
@@ -0,0 +1,10 @@
+/*
+Package observer defines a keymanager holding validating public keys only, without any secret
+material. It lets the validator client follow the duties of a set of keys, for example to audit
+them or to check a setup before migrating keys to it, while making it impossible to sign.
+
+The public keys are read from a file or from an http(s) URL, either as a JSON list of hex
+encoded keys or as one hex encoded key per line, where empty lines and lines starting with #
+are ignored.
+*/
+package observer
@@ -0,0 +1,150 @@
+package observer
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+)
+
+// ErrSigningDisabled is returned for every signing request made to the keymanager.
+var ErrSigningDisabled = errors.New("observer keymanager holds no secret keys and cannot sign")
+
+// fetchTimeout bounds the request made to fetch public keys from a URL.
+const fetchTimeout = 30 * time.Second
+
+// SetupConfig includes configuration values for initializing an observer keymanager.
+type SetupConfig struct {
+	// Source is the path of a file, or an http(s) URL, listing the public keys to observe.
+	Source string
+}
+
+// Keymanager implementation holding validating public keys without their secret keys.
+type Keymanager struct {
+	pubKeys             [][48]byte
+	accountsChangedFeed *event.Feed
+}
+
+// NewKeymanager loads the public keys to observe from the configured source.
+func NewKeymanager(ctx context.Context, cfg *SetupConfig) (*Keymanager, error) {
+	enc, err := readSource(ctx, cfg.Source)
+	if err != nil {
+		return nil, err
+	}
+	pubKeys, err := ParsePublicKeys(enc)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not parse public keys from %s", cfg.Source)
+	}
+	if len(pubKeys) == 0 {
+		return nil, fmt.Errorf("no public keys found in %s", cfg.Source)
+	}
+	log.WithField("source", cfg.Source).Infof("Observing %d validating public keys, signing is disabled", len(pubKeys))
+	return &Keymanager{
+		pubKeys:             pubKeys,
+		accountsChangedFeed: new(event.Feed),
+	}, nil
+}
+
+// FetchValidatingPublicKeys returns the observed public keys.
+func (km *Keymanager) FetchValidatingPublicKeys(_ context.Context) ([][48]byte, error) {
+	keys := make([][48]byte, len(km.pubKeys))
+	copy(keys, km.pubKeys)
+	return keys, nil
+}
+
+// Sign always fails, as the keymanager holds no secret keys.
+func (km *Keymanager) Sign(_ context.Context, _ *validatorpb.SignRequest) (bls.Signature, error) {
+	return nil, ErrSigningDisabled
+}
+
+// SubscribeAccountChanges creates an event subscription for a channel
+// to listen for public key changes at runtime, such as when new validator accounts
+// are imported into the keymanager while the validator process is running.
+func (km *Keymanager) SubscribeAccountChanges(pubKeysChan chan [][48]byte) event.Subscription {
+	return km.accountsChangedFeed.Subscribe(pubKeysChan)
+}
+
+// ParsePublicKeys decodes a list of hex encoded public keys, given either as a JSON list or
+// as one key per line. Duplicate keys are only returned once.
+func ParsePublicKeys(enc []byte) ([][48]byte, error) {
+	var encodedKeys []string
+	if trimmed := bytes.TrimSpace(enc); len(trimmed) > 0 && trimmed[0] == '[' {
+		if err := json.Unmarshal(trimmed, &encodedKeys); err != nil {
+			return nil, errors.Wrap(err, "could not decode JSON list of public keys")
+		}
+	} else {
+		for _, line := range strings.Split(string(enc), "\n") {
+			line = strings.TrimSpace(line)
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			encodedKeys = append(encodedKeys, line)
+		}
+	}
+	seen := make(map[[48]byte]bool, len(encodedKeys))
+	pubKeys := make([][48]byte, 0, len(encodedKeys))
+	for _, encodedKey := range encodedKeys {
+		b, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(encodedKey), "0x"))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decode public key %s", encodedKey)
+		}
+		if len(b) != 48 {
+			return nil, fmt.Errorf("public key %s has length %d, expected 48", encodedKey, len(b))
+		}
+		if _, err := bls.PublicKeyFromBytes(b); err != nil {
+			return nil, errors.Wrapf(err, "public key %s is not a valid BLS public key", encodedKey)
+		}
+		var pubKey [48]byte
+		copy(pubKey[:], b)
+		if seen[pubKey] {
+			continue
+		}
+		seen[pubKey] = true
+		pubKeys = append(pubKeys, pubKey)
+	}
+	return pubKeys, nil
+}
+
+func readSource(ctx context.Context, source string) ([]byte, error) {
+	if !strings.HasPrefix(source, "http://") && !strings.HasPrefix(source, "https://") {
+		path, err := fileutil.ExpandPath(source)
+		if err != nil {
+			return nil, err
+		}
+		enc, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read public keys file %s", path)
+		}
+		return enc, nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, fetchTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, source, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not fetch public keys from %s", source)
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Error("Could not close response body")
+		}
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not fetch public keys from %s: %s", source, resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
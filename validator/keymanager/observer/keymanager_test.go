@@ -0,0 +1,96 @@
+package observer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func randomPubKeys(t *testing.T, n int) [][48]byte {
+	pubKeys := make([][48]byte, n)
+	for i := range pubKeys {
+		secretKey, err := bls.RandKey()
+		require.NoError(t, err)
+		copy(pubKeys[i][:], secretKey.PublicKey().Marshal())
+	}
+	return pubKeys
+}
+
+func TestNewKeymanager_File(t *testing.T) {
+	ctx := context.Background()
+	pubKeys := randomPubKeys(t, 2)
+	content := fmt.Sprintf("# observed keys\n%#x\n\n%x\n%#x\n", pubKeys[0], pubKeys[1], pubKeys[0])
+	path := filepath.Join(t.TempDir(), "keys.txt")
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+
+	km, err := NewKeymanager(ctx, &SetupConfig{Source: path})
+	require.NoError(t, err)
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, pubKeys, keys)
+
+	_, err = km.Sign(ctx, &validatorpb.SignRequest{PublicKey: pubKeys[0][:]})
+	assert.ErrorContains(t, ErrSigningDisabled.Error(), err)
+}
+
+func TestNewKeymanager_URL(t *testing.T) {
+	ctx := context.Background()
+	pubKeys := randomPubKeys(t, 3)
+	encoded := make([]string, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		encoded[i] = fmt.Sprintf("%#x", pubKey)
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/keys" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(encoded))
+	}))
+	defer srv.Close()
+
+	km, err := NewKeymanager(ctx, &SetupConfig{Source: srv.URL + "/keys"})
+	require.NoError(t, err)
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, pubKeys, keys)
+
+	_, err = NewKeymanager(ctx, &SetupConfig{Source: srv.URL + "/missing"})
+	assert.ErrorContains(t, "404", err)
+}
+
+func TestParsePublicKeys_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{name: "not hex", content: "0xzz", wantErr: "could not decode public key"},
+		{name: "wrong length", content: "0x0102", wantErr: "has length 2"},
+		{name: "not a BLS key", content: fmt.Sprintf("%#x", make([]byte, 48)), wantErr: "not a valid BLS public key"},
+		{name: "bad JSON", content: `["0x01"`, wantErr: "could not decode JSON"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParsePublicKeys([]byte(tt.content))
+			assert.ErrorContains(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestNewKeymanager_NoKeys(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys.txt")
+	require.NoError(t, ioutil.WriteFile(path, []byte("# nothing yet\n"), 0600))
+	_, err := NewKeymanager(context.Background(), &SetupConfig{Source: path})
+	assert.ErrorContains(t, "no public keys found", err)
+}
@@ -0,0 +1,5 @@
+package observer
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "observer-keymanager")
@@ -24,6 +24,7 @@ type Keystore struct {
 	Crypto  map[string]interface{} `json:"crypto"`
 	ID      string                 `json:"uuid"`
 	Pubkey  string                 `json:"pubkey"`
+	Path    string                 `json:"path"`
 	Version uint                   `json:"version"`
 	Name    string                 `json:"name"`
 }
@@ -26,6 +26,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/fileutil"
 	"github.com/prysmaticlabs/prysm/shared/gateway"
+	"github.com/prysmaticlabs/prysm/shared/networkdir"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/prereq"
 	"github.com/prysmaticlabs/prysm/shared/prometheus"
@@ -34,13 +35,18 @@ import (
 	accountsiface "github.com/prysmaticlabs/prysm/validator/accounts/iface"
 	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
 	"github.com/prysmaticlabs/prysm/validator/client"
+	vdb "github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/validator/exits"
 	g "github.com/prysmaticlabs/prysm/validator/graffiti"
+	"github.com/prysmaticlabs/prysm/validator/keyconfig"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/observer"
 	"github.com/prysmaticlabs/prysm/validator/rpc"
 	slashingprotection "github.com/prysmaticlabs/prysm/validator/slashing-protection"
 	"github.com/prysmaticlabs/prysm/validator/slashing-protection/iface"
+	"github.com/prysmaticlabs/prysm/validator/slashing-protection/remote"
 	"github.com/prysmaticlabs/prysm/validator/web"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
@@ -54,6 +60,7 @@ type ValidatorClient struct {
 	ctx               context.Context
 	cancel            context.CancelFunc
 	db                *kv.Store
+	slashingDB        vdb.Database
 	services          *shared.ServiceRegistry // Lifecycle and service store.
 	lock              sync.RWMutex
 	wallet            *wallet.Wallet
@@ -101,6 +108,9 @@ func NewValidatorClient(cliCtx *cli.Context) (*ValidatorClient, error) {
 		chainConfigFileName := cliCtx.String(cmd.ChainConfigFileFlag.Name)
 		params.LoadChainConfigFile(chainConfigFileName)
 	}
+	if _, err := networkdir.ConfigureFromCLI(cliCtx); err != nil {
+		return nil, err
+	}
 
 	// If the --web flag is enabled to administer the validator
 	// client via a web portal, we start the validator client in a different way.
@@ -179,6 +189,13 @@ func (c *ValidatorClient) initializeFromCLI(cliCtx *cli.Context) error {
 		if err != nil {
 			return errors.Wrap(err, "could not generate interop keys")
 		}
+	} else if cliCtx.IsSet(flags.ObserverKeysFlag.Name) {
+		keyManager, err = observer.NewKeymanager(cliCtx.Context, &observer.SetupConfig{
+			Source: cliCtx.String(flags.ObserverKeysFlag.Name),
+		})
+		if err != nil {
+			return errors.Wrap(err, "could not load public keys to observe")
+		}
 	} else {
 		// Read the wallet from the specified path.
 		w, err := wallet.OpenWalletOrElseCli(cliCtx, func(cliCtx *cli.Context) (*wallet.Wallet, error) {
@@ -397,8 +414,12 @@ func (c *ValidatorClient) registerValidatorService(
 		protector = sp
 	}
 
+	valDB, err := c.slashingProtectionDB()
+	if err != nil {
+		return err
+	}
+
 	gStruct := &g.Graffiti{}
-	var err error
 	if c.cliCtx.IsSet(flags.GraffitiFileFlag.Name) {
 		n := c.cliCtx.String(flags.GraffitiFileFlag.Name)
 		gStruct, err = g.ParseGraffitiFile(n)
@@ -407,6 +428,19 @@ func (c *ValidatorClient) registerValidatorService(
 		}
 	}
 
+	var keyConfig *keyconfig.Store
+	if path := c.cliCtx.String(flags.KeyConfigFileFlag.Name); path != "" {
+		keyConfig, err = keyconfig.NewStore(path)
+		if err != nil {
+			return errors.Wrap(err, "could not load key configuration file")
+		}
+	}
+
+	scheduledExits, err := c.scheduledExitsStore()
+	if err != nil {
+		return err
+	}
+
 	v, err := client.NewValidatorService(c.cliCtx.Context, &client.Config{
 		Endpoint:                   endpoint,
 		DataDir:                    dataDir,
@@ -420,11 +454,17 @@ func (c *ValidatorClient) registerValidatorService(
 		GrpcRetryDelay:             grpcRetryDelay,
 		GrpcHeadersFlag:            c.cliCtx.String(flags.GrpcHeadersFlag.Name),
 		Protector:                  protector,
-		ValDB:                      c.db,
+		ValDB:                      valDB,
 		UseWeb:                     c.cliCtx.Bool(flags.EnableWebFlag.Name),
 		WalletInitializedFeed:      c.walletInitialized,
 		GraffitiStruct:             gStruct,
 		LogDutyCountDown:           c.cliCtx.Bool(flags.EnableDutyCountDown.Name),
+		BroadcastToBeaconNodes:     c.cliCtx.Bool(flags.BeaconRPCBroadcastFlag.Name),
+		DoppelGangerEpochs:         c.cliCtx.Uint64(flags.DoppelGangerEpochsFlag.Name),
+		DoppelGangerDisableKey:     c.cliCtx.Bool(flags.DoppelGangerDisableKeyFlag.Name),
+		KeyConfig:                  keyConfig,
+		ObserverMode:               c.cliCtx.IsSet(flags.ObserverKeysFlag.Name),
+		ScheduledExits:             scheduledExits,
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not initialize validator service")
@@ -432,6 +472,55 @@ func (c *ValidatorClient) registerValidatorService(
 
 	return c.services.RegisterService(v)
 }
+
+// scheduledExitsStore returns the store of voluntary exits scheduled with the keys of the
+// wallet, or nil if there is no wallet or its keymanager does not keep the signing keys.
+func (c *ValidatorClient) scheduledExitsStore() (*exits.Store, error) {
+	if c.wallet == nil {
+		return nil, nil
+	}
+	if kind := c.wallet.KeymanagerKind(); kind != keymanager.Imported && kind != keymanager.Derived {
+		return nil, nil
+	}
+	store, err := exits.NewStore(filepath.Join(c.wallet.AccountsDir(), exits.StoreFileName), c.wallet.Password())
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open scheduled voluntary exits")
+	}
+	return store, nil
+}
+
+// slashingProtectionDB returns the validator database used for slashing protection, which
+// delegates to a remote slashing protection server if one is configured.
+func (c *ValidatorClient) slashingProtectionDB() (vdb.Database, error) {
+	if c.slashingDB != nil {
+		return c.slashingDB, nil
+	}
+	endpoint := c.cliCtx.String(flags.SlashingProtectionRemoteFlag.Name)
+	if endpoint == "" {
+		c.slashingDB = c.db
+		return c.db, nil
+	}
+	var token string
+	if tokenFile := c.cliCtx.String(flags.SlashingProtectionTokenFileFlag.Name); tokenFile != "" {
+		var err error
+		token, err = remote.ReadTokenFile(tokenFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+	remoteDB, err := remote.NewDB(c.cliCtx.Context, c.db, &remote.DBConfig{
+		Endpoint: endpoint,
+		CertFlag: c.cliCtx.String(flags.SlashingProtectionRemoteCertFlag.Name),
+		Token:    token,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not connect to slashing protection server")
+	}
+	log.WithField("endpoint", endpoint).Info("Using remote slashing protection server")
+	c.slashingDB = remoteDB
+	return remoteDB, nil
+}
+
 func (c *ValidatorClient) registerSlasherService() error {
 	endpoint := c.cliCtx.String(flags.SlasherRPCProviderFlag.Name)
 	if endpoint == "" {
@@ -475,8 +564,12 @@ func (c *ValidatorClient) registerRPCService(cliCtx *cli.Context, km keymanager.
 	walletDir := cliCtx.String(flags.WalletDirFlag.Name)
 	grpcHeaders := c.cliCtx.String(flags.GrpcHeadersFlag.Name)
 	clientCert := c.cliCtx.String(flags.CertFlag.Name)
+	valDB, err := c.slashingProtectionDB()
+	if err != nil {
+		return err
+	}
 	server := rpc.NewServer(cliCtx.Context, &rpc.Config{
-		ValDB:                    c.db,
+		ValDB:                    valDB,
 		Host:                     rpcHost,
 		Port:                     fmt.Sprintf("%d", rpcPort),
 		WalletInitializedFeed:    c.walletInitialized,
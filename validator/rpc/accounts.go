@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/golang/protobuf/ptypes/empty"
 	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
@@ -209,3 +210,28 @@ func (s *Server) VoluntaryExit(
 		ExitedKeys: rawExitedKeys,
 	}, nil
 }
+
+// ListDoppelGangerStatuses returns the doppelganger protection state of the validating keys
+// activated since the validator client started.
+func (s *Server) ListDoppelGangerStatuses(_ context.Context, _ *empty.Empty) (*pb.DoppelGangerStatusesResponse, error) {
+	if s.validatorService == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator service not running")
+	}
+	states := s.validatorService.DoppelGangerStatuses()
+	res := &pb.DoppelGangerStatusesResponse{
+		Enabled:  states != nil,
+		Statuses: make([]*pb.DoppelGangerStatusesResponse_KeyStatus, len(states)),
+	}
+	for i, st := range states {
+		pubKey := st.PublicKey
+		res.Statuses[i] = &pb.DoppelGangerStatusesResponse_KeyStatus{
+			ValidatingPublicKey: pubKey[:],
+			ValidatorIndex:      uint64(st.ValidatorIndex),
+			Status:              st.Status.String(),
+			StartEpoch:          uint64(st.StartEpoch),
+			RemainingEpochs:     st.RemainingEpochs,
+			DetectedEpoch:       uint64(st.DetectedEpoch),
+		}
+	}
+	return res, nil
+}
@@ -12,16 +12,19 @@ import (
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/mock"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	"github.com/prysmaticlabs/prysm/validator/accounts"
 	"github.com/prysmaticlabs/prysm/validator/accounts/iface"
 	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/client"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
 	constant "github.com/prysmaticlabs/prysm/validator/testing"
@@ -313,3 +316,27 @@ func TestServer_VoluntaryExit(t *testing.T) {
 	require.NoError(t, err)
 	require.DeepEqual(t, rawPubKeys, res.ExitedKeys)
 }
+
+func TestServer_ListDoppelGangerStatuses(t *testing.T) {
+	ctx := context.Background()
+	s := &Server{}
+	_, err := s.ListDoppelGangerStatuses(ctx, &empty.Empty{})
+	assert.ErrorContains(t, "Validator service not running", err)
+
+	vs, err := client.NewValidatorService(ctx, &client.Config{})
+	require.NoError(t, err)
+	s.validatorService = vs
+	res, err := s.ListDoppelGangerStatuses(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, false, res.Enabled)
+
+	resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{EnableDoppelGanger: true})
+	defer resetCfg()
+	vs, err = client.NewValidatorService(ctx, &client.Config{DoppelGangerEpochs: 2})
+	require.NoError(t, err)
+	s.validatorService = vs
+	res, err = s.ListDoppelGangerStatuses(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, true, res.Enabled)
+	assert.Equal(t, 0, len(res.Statuses))
+}
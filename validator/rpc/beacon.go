@@ -32,6 +32,7 @@ func (s *Server) registerBeaconClient() error {
 		s.clientGrpcRetries,
 		s.clientGrpcRetryDelay,
 		streamInterceptor,
+		client.WithMultipleEndpoints(),
 	)
 	if dialOpts == nil {
 		return errors.New("no dial options for beacon chain gRPC client")
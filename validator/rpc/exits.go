@@ -0,0 +1,146 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	types "github.com/prysmaticlabs/eth2-types"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/validator/exits"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ScheduleVoluntaryExits signs voluntary exits of the specified keys for an epoch, which the
+// validator client submits once the chain reaches the epoch.
+func (s *Server) ScheduleVoluntaryExits(
+	ctx context.Context, req *pb.ScheduleVoluntaryExitsRequest,
+) (*pb.ScheduleVoluntaryExitsResponse, error) {
+	if len(req.PublicKeys) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "No public keys specified to exit")
+	}
+	manager, err := s.exitsManager()
+	if err != nil {
+		return nil, err
+	}
+	if s.keymanager == nil {
+		return nil, status.Error(codes.FailedPrecondition, "No wallet found")
+	}
+	pubKeys, err := publicKeysFromRequest(req.PublicKeys)
+	if err != nil {
+		return nil, err
+	}
+	scheduled, failed, err := manager.Schedule(ctx, s.keymanager.Sign, pubKeys, types.Epoch(req.Epoch))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not schedule voluntary exits: %v", err)
+	}
+	res := &pb.ScheduleVoluntaryExitsResponse{
+		Scheduled: make([]*pb.ScheduledVoluntaryExit, len(scheduled)),
+		Failed:    make([]*pb.ScheduleVoluntaryExitsResponse_FailedKey, 0, len(failed)),
+	}
+	for i, exit := range scheduled {
+		res.Scheduled[i] = scheduledExitToProto(exit)
+	}
+	// Failed keys are reported in the order of the request.
+	for _, pubKey := range pubKeys {
+		if err, ok := failed[pubKey]; ok {
+			key := pubKey
+			res.Failed = append(res.Failed, &pb.ScheduleVoluntaryExitsResponse_FailedKey{
+				PublicKey: key[:],
+				Error:     err.Error(),
+			})
+		}
+	}
+	return res, nil
+}
+
+// ListScheduledVoluntaryExits returns the scheduled voluntary exits and their progress.
+func (s *Server) ListScheduledVoluntaryExits(_ context.Context, _ *empty.Empty) (*pb.ScheduledVoluntaryExitsResponse, error) {
+	manager, err := s.exitsManager()
+	if err != nil {
+		return nil, err
+	}
+	return scheduledExitsResponse(manager)
+}
+
+// CancelScheduledVoluntaryExits removes the scheduled voluntary exits of the specified keys,
+// unless they were already submitted.
+func (s *Server) CancelScheduledVoluntaryExits(
+	_ context.Context, req *pb.CancelScheduledVoluntaryExitsRequest,
+) (*pb.ScheduledVoluntaryExitsResponse, error) {
+	manager, err := s.exitsManager()
+	if err != nil {
+		return nil, err
+	}
+	pubKeys, err := publicKeysFromRequest(req.PublicKeys)
+	if err != nil {
+		return nil, err
+	}
+	if err := manager.Cancel(pubKeys); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not cancel scheduled voluntary exits: %v", err)
+	}
+	return scheduledExitsResponse(manager)
+}
+
+func (s *Server) exitsManager() (*exits.Manager, error) {
+	if s.validatorService == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator service not running")
+	}
+	manager := s.validatorService.ScheduledExits()
+	if manager == nil {
+		return nil, status.Error(
+			codes.FailedPrecondition, "Voluntary exits can only be scheduled with Imported or Derived wallets",
+		)
+	}
+	return manager, nil
+}
+
+func publicKeysFromRequest(keys [][]byte) ([][48]byte, error) {
+	pubKeys := make([][48]byte, len(keys))
+	for i, key := range keys {
+		if len(key) != 48 {
+			return nil, status.Errorf(codes.InvalidArgument, "Public key has length %d, expected 48", len(key))
+		}
+		pubKeys[i] = bytesutil.ToBytes48(key)
+	}
+	return pubKeys, nil
+}
+
+func scheduledExitsResponse(manager *exits.Manager) (*pb.ScheduledVoluntaryExitsResponse, error) {
+	scheduled, err := manager.Store().Exits()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not read scheduled voluntary exits: %v", err)
+	}
+	res := &pb.ScheduledVoluntaryExitsResponse{
+		Exits: make([]*pb.ScheduledVoluntaryExit, len(scheduled)),
+	}
+	for i, exit := range scheduled {
+		res.Exits[i] = scheduledExitToProto(exit)
+	}
+	return res, nil
+}
+
+func scheduledExitToProto(exit *exits.ScheduledExit) *pb.ScheduledVoluntaryExit {
+	var st pb.ScheduledVoluntaryExit_Status
+	switch exit.Status {
+	case exits.Submitted:
+		st = pb.ScheduledVoluntaryExit_SUBMITTED
+	case exits.Exiting:
+		st = pb.ScheduledVoluntaryExit_EXITING
+	case exits.Withdrawable:
+		st = pb.ScheduledVoluntaryExit_WITHDRAWABLE
+	default:
+		st = pb.ScheduledVoluntaryExit_SCHEDULED
+	}
+	pubKey := exit.PubKey
+	return &pb.ScheduledVoluntaryExit{
+		PublicKey:         pubKey[:],
+		ValidatorIndex:    uint64(exit.SignedExit.Exit.ValidatorIndex),
+		Epoch:             uint64(exit.SignedExit.Exit.Epoch),
+		Status:            st,
+		ExitEpoch:         uint64(exit.ExitEpoch),
+		WithdrawableEpoch: uint64(exit.WithdrawableEpoch),
+		Error:             exit.Error,
+	}
+}
@@ -0,0 +1,41 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/client"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer_ScheduledVoluntaryExits_Preconditions(t *testing.T) {
+	ctx := context.Background()
+	s := &Server{}
+	_, err := s.ScheduleVoluntaryExits(ctx, &pb.ScheduleVoluntaryExitsRequest{})
+	assert.ErrorContains(t, "No public keys specified", err)
+	_, err = s.ListScheduledVoluntaryExits(ctx, &empty.Empty{})
+	assert.ErrorContains(t, "Validator service not running", err)
+
+	vs, err := client.NewValidatorService(ctx, &client.Config{})
+	require.NoError(t, err)
+	s.validatorService = vs
+	_, err = s.ScheduleVoluntaryExits(ctx, &pb.ScheduleVoluntaryExitsRequest{PublicKeys: [][]byte{{1}}, Epoch: 10})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = s.CancelScheduledVoluntaryExits(ctx, &pb.CancelScheduledVoluntaryExitsRequest{})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestPublicKeysFromRequest(t *testing.T) {
+	key := [48]byte{1, 2, 3}
+	pubKeys, err := publicKeysFromRequest([][]byte{key[:]})
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][48]byte{key}, pubKeys)
+
+	_, err = publicKeysFromRequest([][]byte{key[:47]})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,153 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/validator/keyconfig"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetKeyConfig returns the content of the key configuration file of the validator client.
+func (s *Server) GetKeyConfig(_ context.Context, _ *empty.Empty) (*pb.KeyConfigResponse, error) {
+	store, err := s.keyConfigStore()
+	if err != nil {
+		return nil, err
+	}
+	return keyConfigResponse(store), nil
+}
+
+// SetKeyConfig replaces the configuration of a validating key, or the default section of the
+// key configuration if no key is given. The change is saved to the key configuration file and
+// applies from the next duties of the validator client.
+func (s *Server) SetKeyConfig(_ context.Context, req *pb.SetKeyConfigRequest) (*pb.KeyConfigResponse, error) {
+	store, err := s.keyConfigStore()
+	if err != nil {
+		return nil, err
+	}
+	cfg := keyConfigFromProto(req.Config)
+	if len(req.ValidatingPublicKey) == 0 {
+		err = store.SetDefault(cfg)
+	} else {
+		if len(req.ValidatingPublicKey) != 48 {
+			return nil, status.Errorf(codes.InvalidArgument, "Public key has length %d, expected 48", len(req.ValidatingPublicKey))
+		}
+		err = store.SetKey(bytesutil.ToBytes48(req.ValidatingPublicKey), cfg)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not set key configuration: %v", err)
+	}
+	return keyConfigResponse(store), nil
+}
+
+// DeleteKeyConfig removes the configuration of a validating key, which then uses the default
+// section of the key configuration.
+func (s *Server) DeleteKeyConfig(_ context.Context, req *pb.DeleteKeyConfigRequest) (*pb.KeyConfigResponse, error) {
+	store, err := s.keyConfigStore()
+	if err != nil {
+		return nil, err
+	}
+	if len(req.ValidatingPublicKey) != 48 {
+		return nil, status.Errorf(codes.InvalidArgument, "Public key has length %d, expected 48", len(req.ValidatingPublicKey))
+	}
+	if err := store.DeleteKey(bytesutil.ToBytes48(req.ValidatingPublicKey)); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not delete key configuration: %v", err)
+	}
+	return keyConfigResponse(store), nil
+}
+
+func (s *Server) keyConfigStore() (*keyconfig.Store, error) {
+	if s.validatorService == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator service not running")
+	}
+	store := s.validatorService.KeyConfig()
+	if store == nil {
+		return nil, status.Error(codes.FailedPrecondition, "No key configuration file in use, start the validator client with --key-config-file")
+	}
+	return store, nil
+}
+
+func keyConfigResponse(store *keyconfig.Store) *pb.KeyConfigResponse {
+	f := store.File()
+	res := &pb.KeyConfigResponse{
+		Path:          store.Path(),
+		DefaultConfig: keyConfigToProto(f.Default),
+		Keys:          make([]*pb.KeyConfigResponse_Key, 0, len(f.Keys)),
+	}
+	for key, cfg := range f.Keys {
+		pubKey, err := keyconfig.PubKeyFromHex(key)
+		if err != nil {
+			continue
+		}
+		res.Keys = append(res.Keys, &pb.KeyConfigResponse_Key{
+			ValidatingPublicKey: pubKey[:],
+			Config:              keyConfigToProto(cfg),
+		})
+	}
+	sort.Slice(res.Keys, func(i, j int) bool {
+		return bytes.Compare(res.Keys[i].ValidatingPublicKey, res.Keys[j].ValidatingPublicKey) < 0
+	})
+	return res
+}
+
+func keyConfigToProto(cfg *keyconfig.KeyConfig) *pb.KeyConfig {
+	if cfg == nil {
+		return &pb.KeyConfig{}
+	}
+	res := &pb.KeyConfig{
+		Enabled:           settingToProto(cfg.Enabled),
+		DoppelgangerCheck: settingToProto(cfg.DoppelGangerCheck),
+		Attest:            settingToProto(cfg.Attest),
+		Propose:           settingToProto(cfg.Propose),
+	}
+	if cfg.Graffiti != nil {
+		res.Graffiti = *cfg.Graffiti
+	}
+	return res
+}
+
+func keyConfigFromProto(cfg *pb.KeyConfig) *keyconfig.KeyConfig {
+	if cfg == nil {
+		return &keyconfig.KeyConfig{}
+	}
+	res := &keyconfig.KeyConfig{
+		Enabled:           settingFromProto(cfg.Enabled),
+		DoppelGangerCheck: settingFromProto(cfg.DoppelgangerCheck),
+		Attest:            settingFromProto(cfg.Attest),
+		Propose:           settingFromProto(cfg.Propose),
+	}
+	if cfg.Graffiti != "" {
+		graffiti := cfg.Graffiti
+		res.Graffiti = &graffiti
+	}
+	return res
+}
+
+func settingToProto(b *bool) pb.KeyConfig_Setting {
+	switch {
+	case b == nil:
+		return pb.KeyConfig_UNSET
+	case *b:
+		return pb.KeyConfig_ENABLED
+	default:
+		return pb.KeyConfig_DISABLED
+	}
+}
+
+func settingFromProto(s pb.KeyConfig_Setting) *bool {
+	var b bool
+	switch s {
+	case pb.KeyConfig_ENABLED:
+		b = true
+	case pb.KeyConfig_DISABLED:
+		b = false
+	default:
+		return nil
+	}
+	return &b
+}
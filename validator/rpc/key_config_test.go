@@ -0,0 +1,80 @@
+package rpc
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/client"
+	"github.com/prysmaticlabs/prysm/validator/keyconfig"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer_KeyConfig(t *testing.T) {
+	ctx := context.Background()
+	s := &Server{}
+	_, err := s.GetKeyConfig(ctx, &empty.Empty{})
+	assert.ErrorContains(t, "Validator service not running", err)
+
+	vs, err := client.NewValidatorService(ctx, &client.Config{})
+	require.NoError(t, err)
+	s.validatorService = vs
+	_, err = s.GetKeyConfig(ctx, &empty.Empty{})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	path := filepath.Join(t.TempDir(), "keys.yaml")
+	store, err := keyconfig.NewStore(path)
+	require.NoError(t, err)
+	vs, err = client.NewValidatorService(ctx, &client.Config{KeyConfig: store})
+	require.NoError(t, err)
+	s.validatorService = vs
+
+	res, err := s.SetKeyConfig(ctx, &pb.SetKeyConfigRequest{
+		Config: &pb.KeyConfig{Graffiti: "prysm", DoppelgangerCheck: pb.KeyConfig_DISABLED},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, path, res.Path)
+	assert.Equal(t, "prysm", res.DefaultConfig.Graffiti)
+
+	pubKey := [48]byte{1}
+	res, err = s.SetKeyConfig(ctx, &pb.SetKeyConfigRequest{
+		ValidatingPublicKey: pubKey[:],
+		Config:              &pb.KeyConfig{Propose: pb.KeyConfig_DISABLED, Attest: pb.KeyConfig_ENABLED},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Keys))
+	assert.DeepEqual(t, pubKey[:], res.Keys[0].ValidatingPublicKey)
+	assert.Equal(t, pb.KeyConfig_DISABLED, res.Keys[0].Config.Propose)
+	assert.Equal(t, pb.KeyConfig_ENABLED, res.Keys[0].Config.Attest)
+	assert.Equal(t, pb.KeyConfig_UNSET, res.Keys[0].Config.Enabled)
+
+	settings := store.Settings(pubKey)
+	assert.Equal(t, "prysm", settings.Graffiti)
+	assert.Equal(t, false, settings.Propose)
+	assert.Equal(t, false, settings.DoppelGangerCheck)
+	assert.Equal(t, true, settings.Enabled)
+
+	// Changes are written to the key configuration file.
+	reloaded, err := keyconfig.NewStore(path)
+	require.NoError(t, err)
+	assert.DeepEqual(t, settings, reloaded.Settings(pubKey))
+
+	res, err = s.DeleteKeyConfig(ctx, &pb.DeleteKeyConfigRequest{ValidatingPublicKey: pubKey[:]})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(res.Keys))
+	assert.Equal(t, true, store.Settings(pubKey).Propose)
+
+	_, err = s.SetKeyConfig(ctx, &pb.SetKeyConfigRequest{ValidatingPublicKey: []byte{1}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = s.SetKeyConfig(ctx, &pb.SetKeyConfigRequest{
+		Config: &pb.KeyConfig{Graffiti: "this graffiti is far too long to fit in a block"},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = s.DeleteKeyConfig(ctx, &pb.DeleteKeyConfigRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
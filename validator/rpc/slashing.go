@@ -9,6 +9,7 @@ import (
 	"github.com/pkg/errors"
 	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
 	slashing "github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format"
+	"github.com/prysmaticlabs/prysm/validator/slashing-protection/remote"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -27,6 +28,17 @@ func (s *Server) ExportSlashingProtection(ctx context.Context, _ *empty.Empty) (
 		return nil, errors.New("err finding validator database at path")
 	}
 
+	// The history is kept by the slashing protection server if one is used.
+	if remoteDB, ok := s.valDB.(*remote.DB); ok {
+		encoded, err := remoteDB.ExportSlashingProtectionJSON(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not export slashing protection history from the slashing protection server")
+		}
+		return &pb.ExportSlashingProtectionResponse{
+			File: string(encoded),
+		}, nil
+	}
+
 	eipJSON, err := slashing.ExportStandardProtectionJSON(ctx, s.valDB)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not export slashing protection history")
@@ -58,6 +70,14 @@ func (s *Server) ImportSlashingProtection(ctx context.Context, req *pb.ImportSla
 	}
 	enc := []byte(req.SlashingProtectionJson)
 
+	if remoteDB, ok := s.valDB.(*remote.DB); ok {
+		if err := remoteDB.ImportSlashingProtectionJSON(ctx, enc); err != nil {
+			return nil, errors.Wrap(err, "could not import slashing protection history on the slashing protection server")
+		}
+		log.Info("Slashing protection JSON successfully imported on the slashing protection server")
+		return &empty.Empty{}, nil
+	}
+
 	buf := bytes.NewBuffer(enc)
 	if err := slashing.ImportStandardProtectionJSON(ctx, s.valDB, buf); err != nil {
 		return nil, err
@@ -28,28 +28,66 @@ const (
 // 3. Call the function which actually exports the data from
 // from the validator's db into an EIP standard slashing protection format
 // 4. Format and save the JSON file to a user's specified output directory.
+//
+// If a remote slashing protection server is specified, the history kept by the server
+// is exported instead.
 func ExportSlashingProtectionJSONCli(cliCtx *cli.Context) error {
+	var encoded []byte
+	var err error
+	if cliCtx.String(flags.SlashingProtectionRemoteFlag.Name) != "" {
+		encoded, err = exportRemoteSlashingProtectionJSON(cliCtx)
+	} else {
+		encoded, err = exportLocalSlashingProtectionJSON(cliCtx)
+	}
+	if err != nil {
+		return err
+	}
+	outputDir, err := prompt.InputDirectory(
+		cliCtx,
+		"Enter your desired output directory for your slashing protection history",
+		flags.SlashingProtectionExportDirFlag,
+	)
+	if err != nil {
+		return errors.Wrap(err, "could not get slashing protection json file")
+	}
+	if outputDir == "" {
+		return errors.New("output directory not specified")
+	}
+	exists, err := fileutil.HasDir(outputDir)
+	if err != nil {
+		return errors.Wrapf(err, "could not check if output directory %s already exists", outputDir)
+	}
+	if !exists {
+		if err := fileutil.MkdirAll(outputDir); err != nil {
+			return errors.Wrapf(err, "could not create output directory %s", outputDir)
+		}
+	}
+	outputFilePath := filepath.Join(outputDir, jsonExportFileName)
+	return fileutil.WriteFile(outputFilePath, encoded)
+}
+
+func exportLocalSlashingProtectionJSON(cliCtx *cli.Context) ([]byte, error) {
 	var err error
 	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
 	if !cliCtx.IsSet(cmd.DataDirFlag.Name) {
 		dataDir, err = prompt.InputDirectory(cliCtx, prompt.DataDirDirPromptText, cmd.DataDirFlag)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
 	// ensure that the validator.db is found under the specified dir or its subdirectories
 	found, _, err := fileutil.RecursiveFileFind(kv.ProtectionDbFileName, dataDir)
 	if err != nil {
-		return errors.Wrapf(err, "error finding validator database at path %s", dataDir)
+		return nil, errors.Wrapf(err, "error finding validator database at path %s", dataDir)
 	}
 	if !found {
-		return errors.Wrapf(err, "validator database not found at path %s", dataDir)
+		return nil, errors.Wrapf(err, "validator database not found at path %s", dataDir)
 	}
 
 	validatorDB, err := kv.NewKVStore(cliCtx.Context, dataDir, &kv.Config{})
 	if err != nil {
-		return errors.Wrapf(err, "could not access validator database at path %s", dataDir)
+		return nil, errors.Wrapf(err, "could not access validator database at path %s", dataDir)
 	}
 	defer func() {
 		if err := validatorDB.Close(); err != nil {
@@ -58,32 +96,11 @@ func ExportSlashingProtectionJSONCli(cliCtx *cli.Context) error {
 	}()
 	eipJSON, err := export.ExportStandardProtectionJSON(cliCtx.Context, validatorDB)
 	if err != nil {
-		return errors.Wrap(err, "could not export slashing protection history")
+		return nil, errors.Wrap(err, "could not export slashing protection history")
 	}
-	outputDir, err := prompt.InputDirectory(
-		cliCtx,
-		"Enter your desired output directory for your slashing protection history",
-		flags.SlashingProtectionExportDirFlag,
-	)
-	if err != nil {
-		return errors.Wrap(err, "could not get slashing protection json file")
-	}
-	if outputDir == "" {
-		return errors.New("output directory not specified")
-	}
-	exists, err := fileutil.HasDir(outputDir)
-	if err != nil {
-		return errors.Wrapf(err, "could not check if output directory %s already exists", outputDir)
-	}
-	if !exists {
-		if err := fileutil.MkdirAll(outputDir); err != nil {
-			return errors.Wrapf(err, "could not create output directory %s", outputDir)
-		}
-	}
-	outputFilePath := filepath.Join(outputDir, jsonExportFileName)
 	encoded, err := json.MarshalIndent(eipJSON, "", "\t")
 	if err != nil {
-		return errors.Wrap(err, "could not JSON marshal slashing protection history")
+		return nil, errors.Wrap(err, "could not JSON marshal slashing protection history")
 	}
-	return fileutil.WriteFile(outputFilePath, encoded)
+	return encoded, nil
 }
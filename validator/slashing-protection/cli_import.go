@@ -23,7 +23,27 @@ import (
 // 3. Read the JSON file from user input.
 // 4. Call the function which actually imports the data from
 // from the standard slashing protection JSON file into our database.
+//
+// If a remote slashing protection server is specified, the file is imported on the server instead.
+// With the minimal flag, the history of each public key is reduced to a single record first.
 func ImportSlashingProtectionCLI(cliCtx *cli.Context) error {
+	if cliCtx.String(flags.SlashingProtectionRemoteFlag.Name) != "" {
+		enc, err := readSlashingProtectionFile(cliCtx)
+		if err != nil {
+			return err
+		}
+		if cliCtx.Bool(flags.SlashingProtectionMinimalFlag.Name) {
+			enc, err = minimizeSlashingProtectionJSON(enc)
+			if err != nil {
+				return err
+			}
+		}
+		if err := importRemoteSlashingProtectionJSON(cliCtx, enc); err != nil {
+			return err
+		}
+		log.Info("Slashing protection JSON successfully imported")
+		return nil
+	}
 	var err error
 	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
 	if !cliCtx.IsSet(cmd.DataDirFlag.Name) {
@@ -50,27 +70,34 @@ func ImportSlashingProtectionCLI(cliCtx *cli.Context) error {
 			log.WithError(err).Errorf("Could not close validator DB")
 		}
 	}()
+	enc, err := readSlashingProtectionFile(cliCtx)
+	if err != nil {
+		return err
+	}
+	buf := bytes.NewBuffer(enc)
+	if cliCtx.Bool(flags.SlashingProtectionMinimalFlag.Name) {
+		err = slashingProtectionFormat.ImportMinimalStandardProtectionJSON(cliCtx.Context, valDB, buf)
+	} else {
+		err = slashingProtectionFormat.ImportStandardProtectionJSON(cliCtx.Context, valDB, buf)
+	}
+	if err != nil {
+		return err
+	}
+	log.Info("Slashing protection JSON successfully imported")
+	return nil
+}
+
+func readSlashingProtectionFile(cliCtx *cli.Context) ([]byte, error) {
 	protectionFilePath, err := prompt.InputDirectory(cliCtx, prompt.SlashingProtectionJSONPromptText, flags.SlashingProtectionJSONFileFlag)
 	if err != nil {
-		return errors.Wrap(err, "could not get slashing protection json file")
+		return nil, errors.Wrap(err, "could not get slashing protection json file")
 	}
 	if protectionFilePath == "" {
-		return fmt.Errorf(
+		return nil, fmt.Errorf(
 			"no path to a slashing_protection.json file specified, please retry or "+
 				"you can also specify it with the %s flag",
 			flags.SlashingProtectionJSONFileFlag.Name,
 		)
 	}
-	enc, err := fileutil.ReadFileAsBytes(protectionFilePath)
-	if err != nil {
-		return err
-	}
-	buf := bytes.NewBuffer(enc)
-	if err := slashingProtectionFormat.ImportStandardProtectionJSON(
-		cliCtx.Context, valDB, buf,
-	); err != nil {
-		return err
-	}
-	log.Info("Slashing protection JSON successfully imported")
-	return nil
+	return fileutil.ReadFileAsBytes(protectionFilePath)
 }
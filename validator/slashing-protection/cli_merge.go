@@ -0,0 +1,106 @@
+package slashingprotection
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	slashingProtectionFormat "github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format"
+	"github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format/format"
+	"github.com/urfave/cli/v2"
+)
+
+// MergeSlashingProtectionJSONCli merges several EIP-3076 standard JSON files, for instance
+// exported from different machines, into a single file which can then be imported.
+//
+// Steps:
+// 1. Read and decode every JSON file given on the command line.
+// 2. Merge them, verifying they share the same genesis validators root.
+// 3. Optionally keep only the histories of the given public keys.
+// 4. Optionally reduce the history of each public key to a single record.
+// 5. Save the result to the output file.
+func MergeSlashingProtectionJSONCli(cliCtx *cli.Context) error {
+	var inputFiles []string
+	for _, path := range strings.Split(cliCtx.String(flags.SlashingProtectionJSONFilesFlag.Name), ",") {
+		if path = strings.TrimSpace(path); path != "" {
+			inputFiles = append(inputFiles, path)
+		}
+	}
+	if len(inputFiles) == 0 {
+		return errors.Errorf("no slashing protection JSON files specified, use the %s flag", flags.SlashingProtectionJSONFilesFlag.Name)
+	}
+	outputFile := cliCtx.String(flags.SlashingProtectionOutputFileFlag.Name)
+	if outputFile == "" {
+		return errors.Errorf("no output file specified, use the %s flag", flags.SlashingProtectionOutputFileFlag.Name)
+	}
+
+	interchanges := make([]*format.EIPSlashingProtectionFormat, len(inputFiles))
+	for i, path := range inputFiles {
+		enc, err := fileutil.ReadFileAsBytes(path)
+		if err != nil {
+			return errors.Wrapf(err, "could not read slashing protection JSON file %s", path)
+		}
+		interchanges[i] = &format.EIPSlashingProtectionFormat{}
+		if err := json.Unmarshal(enc, interchanges[i]); err != nil {
+			return errors.Wrapf(err, "could not unmarshal slashing protection JSON file %s", path)
+		}
+	}
+	merged, err := slashingProtectionFormat.MergeInterchanges(interchanges...)
+	if err != nil {
+		return errors.Wrap(err, "could not merge slashing protection JSON files")
+	}
+	if cliCtx.IsSet(flags.SlashingProtectionPublicKeysFlag.Name) {
+		pubKeys, err := parsePublicKeys(cliCtx.String(flags.SlashingProtectionPublicKeysFlag.Name))
+		if err != nil {
+			return err
+		}
+		merged, err = slashingProtectionFormat.FilterInterchange(merged, pubKeys)
+		if err != nil {
+			return errors.Wrap(err, "could not filter slashing protection history")
+		}
+	}
+	if cliCtx.Bool(flags.SlashingProtectionMinimalFlag.Name) {
+		merged, err = slashingProtectionFormat.MinimizeInterchange(merged)
+		if err != nil {
+			return errors.Wrap(err, "could not minimize slashing protection history")
+		}
+	}
+	encoded, err := json.MarshalIndent(merged, "", "\t")
+	if err != nil {
+		return errors.Wrap(err, "could not JSON marshal slashing protection history")
+	}
+	if err := fileutil.WriteFile(outputFile, encoded); err != nil {
+		return err
+	}
+	log.WithField("publicKeys", len(merged.Data)).Infof("Wrote merged slashing protection history to %s", outputFile)
+	return nil
+}
+
+func minimizeSlashingProtectionJSON(enc []byte) ([]byte, error) {
+	interchange := &format.EIPSlashingProtectionFormat{}
+	if err := json.Unmarshal(enc, interchange); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal slashing protection JSON file")
+	}
+	minimized, err := slashingProtectionFormat.MinimizeInterchange(interchange)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not minimize slashing protection history")
+	}
+	return json.Marshal(minimized)
+}
+
+func parsePublicKeys(str string) ([][48]byte, error) {
+	var pubKeys [][48]byte
+	for _, key := range strings.Split(str, ",") {
+		if key = strings.TrimSpace(key); key == "" {
+			continue
+		}
+		pubKey, err := slashingProtectionFormat.PubKeyFromHex(key)
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s is not a valid public key", key)
+		}
+		pubKeys = append(pubKeys, pubKey)
+	}
+	return pubKeys, nil
+}
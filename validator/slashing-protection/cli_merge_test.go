@@ -0,0 +1,68 @@
+package slashingprotection
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format/format"
+	mocks "github.com/prysmaticlabs/prysm/validator/testing"
+	"github.com/urfave/cli/v2"
+)
+
+func TestMergeSlashingProtectionJSONCli(t *testing.T) {
+	dir := t.TempDir()
+	pubKeys, err := mocks.CreateRandomPubKeys(4)
+	require.NoError(t, err)
+	attestingHistory, proposalHistory := mocks.MockAttestingAndProposalHistories(pubKeys)
+	var inputFiles []string
+	for i := 0; i < 2; i++ {
+		mockJSON, err := mocks.MockSlashingProtectionJSON(
+			pubKeys[2*i:2*i+2], attestingHistory[2*i:2*i+2], proposalHistory[2*i:2*i+2],
+		)
+		require.NoError(t, err)
+		encoded, err := json.Marshal(mockJSON)
+		require.NoError(t, err)
+		path := filepath.Join(dir, fmt.Sprintf("protection_%d.json", i))
+		require.NoError(t, fileutil.WriteFile(path, encoded))
+		inputFiles = append(inputFiles, path)
+	}
+	outputFile := filepath.Join(dir, "merged.json")
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(flags.SlashingProtectionJSONFilesFlag.Name, strings.Join(inputFiles, ","), "")
+	set.String(flags.SlashingProtectionOutputFileFlag.Name, outputFile, "")
+	set.String(flags.SlashingProtectionPublicKeysFlag.Name, "", "")
+	set.Bool(flags.SlashingProtectionMinimalFlag.Name, true, "")
+	require.NoError(t, set.Set(flags.SlashingProtectionPublicKeysFlag.Name, fmt.Sprintf("%#x,%#x", pubKeys[0], pubKeys[3])))
+	cliCtx := cli.NewContext(&app, set, nil)
+	require.NoError(t, MergeSlashingProtectionJSONCli(cliCtx))
+
+	enc, err := fileutil.ReadFileAsBytes(outputFile)
+	require.NoError(t, err)
+	merged := &format.EIPSlashingProtectionFormat{}
+	require.NoError(t, json.Unmarshal(enc, merged))
+	require.Equal(t, 2, len(merged.Data))
+	assert.Equal(t, fmt.Sprintf("%#x", pubKeys[0]), merged.Data[0].Pubkey)
+	assert.Equal(t, fmt.Sprintf("%#x", pubKeys[3]), merged.Data[1].Pubkey)
+	for _, data := range merged.Data {
+		assert.Equal(t, 1, len(data.SignedAttestations))
+		assert.Equal(t, 1, len(data.SignedBlocks))
+	}
+}
+
+func TestMergeSlashingProtectionJSONCli_NoFiles(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(flags.SlashingProtectionJSONFilesFlag.Name, "", "")
+	cliCtx := cli.NewContext(&app, set, nil)
+	assert.ErrorContains(t, "no slashing protection JSON files specified", MergeSlashingProtectionJSONCli(cliCtx))
+}
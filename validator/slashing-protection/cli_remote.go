@@ -0,0 +1,128 @@
+package slashingprotection
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/protection/v1"
+	"github.com/prysmaticlabs/prysm/shared/apiauth"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/validator/slashing-protection/remote"
+	"github.com/urfave/cli/v2"
+)
+
+// remoteRequestTimeout bounds the import and export requests sent to a slashing protection server.
+const remoteRequestTimeout = 5 * time.Minute
+
+// ServeSlashingProtectionCli runs a slashing protection server over the validator database in
+// the datadir, which can be shared by several validator clients, until it is interrupted.
+func ServeSlashingProtectionCli(cliCtx *cli.Context) error {
+	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
+	validatorDB, err := kv.NewKVStore(cliCtx.Context, dataDir, &kv.Config{})
+	if err != nil {
+		return errors.Wrapf(err, "could not access validator database at path %s", dataDir)
+	}
+	defer func() {
+		if err := validatorDB.Close(); err != nil {
+			log.WithError(err).Errorf("Could not close validator DB")
+		}
+	}()
+
+	var authorizer *apiauth.Authorizer
+	if configPath := cliCtx.String(flags.SlashingProtectionAuthConfigFlag.Name); configPath != "" {
+		authConfig, err := apiauth.LoadConfigFile(configPath)
+		if err != nil {
+			return errors.Wrap(err, "could not load API token configuration")
+		}
+		authorizer, err = apiauth.NewAuthorizer(authConfig)
+		if err != nil {
+			return errors.Wrap(err, "could not create API authorizer")
+		}
+	}
+
+	server := remote.NewServer(cliCtx.Context, &remote.Config{
+		Host:           cliCtx.String(flags.SlashingProtectionHostFlag.Name),
+		Port:           fmt.Sprintf("%d", cliCtx.Int(flags.SlashingProtectionPortFlag.Name)),
+		CertFlag:       cliCtx.String(flags.SlashingProtectionTLSCertFlag.Name),
+		KeyFlag:        cliCtx.String(flags.SlashingProtectionTLSKeyFlag.Name),
+		Authorizer:     authorizer,
+		InsecureNoAuth: cliCtx.Bool(flags.SlashingProtectionInsecureNoAuthFlag.Name),
+		DB:             validatorDB,
+	})
+	server.Start()
+	if err := server.Status(); err != nil {
+		return err
+	}
+
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sigc)
+	select {
+	case <-sigc:
+		log.Info("Got interrupt, shutting down...")
+	case <-cliCtx.Context.Done():
+	}
+	return server.Stop()
+}
+
+func importRemoteSlashingProtectionJSON(cliCtx *cli.Context, enc []byte) error {
+	client, closeConn, err := remoteSlashingProtectionClient(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+	ctx, cancel := context.WithTimeout(cliCtx.Context, remoteRequestTimeout)
+	defer cancel()
+	if err := remote.ImportSlashingProtection(ctx, client, enc); err != nil {
+		return errors.Wrap(err, "could not import slashing protection history on the slashing protection server")
+	}
+	return nil
+}
+
+func exportRemoteSlashingProtectionJSON(cliCtx *cli.Context) ([]byte, error) {
+	client, closeConn, err := remoteSlashingProtectionClient(cliCtx)
+	if err != nil {
+		return nil, err
+	}
+	defer closeConn()
+	ctx, cancel := context.WithTimeout(cliCtx.Context, remoteRequestTimeout)
+	defer cancel()
+	encoded, err := remote.ExportSlashingProtection(ctx, client)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not export slashing protection history from the slashing protection server")
+	}
+	return encoded, nil
+}
+
+func remoteSlashingProtectionClient(cliCtx *cli.Context) (pb.SlashingProtectionClient, func(), error) {
+	var token string
+	if tokenFile := cliCtx.String(flags.SlashingProtectionTokenFileFlag.Name); tokenFile != "" {
+		var err error
+		token, err = remote.ReadTokenFile(tokenFile)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	conn, err := remote.Dial(
+		cliCtx.Context,
+		cliCtx.String(flags.SlashingProtectionRemoteFlag.Name),
+		cliCtx.String(flags.SlashingProtectionRemoteCertFlag.Name),
+		token,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	closeConn := func() {
+		if err := conn.Close(); err != nil {
+			log.WithError(err).Error("Could not close connection to slashing protection server")
+		}
+	}
+	return pb.NewSlashingProtectionClient(conn), closeConn, nil
+}
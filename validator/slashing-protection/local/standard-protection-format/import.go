@@ -23,14 +23,143 @@ import (
 // protection in the validator client's database. For more information, see the EIP document here:
 // https://eips.ethereum.org/EIPS/eip-3076.
 func ImportStandardProtectionJSON(ctx context.Context, validatorDB db.Database, r io.Reader) error {
+	interchangeJSON, err := decodeInterchange(r)
+	if err != nil {
+		return err
+	}
+	return importInterchange(ctx, validatorDB, interchangeJSON)
+}
+
+// ImportMinimalStandardProtectionJSON imports an EIP-3076 compliant JSON file like
+// ImportStandardProtectionJSON, after reducing the history of every public key to its highest
+// source and target epochs and highest proposal slot with MinimizeInterchange. This keeps large
+// histories from bloating the database. The lowest signed epochs and slot of every imported
+// public key are then raised to these values, or kept if they were already higher, so the
+// validator refuses to sign anything below them even if the database had an older history.
+// The integrity check of the database reports such values above the recorded history as
+// informational, and its repair never lowers them.
+func ImportMinimalStandardProtectionJSON(ctx context.Context, validatorDB db.Database, r io.Reader) error {
+	interchangeJSON, err := decodeInterchange(r)
+	if err != nil {
+		return err
+	}
+	minimized, err := MinimizeInterchange(interchangeJSON)
+	if err != nil {
+		return errors.Wrap(err, "could not minimize slashing protection JSON file")
+	}
+	floors, err := lowestSignedFloors(ctx, validatorDB, minimized)
+	if err != nil {
+		return err
+	}
+	if err := importInterchange(ctx, validatorDB, minimized); err != nil {
+		return err
+	}
+	for pubKey, floor := range floors {
+		if floor.hasProposal {
+			if err := validatorDB.RaiseLowestSignedProposal(ctx, pubKey, floor.slot); err != nil {
+				return errors.Wrapf(err, "could not raise lowest signed proposal for %#x", pubKey)
+			}
+		}
+		if floor.hasAttestation {
+			if err := validatorDB.RaiseLowestSignedEpochs(ctx, pubKey, floor.source, floor.target); err != nil {
+				return errors.Wrapf(err, "could not raise lowest signed epochs for %#x", pubKey)
+			}
+		}
+	}
+	return nil
+}
+
+// lowestSigned is the lowest signed source and target epochs and proposal slot a public key
+// must keep after a minimal import.
+type lowestSigned struct {
+	hasAttestation, hasProposal bool
+	source, target              types.Epoch
+	slot                        types.Slot
+}
+
+// lowestSignedFloors returns, for every public key of a minimized interchange, the highest of
+// its lowest signed epochs and slot in the database and of its entries in the interchange.
+// The database values are read before importing, as saving the interchange lowers them.
+func lowestSignedFloors(
+	ctx context.Context, validatorDB db.Database, minimized *format.EIPSlashingProtectionFormat,
+) (map[[48]byte]*lowestSigned, error) {
+	floors := make(map[[48]byte]*lowestSigned)
+	for _, validatorData := range minimized.Data {
+		pubKey, err := PubKeyFromHex(validatorData.Pubkey)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a valid public key: %w", validatorData.Pubkey, err)
+		}
+		floor := &lowestSigned{}
+		floors[pubKey] = floor
+		for _, block := range validatorData.SignedBlocks {
+			slot, err := SlotFromString(block.Slot)
+			if err != nil {
+				return nil, fmt.Errorf("%s is not a valid slot: %w", block.Slot, err)
+			}
+			if !floor.hasProposal || slot > floor.slot {
+				floor.slot = slot
+			}
+			floor.hasProposal = true
+		}
+		for _, att := range validatorData.SignedAttestations {
+			source, err := EpochFromString(att.SourceEpoch)
+			if err != nil {
+				return nil, fmt.Errorf("%s is not a valid epoch: %w", att.SourceEpoch, err)
+			}
+			target, err := EpochFromString(att.TargetEpoch)
+			if err != nil {
+				return nil, fmt.Errorf("%s is not a valid epoch: %w", att.TargetEpoch, err)
+			}
+			if !floor.hasAttestation || source > floor.source {
+				floor.source = source
+			}
+			if !floor.hasAttestation || target > floor.target {
+				floor.target = target
+			}
+			floor.hasAttestation = true
+		}
+		if floor.hasProposal {
+			slot, exists, err := validatorDB.LowestSignedProposal(ctx, pubKey)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not get lowest signed proposal for %#x", pubKey)
+			}
+			if exists && slot > floor.slot {
+				floor.slot = slot
+			}
+		}
+		if floor.hasAttestation {
+			source, exists, err := validatorDB.LowestSignedSourceEpoch(ctx, pubKey)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not get lowest signed source epoch for %#x", pubKey)
+			}
+			if exists && source > floor.source {
+				floor.source = source
+			}
+			target, exists, err := validatorDB.LowestSignedTargetEpoch(ctx, pubKey)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not get lowest signed target epoch for %#x", pubKey)
+			}
+			if exists && target > floor.target {
+				floor.target = target
+			}
+		}
+	}
+	return floors, nil
+}
+
+func decodeInterchange(r io.Reader) (*format.EIPSlashingProtectionFormat, error) {
 	encodedJSON, err := ioutil.ReadAll(r)
 	if err != nil {
-		return errors.Wrap(err, "could not read slashing protection JSON file")
+		return nil, errors.Wrap(err, "could not read slashing protection JSON file")
 	}
 	interchangeJSON := &format.EIPSlashingProtectionFormat{}
 	if err := json.Unmarshal(encodedJSON, interchangeJSON); err != nil {
-		return errors.Wrap(err, "could not unmarshal slashing protection JSON file")
+		return nil, errors.Wrap(err, "could not unmarshal slashing protection JSON file")
 	}
+	return interchangeJSON, nil
+}
+
+func importInterchange(ctx context.Context, validatorDB db.Database, interchangeJSON *format.EIPSlashingProtectionFormat) error {
 	if interchangeJSON.Data == nil {
 		log.Warn("No slashing protection data to import")
 		return nil
@@ -0,0 +1,187 @@
+package interchangeformat
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format/format"
+)
+
+// MergeInterchanges combines several EIP-3076 interchange files into one, for instance to
+// consolidate keys previously running on different machines. All files must be of the
+// supported version and share the same genesis validators root. The histories of a public
+// key appearing in several files are concatenated, dropping exact duplicates, so conflicting
+// entries remain visible to the slashing checks of the import.
+func MergeInterchanges(interchanges ...*format.EIPSlashingProtectionFormat) (*format.EIPSlashingProtectionFormat, error) {
+	if len(interchanges) == 0 {
+		return nil, errors.New("no slashing protection interchange files to merge")
+	}
+	merged := &format.EIPSlashingProtectionFormat{}
+	merged.Metadata.InterchangeFormatVersion = format.InterchangeFormatVersion
+	var genesisValidatorsRoot [32]byte
+	dataByPubKey := make(map[[48]byte]*format.ProtectionData)
+	seenBlocks := make(map[[48]byte]map[format.SignedBlock]bool)
+	seenAtts := make(map[[48]byte]map[format.SignedAttestation]bool)
+	for i, interchange := range interchanges {
+		if version := interchange.Metadata.InterchangeFormatVersion; version != format.InterchangeFormatVersion {
+			return nil, fmt.Errorf(
+				"slashing protection JSON version '%s' is not supported, wanted '%s'",
+				version,
+				format.InterchangeFormatVersion,
+			)
+		}
+		gvr, err := RootFromHex(interchange.Metadata.GenesisValidatorsRoot)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a valid root: %w", interchange.Metadata.GenesisValidatorsRoot, err)
+		}
+		if i == 0 {
+			genesisValidatorsRoot = gvr
+			merged.Metadata.GenesisValidatorsRoot = fmt.Sprintf("%#x", gvr)
+		} else if gvr != genesisValidatorsRoot {
+			return nil, fmt.Errorf(
+				"genesis validators root %#x does not match %#x of the first file, the files are from different chains",
+				gvr,
+				genesisValidatorsRoot,
+			)
+		}
+		for _, validatorData := range interchange.Data {
+			pubKey, err := PubKeyFromHex(validatorData.Pubkey)
+			if err != nil {
+				return nil, fmt.Errorf("%s is not a valid public key: %w", validatorData.Pubkey, err)
+			}
+			data, ok := dataByPubKey[pubKey]
+			if !ok {
+				data = &format.ProtectionData{
+					Pubkey:             fmt.Sprintf("%#x", pubKey),
+					SignedBlocks:       make([]*format.SignedBlock, 0),
+					SignedAttestations: make([]*format.SignedAttestation, 0),
+				}
+				dataByPubKey[pubKey] = data
+				seenBlocks[pubKey] = make(map[format.SignedBlock]bool)
+				seenAtts[pubKey] = make(map[format.SignedAttestation]bool)
+				merged.Data = append(merged.Data, data)
+			}
+			for _, blk := range validatorData.SignedBlocks {
+				if blk == nil || seenBlocks[pubKey][*blk] {
+					continue
+				}
+				seenBlocks[pubKey][*blk] = true
+				data.SignedBlocks = append(data.SignedBlocks, blk)
+			}
+			for _, att := range validatorData.SignedAttestations {
+				if att == nil || seenAtts[pubKey][*att] {
+					continue
+				}
+				seenAtts[pubKey][*att] = true
+				data.SignedAttestations = append(data.SignedAttestations, att)
+			}
+		}
+	}
+	return merged, nil
+}
+
+// FilterInterchange returns the interchange restricted to the histories of the given public keys.
+func FilterInterchange(
+	interchange *format.EIPSlashingProtectionFormat, pubKeys [][48]byte,
+) (*format.EIPSlashingProtectionFormat, error) {
+	keep := make(map[[48]byte]bool, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		keep[pubKey] = true
+	}
+	filtered := &format.EIPSlashingProtectionFormat{Metadata: interchange.Metadata}
+	for _, validatorData := range interchange.Data {
+		pubKey, err := PubKeyFromHex(validatorData.Pubkey)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a valid public key: %w", validatorData.Pubkey, err)
+		}
+		if keep[pubKey] {
+			filtered.Data = append(filtered.Data, validatorData)
+		}
+	}
+	return filtered, nil
+}
+
+// MinimizeInterchange replaces the history of every public key with a single synthetic
+// attestation at the highest source and target epochs and a single synthetic block at the
+// highest slot, without signing roots. The result alone does not refuse everything the full
+// history would, as saving it never raises the lowest signed epochs and slot of a database
+// which already has an older history; ImportMinimalStandardProtectionJSON raises them after
+// importing it.
+func MinimizeInterchange(interchange *format.EIPSlashingProtectionFormat) (*format.EIPSlashingProtectionFormat, error) {
+	type bounds struct {
+		hasAtts, hasBlocks bool
+		source, target     types.Epoch
+		slot               types.Slot
+	}
+	minimized := &format.EIPSlashingProtectionFormat{Metadata: interchange.Metadata}
+	boundsByPubKey := make(map[[48]byte]*bounds)
+	var pubKeys [][48]byte
+	for _, validatorData := range interchange.Data {
+		pubKey, err := PubKeyFromHex(validatorData.Pubkey)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a valid public key: %w", validatorData.Pubkey, err)
+		}
+		b, ok := boundsByPubKey[pubKey]
+		if !ok {
+			b = &bounds{}
+			boundsByPubKey[pubKey] = b
+			pubKeys = append(pubKeys, pubKey)
+		}
+		for _, blk := range validatorData.SignedBlocks {
+			if blk == nil {
+				continue
+			}
+			slot, err := SlotFromString(blk.Slot)
+			if err != nil {
+				return nil, fmt.Errorf("%s is not a valid slot: %w", blk.Slot, err)
+			}
+			if !b.hasBlocks || slot > b.slot {
+				b.slot = slot
+			}
+			b.hasBlocks = true
+		}
+		for _, att := range validatorData.SignedAttestations {
+			if att == nil {
+				continue
+			}
+			source, err := EpochFromString(att.SourceEpoch)
+			if err != nil {
+				return nil, fmt.Errorf("%s is not a valid epoch: %w", att.SourceEpoch, err)
+			}
+			target, err := EpochFromString(att.TargetEpoch)
+			if err != nil {
+				return nil, fmt.Errorf("%s is not a valid epoch: %w", att.TargetEpoch, err)
+			}
+			if !b.hasAtts || source > b.source {
+				b.source = source
+			}
+			if !b.hasAtts || target > b.target {
+				b.target = target
+			}
+			b.hasAtts = true
+		}
+	}
+	for _, pubKey := range pubKeys {
+		b := boundsByPubKey[pubKey]
+		data := &format.ProtectionData{
+			Pubkey:             fmt.Sprintf("%#x", pubKey),
+			SignedBlocks:       make([]*format.SignedBlock, 0),
+			SignedAttestations: make([]*format.SignedAttestation, 0),
+		}
+		if b.hasBlocks {
+			data.SignedBlocks = append(data.SignedBlocks, &format.SignedBlock{
+				Slot: strconv.FormatUint(uint64(b.slot), 10),
+			})
+		}
+		if b.hasAtts {
+			data.SignedAttestations = append(data.SignedAttestations, &format.SignedAttestation{
+				SourceEpoch: strconv.FormatUint(uint64(b.source), 10),
+				TargetEpoch: strconv.FormatUint(uint64(b.target), 10),
+			})
+		}
+		minimized.Data = append(minimized.Data, data)
+	}
+	return minimized, nil
+}
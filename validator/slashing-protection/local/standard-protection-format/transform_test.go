@@ -0,0 +1,215 @@
+package interchangeformat
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+	"github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format/format"
+)
+
+func testInterchange(gvr string, data ...*format.ProtectionData) *format.EIPSlashingProtectionFormat {
+	interchange := &format.EIPSlashingProtectionFormat{Data: data}
+	interchange.Metadata.InterchangeFormatVersion = format.InterchangeFormatVersion
+	interchange.Metadata.GenesisValidatorsRoot = gvr
+	return interchange
+}
+
+func testPubKeyHex(b byte) string {
+	return fmt.Sprintf("%#x", [48]byte{b})
+}
+
+var testGenesisValidatorsRoot = fmt.Sprintf("%#x", [32]byte{1})
+
+func TestMergeInterchanges(t *testing.T) {
+	first := testInterchange(testGenesisValidatorsRoot, &format.ProtectionData{
+		Pubkey:             testPubKeyHex(1),
+		SignedBlocks:       []*format.SignedBlock{{Slot: "1"}},
+		SignedAttestations: []*format.SignedAttestation{{SourceEpoch: "1", TargetEpoch: "2"}},
+	})
+	second := testInterchange(testGenesisValidatorsRoot, &format.ProtectionData{
+		Pubkey:             testPubKeyHex(2)[2:],
+		SignedAttestations: []*format.SignedAttestation{{SourceEpoch: "3", TargetEpoch: "4"}},
+	}, &format.ProtectionData{
+		Pubkey:             testPubKeyHex(1),
+		SignedBlocks:       []*format.SignedBlock{{Slot: "1"}, {Slot: "5"}},
+		SignedAttestations: []*format.SignedAttestation{{SourceEpoch: "1", TargetEpoch: "2"}},
+	})
+	merged, err := MergeInterchanges(first, second)
+	require.NoError(t, err)
+	assert.Equal(t, testGenesisValidatorsRoot, merged.Metadata.GenesisValidatorsRoot)
+	require.Equal(t, 2, len(merged.Data))
+	assert.DeepEqual(t, &format.ProtectionData{
+		Pubkey:             testPubKeyHex(1),
+		SignedBlocks:       []*format.SignedBlock{{Slot: "1"}, {Slot: "5"}},
+		SignedAttestations: []*format.SignedAttestation{{SourceEpoch: "1", TargetEpoch: "2"}},
+	}, merged.Data[0])
+	assert.DeepEqual(t, &format.ProtectionData{
+		Pubkey:             testPubKeyHex(2),
+		SignedBlocks:       []*format.SignedBlock{},
+		SignedAttestations: []*format.SignedAttestation{{SourceEpoch: "3", TargetEpoch: "4"}},
+	}, merged.Data[1])
+}
+
+func TestMergeInterchanges_Invalid(t *testing.T) {
+	_, err := MergeInterchanges()
+	assert.ErrorContains(t, "no slashing protection interchange files", err)
+
+	otherChain := testInterchange(fmt.Sprintf("%#x", [32]byte{2}))
+	_, err = MergeInterchanges(testInterchange(testGenesisValidatorsRoot), otherChain)
+	assert.ErrorContains(t, "files are from different chains", err)
+
+	oldVersion := testInterchange(testGenesisValidatorsRoot)
+	oldVersion.Metadata.InterchangeFormatVersion = "4"
+	_, err = MergeInterchanges(oldVersion)
+	assert.ErrorContains(t, "is not supported", err)
+
+	_, err = MergeInterchanges(testInterchange(testGenesisValidatorsRoot, &format.ProtectionData{Pubkey: "0x01"}))
+	assert.ErrorContains(t, "is not a valid public key", err)
+}
+
+func TestFilterInterchange(t *testing.T) {
+	interchange := testInterchange(testGenesisValidatorsRoot,
+		&format.ProtectionData{Pubkey: testPubKeyHex(1)},
+		&format.ProtectionData{Pubkey: testPubKeyHex(2)},
+		&format.ProtectionData{Pubkey: testPubKeyHex(3)},
+	)
+	filtered, err := FilterInterchange(interchange, [][48]byte{{1}, {3}, {4}})
+	require.NoError(t, err)
+	assert.Equal(t, interchange.Metadata, filtered.Metadata)
+	require.Equal(t, 2, len(filtered.Data))
+	assert.Equal(t, testPubKeyHex(1), filtered.Data[0].Pubkey)
+	assert.Equal(t, testPubKeyHex(3), filtered.Data[1].Pubkey)
+}
+
+func TestMinimizeInterchange(t *testing.T) {
+	interchange := testInterchange(testGenesisValidatorsRoot, &format.ProtectionData{
+		Pubkey:       testPubKeyHex(1),
+		SignedBlocks: []*format.SignedBlock{{Slot: "7"}, {Slot: "3", SigningRoot: testGenesisValidatorsRoot}},
+		SignedAttestations: []*format.SignedAttestation{
+			{SourceEpoch: "5", TargetEpoch: "6"},
+			{SourceEpoch: "2", TargetEpoch: "9", SigningRoot: testGenesisValidatorsRoot},
+		},
+	}, &format.ProtectionData{
+		Pubkey:       testPubKeyHex(1),
+		SignedBlocks: []*format.SignedBlock{{Slot: "4"}},
+	}, &format.ProtectionData{
+		Pubkey:       testPubKeyHex(2),
+		SignedBlocks: []*format.SignedBlock{{Slot: "4"}},
+	})
+	minimized, err := MinimizeInterchange(interchange)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(minimized.Data))
+	assert.DeepEqual(t, &format.ProtectionData{
+		Pubkey:             testPubKeyHex(1),
+		SignedBlocks:       []*format.SignedBlock{{Slot: "7"}},
+		SignedAttestations: []*format.SignedAttestation{{SourceEpoch: "5", TargetEpoch: "9"}},
+	}, minimized.Data[0])
+	assert.DeepEqual(t, &format.ProtectionData{
+		Pubkey:             testPubKeyHex(2),
+		SignedBlocks:       []*format.SignedBlock{{Slot: "4"}},
+		SignedAttestations: []*format.SignedAttestation{},
+	}, minimized.Data[1])
+
+	_, err = MinimizeInterchange(testInterchange(testGenesisValidatorsRoot, &format.ProtectionData{
+		Pubkey:       testPubKeyHex(1),
+		SignedBlocks: []*format.SignedBlock{{Slot: "x"}},
+	}))
+	assert.ErrorContains(t, "is not a valid slot", err)
+}
+
+func TestImportMinimalStandardProtectionJSON(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	validatorDB := dbtest.SetupDB(t, [][48]byte{pubKey})
+	interchange := testInterchange(testGenesisValidatorsRoot, &format.ProtectionData{
+		Pubkey:       testPubKeyHex(1),
+		SignedBlocks: []*format.SignedBlock{{Slot: "3"}, {Slot: "7"}},
+		SignedAttestations: []*format.SignedAttestation{
+			{SourceEpoch: "1", TargetEpoch: "2"},
+			{SourceEpoch: "2", TargetEpoch: "3"},
+			{SourceEpoch: "3", TargetEpoch: "4"},
+		},
+	})
+	enc, err := json.Marshal(interchange)
+	require.NoError(t, err)
+	require.NoError(t, ImportMinimalStandardProtectionJSON(ctx, validatorDB, bytes.NewReader(enc)))
+
+	atts, err := validatorDB.AttestationHistoryForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(atts))
+	assert.Equal(t, types.Epoch(3), atts[0].Source)
+	assert.Equal(t, types.Epoch(4), atts[0].Target)
+	source, _, err := validatorDB.LowestSignedSourceEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(3), source)
+	proposals, err := validatorDB.ProposalHistoryForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(proposals))
+	assert.Equal(t, types.Slot(7), proposals[0].Slot)
+}
+
+func TestImportMinimalStandardProtectionJSON_ExistingHistory(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	validatorDB := dbtest.SetupDB(t, [][48]byte{pubKey})
+	// The database already has an old history, so its lowest signed epochs and slot are low.
+	require.NoError(t, validatorDB.SaveAttestationForPubKey(ctx, pubKey, [32]byte{1}, createAttestation(1, 2)))
+	require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubKey, 2, []byte{31: 1}))
+
+	interchange := testInterchange(testGenesisValidatorsRoot, &format.ProtectionData{
+		Pubkey:       testPubKeyHex(1),
+		SignedBlocks: []*format.SignedBlock{{Slot: "50"}, {Slot: "90"}},
+		SignedAttestations: []*format.SignedAttestation{
+			{SourceEpoch: "11", TargetEpoch: "12"},
+			{SourceEpoch: "99", TargetEpoch: "100"},
+		},
+	})
+	enc, err := json.Marshal(interchange)
+	require.NoError(t, err)
+	require.NoError(t, ImportMinimalStandardProtectionJSON(ctx, validatorDB, bytes.NewReader(enc)))
+
+	source, exists, err := validatorDB.LowestSignedSourceEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	assert.Equal(t, types.Epoch(99), source)
+	target, exists, err := validatorDB.LowestSignedTargetEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	assert.Equal(t, types.Epoch(100), target)
+	slot, exists, err := validatorDB.LowestSignedProposal(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	assert.Equal(t, types.Slot(90), slot)
+
+	// They are above the old history on purpose, which the integrity check accepts.
+	issues, err := validatorDB.(*kv.Store).CheckIntegrity(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(issues))
+	for _, issue := range issues {
+		assert.Equal(t, true, issue.Informational, issue.Description)
+	}
+
+	// Importing an older minimal history must not lower them again.
+	interchange = testInterchange(testGenesisValidatorsRoot, &format.ProtectionData{
+		Pubkey:             testPubKeyHex(1),
+		SignedBlocks:       []*format.SignedBlock{{Slot: "60"}},
+		SignedAttestations: []*format.SignedAttestation{{SourceEpoch: "20", TargetEpoch: "30"}},
+	})
+	enc, err = json.Marshal(interchange)
+	require.NoError(t, err)
+	require.NoError(t, ImportMinimalStandardProtectionJSON(ctx, validatorDB, bytes.NewReader(enc)))
+	source, _, err = validatorDB.LowestSignedSourceEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(99), source)
+	slot, _, err = validatorDB.LowestSignedProposal(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(90), slot)
+}
@@ -0,0 +1,129 @@
+package remote
+
+import (
+	"context"
+	"fmt"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/protection/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slashutil"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+)
+
+var notSlashable = &pb.ProtectionResponse{Kind: pb.ProtectionResponse_NOT_SLASHABLE}
+
+// checkAttestation applies the same rules as the validator client's local slashing protection:
+// the EIP-3076 lowest source and target epoch conditions, followed by the double and surround
+// vote checks against the attesting history of the key. Keys blacklisted by an import are
+// refused, as the validator clients leave that check to the server.
+func checkAttestation(
+	ctx context.Context, validatorDB db.Database, pubKey [48]byte, signingRoot [32]byte, att *ethpb.IndexedAttestation,
+) (*pb.ProtectionResponse, error) {
+	if res, err := checkBlacklisted(ctx, validatorDB, pubKey); err != nil || res != nil {
+		return res, err
+	}
+	lowestSourceEpoch, exists, err := validatorDB.LowestSignedSourceEpoch(ctx, pubKey)
+	if err != nil {
+		return nil, err
+	}
+	if exists && att.Data.Source.Epoch < lowestSourceEpoch {
+		return slashable(pb.ProtectionResponse_BELOW_MINIMUM, fmt.Sprintf(
+			"could not sign attestation lower than lowest source epoch in db, %d < %d",
+			att.Data.Source.Epoch,
+			lowestSourceEpoch,
+		)), nil
+	}
+	existingSigningRoot, err := validatorDB.SigningRootAtTargetEpoch(ctx, pubKey, att.Data.Target.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	signingRootsDiffer := slashutil.SigningRootsDiffer(existingSigningRoot, signingRoot)
+	lowestTargetEpoch, exists, err := validatorDB.LowestSignedTargetEpoch(ctx, pubKey)
+	if err != nil {
+		return nil, err
+	}
+	if signingRootsDiffer && exists && att.Data.Target.Epoch <= lowestTargetEpoch {
+		return slashable(pb.ProtectionResponse_BELOW_MINIMUM, fmt.Sprintf(
+			"could not sign attestation lower than or equal to lowest target epoch in db, %d <= %d",
+			att.Data.Target.Epoch,
+			lowestTargetEpoch,
+		)), nil
+	}
+	kind, err := validatorDB.CheckSlashableAttestation(ctx, pubKey, signingRoot, att)
+	switch kind {
+	case kv.DoubleVote:
+		return slashable(pb.ProtectionResponse_DOUBLE_VOTE, err.Error()), nil
+	case kv.SurroundingVote:
+		return slashable(pb.ProtectionResponse_SURROUNDING_VOTE, err.Error()), nil
+	case kv.SurroundedVote:
+		return slashable(pb.ProtectionResponse_SURROUNDED_VOTE, err.Error()), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return notSlashable, nil
+}
+
+// checkProposal rejects a second proposal with a different signing root at the same slot,
+// and proposals at or below the lowest signed proposal slot of the key as required by EIP-3076.
+// Keys blacklisted by an import are refused.
+func checkProposal(
+	ctx context.Context, validatorDB db.Database, pubKey [48]byte, slot types.Slot, signingRoot [32]byte,
+) (*pb.ProtectionResponse, error) {
+	if res, err := checkBlacklisted(ctx, validatorDB, pubKey); err != nil || res != nil {
+		return res, err
+	}
+	prevSigningRoot, proposalAtSlotExists, err := validatorDB.ProposalHistoryForSlot(ctx, pubKey, slot)
+	if err != nil {
+		return nil, err
+	}
+	// An empty signing root in the history means the signing root of the proposal is unknown,
+	// in which case any proposal at the slot is considered slashable.
+	signingRootIsDifferent := prevSigningRoot == params.BeaconConfig().ZeroHash || prevSigningRoot != signingRoot
+	if proposalAtSlotExists && signingRootIsDifferent {
+		return slashable(
+			pb.ProtectionResponse_DOUBLE_PROPOSAL,
+			fmt.Sprintf("a different block was already signed at slot %d", slot),
+		), nil
+	}
+	lowestSignedProposalSlot, lowestProposalExists, err := validatorDB.LowestSignedProposal(ctx, pubKey)
+	if err != nil {
+		return nil, err
+	}
+	if lowestProposalExists && signingRootIsDifferent && lowestSignedProposalSlot >= slot {
+		return slashable(pb.ProtectionResponse_BELOW_MINIMUM, fmt.Sprintf(
+			"could not sign block with slot <= lowest signed slot in db, lowest signed slot: %d >= block slot: %d",
+			lowestSignedProposalSlot,
+			slot,
+		)), nil
+	}
+	return notSlashable, nil
+}
+
+// checkBlacklisted refuses the keys whose imported history was found slashable.
+func checkBlacklisted(ctx context.Context, validatorDB db.Database, pubKey [48]byte) (*pb.ProtectionResponse, error) {
+	blacklisted, err := validatorDB.EIPImportBlacklistedPublicKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range blacklisted {
+		if k == pubKey {
+			return slashable(
+				pb.ProtectionResponse_BLACKLISTED,
+				"the key was blacklisted for slashable history in a slashing protection import",
+			), nil
+		}
+	}
+	return nil, nil
+}
+
+func slashable(kind pb.ProtectionResponse_SlashingKind, reason string) *pb.ProtectionResponse {
+	return &pb.ProtectionResponse{
+		Slashable: true,
+		Kind:      kind,
+		Reason:    reason,
+	}
+}
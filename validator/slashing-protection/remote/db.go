@@ -0,0 +1,340 @@
+package remote
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
+	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/protection/v1"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"go.opencensus.io/plugin/ocgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// Ensure the remote database implements the interfaces used by the validator client.
+var (
+	_ = db.Database(&DB{})
+	_ = db.AtomicSlashingProtector(&DB{})
+)
+
+// defaultRequestTimeout bounds every request to the slashing protection server, so that an
+// unresponsive server results in a refused signature rather than a stalled validator client.
+const defaultRequestTimeout = 5 * time.Second
+
+// DBConfig for a remote slashing protection database.
+type DBConfig struct {
+	Endpoint string
+	CertFlag string
+	// Token is the API token sent as a bearer token with every request.
+	Token          string
+	RequestTimeout time.Duration
+}
+
+// ErrRemoteHistory is returned by the slashing protection methods of the remote database which
+// have no counterpart on the slashing protection server, rather than reading or writing a local
+// history which is never used to protect the keys.
+var ErrRemoteHistory = errors.New("the slashing protection history is kept by the remote slashing protection server")
+
+// DB is a validator database which keeps everything but the slashing protection history in
+// the local database, and checks and records every signing request on a remote slashing
+// protection server. If the server cannot be reached, the slashing protection methods return
+// an error and the validator client refuses to sign. The slashing protection history is only
+// available through the import and export methods of the server.
+type DB struct {
+	db.Database
+	cfg    *DBConfig
+	conn   *grpc.ClientConn
+	client pb.SlashingProtectionClient
+}
+
+// NewDB wraps the local validator database with a client for the slashing protection
+// server at the configured endpoint.
+func NewDB(ctx context.Context, local db.Database, cfg *DBConfig) (*DB, error) {
+	conn, err := Dial(ctx, cfg.Endpoint, cfg.CertFlag, cfg.Token)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.RequestTimeout == 0 {
+		cfg.RequestTimeout = defaultRequestTimeout
+	}
+	return &DB{
+		Database: local,
+		cfg:      cfg,
+		conn:     conn,
+		client:   pb.NewSlashingProtectionClient(conn),
+	}, nil
+}
+
+// Dial opens a connection to the slashing protection server at the endpoint.
+func Dial(ctx context.Context, endpoint, certFlag, token string) (*grpc.ClientConn, error) {
+	if endpoint == "" {
+		return nil, errors.New("no slashing protection server endpoint specified")
+	}
+	if token != "" && certFlag == "" {
+		return nil, errors.New("an API token can only be sent to the slashing protection server over TLS, " +
+			"please provide its certificate")
+	}
+	var dialOpt grpc.DialOption
+	if certFlag != "" {
+		creds, err := credentials.NewClientTLSFromFile(certFlag, "")
+		if err != nil {
+			return nil, fmt.Errorf("could not get valid slashing protection server credentials: %w", err)
+		}
+		dialOpt = grpc.WithTransportCredentials(creds)
+	} else {
+		dialOpt = grpc.WithInsecure()
+		log.Warn("You are using an insecure slashing protection gRPC connection! Please provide a certificate " +
+			"to use a secure connection.")
+	}
+	opts := []grpc.DialOption{
+		dialOpt,
+		grpc.WithStatsHandler(&ocgrpc.ClientHandler{}),
+		grpc.WithUnaryInterceptor(middleware.ChainUnaryClient(
+			grpc_opentracing.UnaryClientInterceptor(),
+			grpc_prometheus.UnaryClientInterceptor,
+		)),
+	}
+	if token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(tokenCredentials(token)))
+	}
+	conn, err := grpc.DialContext(ctx, endpoint, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("could not dial slashing protection server %s: %w", endpoint, err)
+	}
+	return conn, nil
+}
+
+// ReadTokenFile reads the API token used to authenticate to the slashing protection server.
+func ReadTokenFile(path string) (string, error) {
+	enc, err := fileutil.ReadFileAsBytes(path)
+	if err != nil {
+		return "", fmt.Errorf("could not read slashing protection token file: %w", err)
+	}
+	return strings.TrimSpace(string(enc)), nil
+}
+
+// Close the connection to the server and the local database.
+func (d *DB) Close() error {
+	if err := d.conn.Close(); err != nil {
+		log.WithError(err).Error("Could not close connection to slashing protection server")
+	}
+	return d.Database.Close()
+}
+
+// CheckSlashableAttestation checks the attestation against the history kept by the server.
+func (d *DB) CheckSlashableAttestation(
+	ctx context.Context, pubKey [48]byte, signingRoot [32]byte, att *ethpb.IndexedAttestation,
+) (kv.SlashingKind, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.cfg.RequestTimeout)
+	defer cancel()
+	res, err := d.client.CheckSlashableAttestation(ctx, attestationRequest(pubKey, signingRoot, att))
+	if err != nil {
+		return kv.NotSlashable, fmt.Errorf("could not check attestation with slashing protection server: %w", err)
+	}
+	return slashingKind(res)
+}
+
+// SaveAttestationForPubKey records the attestation on the server.
+func (d *DB) SaveAttestationForPubKey(
+	ctx context.Context, pubKey [48]byte, signingRoot [32]byte, att *ethpb.IndexedAttestation,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, d.cfg.RequestTimeout)
+	defer cancel()
+	if _, err := d.client.SaveAttestationForPubKey(ctx, attestationRequest(pubKey, signingRoot, att)); err != nil {
+		return fmt.Errorf("could not save attestation with slashing protection server: %w", err)
+	}
+	return nil
+}
+
+// SaveAttestationsForPubKey records the attestations on the server.
+func (d *DB) SaveAttestationsForPubKey(
+	ctx context.Context, pubKey [48]byte, signingRoots [][32]byte, atts []*ethpb.IndexedAttestation,
+) error {
+	if len(signingRoots) != len(atts) {
+		return fmt.Errorf("got %d signing roots for %d attestations", len(signingRoots), len(atts))
+	}
+	for i, att := range atts {
+		if err := d.SaveAttestationForPubKey(ctx, pubKey, signingRoots[i], att); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CheckAndSaveAttestation checks the attestation and records it on the server in a single
+// request. A non-nil error is returned if the attestation must not be signed.
+func (d *DB) CheckAndSaveAttestation(
+	ctx context.Context, pubKey [48]byte, signingRoot [32]byte, att *ethpb.IndexedAttestation,
+) (kv.SlashingKind, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.cfg.RequestTimeout)
+	defer cancel()
+	res, err := d.client.CheckAndSaveAttestation(ctx, attestationRequest(pubKey, signingRoot, att))
+	if err != nil {
+		return kv.NotSlashable, fmt.Errorf("could not check attestation with slashing protection server: %w", err)
+	}
+	return slashingKind(res)
+}
+
+// SaveProposalHistoryForSlot records the proposal on the server.
+func (d *DB) SaveProposalHistoryForSlot(ctx context.Context, pubKey [48]byte, slot types.Slot, signingRoot []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, d.cfg.RequestTimeout)
+	defer cancel()
+	if _, err := d.client.SaveProposalForPubKey(ctx, proposalRequest(pubKey, slot, signingRoot)); err != nil {
+		return fmt.Errorf("could not save proposal with slashing protection server: %w", err)
+	}
+	return nil
+}
+
+// CheckAndSaveProposal checks the proposal and records it on the server in a single request.
+// A non-nil error is returned if the block must not be signed.
+func (d *DB) CheckAndSaveProposal(ctx context.Context, pubKey [48]byte, slot types.Slot, signingRoot [32]byte) error {
+	ctx, cancel := context.WithTimeout(ctx, d.cfg.RequestTimeout)
+	defer cancel()
+	res, err := d.client.CheckAndSaveProposal(ctx, proposalRequest(pubKey, slot, signingRoot[:]))
+	if err != nil {
+		return fmt.Errorf("could not check proposal with slashing protection server: %w", err)
+	}
+	if res.Slashable {
+		return errors.New(res.Reason)
+	}
+	return nil
+}
+
+// HighestSignedProposal is not available, as the proposal history is kept by the server.
+func (d *DB) HighestSignedProposal(_ context.Context, _ [48]byte) (types.Slot, bool, error) {
+	return 0, false, ErrRemoteHistory
+}
+
+// LowestSignedProposal is not available, as the proposal history is kept by the server.
+func (d *DB) LowestSignedProposal(_ context.Context, _ [48]byte) (types.Slot, bool, error) {
+	return 0, false, ErrRemoteHistory
+}
+
+// RaiseLowestSignedProposal is not available, as the proposal history is kept by the server.
+func (d *DB) RaiseLowestSignedProposal(_ context.Context, _ [48]byte, _ types.Slot) error {
+	return ErrRemoteHistory
+}
+
+// ProposalHistoryForPubKey is not available, as the proposal history is kept by the server.
+func (d *DB) ProposalHistoryForPubKey(_ context.Context, _ [48]byte) ([]*kv.Proposal, error) {
+	return nil, ErrRemoteHistory
+}
+
+// ProposalHistoryForSlot is not available, as the proposal history is kept by the server.
+func (d *DB) ProposalHistoryForSlot(_ context.Context, _ [48]byte, _ types.Slot) ([32]byte, bool, error) {
+	return [32]byte{}, false, ErrRemoteHistory
+}
+
+// ProposedPublicKeys is not available, as the proposal history is kept by the server.
+func (d *DB) ProposedPublicKeys(_ context.Context) ([][48]byte, error) {
+	return nil, ErrRemoteHistory
+}
+
+// EIPImportBlacklistedPublicKeys returns no public keys, as histories are imported on the
+// server, which refuses every signing request for the keys it blacklisted during an import.
+func (d *DB) EIPImportBlacklistedPublicKeys(_ context.Context) ([][48]byte, error) {
+	return [][48]byte{}, nil
+}
+
+// SaveEIPImportBlacklistedPublicKeys is not available, as histories are imported on the server.
+func (d *DB) SaveEIPImportBlacklistedPublicKeys(_ context.Context, _ [][48]byte) error {
+	return ErrRemoteHistory
+}
+
+// SigningRootAtTargetEpoch is not available, as the attesting history is kept by the server.
+func (d *DB) SigningRootAtTargetEpoch(_ context.Context, _ [48]byte, _ types.Epoch) ([32]byte, error) {
+	return [32]byte{}, ErrRemoteHistory
+}
+
+// LowestSignedTargetEpoch is not available, as the attesting history is kept by the server.
+func (d *DB) LowestSignedTargetEpoch(_ context.Context, _ [48]byte) (types.Epoch, bool, error) {
+	return 0, false, ErrRemoteHistory
+}
+
+// LowestSignedSourceEpoch is not available, as the attesting history is kept by the server.
+func (d *DB) LowestSignedSourceEpoch(_ context.Context, _ [48]byte) (types.Epoch, bool, error) {
+	return 0, false, ErrRemoteHistory
+}
+
+// RaiseLowestSignedEpochs is not available, as the attesting history is kept by the server.
+func (d *DB) RaiseLowestSignedEpochs(_ context.Context, _ [48]byte, _, _ types.Epoch) error {
+	return ErrRemoteHistory
+}
+
+// AttestedPublicKeys is not available, as the attesting history is kept by the server.
+func (d *DB) AttestedPublicKeys(_ context.Context) ([][48]byte, error) {
+	return nil, ErrRemoteHistory
+}
+
+// AttestationHistoryForPubKey is not available, as the attesting history is kept by the server.
+func (d *DB) AttestationHistoryForPubKey(_ context.Context, _ [48]byte) ([]*kv.AttestationRecord, error) {
+	return nil, ErrRemoteHistory
+}
+
+// ImportSlashingProtectionJSON imports an EIP-3076 interchange file on the server.
+func (d *DB) ImportSlashingProtectionJSON(ctx context.Context, interchangeJSON []byte) error {
+	return ImportSlashingProtection(ctx, d.client, interchangeJSON)
+}
+
+// ExportSlashingProtectionJSON exports the history kept by the server as an EIP-3076 interchange file.
+func (d *DB) ExportSlashingProtectionJSON(ctx context.Context) ([]byte, error) {
+	return ExportSlashingProtection(ctx, d.client)
+}
+
+// ImportSlashingProtection imports an EIP-3076 interchange file on the server.
+func ImportSlashingProtection(ctx context.Context, client pb.SlashingProtectionClient, interchangeJSON []byte) error {
+	_, err := client.ImportSlashingProtection(ctx, &pb.ImportSlashingProtectionRequest{
+		SlashingProtectionJson: interchangeJSON,
+	})
+	return err
+}
+
+// ExportSlashingProtection exports the history kept by the server as an EIP-3076 interchange file.
+func ExportSlashingProtection(ctx context.Context, client pb.SlashingProtectionClient) ([]byte, error) {
+	res, err := client.ExportSlashingProtection(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	return res.SlashingProtectionJson, nil
+}
+
+func slashingKind(res *pb.ProtectionResponse) (kv.SlashingKind, error) {
+	if !res.Slashable {
+		return kv.NotSlashable, nil
+	}
+	err := errors.New(res.Reason)
+	switch res.Kind {
+	case pb.ProtectionResponse_DOUBLE_VOTE:
+		return kv.DoubleVote, err
+	case pb.ProtectionResponse_SURROUNDING_VOTE:
+		return kv.SurroundingVote, err
+	case pb.ProtectionResponse_SURROUNDED_VOTE:
+		return kv.SurroundedVote, err
+	default:
+		return kv.NotSlashable, err
+	}
+}
+
+// tokenCredentials sends the API token of the client as a bearer token.
+type tokenCredentials string
+
+// GetRequestMetadata returns the authorization header of the request.
+func (t tokenCredentials) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+// RequireTransportSecurity prevents the token from being sent over insecure connections.
+func (t tokenCredentials) RequireTransportSecurity() bool {
+	return true
+}
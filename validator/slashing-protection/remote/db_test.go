@@ -0,0 +1,199 @@
+package remote
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/apiauth"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+)
+
+// writeTestCertificate writes a self-signed certificate for 127.0.0.1 and its key to a
+// temporary directory and returns their paths.
+func writeTestCertificate(t *testing.T) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "slashing-protection"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		IsCA:         true,
+
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	dir := t.TempDir()
+	certPath := filepath.Join(dir, "server.crt")
+	keyPath := filepath.Join(dir, "server.key")
+	require.NoError(t, ioutil.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, ioutil.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600))
+	return certPath, keyPath
+}
+
+func startServer(t *testing.T, pubKeys [][48]byte) (*Server, string) {
+	authorizer, err := apiauth.NewAuthorizer(&apiauth.Config{
+		Tokens: []*apiauth.TokenConfig{
+			{Name: "validators", Token: "secret", GRPCMethods: []string{"/ethereum.validator.protection.v1.SlashingProtection/*"}},
+		},
+	})
+	require.NoError(t, err)
+	certPath, keyPath := writeTestCertificate(t)
+	s := NewServer(context.Background(), &Config{
+		Host:       "127.0.0.1",
+		Port:       "0",
+		CertFlag:   certPath,
+		KeyFlag:    keyPath,
+		Authorizer: authorizer,
+		DB:         dbtest.SetupDB(t, pubKeys),
+	})
+	s.Start()
+	require.NoError(t, s.Status())
+	t.Cleanup(func() {
+		require.NoError(t, s.Stop())
+	})
+	return s, certPath
+}
+
+func setupRemoteDB(t *testing.T, endpoint, certPath, token string) *DB {
+	// Only the slashing protection methods are exercised, which never reach the local database.
+	db, err := NewDB(context.Background(), nil, &DBConfig{
+		Endpoint:       endpoint,
+		CertFlag:       certPath,
+		Token:          token,
+		RequestTimeout: time.Second,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.conn.Close())
+	})
+	return db
+}
+
+func TestDB_CheckAndSaveAttestation(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	s, certPath := startServer(t, [][48]byte{pubKey})
+
+	// Two validator clients sharing the server.
+	first := setupRemoteDB(t, s.Addr().String(), certPath, "secret")
+	second := setupRemoteDB(t, s.Addr().String(), certPath, "secret")
+
+	kind, err := first.CheckAndSaveAttestation(ctx, pubKey, [32]byte{1}, createAttestation(1, 2))
+	require.NoError(t, err)
+	assert.Equal(t, kv.NotSlashable, kind)
+
+	kind, err = second.CheckAndSaveAttestation(ctx, pubKey, [32]byte{2}, createAttestation(1, 2))
+	assert.ErrorContains(t, "lowest target epoch", err)
+	assert.Equal(t, kv.NotSlashable, kind)
+
+	require.NoError(t, second.SaveAttestationForPubKey(ctx, pubKey, [32]byte{3}, createAttestation(3, 4)))
+	kind, err = first.CheckSlashableAttestation(ctx, pubKey, [32]byte{4}, createAttestation(3, 4))
+	require.NotNil(t, err)
+	assert.Equal(t, kv.DoubleVote, kind)
+}
+
+func TestDB_CheckAndSaveProposal(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	s, certPath := startServer(t, [][48]byte{pubKey})
+	first := setupRemoteDB(t, s.Addr().String(), certPath, "secret")
+	second := setupRemoteDB(t, s.Addr().String(), certPath, "secret")
+
+	require.NoError(t, first.CheckAndSaveProposal(ctx, pubKey, 5, [32]byte{1}))
+	require.NoError(t, first.CheckAndSaveProposal(ctx, pubKey, 5, [32]byte{1}))
+	assert.ErrorContains(t, "already signed at slot 5", second.CheckAndSaveProposal(ctx, pubKey, 5, [32]byte{2}))
+
+	require.NoError(t, second.SaveProposalHistoryForSlot(ctx, pubKey, 6, make([]byte, 32)))
+	assert.ErrorContains(t, "already signed at slot 6", first.CheckAndSaveProposal(ctx, pubKey, 6, [32]byte{3}))
+}
+
+func TestDB_Unauthenticated(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	s, certPath := startServer(t, [][48]byte{pubKey})
+
+	db := setupRemoteDB(t, s.Addr().String(), certPath, "wrong")
+	_, err := db.CheckAndSaveAttestation(ctx, pubKey, [32]byte{1}, createAttestation(1, 2))
+	assert.ErrorContains(t, "Unauthenticated", err)
+	assert.ErrorContains(t, "Unauthenticated", db.CheckAndSaveProposal(ctx, pubKey, 1, [32]byte{1}))
+}
+
+func TestDB_ServerUnreachable(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	s, certPath := startServer(t, [][48]byte{pubKey})
+	endpoint := s.Addr().String()
+	require.NoError(t, s.Stop())
+
+	// Signing requests are refused rather than checked against the local history.
+	db := setupRemoteDB(t, endpoint, certPath, "secret")
+	_, err := db.CheckAndSaveAttestation(ctx, pubKey, [32]byte{1}, createAttestation(1, 2))
+	assert.ErrorContains(t, "could not check attestation with slashing protection server", err)
+	err = db.CheckAndSaveProposal(ctx, pubKey, 1, [32]byte{1})
+	assert.ErrorContains(t, "could not check proposal with slashing protection server", err)
+}
+
+func TestImportExportSlashingProtection(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	s, certPath := startServer(t, [][48]byte{pubKey})
+	require.NoError(t, s.cfg.DB.SaveGenesisValidatorsRoot(ctx, make([]byte, 32)))
+	db := setupRemoteDB(t, s.Addr().String(), certPath, "secret")
+	require.NoError(t, db.CheckAndSaveProposal(ctx, pubKey, 5, [32]byte{1}))
+
+	exported, err := ExportSlashingProtection(ctx, db.client)
+	require.NoError(t, err)
+	assert.Equal(t, true, strings.Contains(string(exported), `"slot": "5"`))
+	require.NoError(t, ImportSlashingProtection(ctx, db.client, exported))
+}
+
+func TestDB_TokenRequiresTLS(t *testing.T) {
+	_, err := NewDB(context.Background(), nil, &DBConfig{
+		Endpoint: "127.0.0.1:7600",
+		Token:    "secret",
+	})
+	assert.ErrorContains(t, "can only be sent to the slashing protection server over TLS", err)
+}
+
+func TestDB_LocalHistoryUnavailable(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	s, certPath := startServer(t, [][48]byte{pubKey})
+	db := setupRemoteDB(t, s.Addr().String(), certPath, "secret")
+
+	_, _, err := db.LowestSignedSourceEpoch(ctx, pubKey)
+	assert.ErrorContains(t, ErrRemoteHistory.Error(), err)
+	_, _, err = db.LowestSignedTargetEpoch(ctx, pubKey)
+	assert.ErrorContains(t, ErrRemoteHistory.Error(), err)
+	_, _, err = db.ProposalHistoryForSlot(ctx, pubKey, 1)
+	assert.ErrorContains(t, ErrRemoteHistory.Error(), err)
+	_, err = db.ProposedPublicKeys(ctx)
+	assert.ErrorContains(t, ErrRemoteHistory.Error(), err)
+	_, err = db.AttestationHistoryForPubKey(ctx, pubKey)
+	assert.ErrorContains(t, ErrRemoteHistory.Error(), err)
+	blacklisted, err := db.EIPImportBlacklistedPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(blacklisted))
+}
@@ -0,0 +1,19 @@
+package remote
+
+import (
+	"fmt"
+
+	pb "github.com/prysmaticlabs/prysm/proto/validator/protection/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "remote-slashing-protection")
+
+func requestFields(pubKey [48]byte, res *pb.ProtectionResponse) logrus.Fields {
+	return logrus.Fields{
+		"publicKey": fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])),
+		"kind":      res.Kind.String(),
+		"reason":    res.Reason,
+	}
+}
@@ -0,0 +1,363 @@
+// Package remote implements a slashing protection service which can be shared by a fleet of
+// validator clients over gRPC, as well as a validator database backend which delegates its
+// slashing protection checks to such a service.
+package remote
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sync"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
+	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
+	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/protection/v1"
+	"github.com/prysmaticlabs/prysm/shared/apiauth"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	spf "github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format"
+	"go.opencensus.io/plugin/ocgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+)
+
+// Config for the slashing protection server.
+type Config struct {
+	Host       string
+	Port       string
+	CertFlag   string
+	KeyFlag    string
+	Authorizer *apiauth.Authorizer
+	// InsecureNoAuth lets the server start without an Authorizer, so that any client
+	// reaching it can sign requests on behalf of the protected keys.
+	InsecureNoAuth bool
+	DB             db.Database
+}
+
+// Server serves the slashing protection history of a validator database over gRPC.
+// Checking and saving a signing request for a key happen atomically with respect to
+// any other request for the same key, so that several validator clients sharing the
+// server can never both be allowed to sign conflicting messages.
+type Server struct {
+	cfg        *Config
+	ctx        context.Context
+	cancel     context.CancelFunc
+	listener   net.Listener
+	grpcServer *grpc.Server
+	keysLock   sync.Mutex
+	keyLocks   map[[48]byte]*sync.Mutex
+	startErr   error
+}
+
+// NewServer creates a new slashing protection server.
+func NewServer(ctx context.Context, cfg *Config) *Server {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Server{
+		cfg:      cfg,
+		ctx:      ctx,
+		cancel:   cancel,
+		keyLocks: make(map[[48]byte]*sync.Mutex),
+	}
+}
+
+// Start the gRPC server.
+func (s *Server) Start() {
+	if s.cfg.Authorizer == nil && !s.cfg.InsecureNoAuth {
+		s.startErr = errors.New("no API token configuration given, refusing to start an unauthenticated " +
+			"slashing protection server")
+		log.WithError(s.startErr).Error("Could not start slashing protection server")
+		return
+	}
+	address := fmt.Sprintf("%s:%s", s.cfg.Host, s.cfg.Port)
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		s.startErr = errors.Wrapf(err, "could not listen to %s", address)
+		log.WithError(err).Errorf("Could not listen to port in Start() %s", address)
+		return
+	}
+	s.listener = lis
+	log.WithField("address", address).Info("gRPC server listening on port")
+
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		recovery.StreamServerInterceptor(
+			recovery.WithRecoveryHandlerContext(traceutil.RecoveryHandlerFunc),
+		),
+		grpc_prometheus.StreamServerInterceptor,
+		grpc_opentracing.StreamServerInterceptor(),
+	}
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		recovery.UnaryServerInterceptor(
+			recovery.WithRecoveryHandlerContext(traceutil.RecoveryHandlerFunc),
+		),
+		grpc_prometheus.UnaryServerInterceptor,
+		grpc_opentracing.UnaryServerInterceptor(),
+	}
+	if s.cfg.Authorizer != nil {
+		streamInterceptors = append(streamInterceptors, s.cfg.Authorizer.StreamServerInterceptor())
+		unaryInterceptors = append(unaryInterceptors, s.cfg.Authorizer.UnaryServerInterceptor())
+	} else {
+		log.Warn("Running without authentication, any client reaching the server can sign requests " +
+			"on behalf of the protected keys")
+	}
+	opts := []grpc.ServerOption{
+		grpc.StatsHandler(&ocgrpc.ServerHandler{}),
+		grpc.StreamInterceptor(middleware.ChainStreamServer(streamInterceptors...)),
+		grpc.UnaryInterceptor(middleware.ChainUnaryServer(unaryInterceptors...)),
+	}
+	if s.cfg.CertFlag != "" && s.cfg.KeyFlag != "" {
+		creds, err := credentials.NewServerTLSFromFile(s.cfg.CertFlag, s.cfg.KeyFlag)
+		if err != nil {
+			log.WithError(err).Fatal("Could not load TLS keys")
+		}
+		opts = append(opts, grpc.Creds(creds))
+	} else {
+		log.Warn("You are using an insecure gRPC server. Please provide a certificate and key " +
+			"to use a secure connection.")
+	}
+	s.grpcServer = grpc.NewServer(opts...)
+	pb.RegisterSlashingProtectionServer(s.grpcServer, s)
+	reflection.Register(s.grpcServer)
+
+	go func() {
+		if err := s.grpcServer.Serve(s.listener); err != nil {
+			log.WithError(err).Errorf("Could not serve gRPC")
+		}
+	}()
+}
+
+// Stop the service.
+func (s *Server) Stop() error {
+	s.cancel()
+	if s.grpcServer != nil {
+		s.grpcServer.GracefulStop()
+		log.Debug("Initiated graceful stop of gRPC server")
+	}
+	return nil
+}
+
+// Status returns an error if the server could not be started.
+func (s *Server) Status() error {
+	return s.startErr
+}
+
+// Addr returns the address the server listens on, or nil if it is not started.
+func (s *Server) Addr() net.Addr {
+	if s.listener == nil {
+		return nil
+	}
+	return s.listener.Addr()
+}
+
+// CheckSlashableAttestation checks whether signing the attestation would be slashable
+// given the attesting history of the key.
+func (s *Server) CheckSlashableAttestation(ctx context.Context, req *pb.AttestationRequest) (*pb.ProtectionResponse, error) {
+	pubKey, signingRoot, err := validateAttestationRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	unlock := s.lockKey(pubKey)
+	defer unlock()
+	res, err := checkAttestation(ctx, s.cfg.DB, pubKey, signingRoot, req.Attestation)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not check attestation: %v", err)
+	}
+	return res, nil
+}
+
+// SaveAttestationForPubKey saves the attestation to the attesting history of the key.
+func (s *Server) SaveAttestationForPubKey(ctx context.Context, req *pb.AttestationRequest) (*empty.Empty, error) {
+	pubKey, signingRoot, err := validateAttestationRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	unlock := s.lockKey(pubKey)
+	defer unlock()
+	if err := s.cfg.DB.SaveAttestationForPubKey(ctx, pubKey, signingRoot, req.Attestation); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not save attestation: %v", err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// CheckAndSaveAttestation checks whether signing the attestation would be slashable and,
+// if it is not, saves it to the attesting history of the key before returning.
+func (s *Server) CheckAndSaveAttestation(ctx context.Context, req *pb.AttestationRequest) (*pb.ProtectionResponse, error) {
+	pubKey, signingRoot, err := validateAttestationRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	unlock := s.lockKey(pubKey)
+	defer unlock()
+	res, err := checkAttestation(ctx, s.cfg.DB, pubKey, signingRoot, req.Attestation)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not check attestation: %v", err)
+	}
+	if res.Slashable {
+		log.WithFields(requestFields(pubKey, res)).Warn("Refused slashable attestation")
+		return res, nil
+	}
+	if err := s.cfg.DB.SaveAttestationForPubKey(ctx, pubKey, signingRoot, req.Attestation); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not save attestation: %v", err)
+	}
+	return res, nil
+}
+
+// CheckSlashableProposal checks whether signing a block at the slot would be slashable
+// given the proposal history of the key.
+func (s *Server) CheckSlashableProposal(ctx context.Context, req *pb.ProposalRequest) (*pb.ProtectionResponse, error) {
+	pubKey, signingRoot, err := validateProposalRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	unlock := s.lockKey(pubKey)
+	defer unlock()
+	res, err := checkProposal(ctx, s.cfg.DB, pubKey, req.Slot, signingRoot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not check proposal: %v", err)
+	}
+	return res, nil
+}
+
+// SaveProposalForPubKey saves the proposal to the proposal history of the key.
+func (s *Server) SaveProposalForPubKey(ctx context.Context, req *pb.ProposalRequest) (*empty.Empty, error) {
+	pubKey, _, err := validateProposalRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	unlock := s.lockKey(pubKey)
+	defer unlock()
+	if err := s.cfg.DB.SaveProposalHistoryForSlot(ctx, pubKey, req.Slot, req.SigningRoot); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not save proposal: %v", err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// CheckAndSaveProposal checks whether signing a block at the slot would be slashable and,
+// if it is not, saves it to the proposal history of the key before returning.
+func (s *Server) CheckAndSaveProposal(ctx context.Context, req *pb.ProposalRequest) (*pb.ProtectionResponse, error) {
+	pubKey, signingRoot, err := validateProposalRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	unlock := s.lockKey(pubKey)
+	defer unlock()
+	res, err := checkProposal(ctx, s.cfg.DB, pubKey, req.Slot, signingRoot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not check proposal: %v", err)
+	}
+	if res.Slashable {
+		log.WithFields(requestFields(pubKey, res)).Warn("Refused slashable proposal")
+		return res, nil
+	}
+	if err := s.cfg.DB.SaveProposalHistoryForSlot(ctx, pubKey, req.Slot, req.SigningRoot); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not save proposal: %v", err)
+	}
+	return res, nil
+}
+
+// ImportSlashingProtection imports an EIP-3076 slashing protection interchange file.
+func (s *Server) ImportSlashingProtection(
+	ctx context.Context, req *pb.ImportSlashingProtectionRequest,
+) (*empty.Empty, error) {
+	if len(req.SlashingProtectionJson) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Empty slashing protection json specified")
+	}
+	// Imports rewrite the history of many keys at once, so no other request may run concurrently.
+	s.keysLock.Lock()
+	defer s.keysLock.Unlock()
+	for _, l := range s.keyLocks {
+		l.Lock()
+	}
+	defer func() {
+		for _, l := range s.keyLocks {
+			l.Unlock()
+		}
+	}()
+	if err := spf.ImportStandardProtectionJSON(ctx, s.cfg.DB, bytes.NewReader(req.SlashingProtectionJson)); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not import slashing protection history: %v", err)
+	}
+	log.Info("Slashing protection JSON successfully imported")
+	return &empty.Empty{}, nil
+}
+
+// ExportSlashingProtection exports the slashing protection history of all keys as an
+// EIP-3076 interchange file.
+func (s *Server) ExportSlashingProtection(ctx context.Context, _ *empty.Empty) (*pb.ExportSlashingProtectionResponse, error) {
+	eipJSON, err := spf.ExportStandardProtectionJSON(ctx, s.cfg.DB)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not export slashing protection history: %v", err)
+	}
+	encoded, err := json.MarshalIndent(eipJSON, "", "\t")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not marshal slashing protection history: %v", err)
+	}
+	return &pb.ExportSlashingProtectionResponse{
+		SlashingProtectionJson: encoded,
+	}, nil
+}
+
+// lockKey serializes the requests for a public key and returns the function releasing the lock.
+func (s *Server) lockKey(pubKey [48]byte) func() {
+	s.keysLock.Lock()
+	l, ok := s.keyLocks[pubKey]
+	if !ok {
+		l = &sync.Mutex{}
+		s.keyLocks[pubKey] = l
+	}
+	s.keysLock.Unlock()
+	l.Lock()
+	return l.Unlock
+}
+
+func validateAttestationRequest(req *pb.AttestationRequest) ([48]byte, [32]byte, error) {
+	pubKey, signingRoot, err := validateKeyAndRoot(req.PublicKey, req.SigningRoot)
+	if err != nil {
+		return pubKey, signingRoot, err
+	}
+	att := req.Attestation
+	if att == nil || att.Data == nil || att.Data.Source == nil || att.Data.Target == nil {
+		return pubKey, signingRoot, status.Error(codes.InvalidArgument, "Attestation data is missing")
+	}
+	return pubKey, signingRoot, nil
+}
+
+func validateProposalRequest(req *pb.ProposalRequest) ([48]byte, [32]byte, error) {
+	return validateKeyAndRoot(req.PublicKey, req.SigningRoot)
+}
+
+func validateKeyAndRoot(pubKey, signingRoot []byte) ([48]byte, [32]byte, error) {
+	if len(pubKey) != 48 {
+		return [48]byte{}, [32]byte{}, status.Errorf(codes.InvalidArgument, "Public key must be 48 bytes, got %d", len(pubKey))
+	}
+	if len(signingRoot) != 32 {
+		return [48]byte{}, [32]byte{}, status.Errorf(codes.InvalidArgument, "Signing root must be 32 bytes, got %d", len(signingRoot))
+	}
+	return bytesutil.ToBytes48(pubKey), bytesutil.ToBytes32(signingRoot), nil
+}
+
+func attestationRequest(pubKey [48]byte, signingRoot [32]byte, att *ethpb.IndexedAttestation) *pb.AttestationRequest {
+	return &pb.AttestationRequest{
+		PublicKey:   pubKey[:],
+		SigningRoot: signingRoot[:],
+		Attestation: att,
+	}
+}
+
+func proposalRequest(pubKey [48]byte, slot types.Slot, signingRoot []byte) *pb.ProposalRequest {
+	return &pb.ProposalRequest{
+		PublicKey:   pubKey[:],
+		SigningRoot: signingRoot,
+		Slot:        slot,
+	}
+}
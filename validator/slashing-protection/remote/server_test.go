@@ -0,0 +1,207 @@
+package remote
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/protection/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+	"github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format/format"
+	mocks "github.com/prysmaticlabs/prysm/validator/testing"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func createAttestation(source, target types.Epoch) *ethpb.IndexedAttestation {
+	return &ethpb.IndexedAttestation{
+		Data: &ethpb.AttestationData{
+			BeaconBlockRoot: make([]byte, 32),
+			Source:          &ethpb.Checkpoint{Epoch: source, Root: make([]byte, 32)},
+			Target:          &ethpb.Checkpoint{Epoch: target, Root: make([]byte, 32)},
+		},
+	}
+}
+
+func setupServer(t *testing.T, pubKeys [][48]byte) *Server {
+	return NewServer(context.Background(), &Config{DB: dbtest.SetupDB(t, pubKeys)})
+}
+
+func TestServer_CheckAndSaveAttestation(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	s := setupServer(t, [][48]byte{pubKey})
+
+	res, err := s.CheckAndSaveAttestation(ctx, attestationRequest(pubKey, [32]byte{1}, createAttestation(1, 2)))
+	require.NoError(t, err)
+	assert.Equal(t, false, res.Slashable)
+
+	// Signing the same attestation again is safe.
+	res, err = s.CheckAndSaveAttestation(ctx, attestationRequest(pubKey, [32]byte{1}, createAttestation(1, 2)))
+	require.NoError(t, err)
+	assert.Equal(t, false, res.Slashable)
+
+	for _, att := range []*ethpb.IndexedAttestation{createAttestation(3, 4), createAttestation(4, 10)} {
+		res, err = s.CheckAndSaveAttestation(ctx, attestationRequest(pubKey, [32]byte{2}, att))
+		require.NoError(t, err)
+		assert.Equal(t, false, res.Slashable)
+	}
+
+	tests := []struct {
+		name string
+		att  *ethpb.IndexedAttestation
+		kind pb.ProtectionResponse_SlashingKind
+	}{
+		{name: "double vote", att: createAttestation(1, 10), kind: pb.ProtectionResponse_DOUBLE_VOTE},
+		{name: "surrounding vote", att: createAttestation(2, 5), kind: pb.ProtectionResponse_SURROUNDING_VOTE},
+		{name: "surrounded vote", att: createAttestation(5, 6), kind: pb.ProtectionResponse_SURROUNDED_VOTE},
+		{name: "below minimum source", att: createAttestation(0, 11), kind: pb.ProtectionResponse_BELOW_MINIMUM},
+		{name: "below minimum target", att: createAttestation(1, 2), kind: pb.ProtectionResponse_BELOW_MINIMUM},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := s.CheckAndSaveAttestation(ctx, attestationRequest(pubKey, [32]byte{3}, tt.att))
+			require.NoError(t, err)
+			assert.Equal(t, true, res.Slashable)
+			assert.Equal(t, tt.kind, res.Kind)
+			assert.NotEqual(t, "", res.Reason)
+		})
+	}
+
+	// Checking an attestation does not record it.
+	res, err = s.CheckSlashableAttestation(ctx, attestationRequest(pubKey, [32]byte{4}, createAttestation(10, 11)))
+	require.NoError(t, err)
+	assert.Equal(t, false, res.Slashable)
+	res, err = s.CheckSlashableAttestation(ctx, attestationRequest(pubKey, [32]byte{5}, createAttestation(10, 11)))
+	require.NoError(t, err)
+	assert.Equal(t, false, res.Slashable)
+}
+
+func TestServer_CheckAndSaveAttestation_Concurrent(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	s := setupServer(t, [][48]byte{pubKey})
+
+	// Many clients trying to sign conflicting attestations for the same target
+	// must result in exactly one of them being allowed to sign.
+	numClients := 10
+	var wg sync.WaitGroup
+	var lock sync.Mutex
+	allowed := 0
+	for i := 0; i < numClients; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			res, err := s.CheckAndSaveAttestation(ctx, attestationRequest(pubKey, [32]byte{byte(i + 1)}, createAttestation(1, 2)))
+			require.NoError(t, err)
+			if !res.Slashable {
+				lock.Lock()
+				allowed++
+				lock.Unlock()
+			}
+		}(i)
+	}
+	wg.Wait()
+	assert.Equal(t, 1, allowed)
+}
+
+func TestServer_CheckAndSaveProposal(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	s := setupServer(t, [][48]byte{pubKey})
+
+	res, err := s.CheckAndSaveProposal(ctx, proposalRequest(pubKey, 10, []byte{1: 1, 31: 0}))
+	require.NoError(t, err)
+	assert.Equal(t, false, res.Slashable)
+
+	res, err = s.CheckAndSaveProposal(ctx, proposalRequest(pubKey, 10, []byte{2: 1, 31: 0}))
+	require.NoError(t, err)
+	assert.Equal(t, true, res.Slashable)
+	assert.Equal(t, pb.ProtectionResponse_DOUBLE_PROPOSAL, res.Kind)
+
+	res, err = s.CheckSlashableProposal(ctx, proposalRequest(pubKey, 9, []byte{3: 1, 31: 0}))
+	require.NoError(t, err)
+	assert.Equal(t, true, res.Slashable)
+	assert.Equal(t, pb.ProtectionResponse_BELOW_MINIMUM, res.Kind)
+
+	res, err = s.CheckAndSaveProposal(ctx, proposalRequest(pubKey, 11, []byte{4: 1, 31: 0}))
+	require.NoError(t, err)
+	assert.Equal(t, false, res.Slashable)
+}
+
+func TestServer_Blacklisted(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	s := setupServer(t, [][48]byte{pubKey})
+	require.NoError(t, s.cfg.DB.SaveEIPImportBlacklistedPublicKeys(ctx, [][48]byte{pubKey}))
+
+	res, err := s.CheckAndSaveAttestation(ctx, attestationRequest(pubKey, [32]byte{1}, createAttestation(1, 2)))
+	require.NoError(t, err)
+	assert.Equal(t, true, res.Slashable)
+	assert.Equal(t, pb.ProtectionResponse_BLACKLISTED, res.Kind)
+	res, err = s.CheckAndSaveProposal(ctx, proposalRequest(pubKey, 1, make([]byte, 32)))
+	require.NoError(t, err)
+	assert.Equal(t, true, res.Slashable)
+	assert.Equal(t, pb.ProtectionResponse_BLACKLISTED, res.Kind)
+}
+
+func TestServer_StartWithoutAuthorizer(t *testing.T) {
+	validatorDB := dbtest.SetupDB(t, nil)
+	s := NewServer(context.Background(), &Config{Host: "127.0.0.1", Port: "0", DB: validatorDB})
+	s.Start()
+	assert.ErrorContains(t, "refusing to start an unauthenticated slashing protection server", s.Status())
+	assert.Equal(t, nil, s.Addr())
+
+	s = NewServer(context.Background(), &Config{
+		Host:           "127.0.0.1",
+		Port:           "0",
+		InsecureNoAuth: true,
+		DB:             validatorDB,
+	})
+	s.Start()
+	require.NoError(t, s.Status())
+	require.NoError(t, s.Stop())
+}
+
+func TestServer_InvalidRequests(t *testing.T) {
+	ctx := context.Background()
+	s := setupServer(t, nil)
+
+	_, err := s.CheckAndSaveAttestation(ctx, &pb.AttestationRequest{PublicKey: []byte{1}, SigningRoot: make([]byte, 32)})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = s.CheckAndSaveAttestation(ctx, &pb.AttestationRequest{PublicKey: make([]byte, 48), SigningRoot: make([]byte, 32)})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = s.CheckAndSaveProposal(ctx, &pb.ProposalRequest{PublicKey: make([]byte, 48), SigningRoot: []byte{1}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = s.ImportSlashingProtection(ctx, &pb.ImportSlashingProtectionRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_ImportExportSlashingProtection(t *testing.T) {
+	ctx := context.Background()
+	pubKeys, err := mocks.CreateRandomPubKeys(3)
+	require.NoError(t, err)
+	s := setupServer(t, pubKeys)
+
+	attestingHistory, proposalHistory := mocks.MockAttestingAndProposalHistories(pubKeys)
+	mockJSON, err := mocks.MockSlashingProtectionJSON(pubKeys, attestingHistory, proposalHistory)
+	require.NoError(t, err)
+	encoded, err := json.Marshal(mockJSON)
+	require.NoError(t, err)
+
+	_, err = s.ImportSlashingProtection(ctx, &pb.ImportSlashingProtectionRequest{SlashingProtectionJson: encoded})
+	require.NoError(t, err)
+
+	res, err := s.ExportSlashingProtection(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	exported := &format.EIPSlashingProtectionFormat{}
+	require.NoError(t, json.Unmarshal(res.SlashingProtectionJson, exported))
+	assert.Equal(t, mockJSON.Metadata.GenesisValidatorsRoot, exported.Metadata.GenesisValidatorsRoot)
+	assert.Equal(t, len(pubKeys), len(exported.Data))
+}